@@ -368,17 +368,15 @@ func TestTags(t *testing.T) {
 	require.True(t, ok, "expected content to be of type TextContent")
 
 	var trimmedTags []struct {
-		Name   string `json:"name"`
-		Commit struct {
-			SHA string `json:"sha"`
-		} `json:"commit"`
+		Name string `json:"name"`
+		SHA  string `json:"sha"`
 	}
 	err = json.Unmarshal([]byte(textContent.Text), &trimmedTags)
 	require.NoError(t, err, "expected to unmarshal text content successfully")
 
 	require.Len(t, trimmedTags, 1, "expected to find one tag")
 	require.Equal(t, "v0.0.1", trimmedTags[0].Name, "expected tag name to match")
-	require.Equal(t, *ref.Object.SHA, trimmedTags[0].Commit.SHA, "expected tag SHA to match")
+	require.Equal(t, *ref.Object.SHA, trimmedTags[0].SHA, "expected tag SHA to match")
 
 	// And fetch an individual tag
 	getTagRequest := mcp.CallToolRequest{}
@@ -881,7 +879,7 @@ func TestRequestCopilotReview(t *testing.T) {
 	require.True(t, ok, "expected content to be of type TextContent")
 
 	var trimmedCommitText struct {
-		SHA string `json:"sha"`
+		SHA string `json:"commit_sha"`
 	}
 	err = json.Unmarshal([]byte(textContent.Text), &trimmedCommitText)
 	require.NoError(t, err, "expected to unmarshal text content successfully")
@@ -1132,13 +1130,11 @@ func TestPullRequestAtomicCreateAndSubmit(t *testing.T) {
 	require.True(t, ok, "expected content to be of type TextContent")
 
 	var trimmedCommitText struct {
-		Commit struct {
-			SHA string `json:"sha"`
-		} `json:"commit"`
+		CommitSHA string `json:"commit_sha"`
 	}
 	err = json.Unmarshal([]byte(textContent.Text), &trimmedCommitText)
 	require.NoError(t, err, "expected to unmarshal text content successfully")
-	commitID := trimmedCommitText.Commit.SHA
+	commitID := trimmedCommitText.CommitSHA
 
 	// Create a pull request
 	prRequest := mcp.CallToolRequest{}
@@ -1292,13 +1288,11 @@ func TestPullRequestReviewCommentSubmit(t *testing.T) {
 	require.True(t, ok, "expected content to be of type TextContent")
 
 	var trimmedCommitText struct {
-		Commit struct {
-			SHA string `json:"sha"`
-		} `json:"commit"`
+		CommitSHA string `json:"commit_sha"`
 	}
 	err = json.Unmarshal([]byte(textContent.Text), &trimmedCommitText)
 	require.NoError(t, err, "expected to unmarshal text content successfully")
-	commitId := trimmedCommitText.Commit.SHA
+	commitId := trimmedCommitText.CommitSHA
 
 	// Create a pull request
 	prRequest := mcp.CallToolRequest{}
@@ -1,13 +1,23 @@
 package github
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/translations"
@@ -81,10 +91,48 @@ func ListWorkflows(getClient GetClientFn, t translations.TranslationHelperFunc)
 		}
 }
 
-// ListWorkflowRuns creates a tool to list workflow runs for a specific workflow
+// WorkflowRunSummary is the compact form of a workflow run returned by list_workflow_runs.
+type WorkflowRunSummary struct {
+	ID           int64  `json:"id"`
+	RunNumber    int    `json:"run_number"`
+	WorkflowName string `json:"workflow_name"`
+	HeadBranch   string `json:"head_branch"`
+	HeadSHA      string `json:"head_sha"`
+	Event        string `json:"event"`
+	Status       string `json:"status"`
+	Conclusion   string `json:"conclusion,omitempty"`
+	CreatedAt    string `json:"created_at,omitempty"`
+	UpdatedAt    string `json:"updated_at,omitempty"`
+	HTMLURL      string `json:"html_url"`
+}
+
+// WorkflowRunList is the result of list_workflow_runs.
+type WorkflowRunList struct {
+	TotalCount   int                  `json:"total_count"`
+	WorkflowRuns []WorkflowRunSummary `json:"workflow_runs"`
+}
+
+// summarizeWorkflowRun converts a workflow run into its compact summary form.
+func summarizeWorkflowRun(run *github.WorkflowRun) WorkflowRunSummary {
+	return WorkflowRunSummary{
+		ID:           run.GetID(),
+		RunNumber:    run.GetRunNumber(),
+		WorkflowName: run.GetName(),
+		HeadBranch:   run.GetHeadBranch(),
+		HeadSHA:      run.GetHeadSHA(),
+		Event:        run.GetEvent(),
+		Status:       run.GetStatus(),
+		Conclusion:   run.GetConclusion(),
+		CreatedAt:    summaryTimestamp(run.GetCreatedAt()),
+		UpdatedAt:    summaryTimestamp(run.GetUpdatedAt()),
+		HTMLURL:      run.GetHTMLURL(),
+	}
+}
+
+// ListWorkflowRuns creates a tool to list workflow runs for a repository or a specific workflow
 func ListWorkflowRuns(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("list_workflow_runs",
-			mcp.WithDescription(t("TOOL_LIST_WORKFLOW_RUNS_DESCRIPTION", "List workflow runs for a specific workflow")),
+			mcp.WithDescription(t("TOOL_LIST_WORKFLOW_RUNS_DESCRIPTION", "List workflow runs of a repository, or of a specific workflow, with their status, conclusion and head branch")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_LIST_WORKFLOW_RUNS_USER_TITLE", "List workflow runs"),
 				ReadOnlyHint: ToBoolPtr(true),
@@ -98,8 +146,7 @@ func ListWorkflowRuns(getClient GetClientFn, t translations.TranslationHelperFun
 				mcp.Description(DescriptionRepositoryName),
 			),
 			mcp.WithString("workflow_id",
-				mcp.Required(),
-				mcp.Description("The workflow ID or workflow file name"),
+				mcp.Description("The workflow ID or workflow file name. Omit to list the runs of all workflows"),
 			),
 			mcp.WithString("actor",
 				mcp.Description("Returns someone's workflow runs. Use the login for the user who created the workflow run."),
@@ -145,8 +192,26 @@ func ListWorkflowRuns(getClient GetClientFn, t translations.TranslationHelperFun
 				),
 			),
 			mcp.WithString("status",
-				mcp.Description("Returns workflow runs with the check run status"),
-				mcp.Enum("queued", "in_progress", "completed", "requested", "waiting"),
+				mcp.Description("Returns workflow runs with this status or conclusion"),
+				mcp.Enum(
+					"queued",
+					"in_progress",
+					"completed",
+					"requested",
+					"waiting",
+					"pending",
+					"action_required",
+					"cancelled",
+					"failure",
+					"neutral",
+					"skipped",
+					"stale",
+					"success",
+					"timed_out",
+				),
+			),
+			mcp.WithString("created",
+				mcp.Description("Returns workflow runs created in a date range, such as >=2025-01-01 or 2025-01-01..2025-01-07"),
 			),
 			WithPagination(),
 		),
@@ -159,7 +224,7 @@ func ListWorkflowRuns(getClient GetClientFn, t translations.TranslationHelperFun
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			workflowID, err := RequiredParam[string](request, "workflow_id")
+			workflowID, err := OptionalParam[string](request, "workflow_id")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
@@ -181,6 +246,10 @@ func ListWorkflowRuns(getClient GetClientFn, t translations.TranslationHelperFun
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			created, err := OptionalParam[string](request, "created")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
 			// Get optional pagination parameters
 			pagination, err := OptionalPaginationParams(request)
@@ -195,35 +264,126 @@ func ListWorkflowRuns(getClient GetClientFn, t translations.TranslationHelperFun
 
 			// Set up list options
 			opts := &github.ListWorkflowRunsOptions{
-				Actor:  actor,
-				Branch: branch,
-				Event:  event,
-				Status: status,
+				Actor:   actor,
+				Branch:  branch,
+				Event:   event,
+				Status:  status,
+				Created: created,
 				ListOptions: github.ListOptions{
 					PerPage: pagination.PerPage,
 					Page:    pagination.Page,
 				},
 			}
 
-			workflowRuns, resp, err := client.Actions.ListWorkflowRunsByFileName(ctx, owner, repo, workflowID, opts)
+			var (
+				workflowRuns *github.WorkflowRuns
+				resp         *github.Response
+			)
+			if workflowID == "" {
+				workflowRuns, resp, err = client.Actions.ListRepositoryWorkflowRuns(ctx, owner, repo, opts)
+			} else {
+				// The workflow file name endpoint also accepts a workflow ID.
+				workflowRuns, resp, err = client.Actions.ListWorkflowRunsByFileName(ctx, owner, repo, workflowID, opts)
+			}
 			if err != nil {
-				return nil, fmt.Errorf("failed to list workflow runs: %w", err)
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list workflow runs", resp, err), nil
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			r, err := json.Marshal(workflowRuns)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			result := WorkflowRunList{
+				TotalCount:   workflowRuns.GetTotalCount(),
+				WorkflowRuns: make([]WorkflowRunSummary, 0, len(workflowRuns.WorkflowRuns)),
+			}
+			for _, run := range workflowRuns.WorkflowRuns {
+				result.WorkflowRuns = append(result.WorkflowRuns, summarizeWorkflowRun(run))
 			}
 
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledTextResult(result), nil
+		}
+}
+
+// workflowRunPollInterval and workflowRunPollTimeout control how long run_workflow waits for the run it
+// dispatched to show up. They are variables so that tests can shorten them.
+var (
+	workflowRunPollInterval = 2 * time.Second
+	workflowRunPollTimeout  = 30 * time.Second
+)
+
+// workflowDispatchInputs checks that the inputs of a workflow dispatch are a flat map of strings, numbers
+// and booleans, and converts the values to the strings GitHub expects.
+func workflowDispatchInputs(args map[string]any) (map[string]any, error) {
+	raw, ok := args["inputs"]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	rawInputs, ok := raw.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("inputs must be an object mapping input names to values")
+	}
+
+	inputs := make(map[string]any, len(rawInputs))
+	for name, value := range rawInputs {
+		switch v := value.(type) {
+		case string:
+			inputs[name] = v
+		case bool:
+			inputs[name] = strconv.FormatBool(v)
+		case float64:
+			inputs[name] = strconv.FormatFloat(v, 'f', -1, 64)
+		case int:
+			inputs[name] = strconv.Itoa(v)
+		default:
+			return nil, fmt.Errorf("input %q must be a string, number or boolean", name)
+		}
+	}
+	return inputs, nil
+}
+
+// waitForDispatchedWorkflowRun polls the workflow_dispatch runs of a workflow on ref that actor created since
+// dispatchedAt until one shows up or workflowRunPollTimeout passes, in which case it returns nil. Filtering
+// by ref and actor keeps runs dispatched concurrently by someone else, or on another ref, from being returned.
+func waitForDispatchedWorkflowRun(ctx context.Context, client *github.Client, owner, repo, workflowID, ref, actor string, dispatchedAt time.Time) (*github.WorkflowRun, *github.Response, error) {
+	opts := &github.ListWorkflowRunsOptions{
+		Actor:       actor,
+		Branch:      strings.TrimPrefix(strings.TrimPrefix(ref, "refs/heads/"), "refs/tags/"),
+		Event:       "workflow_dispatch",
+		Created:     ">=" + dispatchedAt.Format(time.RFC3339),
+		ListOptions: github.ListOptions{PerPage: 1},
+	}
+	deadline := timeNow().Add(workflowRunPollTimeout)
+	for {
+		var runs *github.WorkflowRuns
+		var resp *github.Response
+		var err error
+		if workflowIDInt, parseErr := strconv.ParseInt(workflowID, 10, 64); parseErr == nil {
+			runs, resp, err = client.Actions.ListWorkflowRunsByID(ctx, owner, repo, workflowIDInt, opts)
+		} else {
+			runs, resp, err = client.Actions.ListWorkflowRunsByFileName(ctx, owner, repo, workflowID, opts)
+		}
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+
+		if len(runs.WorkflowRuns) > 0 {
+			return runs.WorkflowRuns[0], resp, nil
+		}
+		if !timeNow().Before(deadline) {
+			return nil, resp, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		case <-time.After(workflowRunPollInterval):
 		}
+	}
 }
 
 // RunWorkflow creates a tool to run an Actions workflow
 func RunWorkflow(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("run_workflow",
-			mcp.WithDescription(t("TOOL_RUN_WORKFLOW_DESCRIPTION", "Run an Actions workflow by workflow ID or filename")),
+			mcp.WithDescription(t("TOOL_RUN_WORKFLOW_DESCRIPTION", "Run an Actions workflow by workflow ID or filename. The workflow must have a workflow_dispatch trigger")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_RUN_WORKFLOW_USER_TITLE", "Run workflow"),
 				ReadOnlyHint: ToBoolPtr(false),
@@ -245,7 +405,10 @@ func RunWorkflow(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 				mcp.Description("The git reference for the workflow. The reference can be a branch or tag name."),
 			),
 			mcp.WithObject("inputs",
-				mcp.Description("Inputs the workflow accepts"),
+				mcp.Description("Inputs the workflow accepts, as a flat object of string, number or boolean values"),
+			),
+			mcp.WithBoolean("wait_for_run",
+				mcp.Description("Wait up to 30 seconds for the workflow run to be created and return its ID"),
 			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -265,13 +428,13 @@ func RunWorkflow(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-
-			// Get optional inputs parameter
-			var inputs map[string]interface{}
-			if requestInputs, ok := request.GetArguments()["inputs"]; ok {
-				if inputsMap, ok := requestInputs.(map[string]interface{}); ok {
-					inputs = inputsMap
-				}
+			inputs, err := workflowDispatchInputs(request.GetArguments())
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			waitForRun, err := OptionalParam[bool](request, "wait_for_run")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
 			}
 
 			client, err := getClient(ctx)
@@ -279,6 +442,17 @@ func RunWorkflow(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
 
+			// The run is looked up by its actor, so the authenticated user is needed before dispatching.
+			var actor string
+			if waitForRun {
+				user, userResp, err := client.Users.Get(ctx, "")
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get the authenticated user", userResp, err), nil
+				}
+				_ = userResp.Body.Close()
+				actor = user.GetLogin()
+			}
+
 			event := github.CreateWorkflowDispatchEventRequest{
 				Ref:    ref,
 				Inputs: inputs,
@@ -287,6 +461,9 @@ func RunWorkflow(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 			var resp *github.Response
 			var workflowType string
 
+			// The dispatch does not return the run it creates, so the run is looked up among the runs
+			// created since just before the dispatch.
+			dispatchedAt := timeNow().Truncate(time.Second)
 			if workflowIDInt, parseErr := strconv.ParseInt(workflowID, 10, 64); parseErr == nil {
 				resp, err = client.Actions.CreateWorkflowDispatchEventByID(ctx, owner, repo, workflowIDInt, event)
 				workflowType = "workflow_id"
@@ -296,7 +473,14 @@ func RunWorkflow(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 			}
 
 			if err != nil {
-				return nil, fmt.Errorf("failed to run workflow: %w", err)
+				var errResp *github.ErrorResponse
+				if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity && errors.As(err, &errResp) &&
+					strings.Contains(errResp.Message, "workflow_dispatch") {
+					message := fmt.Sprintf("workflow %s cannot be run manually because it has no workflow_dispatch trigger on %s; add an on.workflow_dispatch trigger to the workflow file", workflowID, ref)
+					_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, message, resp, err)
+					return mcp.NewToolResultError(message), nil
+				}
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to run workflow", resp, err), nil
 			}
 			defer func() { _ = resp.Body.Close() }()
 
@@ -310,6 +494,19 @@ func RunWorkflow(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 				"status_code":   resp.StatusCode,
 			}
 
+			if waitForRun {
+				run, runResp, err := waitForDispatchedWorkflowRun(ctx, client, owner, repo, workflowID, ref, actor, dispatchedAt)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "workflow run has been queued, but failed to look it up", runResp, err), nil
+				}
+				if run != nil {
+					result["run_id"] = run.GetID()
+					result["run_url"] = run.GetHTMLURL()
+				} else {
+					result["message"] = fmt.Sprintf("Workflow run has been queued, but it did not show up within %s; use list_workflow_runs to find it", workflowRunPollTimeout)
+				}
+			}
+
 			r, err := json.Marshal(result)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
@@ -319,10 +516,76 @@ func RunWorkflow(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 		}
 }
 
+// WorkflowJobSummary is the compact form of a workflow job returned by get_workflow_run.
+type WorkflowJobSummary struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Status      string `json:"status"`
+	Conclusion  string `json:"conclusion,omitempty"`
+	StartedAt   string `json:"started_at,omitempty"`
+	CompletedAt string `json:"completed_at,omitempty"`
+	// FailedSteps are the names of the steps that failed or timed out, which usually tells what went
+	// wrong without reading the logs.
+	FailedSteps []string `json:"failed_steps,omitempty"`
+	HTMLURL     string   `json:"html_url"`
+}
+
+// WorkflowRunDetails is the result of get_workflow_run.
+type WorkflowRunDetails struct {
+	WorkflowRunSummary
+	Actor        string `json:"actor,omitempty"`
+	RunStartedAt string `json:"run_started_at,omitempty"`
+	RunAttempt   int    `json:"run_attempt"`
+	// LatestAttempt is the number of the most recent attempt of the run, which is newer than RunAttempt
+	// when the run was re-run since.
+	LatestAttempt      int                  `json:"latest_attempt"`
+	NewerAttemptExists bool                 `json:"newer_attempt_exists"`
+	Jobs               []WorkflowJobSummary `json:"jobs,omitempty"`
+}
+
+// summarizeWorkflowJob converts a workflow job into its compact summary form.
+func summarizeWorkflowJob(job *github.WorkflowJob) WorkflowJobSummary {
+	summary := WorkflowJobSummary{
+		ID:          job.GetID(),
+		Name:        job.GetName(),
+		Status:      job.GetStatus(),
+		Conclusion:  job.GetConclusion(),
+		StartedAt:   summaryTimestamp(job.GetStartedAt()),
+		CompletedAt: summaryTimestamp(job.GetCompletedAt()),
+		HTMLURL:     job.GetHTMLURL(),
+	}
+	for _, step := range job.Steps {
+		if isFailedConclusion(step.GetConclusion()) {
+			summary.FailedSteps = append(summary.FailedSteps, step.GetName())
+		}
+	}
+	return summary
+}
+
+// listAllWorkflowJobs lists the jobs of an attempt of a workflow run, following pagination as matrix
+// builds can have more jobs than fit in a page.
+func listAllWorkflowJobs(ctx context.Context, client *github.Client, owner, repo string, runID, attempt int64) ([]*github.WorkflowJob, *github.Response, error) {
+	var allJobs []*github.WorkflowJob
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		jobs, resp, err := client.Actions.ListWorkflowJobsAttempt(ctx, owner, repo, runID, attempt, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+
+		allJobs = append(allJobs, jobs.Jobs...)
+		if resp.NextPage == 0 {
+			return allJobs, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
 // GetWorkflowRun creates a tool to get details of a specific workflow run
 func GetWorkflowRun(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("get_workflow_run",
-			mcp.WithDescription(t("TOOL_GET_WORKFLOW_RUN_DESCRIPTION", "Get details of a specific workflow run")),
+			mcp.WithDescription(t("TOOL_GET_WORKFLOW_RUN_DESCRIPTION", "Get details of a specific workflow run, including its attempt number and, with include_jobs, the status of each job and the names of its failed steps")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_GET_WORKFLOW_RUN_USER_TITLE", "Get workflow run"),
 				ReadOnlyHint: ToBoolPtr(true),
@@ -339,6 +602,12 @@ func GetWorkflowRun(getClient GetClientFn, t translations.TranslationHelperFunc)
 				mcp.Required(),
 				mcp.Description("The unique identifier of the workflow run"),
 			),
+			mcp.WithNumber("attempt",
+				mcp.Description("Attempt of the run to get. Defaults to the latest attempt"),
+			),
+			mcp.WithBoolean("include_jobs",
+				mcp.Description("Include a summary of each job of the attempt, with the names of its failed steps"),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](request, "owner")
@@ -354,24 +623,63 @@ func GetWorkflowRun(getClient GetClientFn, t translations.TranslationHelperFunc)
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 			runID := int64(runIDInt)
+			attempt, err := OptionalIntParam(request, "attempt")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if attempt < 0 {
+				return mcp.NewToolResultError("attempt must be at least 1"), nil
+			}
+			includeJobs, err := OptionalParam[bool](request, "include_jobs")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
 
+			// The run is always fetched to know its latest attempt.
 			workflowRun, resp, err := client.Actions.GetWorkflowRunByID(ctx, owner, repo, runID)
 			if err != nil {
-				return nil, fmt.Errorf("failed to get workflow run: %w", err)
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get workflow run", resp, err), nil
 			}
-			defer func() { _ = resp.Body.Close() }()
+			_ = resp.Body.Close()
 
-			r, err := json.Marshal(workflowRun)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			latestAttempt := workflowRun.GetRunAttempt()
+			if attempt > latestAttempt {
+				return mcp.NewToolResultError(fmt.Sprintf("workflow run %d has no attempt %d, its latest attempt is %d", runID, attempt, latestAttempt)), nil
+			}
+			if attempt != 0 && attempt != latestAttempt {
+				workflowRun, resp, err = client.Actions.GetWorkflowRunAttempt(ctx, owner, repo, runID, attempt, nil)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get attempt %d of workflow run", attempt), resp, err), nil
+				}
+				_ = resp.Body.Close()
 			}
 
-			return mcp.NewToolResultText(string(r)), nil
+			details := WorkflowRunDetails{
+				WorkflowRunSummary: summarizeWorkflowRun(workflowRun),
+				Actor:              workflowRun.GetActor().GetLogin(),
+				RunStartedAt:       summaryTimestamp(workflowRun.GetRunStartedAt()),
+				RunAttempt:         workflowRun.GetRunAttempt(),
+				LatestAttempt:      latestAttempt,
+				NewerAttemptExists: workflowRun.GetRunAttempt() < latestAttempt,
+			}
+
+			if includeJobs {
+				jobs, resp, err := listAllWorkflowJobs(ctx, client, owner, repo, runID, int64(details.RunAttempt))
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list workflow jobs", resp, err), nil
+				}
+				details.Jobs = make([]WorkflowJobSummary, 0, len(jobs))
+				for _, job := range jobs {
+					details.Jobs = append(details.Jobs, summarizeWorkflowJob(job))
+				}
+			}
+
+			return MarshalledTextResult(details), nil
 		}
 }
 
@@ -441,10 +749,72 @@ func GetWorkflowRunLogs(getClient GetClientFn, t translations.TranslationHelperF
 		}
 }
 
+// WorkflowJobStep is a step of a workflow job returned by list_workflow_jobs.
+type WorkflowJobStep struct {
+	Number     int64  `json:"number"`
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	Conclusion string `json:"conclusion,omitempty"`
+}
+
+// WorkflowJobDetails is a workflow job with its steps, as returned by list_workflow_jobs.
+type WorkflowJobDetails struct {
+	ID          int64             `json:"id"`
+	Name        string            `json:"name"`
+	Status      string            `json:"status"`
+	Conclusion  string            `json:"conclusion,omitempty"`
+	RunnerName  string            `json:"runner_name,omitempty"`
+	StartedAt   string            `json:"started_at,omitempty"`
+	CompletedAt string            `json:"completed_at,omitempty"`
+	HTMLURL     string            `json:"html_url"`
+	Steps       []WorkflowJobStep `json:"steps"`
+}
+
+// WorkflowJobList is the result of list_workflow_jobs.
+type WorkflowJobList struct {
+	// TotalCount is the number of jobs of the run, before the conclusion and failed_only filters.
+	TotalCount      int                  `json:"total_count"`
+	Jobs            []WorkflowJobDetails `json:"jobs"`
+	OptimizationTip string               `json:"optimization_tip"`
+}
+
+// isFailedConclusion reports whether a job or step conclusion means that it failed.
+func isFailedConclusion(conclusion string) bool {
+	return conclusion == "failure" || conclusion == "timed_out"
+}
+
+// newWorkflowJobDetails converts a workflow job into the form returned by list_workflow_jobs, keeping only
+// its failed steps when failedStepsOnly is set.
+func newWorkflowJobDetails(job *github.WorkflowJob, failedStepsOnly bool) WorkflowJobDetails {
+	details := WorkflowJobDetails{
+		ID:          job.GetID(),
+		Name:        job.GetName(),
+		Status:      job.GetStatus(),
+		Conclusion:  job.GetConclusion(),
+		RunnerName:  job.GetRunnerName(),
+		StartedAt:   summaryTimestamp(job.GetStartedAt()),
+		CompletedAt: summaryTimestamp(job.GetCompletedAt()),
+		HTMLURL:     job.GetHTMLURL(),
+		Steps:       []WorkflowJobStep{},
+	}
+	for _, step := range job.Steps {
+		if failedStepsOnly && !isFailedConclusion(step.GetConclusion()) {
+			continue
+		}
+		details.Steps = append(details.Steps, WorkflowJobStep{
+			Number:     step.GetNumber(),
+			Name:       step.GetName(),
+			Status:     step.GetStatus(),
+			Conclusion: step.GetConclusion(),
+		})
+	}
+	return details
+}
+
 // ListWorkflowJobs creates a tool to list jobs for a specific workflow run
 func ListWorkflowJobs(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("list_workflow_jobs",
-			mcp.WithDescription(t("TOOL_LIST_WORKFLOW_JOBS_DESCRIPTION", "List jobs for a specific workflow run")),
+			mcp.WithDescription(t("TOOL_LIST_WORKFLOW_JOBS_DESCRIPTION", "List jobs for a specific workflow run, with the conclusion of each of their steps. Use failed_only to get only the failed jobs and their failed steps")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_LIST_WORKFLOW_JOBS_USER_TITLE", "List workflow jobs"),
 				ReadOnlyHint: ToBoolPtr(true),
@@ -462,9 +832,16 @@ func ListWorkflowJobs(getClient GetClientFn, t translations.TranslationHelperFun
 				mcp.Description("The unique identifier of the workflow run"),
 			),
 			mcp.WithString("filter",
-				mcp.Description("Filters jobs by their completed_at timestamp"),
+				mcp.Description("Filters jobs by their completed_at timestamp. latest returns the jobs of the most recent attempt, all the jobs of every attempt"),
 				mcp.Enum("latest", "all"),
 			),
+			mcp.WithString("conclusion",
+				mcp.Description("Only return jobs with this conclusion. Applied to the jobs of the requested page"),
+				mcp.Enum("success", "failure", "neutral", "cancelled", "skipped", "timed_out", "action_required"),
+			),
+			mcp.WithBoolean("failed_only",
+				mcp.Description("Only return jobs that failed or timed out, with only their failed steps. Applied to the jobs of the requested page"),
+			),
 			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -476,17 +853,24 @@ func ListWorkflowJobs(getClient GetClientFn, t translations.TranslationHelperFun
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			runIDInt, err := RequiredInt(request, "run_id")
+			runID, err := RequiredBigInt(request, "run_id")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			runID := int64(runIDInt)
 
 			// Get optional filtering parameters
 			filter, err := OptionalParam[string](request, "filter")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			conclusion, err := OptionalParam[string](request, "conclusion")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			failedOnly, err := OptionalParam[bool](request, "failed_only")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
 			// Get optional pagination parameters
 			pagination, err := OptionalPaginationParams(request)
@@ -510,29 +894,48 @@ func ListWorkflowJobs(getClient GetClientFn, t translations.TranslationHelperFun
 
 			jobs, resp, err := client.Actions.ListWorkflowJobs(ctx, owner, repo, runID, opts)
 			if err != nil {
-				return nil, fmt.Errorf("failed to list workflow jobs: %w", err)
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list workflow jobs", resp, err), nil
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			// Add optimization tip for failed job debugging
-			response := map[string]any{
-				"jobs":             jobs,
-				"optimization_tip": "For debugging failed jobs, consider using get_job_logs with failed_only=true and run_id=" + fmt.Sprintf("%d", runID) + " to get logs directly without needing to list jobs first",
+			result := WorkflowJobList{
+				TotalCount: jobs.GetTotalCount(),
+				Jobs:       []WorkflowJobDetails{},
+				// Add optimization tip for failed job debugging
+				OptimizationTip: fmt.Sprintf("For debugging failed jobs, consider using get_job_logs with failed_only=true and run_id=%d to get logs directly without needing to list jobs first", runID),
 			}
-
-			r, err := json.Marshal(response)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			for _, job := range jobs.Jobs {
+				if conclusion != "" && job.GetConclusion() != conclusion {
+					continue
+				}
+				if failedOnly && !isFailedConclusion(job.GetConclusion()) {
+					continue
+				}
+				result.Jobs = append(result.Jobs, newWorkflowJobDetails(job, failedOnly))
 			}
 
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledTextResult(result), nil
 		}
 }
 
+const (
+	// defaultJobLogTailLines is the number of lines get_job_logs returns from the end of each log
+	// unless tail_lines is given.
+	defaultJobLogTailLines = 100
+	// maxJobLogTailLines is the largest tail_lines get_job_logs accepts.
+	maxJobLogTailLines = 5000
+	// defaultFailedJobLogsMaxBytes is the total size of the logs get_job_logs returns for the failed jobs
+	// of a run unless max_total_bytes is given.
+	defaultFailedJobLogsMaxBytes = 64 << 10
+)
+
+// logTimestampRegexp matches the timestamp GitHub Actions prefixes each log line with.
+var logTimestampRegexp = regexp.MustCompile(`(?m)^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(?:\.\d+)?Z `)
+
 // GetJobLogs creates a tool to download logs for a specific workflow job or efficiently get all failed job logs for a workflow run
 func GetJobLogs(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("get_job_logs",
-			mcp.WithDescription(t("TOOL_GET_JOB_LOGS_DESCRIPTION", "Download logs for a specific workflow job or efficiently get all failed job logs for a workflow run")),
+			mcp.WithDescription(t("TOOL_GET_JOB_LOGS_DESCRIPTION", "Download logs for a specific workflow job or efficiently get all failed job logs for a workflow run. Only the end of each log is returned, and truncated logs are marked as such")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_GET_JOB_LOGS_USER_TITLE", "Get job logs"),
 				ReadOnlyHint: ToBoolPtr(true),
@@ -558,8 +961,15 @@ func GetJobLogs(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				mcp.Description("Returns actual log content instead of URLs"),
 			),
 			mcp.WithNumber("tail_lines",
-				mcp.Description("Number of lines to return from the end of the log"),
-				mcp.DefaultNumber(500),
+				mcp.Description(fmt.Sprintf("Number of lines to return from the end of each log, at most %d", maxJobLogTailLines)),
+				mcp.DefaultNumber(defaultJobLogTailLines),
+			),
+			mcp.WithBoolean("strip_timestamps",
+				mcp.Description("Remove the timestamp at the start of each log line"),
+			),
+			mcp.WithNumber("max_total_bytes",
+				mcp.Description("Largest total size of the log content returned for the failed jobs of a run. The logs of later jobs are cut or left out once it is reached"),
+				mcp.DefaultNumber(defaultFailedJobLogsMaxBytes),
 			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -589,13 +999,23 @@ func GetJobLogs(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			tailLines, err := OptionalIntParam(request, "tail_lines")
+			tailLines, err := OptionalIntParamWithDefault(request, "tail_lines", defaultJobLogTailLines)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if tailLines < 1 || tailLines > maxJobLogTailLines {
+				return mcp.NewToolResultError(fmt.Sprintf("tail_lines must be between 1 and %d", maxJobLogTailLines)), nil
+			}
+			stripTimestamps, err := OptionalParam[bool](request, "strip_timestamps")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxTotalBytes, err := OptionalIntParamWithDefault(request, "max_total_bytes", defaultFailedJobLogsMaxBytes)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			// Default to 500 lines if not specified
-			if tailLines == 0 {
-				tailLines = 500
+			if maxTotalBytes < 1 {
+				return mcp.NewToolResultError("max_total_bytes must be at least 1"), nil
 			}
 
 			client, err := getClient(ctx)
@@ -611,20 +1031,33 @@ func GetJobLogs(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				return mcp.NewToolResultError("job_id is required when failed_only is false"), nil
 			}
 
+			logOpts := jobLogOptions{
+				returnContent:   returnContent,
+				tailLines:       tailLines,
+				stripTimestamps: stripTimestamps,
+			}
 			if failedOnly && runID > 0 {
 				// Handle failed-only mode: get logs for all failed jobs in the workflow run
-				return handleFailedJobLogs(ctx, client, owner, repo, int64(runID), returnContent, tailLines)
+				return handleFailedJobLogs(ctx, client, owner, repo, int64(runID), logOpts, maxTotalBytes)
 			} else if jobID > 0 {
 				// Handle single job mode
-				return handleSingleJobLogs(ctx, client, owner, repo, int64(jobID), returnContent, tailLines)
+				return handleSingleJobLogs(ctx, client, owner, repo, int64(jobID), logOpts)
 			}
 
 			return mcp.NewToolResultError("Either job_id must be provided for single job logs, or run_id with failed_only=true for failed job logs"), nil
 		}
 }
 
-// handleFailedJobLogs gets logs for all failed jobs in a workflow run
-func handleFailedJobLogs(ctx context.Context, client *github.Client, owner, repo string, runID int64, returnContent bool, tailLines int) (*mcp.CallToolResult, error) {
+// jobLogOptions controls what getJobLogData returns for the log of a job.
+type jobLogOptions struct {
+	returnContent   bool
+	tailLines       int
+	stripTimestamps bool
+}
+
+// handleFailedJobLogs gets logs for all failed jobs in a workflow run, returning at most maxTotalBytes of
+// log content in total.
+func handleFailedJobLogs(ctx context.Context, client *github.Client, owner, repo string, runID int64, logOpts jobLogOptions, maxTotalBytes int) (*mcp.CallToolResult, error) {
 	// First, get all jobs for the workflow run
 	jobs, resp, err := client.Actions.ListWorkflowJobs(ctx, owner, repo, runID, &github.ListWorkflowJobsOptions{
 		Filter: "latest",
@@ -637,7 +1070,7 @@ func handleFailedJobLogs(ctx context.Context, client *github.Client, owner, repo
 	// Filter for failed jobs
 	var failedJobs []*github.WorkflowJob
 	for _, job := range jobs.Jobs {
-		if job.GetConclusion() == "failure" {
+		if isFailedConclusion(job.GetConclusion()) {
 			failedJobs = append(failedJobs, job)
 		}
 	}
@@ -655,8 +1088,22 @@ func handleFailedJobLogs(ctx context.Context, client *github.Client, owner, repo
 
 	// Collect logs for all failed jobs
 	var logResults []map[string]any
+	remainingBytes := maxTotalBytes
+	truncated := false
 	for _, job := range failedJobs {
-		jobResult, resp, err := getJobLogData(ctx, client, owner, repo, job.GetID(), job.GetName(), returnContent, tailLines)
+		if logOpts.returnContent && remainingBytes <= 0 {
+			// The budget is spent, so the job is listed without downloading its log.
+			logResults = append(logResults, map[string]any{
+				"job_id":    job.GetID(),
+				"job_name":  job.GetName(),
+				"truncated": true,
+				"note":      "Log left out because max_total_bytes was reached; get it with job_id",
+			})
+			truncated = true
+			continue
+		}
+
+		jobResult, resp, err := getJobLogData(ctx, client, owner, repo, job.GetID(), job.GetName(), logOpts)
 		if err != nil {
 			// Continue with other jobs even if one fails
 			jobResult = map[string]any{
@@ -666,6 +1113,21 @@ func handleFailedJobLogs(ctx context.Context, client *github.Client, owner, repo
 			}
 			// Enable reporting of status codes and error causes
 			_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to get job logs", resp, err) // Explicitly ignore error for graceful handling
+		} else if content, ok := jobResult["logs_content"].(string); ok {
+			if len(content) > remainingBytes {
+				// Keep the end of the log, starting at a line boundary.
+				content = content[len(content)-remainingBytes:]
+				if i := strings.IndexByte(content, '\n'); i >= 0 {
+					content = content[i+1:]
+				}
+				jobResult["logs_content"] = content
+				jobResult["truncated"] = true
+				jobResult["note"] = "Log cut to fit max_total_bytes"
+				remainingBytes = 0
+			} else {
+				remainingBytes -= len(content)
+			}
+			truncated = truncated || jobResult["truncated"] == true
 		}
 
 		logResults = append(logResults, jobResult)
@@ -677,7 +1139,10 @@ func handleFailedJobLogs(ctx context.Context, client *github.Client, owner, repo
 		"total_jobs":    len(jobs.Jobs),
 		"failed_jobs":   len(failedJobs),
 		"logs":          logResults,
-		"return_format": map[string]bool{"content": returnContent, "urls": !returnContent},
+		"return_format": map[string]bool{"content": logOpts.returnContent, "urls": !logOpts.returnContent},
+	}
+	if logOpts.returnContent {
+		result["truncated"] = truncated
 	}
 
 	r, err := json.Marshal(result)
@@ -689,8 +1154,8 @@ func handleFailedJobLogs(ctx context.Context, client *github.Client, owner, repo
 }
 
 // handleSingleJobLogs gets logs for a single job
-func handleSingleJobLogs(ctx context.Context, client *github.Client, owner, repo string, jobID int64, returnContent bool, tailLines int) (*mcp.CallToolResult, error) {
-	jobResult, resp, err := getJobLogData(ctx, client, owner, repo, jobID, "", returnContent, tailLines)
+func handleSingleJobLogs(ctx context.Context, client *github.Client, owner, repo string, jobID int64, logOpts jobLogOptions) (*mcp.CallToolResult, error) {
+	jobResult, resp, err := getJobLogData(ctx, client, owner, repo, jobID, "", logOpts)
 	if err != nil {
 		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get job logs", resp, err), nil
 	}
@@ -704,7 +1169,7 @@ func handleSingleJobLogs(ctx context.Context, client *github.Client, owner, repo
 }
 
 // getJobLogData retrieves log data for a single job, either as URL or content
-func getJobLogData(ctx context.Context, client *github.Client, owner, repo string, jobID int64, jobName string, returnContent bool, tailLines int) (map[string]any, *github.Response, error) {
+func getJobLogData(ctx context.Context, client *github.Client, owner, repo string, jobID int64, jobName string, logOpts jobLogOptions) (map[string]any, *github.Response, error) {
 	// Get the download URL for the job logs
 	url, resp, err := client.Actions.GetWorkflowJobLogs(ctx, owner, repo, jobID, 1)
 	if err != nil {
@@ -719,9 +1184,9 @@ func getJobLogData(ctx context.Context, client *github.Client, owner, repo strin
 		result["job_name"] = jobName
 	}
 
-	if returnContent {
+	if logOpts.returnContent {
 		// Download and return the actual log content
-		content, originalLength, httpResp, err := downloadLogContent(url.String(), tailLines) //nolint:bodyclose // Response body is closed in downloadLogContent, but we need to return httpResp
+		content, originalLength, httpResp, err := downloadLogContent(url.String(), logOpts.tailLines) //nolint:bodyclose // Response body is closed in downloadLogContent, but we need to return httpResp
 		if err != nil {
 			// To keep the return value consistent wrap the response as a GitHub Response
 			ghRes := &github.Response{
@@ -729,9 +1194,14 @@ func getJobLogData(ctx context.Context, client *github.Client, owner, repo strin
 			}
 			return nil, ghRes, fmt.Errorf("failed to download log content for job %d: %w", jobID, err)
 		}
+		if logOpts.stripTimestamps {
+			content = logTimestampRegexp.ReplaceAllString(content, "")
+		}
 		result["logs_content"] = content
 		result["message"] = "Job logs content retrieved successfully"
 		result["original_length"] = originalLength
+		// The log is only cut when at least tail_lines line breaks were found from its end.
+		result["truncated"] = originalLength == logOpts.tailLines
 	} else {
 		// Return just the URL
 		result["logs_url"] = url.String()
@@ -759,8 +1229,8 @@ func downloadLogContent(logURL string, tailLines int) (string, int, *http.Respon
 		return "", 0, httpResp, fmt.Errorf("failed to read log content: %w", err)
 	}
 
-	// Clean up and format the log content for better readability
-	logContent := strings.TrimSpace(string(content))
+	// Clean up and format the log content for better readability. Job logs start with a UTF-8 byte order mark.
+	logContent := strings.TrimSpace(strings.TrimPrefix(string(content), "\ufeff"))
 
 	trimmedContent, lineCount := trimContent(logContent, tailLines)
 	return trimmedContent, lineCount, httpResp, nil
@@ -786,10 +1256,69 @@ func trimContent(content string, tailLines int) (string, int) {
 	return content, lineCount
 }
 
+// rerunWorkflowRun re-runs a workflow run, or only its failed jobs and the jobs depending on them. The
+// go-github methods send no request body, so a re-run with debug logging is requested directly.
+func rerunWorkflowRun(ctx context.Context, client *github.Client, owner, repo string, runID int64, failedJobsOnly, enableDebugLogging bool) (*github.Response, error) {
+	if !enableDebugLogging {
+		if failedJobsOnly {
+			return client.Actions.RerunFailedJobsByID(ctx, owner, repo, runID)
+		}
+		return client.Actions.RerunWorkflowByID(ctx, owner, repo, runID)
+	}
+
+	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/rerun", owner, repo, runID)
+	if failedJobsOnly {
+		u += "-failed-jobs"
+	}
+	req, err := client.NewRequest(http.MethodPost, u, map[string]bool{"enable_debug_logging": true})
+	if err != nil {
+		return nil, err
+	}
+	return client.Do(ctx, req, nil)
+}
+
+// rerunErrorResponse describes a failed re-run. GitHub refuses to re-run a run that is still in progress
+// or older than the retention window with a 403 or 409, whose message is passed on so that the reason can
+// be explained rather than the re-run retried.
+func rerunErrorResponse(ctx context.Context, message string, resp *github.Response, err error) *mcp.CallToolResult {
+	var errResp *github.ErrorResponse
+	if resp != nil && (resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusConflict) &&
+		errors.As(err, &errResp) && errResp.Message != "" {
+		message = fmt.Sprintf("%s: %s", message, errResp.Message)
+		_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, message, resp, err)
+		return mcp.NewToolResultError(message)
+	}
+	return ghErrors.NewGitHubAPIErrorResponse(ctx, message, resp, err)
+}
+
+// rerunResult describes a queued re-run. The re-run happens asynchronously, so the result points to the
+// run and to get_workflow_run to follow its progress.
+func rerunResult(ctx context.Context, client *github.Client, owner, repo string, runID int64, message string, resp *github.Response) (*mcp.CallToolResult, error) {
+	result := map[string]any{
+		"message":     message + "; poll get_workflow_run with this run_id to follow its progress",
+		"run_id":      runID,
+		"status":      resp.Status,
+		"status_code": resp.StatusCode,
+	}
+
+	// The URL is only a convenience, so failing to get the run does not fail the re-run.
+	if run, runResp, err := client.Actions.GetWorkflowRunByID(ctx, owner, repo, runID); err == nil {
+		_ = runResp.Body.Close()
+		result["html_url"] = run.GetHTMLURL()
+	}
+
+	r, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(r)), nil
+}
+
 // RerunWorkflowRun creates a tool to re-run an entire workflow run
 func RerunWorkflowRun(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("rerun_workflow_run",
-			mcp.WithDescription(t("TOOL_RERUN_WORKFLOW_RUN_DESCRIPTION", "Re-run an entire workflow run")),
+			mcp.WithDescription(t("TOOL_RERUN_WORKFLOW_RUN_DESCRIPTION", "Re-run an entire workflow run. The re-run happens asynchronously")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_RERUN_WORKFLOW_RUN_USER_TITLE", "Rerun workflow run"),
 				ReadOnlyHint: ToBoolPtr(false),
@@ -806,6 +1335,9 @@ func RerunWorkflowRun(getClient GetClientFn, t translations.TranslationHelperFun
 				mcp.Required(),
 				mcp.Description("The unique identifier of the workflow run"),
 			),
+			mcp.WithBoolean("enable_debug_logging",
+				mcp.Description("Enable step debug logging for the re-run"),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](request, "owner")
@@ -816,43 +1348,34 @@ func RerunWorkflowRun(getClient GetClientFn, t translations.TranslationHelperFun
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			runIDInt, err := RequiredInt(request, "run_id")
+			runID, err := RequiredBigInt(request, "run_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			enableDebugLogging, err := OptionalParam[bool](request, "enable_debug_logging")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			runID := int64(runIDInt)
 
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
 
-			resp, err := client.Actions.RerunWorkflowByID(ctx, owner, repo, runID)
+			resp, err := rerunWorkflowRun(ctx, client, owner, repo, runID, false, enableDebugLogging)
 			if err != nil {
-				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to rerun workflow run", resp, err), nil
+				return rerunErrorResponse(ctx, "failed to rerun workflow run", resp, err), nil
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			result := map[string]any{
-				"message":     "Workflow run has been queued for re-run",
-				"run_id":      runID,
-				"status":      resp.Status,
-				"status_code": resp.StatusCode,
-			}
-
-			r, err := json.Marshal(result)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return rerunResult(ctx, client, owner, repo, runID, "Workflow run has been queued for re-run", resp)
 		}
 }
 
 // RerunFailedJobs creates a tool to re-run only the failed jobs in a workflow run
 func RerunFailedJobs(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("rerun_failed_jobs",
-			mcp.WithDescription(t("TOOL_RERUN_FAILED_JOBS_DESCRIPTION", "Re-run only the failed jobs in a workflow run")),
+			mcp.WithDescription(t("TOOL_RERUN_FAILED_JOBS_DESCRIPTION", "Re-run only the failed jobs in a workflow run, and the jobs that depend on them. The re-run happens asynchronously")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_RERUN_FAILED_JOBS_USER_TITLE", "Rerun failed jobs"),
 				ReadOnlyHint: ToBoolPtr(false),
@@ -869,6 +1392,9 @@ func RerunFailedJobs(getClient GetClientFn, t translations.TranslationHelperFunc
 				mcp.Required(),
 				mcp.Description("The unique identifier of the workflow run"),
 			),
+			mcp.WithBoolean("enable_debug_logging",
+				mcp.Description("Enable step debug logging for the re-run"),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](request, "owner")
@@ -879,43 +1405,34 @@ func RerunFailedJobs(getClient GetClientFn, t translations.TranslationHelperFunc
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			runIDInt, err := RequiredInt(request, "run_id")
+			runID, err := RequiredBigInt(request, "run_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			enableDebugLogging, err := OptionalParam[bool](request, "enable_debug_logging")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			runID := int64(runIDInt)
 
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
 
-			resp, err := client.Actions.RerunFailedJobsByID(ctx, owner, repo, runID)
+			resp, err := rerunWorkflowRun(ctx, client, owner, repo, runID, true, enableDebugLogging)
 			if err != nil {
-				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to rerun failed jobs", resp, err), nil
+				return rerunErrorResponse(ctx, "failed to rerun failed jobs", resp, err), nil
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			result := map[string]any{
-				"message":     "Failed jobs have been queued for re-run",
-				"run_id":      runID,
-				"status":      resp.Status,
-				"status_code": resp.StatusCode,
-			}
-
-			r, err := json.Marshal(result)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return rerunResult(ctx, client, owner, repo, runID, "Failed jobs have been queued for re-run", resp)
 		}
 }
 
 // CancelWorkflowRun creates a tool to cancel a workflow run
 func CancelWorkflowRun(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("cancel_workflow_run",
-			mcp.WithDescription(t("TOOL_CANCEL_WORKFLOW_RUN_DESCRIPTION", "Cancel a workflow run")),
+			mcp.WithDescription(t("TOOL_CANCEL_WORKFLOW_RUN_DESCRIPTION", "Cancel a workflow run, for example one superseded by a newer commit. Cancelling a run that already finished reports its conclusion instead")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_CANCEL_WORKFLOW_RUN_USER_TITLE", "Cancel workflow run"),
 				ReadOnlyHint: ToBoolPtr(false),
@@ -942,25 +1459,44 @@ func CancelWorkflowRun(getClient GetClientFn, t translations.TranslationHelperFu
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			runIDInt, err := RequiredInt(request, "run_id")
+			runID, err := RequiredBigInt(request, "run_id")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			runID := int64(runIDInt)
 
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
 
+			// GitHub accepts the cancellation with a 202, which go-github reports as an AcceptedError.
 			resp, err := client.Actions.CancelWorkflowRunByID(ctx, owner, repo, runID)
-			if err != nil {
+			if err != nil && !(resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err)) {
+				// A run that already finished cannot be cancelled, which is not a failure for the caller.
+				if resp != nil && resp.StatusCode == http.StatusConflict {
+					run, runResp, runErr := client.Actions.GetWorkflowRunByID(ctx, owner, repo, runID)
+					if runErr != nil {
+						return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get workflow run", runResp, runErr), nil
+					}
+					defer func() { _ = runResp.Body.Close() }()
+
+					if run.GetStatus() == "completed" {
+						return MarshalledTextResult(map[string]any{
+							"message":    fmt.Sprintf("Workflow run has already finished with conclusion %s", run.GetConclusion()),
+							"accepted":   false,
+							"run_id":     runID,
+							"conclusion": run.GetConclusion(),
+							"html_url":   run.GetHTMLURL(),
+						}), nil
+					}
+				}
 				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to cancel workflow run", resp, err), nil
 			}
 			defer func() { _ = resp.Body.Close() }()
 
 			result := map[string]any{
 				"message":     "Workflow run has been cancelled",
+				"accepted":    true,
 				"run_id":      runID,
 				"status":      resp.Status,
 				"status_code": resp.StatusCode,
@@ -975,10 +1511,42 @@ func CancelWorkflowRun(getClient GetClientFn, t translations.TranslationHelperFu
 		}
 }
 
+// ArtifactSummary is the compact form of a workflow run artifact returned by list_workflow_run_artifacts.
+type ArtifactSummary struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	SizeInBytes int64  `json:"size_in_bytes"`
+	Expired     bool   `json:"expired"`
+	CreatedAt   string `json:"created_at,omitempty"`
+	ExpiresAt   string `json:"expires_at,omitempty"`
+	// ArchiveDownloadURL is the API URL of the zip archive of the artifact, which redirects to the
+	// download when requested with credentials.
+	ArchiveDownloadURL string `json:"archive_download_url"`
+}
+
+// ArtifactSummaryList is the result of list_workflow_run_artifacts.
+type ArtifactSummaryList struct {
+	TotalCount int64             `json:"total_count"`
+	Artifacts  []ArtifactSummary `json:"artifacts"`
+}
+
+// summarizeArtifact converts a workflow run artifact into its compact summary form.
+func summarizeArtifact(artifact *github.Artifact) ArtifactSummary {
+	return ArtifactSummary{
+		ID:                 artifact.GetID(),
+		Name:               artifact.GetName(),
+		SizeInBytes:        artifact.GetSizeInBytes(),
+		Expired:            artifact.GetExpired(),
+		CreatedAt:          summaryTimestamp(artifact.GetCreatedAt()),
+		ExpiresAt:          summaryTimestamp(artifact.GetExpiresAt()),
+		ArchiveDownloadURL: artifact.GetArchiveDownloadURL(),
+	}
+}
+
 // ListWorkflowRunArtifacts creates a tool to list artifacts for a workflow run
 func ListWorkflowRunArtifacts(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("list_workflow_run_artifacts",
-			mcp.WithDescription(t("TOOL_LIST_WORKFLOW_RUN_ARTIFACTS_DESCRIPTION", "List artifacts for a workflow run")),
+			mcp.WithDescription(t("TOOL_LIST_WORKFLOW_RUN_ARTIFACTS_DESCRIPTION", "List artifacts for a workflow run, such as build outputs, coverage reports and test results. Expired artifacts can no longer be downloaded")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_LIST_WORKFLOW_RUN_ARTIFACTS_USER_TITLE", "List workflow artifacts"),
 				ReadOnlyHint: ToBoolPtr(true),
@@ -1006,11 +1574,10 @@ func ListWorkflowRunArtifacts(getClient GetClientFn, t translations.TranslationH
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			runIDInt, err := RequiredInt(request, "run_id")
+			runID, err := RequiredBigInt(request, "run_id")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			runID := int64(runIDInt)
 
 			// Get optional pagination parameters
 			pagination, err := OptionalPaginationParams(request)
@@ -1035,50 +1602,210 @@ func ListWorkflowRunArtifacts(getClient GetClientFn, t translations.TranslationH
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			r, err := json.Marshal(artifacts)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			result := ArtifactSummaryList{
+				TotalCount: artifacts.GetTotalCount(),
+				Artifacts:  make([]ArtifactSummary, 0, len(artifacts.Artifacts)),
+			}
+			for _, artifact := range artifacts.Artifacts {
+				result.Artifacts = append(result.Artifacts, summarizeArtifact(artifact))
 			}
 
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledTextResult(result), nil
 		}
 }
 
-// DownloadWorkflowRunArtifact creates a tool to download a workflow run artifact
-func DownloadWorkflowRunArtifact(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("download_workflow_run_artifact",
-			mcp.WithDescription(t("TOOL_DOWNLOAD_WORKFLOW_RUN_ARTIFACT_DESCRIPTION", "Get download URL for a workflow run artifact")),
-			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				Title:        t("TOOL_DOWNLOAD_WORKFLOW_RUN_ARTIFACT_USER_TITLE", "Download workflow artifact"),
-				ReadOnlyHint: ToBoolPtr(true),
-			}),
-			mcp.WithString("owner",
-				mcp.Required(),
-				mcp.Description(DescriptionRepositoryOwner),
-			),
-			mcp.WithString("repo",
-				mcp.Required(),
-				mcp.Description(DescriptionRepositoryName),
-			),
-			mcp.WithNumber("artifact_id",
-				mcp.Required(),
-				mcp.Description("The unique identifier of the artifact"),
-			),
-		),
-		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			owner, err := RequiredParam[string](request, "owner")
-			if err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
-			}
-			repo, err := RequiredParam[string](request, "repo")
-			if err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
-			}
-			artifactIDInt, err := RequiredInt(request, "artifact_id")
+// maxArtifactExtractBytes is the largest file the artifact tools extract from an artifact to
+// return its content.
+const maxArtifactExtractBytes = 1 << 20
+
+// ArtifactDownload is the result of download_workflow_run_artifact when a file is extracted, and of
+// save_workflow_run_artifact.
+type ArtifactDownload struct {
+	ArtifactID int64 `json:"artifact_id"`
+	// Path is where save_workflow_run_artifact saved the zip archive of the artifact.
+	Path string        `json:"path,omitempty"`
+	Size int64         `json:"size"`
+	File *ArtifactFile `json:"file,omitempty"`
+}
+
+// ArtifactFile is a file extracted from an artifact. Its content is only returned when it is text.
+type ArtifactFile struct {
+	Name    string `json:"name"`
+	Size    int64  `json:"size"`
+	Content string `json:"content,omitempty"`
+	Note    string `json:"note,omitempty"`
+}
+
+// artifactExpiredMessage explains the 410 GitHub returns for an artifact past its retention period.
+func artifactExpiredMessage(artifactID int64) string {
+	return fmt.Sprintf("artifact %d has expired and can no longer be downloaded", artifactID)
+}
+
+// findZipFile finds a file in a zip archive by its path, or by its name when only one file has it.
+func findZipFile(files []*zip.File, name string) (*zip.File, error) {
+	var matches []*zip.File
+	for _, f := range files {
+		if f.Name == name {
+			return f, nil
+		}
+		if !f.FileInfo().IsDir() && path.Base(f.Name) == name {
+			matches = append(matches, f)
+		}
+	}
+	if len(matches) == 1 {
+		return matches[0], nil
+	}
+
+	names := make([]string, 0, len(files))
+	for _, f := range files {
+		if !f.FileInfo().IsDir() {
+			names = append(names, f.Name)
+		}
+	}
+	if len(matches) > 1 {
+		return nil, fmt.Errorf("more than one file in the artifact is named %s, give its path instead: %s", name, strings.Join(names, ", "))
+	}
+	return nil, fmt.Errorf("the artifact has no file %s, it contains: %s", name, strings.Join(names, ", "))
+}
+
+// extractArtifactFile reads a file from the zip archive of an artifact, returning its content only when it
+// is text and no larger than maxArtifactExtractBytes.
+func extractArtifactFile(archivePath, name string) (*ArtifactFile, error) {
+	reader, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open artifact archive: %w", err)
+	}
+	defer func() { _ = reader.Close() }()
+
+	f, err := findZipFile(reader.File, name)
+	if err != nil {
+		return nil, err
+	}
+
+	file := &ArtifactFile{
+		Name: f.Name,
+		Size: int64(f.UncompressedSize64), //nolint:gosec // artifact files are far smaller than 2^63 bytes
+	}
+	if f.UncompressedSize64 > maxArtifactExtractBytes {
+		file.Note = fmt.Sprintf("File is larger than %d bytes, so its content is not returned; use save_workflow_run_artifact to save the artifact", maxArtifactExtractBytes)
+		return file, nil
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract %s: %w", f.Name, err)
+	}
+	defer func() { _ = rc.Close() }()
+
+	content, err := io.ReadAll(io.LimitReader(rc, maxArtifactExtractBytes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract %s: %w", f.Name, err)
+	}
+	if !utf8.Valid(content) || bytes.IndexByte(content, 0) >= 0 {
+		file.Note = "File is binary, so its content is not returned; use save_workflow_run_artifact to save the artifact"
+		return file, nil
+	}
+	file.Content = string(content)
+	return file, nil
+}
+
+// getArtifactDownloadURL gets the short-lived download URL of an artifact. When that fails, it returns
+// the error result for the tool instead.
+func getArtifactDownloadURL(ctx context.Context, client *github.Client, owner, repo string, artifactID int64) (*url.URL, *mcp.CallToolResult) {
+	downloadURL, resp, err := client.Actions.DownloadArtifact(ctx, owner, repo, artifactID, 1)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusGone {
+			message := artifactExpiredMessage(artifactID)
+			_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, message, resp, err)
+			return nil, mcp.NewToolResultError(message)
+		}
+		return nil, ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get artifact download URL", resp, err)
+	}
+	_ = resp.Body.Close()
+	return downloadURL, nil
+}
+
+// saveArtifact downloads the zip archive of an artifact to filePath, or into it when it is an existing
+// directory, and returns where it was saved and its size. Failures are returned as the error result
+// for the tool.
+func saveArtifact(ctx context.Context, downloadURL *url.URL, artifactID int64, filePath string) (string, int64, *mcp.CallToolResult, error) {
+	// The download URL is a short-lived signed URL, so it is downloaded without the GitHub credentials.
+	downloadReq, err := http.NewRequestWithContext(ctx, http.MethodGet, downloadURL.String(), nil)
+	if err != nil {
+		return "", 0, nil, fmt.Errorf("failed to create artifact download request: %w", err)
+	}
+	downloadResp, err := http.DefaultClient.Do(downloadReq)
+	if err != nil {
+		return "", 0, mcp.NewToolResultError(fmt.Sprintf("failed to download artifact: %s", err)), nil
+	}
+	defer func() { _ = downloadResp.Body.Close() }()
+
+	if downloadResp.StatusCode == http.StatusGone {
+		return "", 0, mcp.NewToolResultError(artifactExpiredMessage(artifactID)), nil
+	}
+	if downloadResp.StatusCode != http.StatusOK {
+		return "", 0, mcp.NewToolResultError(fmt.Sprintf("failed to download artifact: HTTP %d", downloadResp.StatusCode)), nil
+	}
+
+	if info, err := os.Stat(filePath); err == nil && info.IsDir() {
+		fileName := archiveFileName(downloadResp.Header.Get("Content-Disposition"))
+		if fileName == "" || fileName == "." || fileName == string(filepath.Separator) {
+			fileName = fmt.Sprintf("artifact-%d.zip", artifactID)
+		}
+		filePath = filepath.Join(filePath, fileName)
+	}
+
+	size, err := saveArchive(downloadResp.Body, filePath, defaultArchiveMaxBytes)
+	if errors.Is(err, errArchiveTooLarge) {
+		return "", 0, mcp.NewToolResultError(fmt.Sprintf("artifact %d is larger than %d bytes", artifactID, defaultArchiveMaxBytes)), nil
+	}
+	if err != nil {
+		return "", 0, mcp.NewToolResultError(fmt.Sprintf("failed to save artifact: %s", err)), nil
+	}
+	return filePath, size, nil, nil
+}
+
+// DownloadWorkflowRunArtifact creates a tool to download a workflow run artifact
+func DownloadWorkflowRunArtifact(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("download_workflow_run_artifact",
+			mcp.WithDescription(t("TOOL_DOWNLOAD_WORKFLOW_RUN_ARTIFACT_DESCRIPTION", "Get download URL for a workflow run artifact, or return the text content of one file in it. Use save_workflow_run_artifact to save the artifact to a local path")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_DOWNLOAD_WORKFLOW_RUN_ARTIFACT_USER_TITLE", "Download workflow artifact"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithNumber("artifact_id",
+				mcp.Required(),
+				mcp.Description("The unique identifier of the artifact"),
+			),
+			mcp.WithString("extract_file",
+				mcp.Description(fmt.Sprintf("Path or name of a file in the artifact to return the content of. Only text files of at most %d bytes are returned", maxArtifactExtractBytes)),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			artifactID, err := RequiredBigInt(request, "artifact_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			extractFile, err := OptionalParam[string](request, "extract_file")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			artifactID := int64(artifactIDInt)
 
 			client, err := getClient(ctx)
 			if err != nil {
@@ -1086,26 +1813,137 @@ func DownloadWorkflowRunArtifact(getClient GetClientFn, t translations.Translati
 			}
 
 			// Get the download URL for the artifact
-			url, resp, err := client.Actions.DownloadArtifact(ctx, owner, repo, artifactID, 1)
+			url, errResult := getArtifactDownloadURL(ctx, client, owner, repo, artifactID)
+			if errResult != nil {
+				return errResult, nil
+			}
+
+			if extractFile == "" {
+				// Create response with the download URL and information
+				result := map[string]any{
+					"download_url": url.String(),
+					"message":      "Artifact is available for download",
+					"note":         "The download_url provides a download link for the artifact as a ZIP archive. The link is temporary and expires after a short time.",
+					"artifact_id":  artifactID,
+				}
+
+				r, err := json.Marshal(result)
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal response: %w", err)
+				}
+
+				return mcp.NewToolResultText(string(r)), nil
+			}
+
+			// The archive is only kept until the file is extracted.
+			tempDir, err := os.MkdirTemp("", "github-mcp-artifact-")
 			if err != nil {
-				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get artifact download URL", resp, err), nil
+				return nil, fmt.Errorf("failed to create temporary directory: %w", err)
 			}
-			defer func() { _ = resp.Body.Close() }()
+			defer func() { _ = os.RemoveAll(tempDir) }()
 
-			// Create response with the download URL and information
-			result := map[string]any{
-				"download_url": url.String(),
-				"message":      "Artifact is available for download",
-				"note":         "The download_url provides a download link for the artifact as a ZIP archive. The link is temporary and expires after a short time.",
-				"artifact_id":  artifactID,
+			filePath, size, errResult, err := saveArtifact(ctx, url, artifactID, filepath.Join(tempDir, "artifact.zip"))
+			if err != nil {
+				return nil, err
+			}
+			if errResult != nil {
+				return errResult, nil
 			}
 
-			r, err := json.Marshal(result)
+			file, err := extractArtifactFile(filePath, extractFile)
 			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
+				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledTextResult(ArtifactDownload{
+				ArtifactID: artifactID,
+				Size:       size,
+				File:       file,
+			}), nil
+		}
+}
+
+// SaveWorkflowRunArtifact creates a tool to save a workflow run artifact to a local path
+func SaveWorkflowRunArtifact(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("save_workflow_run_artifact",
+			mcp.WithDescription(t("TOOL_SAVE_WORKFLOW_RUN_ARTIFACT_DESCRIPTION", "Save the zip archive of a workflow run artifact to a local path, optionally returning the text content of one file in it")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SAVE_WORKFLOW_RUN_ARTIFACT_USER_TITLE", "Save workflow artifact"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithNumber("artifact_id",
+				mcp.Required(),
+				mcp.Description("The unique identifier of the artifact"),
+			),
+			mcp.WithString("dest_path",
+				mcp.Required(),
+				mcp.Description("New file or existing directory to save the zip archive of the artifact to. Existing files are not overwritten"),
+			),
+			mcp.WithString("extract_file",
+				mcp.Description(fmt.Sprintf("Path or name of a file in the artifact to return the content of. Only text files of at most %d bytes are returned", maxArtifactExtractBytes)),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			artifactID, err := RequiredBigInt(request, "artifact_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			destPath, err := RequiredParam[string](request, "dest_path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			extractFile, err := OptionalParam[string](request, "extract_file")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			url, errResult := getArtifactDownloadURL(ctx, client, owner, repo, artifactID)
+			if errResult != nil {
+				return errResult, nil
+			}
+
+			filePath, size, errResult, err := saveArtifact(ctx, url, artifactID, destPath)
+			if err != nil {
+				return nil, err
+			}
+			if errResult != nil {
+				return errResult, nil
+			}
+
+			result := ArtifactDownload{
+				ArtifactID: artifactID,
+				Path:       filePath,
+				Size:       size,
+			}
+			if extractFile != "" {
+				result.File, err = extractArtifactFile(filePath, extractFile)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+			}
+
+			return MarshalledTextResult(result), nil
 		}
 }
 
@@ -1140,11 +1978,10 @@ func DeleteWorkflowRunLogs(getClient GetClientFn, t translations.TranslationHelp
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			runIDInt, err := RequiredInt(request, "run_id")
+			runID, err := RequiredBigInt(request, "run_id")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			runID := int64(runIDInt)
 
 			client, err := getClient(ctx)
 			if err != nil {
@@ -1173,13 +2010,14 @@ func DeleteWorkflowRunLogs(getClient GetClientFn, t translations.TranslationHelp
 		}
 }
 
-// GetWorkflowRunUsage creates a tool to get usage metrics for a workflow run
-func GetWorkflowRunUsage(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("get_workflow_run_usage",
-			mcp.WithDescription(t("TOOL_GET_WORKFLOW_RUN_USAGE_DESCRIPTION", "Get usage metrics for a workflow run")),
+// DeleteArtifact creates a tool to delete a workflow run artifact
+func DeleteArtifact(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("delete_artifact",
+			mcp.WithDescription(t("TOOL_DELETE_ARTIFACT_DESCRIPTION", "Delete a workflow run artifact, freeing the Actions storage it uses")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				Title:        t("TOOL_GET_WORKFLOW_RUN_USAGE_USER_TITLE", "Get workflow usage"),
-				ReadOnlyHint: ToBoolPtr(true),
+				Title:           t("TOOL_DELETE_ARTIFACT_USER_TITLE", "Delete artifact"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -1189,9 +2027,9 @@ func GetWorkflowRunUsage(getClient GetClientFn, t translations.TranslationHelper
 				mcp.Required(),
 				mcp.Description(DescriptionRepositoryName),
 			),
-			mcp.WithNumber("run_id",
+			mcp.WithNumber("artifact_id",
 				mcp.Required(),
-				mcp.Description("The unique identifier of the workflow run"),
+				mcp.Description("The unique identifier of the artifact"),
 			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -1203,24 +2041,30 @@ func GetWorkflowRunUsage(getClient GetClientFn, t translations.TranslationHelper
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			runIDInt, err := RequiredInt(request, "run_id")
+			artifactID, err := RequiredBigInt(request, "artifact_id")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			runID := int64(runIDInt)
 
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
 
-			usage, resp, err := client.Actions.GetWorkflowRunUsageByID(ctx, owner, repo, runID)
+			resp, err := client.Actions.DeleteArtifact(ctx, owner, repo, artifactID)
 			if err != nil {
-				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get workflow run usage", resp, err), nil
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to delete artifact", resp, err), nil
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			r, err := json.Marshal(usage)
+			result := map[string]any{
+				"message":     "Artifact has been deleted",
+				"artifact_id": artifactID,
+				"status":      resp.Status,
+				"status_code": resp.StatusCode,
+			}
+
+			r, err := json.Marshal(result)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -1228,3 +2072,816 @@ func GetWorkflowRunUsage(getClient GetClientFn, t translations.TranslationHelper
 			return mcp.NewToolResultText(string(r)), nil
 		}
 }
+
+const (
+	// defaultArtifactCleanupLimit is the number of artifacts cleanup_artifacts deletes in one call unless
+	// max_deletions is given.
+	defaultArtifactCleanupLimit = 50
+	// maxArtifactCleanupLimit is the largest max_deletions cleanup_artifacts accepts.
+	maxArtifactCleanupLimit = 100
+)
+
+// ArtifactCleanupItem reports what cleanup_artifacts did with one artifact.
+type ArtifactCleanupItem struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	SizeInBytes int64  `json:"size_in_bytes"`
+	CreatedAt   string `json:"created_at,omitempty"`
+	// Result is deleted, or not_found when the artifact was deleted by someone else in the meantime.
+	Result string `json:"result"`
+}
+
+// ArtifactCleanupReport is the result of cleanup_artifacts.
+type ArtifactCleanupReport struct {
+	Deleted    int                   `json:"deleted"`
+	NotFound   int                   `json:"not_found"`
+	FreedBytes int64                 `json:"freed_bytes"`
+	Items      []ArtifactCleanupItem `json:"items"`
+	// LimitReached is set when more artifacts match than max_deletions, so that calling the tool again
+	// deletes more.
+	LimitReached bool `json:"limit_reached"`
+	// ListingTruncated is set when the repository has more artifacts than could be examined, so that
+	// matching artifacts may remain after the cleanup.
+	ListingTruncated bool `json:"listing_truncated,omitempty"`
+	// Error is set when a deletion failed for another reason than the artifact being gone, which stops
+	// the cleanup.
+	Error string `json:"error,omitempty"`
+}
+
+// findArtifactsToCleanUp lists the unexpired artifacts of a repository created before olderThan and with a
+// name matching namePattern. It examines at most DefaultFetchAllMaxItems artifacts, and reports whether
+// the listing was cut short.
+func findArtifactsToCleanUp(ctx context.Context, client *github.Client, owner, repo string, olderThan time.Time, namePattern string) ([]*github.Artifact, bool, *github.Response, error) {
+	result, resp, err := fetchAllPages(ctx, 1, DefaultFetchAllMaxItems, func(page int) ([]*github.Artifact, *github.Response, error) {
+		opts := &github.ListArtifactsOptions{ListOptions: github.ListOptions{Page: page, PerPage: fetchAllPerPage}}
+		artifacts, resp, err := client.Actions.ListArtifacts(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		return artifacts.Artifacts, resp, nil
+	})
+	if err != nil {
+		return nil, false, resp, err
+	}
+
+	var matches []*github.Artifact
+	for _, artifact := range result.Items {
+		// Expired artifacts no longer use storage and cannot be deleted.
+		if artifact.GetExpired() {
+			continue
+		}
+		if !olderThan.IsZero() && !artifact.GetCreatedAt().Before(olderThan) {
+			continue
+		}
+		if namePattern != "" {
+			if ok, _ := path.Match(namePattern, artifact.GetName()); !ok {
+				continue
+			}
+		}
+		matches = append(matches, artifact)
+	}
+	return matches, result.Truncated, resp, nil
+}
+
+// CleanupArtifacts creates a tool to delete the artifacts of a repository matching an age and name filter
+func CleanupArtifacts(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("cleanup_artifacts",
+			mcp.WithDescription(t("TOOL_CLEANUP_ARTIFACTS_DESCRIPTION", fmt.Sprintf("Delete the workflow run artifacts of a repository that are older than a date or have a matching name, to free Actions storage. Reports what happened to each artifact. Only the first %d artifacts of the repository are examined; listing_truncated is set when there were more", DefaultFetchAllMaxItems))),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_CLEANUP_ARTIFACTS_USER_TITLE", "Clean up artifacts"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("older_than",
+				mcp.Description("Only delete artifacts created before this time, as an ISO 8601 timestamp (YYYY-MM-DDTHH:MM:SSZ or YYYY-MM-DD) or relative to now (24h, 7d, 2w, yesterday)"),
+			),
+			mcp.WithString("name_pattern",
+				mcp.Description("Only delete artifacts whose name matches this glob pattern, such as coverage-*"),
+			),
+			mcp.WithNumber("max_deletions",
+				mcp.Description(fmt.Sprintf("Largest number of artifacts to delete in this call, at most %d", maxArtifactCleanupLimit)),
+				mcp.DefaultNumber(defaultArtifactCleanupLimit),
+			),
+			mcp.WithBoolean("confirm",
+				mcp.Required(),
+				mcp.Description("Must be true to confirm that the matching artifacts should be deleted"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			olderThanParam, err := OptionalParam[string](request, "older_than")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			namePattern, err := OptionalParam[string](request, "name_pattern")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			limit, err := OptionalIntParamWithDefault(request, "max_deletions", defaultArtifactCleanupLimit)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			// RequiredParam rejects false, which deserves its own explanation.
+			confirm, err := OptionalParam[bool](request, "confirm")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if !confirm {
+				return mcp.NewToolResultError("confirm must be true to delete artifacts"), nil
+			}
+			if olderThanParam == "" && namePattern == "" {
+				return mcp.NewToolResultError("at least one of older_than or name_pattern is required"), nil
+			}
+			var olderThan time.Time
+			if olderThanParam != "" {
+				olderThan, err = parseISOTimestamp(olderThanParam)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("invalid older_than: %s", err)), nil
+				}
+			}
+			if namePattern != "" {
+				if _, err := path.Match(namePattern, ""); err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("invalid name_pattern %q: %s", namePattern, err)), nil
+				}
+			}
+			if limit < 1 || limit > maxArtifactCleanupLimit {
+				return mcp.NewToolResultError(fmt.Sprintf("max_deletions must be between 1 and %d", maxArtifactCleanupLimit)), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			artifacts, listingTruncated, resp, err := findArtifactsToCleanUp(ctx, client, owner, repo, olderThan, namePattern)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list artifacts", resp, err), nil
+			}
+
+			report := ArtifactCleanupReport{Items: []ArtifactCleanupItem{}, ListingTruncated: listingTruncated}
+			if len(artifacts) > limit {
+				artifacts = artifacts[:limit]
+				report.LimitReached = true
+			}
+			for _, artifact := range artifacts {
+				item := ArtifactCleanupItem{
+					ID:          artifact.GetID(),
+					Name:        artifact.GetName(),
+					SizeInBytes: artifact.GetSizeInBytes(),
+					CreatedAt:   summaryTimestamp(artifact.GetCreatedAt()),
+				}
+
+				resp, err := client.Actions.DeleteArtifact(ctx, owner, repo, artifact.GetID())
+				switch {
+				case err == nil:
+					_ = resp.Body.Close()
+					item.Result = "deleted"
+					report.Deleted++
+					report.FreedBytes += item.SizeInBytes
+				case resp != nil && resp.StatusCode == http.StatusNotFound:
+					item.Result = "not_found"
+					report.NotFound++
+				default:
+					_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to delete artifact", resp, err)
+					report.Error = fmt.Sprintf("failed to delete artifact %d, stopping the cleanup: %s", artifact.GetID(), err)
+					return MarshalledTextResult(report), nil
+				}
+				report.Items = append(report.Items, item)
+			}
+
+			return MarshalledTextResult(report), nil
+		}
+}
+
+// GetWorkflowRunUsage creates a tool to get usage metrics for a workflow run
+func GetWorkflowRunUsage(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_workflow_run_usage",
+			mcp.WithDescription(t("TOOL_GET_WORKFLOW_RUN_USAGE_DESCRIPTION", "Get usage metrics for a workflow run")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_WORKFLOW_RUN_USAGE_USER_TITLE", "Get workflow usage"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithNumber("run_id",
+				mcp.Required(),
+				mcp.Description("The unique identifier of the workflow run"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			runIDInt, err := RequiredInt(request, "run_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			runID := int64(runIDInt)
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			usage, resp, err := client.Actions.GetWorkflowRunUsageByID(ctx, owner, repo, runID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get workflow run usage", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(usage)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// PendingDeploymentReviewer is a user or team that can approve a pending deployment.
+type PendingDeploymentReviewer struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+// PendingDeploymentSummary is an environment a workflow run waits on, as returned by get_pending_deployments.
+type PendingDeploymentSummary struct {
+	EnvironmentID         int64                       `json:"environment_id"`
+	Environment           string                      `json:"environment"`
+	WaitTimer             int64                       `json:"wait_timer"`
+	WaitTimerStartedAt    string                      `json:"wait_timer_started_at,omitempty"`
+	CurrentUserCanApprove bool                        `json:"current_user_can_approve"`
+	Reviewers             []PendingDeploymentReviewer `json:"reviewers"`
+}
+
+// DeploymentSummary is the compact form of a deployment created by approving a pending deployment.
+type DeploymentSummary struct {
+	ID          int64  `json:"id"`
+	Environment string `json:"environment"`
+	Ref         string `json:"ref,omitempty"`
+	SHA         string `json:"sha,omitempty"`
+	CreatedAt   string `json:"created_at,omitempty"`
+}
+
+// summarizePendingDeployment converts a pending deployment into its compact summary form, naming user
+// reviewers by login and team reviewers by slug.
+func summarizePendingDeployment(deployment *github.PendingDeployment) PendingDeploymentSummary {
+	summary := PendingDeploymentSummary{
+		EnvironmentID:         deployment.GetEnvironment().GetID(),
+		Environment:           deployment.GetEnvironment().GetName(),
+		WaitTimer:             deployment.GetWaitTimer(),
+		WaitTimerStartedAt:    summaryTimestamp(deployment.GetWaitTimerStartedAt()),
+		CurrentUserCanApprove: deployment.GetCurrentUserCanApprove(),
+		Reviewers:             []PendingDeploymentReviewer{},
+	}
+	for _, reviewer := range deployment.Reviewers {
+		name := ""
+		switch r := reviewer.Reviewer.(type) {
+		case *github.User:
+			name = r.GetLogin()
+		case *github.Team:
+			name = r.GetSlug()
+		}
+		summary.Reviewers = append(summary.Reviewers, PendingDeploymentReviewer{
+			Type: reviewer.GetType(),
+			Name: name,
+		})
+	}
+	return summary
+}
+
+// permissionErrorResponse describes a failed request. Rate limits are reported as such, and any other 403
+// is explained with the permission it requires, followed by GitHub's own message.
+func permissionErrorResponse(ctx context.Context, message, permission string, resp *github.Response, err error) *mcp.CallToolResult {
+	if result, ok := rateLimitErrorResult(ctx, message, resp, err); ok {
+		return result
+	}
+	if resp != nil && resp.StatusCode == http.StatusForbidden {
+		message = fmt.Sprintf("%s: %s", message, permission)
+		var errResp *github.ErrorResponse
+		if errors.As(err, &errResp) && errResp.Message != "" {
+			message = fmt.Sprintf("%s: %s", message, errResp.Message)
+		}
+		_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, message, resp, err)
+		return mcp.NewToolResultError(message)
+	}
+	return ghErrors.NewGitHubAPIErrorResponse(ctx, message, resp, err)
+}
+
+// environmentIDsParam reads the environment_ids of review_pending_deployments, checking that each ID is a
+// whole number rather than letting a fractional one be truncated to another environment's ID.
+func environmentIDsParam(request mcp.CallToolRequest) ([]int64, error) {
+	values, ok := request.GetArguments()["environment_ids"].([]any)
+	if !ok || len(values) == 0 {
+		return nil, fmt.Errorf("missing required parameter: environment_ids")
+	}
+	environmentIDs := make([]int64, 0, len(values))
+	for _, value := range values {
+		number, ok := value.(float64)
+		if !ok || number < 1 || number != float64(int64(number)) {
+			return nil, fmt.Errorf("environment_ids must be an array of positive whole numbers, got %v", value)
+		}
+		environmentIDs = append(environmentIDs, int64(number))
+	}
+	return environmentIDs, nil
+}
+
+// GetPendingDeployments creates a tool to get the environments a workflow run is waiting to deploy to
+func GetPendingDeployments(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_pending_deployments",
+			mcp.WithDescription(t("TOOL_GET_PENDING_DEPLOYMENTS_DESCRIPTION", "Get the environments a workflow run is waiting on approval to deploy to, who can approve them and whether the current user can")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PENDING_DEPLOYMENTS_USER_TITLE", "Get pending deployments"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithNumber("run_id",
+				mcp.Required(),
+				mcp.Description("The unique identifier of the workflow run"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			runID, err := RequiredBigInt(request, "run_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			deployments, resp, err := client.Actions.GetPendingDeployments(ctx, owner, repo, runID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get pending deployments", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := make([]PendingDeploymentSummary, 0, len(deployments))
+			for _, deployment := range deployments {
+				result = append(result, summarizePendingDeployment(deployment))
+			}
+
+			return MarshalledTextResult(result), nil
+		}
+}
+
+// ReviewPendingDeployments creates a tool to approve or reject the pending deployments of a workflow run
+func ReviewPendingDeployments(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("approve_pending_deployments",
+			mcp.WithDescription(t("TOOL_APPROVE_PENDING_DEPLOYMENTS_DESCRIPTION", "Approve or reject the deployments of a workflow run waiting on environment approval. Use get_pending_deployments to find the environment IDs. Requires being a required reviewer of the environments")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_APPROVE_PENDING_DEPLOYMENTS_USER_TITLE", "Approve pending deployments"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithNumber("run_id",
+				mcp.Required(),
+				mcp.Description("The unique identifier of the workflow run"),
+			),
+			mcp.WithArray("environment_ids",
+				mcp.Required(),
+				mcp.Description("IDs of the environments to approve or reject the deployments to"),
+				mcp.Items(
+					map[string]any{
+						"type": "number",
+					},
+				),
+			),
+			mcp.WithString("state",
+				mcp.Required(),
+				mcp.Description("Whether to approve or reject the deployments"),
+				mcp.Enum("approved", "rejected"),
+			),
+			mcp.WithString("comment",
+				mcp.Description("Comment to go along with the review"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			runID, err := RequiredBigInt(request, "run_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			environmentIDs, err := environmentIDsParam(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			state, err := RequiredParam[string](request, "state")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if state != "approved" && state != "rejected" {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid state %q, must be approved or rejected", state)), nil
+			}
+			comment, err := OptionalParam[string](request, "comment")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			deployments, resp, err := client.Actions.PendingDeployments(ctx, owner, repo, runID, &github.PendingDeploymentsRequest{
+				EnvironmentIDs: environmentIDs,
+				State:          state,
+				Comment:        comment,
+			})
+			if err != nil {
+				return permissionErrorResponse(ctx, "failed to review pending deployments",
+					"reviewing deployments requires being a required reviewer of the environments", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			// Approving creates a deployment for each environment, rejecting creates none.
+			summaries := make([]DeploymentSummary, 0, len(deployments))
+			for _, deployment := range deployments {
+				summaries = append(summaries, DeploymentSummary{
+					ID:          deployment.GetID(),
+					Environment: deployment.GetEnvironment(),
+					Ref:         deployment.GetRef(),
+					SHA:         deployment.GetSHA(),
+					CreatedAt:   summaryTimestamp(deployment.GetCreatedAt()),
+				})
+			}
+			result := map[string]any{
+				"run_id":      runID,
+				"state":       state,
+				"deployments": summaries,
+			}
+			// The run state is only a convenience, so failing to get the run does not fail the review.
+			if run, runResp, err := client.Actions.GetWorkflowRunByID(ctx, owner, repo, runID); err == nil {
+				_ = runResp.Body.Close()
+				result["run_status"] = run.GetStatus()
+				result["run_conclusion"] = run.GetConclusion()
+			}
+
+			return MarshalledTextResult(result), nil
+		}
+}
+
+// ApproveWorkflowRun creates a tool to approve a workflow run from a fork waiting on approval
+func ApproveWorkflowRun(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("approve_workflow_run",
+			mcp.WithDescription(t("TOOL_APPROVE_WORKFLOW_RUN_DESCRIPTION", "Approve a workflow run for a pull request from a fork, which waits on approval when its author is a first-time contributor. Requires write access to the repository")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_APPROVE_WORKFLOW_RUN_USER_TITLE", "Approve workflow run"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithNumber("run_id",
+				mcp.Required(),
+				mcp.Description("The unique identifier of the workflow run"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			runID, err := RequiredBigInt(request, "run_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// go-github has no method for this endpoint, so the request is made directly.
+			req, err := client.NewRequest(http.MethodPost, fmt.Sprintf("repos/%v/%v/actions/runs/%v/approve", owner, repo, runID), nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create request: %w", err)
+			}
+			resp, err := client.Do(ctx, req, nil)
+			if err != nil {
+				return permissionErrorResponse(ctx, "failed to approve workflow run",
+					"approving workflow runs requires write access to the repository", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			run, resp, err := client.Actions.GetWorkflowRunByID(ctx, owner, repo, runID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "workflow run approved, but failed to get it", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(map[string]any{
+				"message":    "Workflow run has been approved",
+				"run_id":     runID,
+				"status":     run.GetStatus(),
+				"conclusion": run.GetConclusion(),
+				"html_url":   run.GetHTMLURL(),
+			}), nil
+		}
+}
+
+// ActionsVariableSummary is the compact form of an Actions variable, as returned by list_actions_variables.
+type ActionsVariableSummary struct {
+	Name      string `json:"name"`
+	Value     string `json:"value"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+// ActionsVariableList is one page of the Actions variables of a repository or environment.
+type ActionsVariableList struct {
+	TotalCount int                      `json:"total_count"`
+	Variables  []ActionsVariableSummary `json:"variables"`
+}
+
+// summarizeActionsVariables converts a page of Actions variables into its compact list form.
+func summarizeActionsVariables(variables *github.ActionsVariables) ActionsVariableList {
+	list := ActionsVariableList{
+		TotalCount: variables.TotalCount,
+		Variables:  make([]ActionsVariableSummary, 0, len(variables.Variables)),
+	}
+	for _, variable := range variables.Variables {
+		list.Variables = append(list.Variables, ActionsVariableSummary{
+			Name:      variable.Name,
+			Value:     variable.Value,
+			UpdatedAt: summaryTimestamp(variable.GetUpdatedAt()),
+		})
+	}
+	return list
+}
+
+// ListActionsVariables creates a tool to list the Actions variables of a repository and one of its environments
+func ListActionsVariables(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_actions_variables",
+			mcp.WithDescription(t("TOOL_LIST_ACTIONS_VARIABLES_DESCRIPTION", "List the GitHub Actions variables of a repository with their values, and those of an environment when one is given. Secrets are not included")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_ACTIONS_VARIABLES_USER_TITLE", "List Actions variables"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("environment",
+				mcp.Description("Name of an environment of the repository whose variables to list as well, such as production"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			environment, err := OptionalParam[string](request, "environment")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			opts := &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			}
+
+			variables, resp, err := client.Actions.ListRepoVariables(ctx, owner, repo, opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list repository variables", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			result := map[string]any{
+				"repository_variables": summarizeActionsVariables(variables),
+			}
+
+			if environment != "" {
+				variables, resp, err := client.Actions.ListEnvVariables(ctx, owner, repo, url.PathEscape(environment), opts)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to list variables of environment %s", environment), resp, err), nil
+				}
+				_ = resp.Body.Close()
+
+				result["environment"] = environment
+				result["environment_variables"] = summarizeActionsVariables(variables)
+			}
+
+			return MarshalledTextResult(result), nil
+		}
+}
+
+// ActionsSecretSummary is the metadata of an Actions secret, as returned by list_actions_secrets. Secret
+// values can't be read through the API, so only the name and timestamps are included.
+type ActionsSecretSummary struct {
+	Name      string `json:"name"`
+	CreatedAt string `json:"created_at,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+// ActionsSecretList is one page of the Actions secrets of a repository, environment or organization.
+type ActionsSecretList struct {
+	TotalCount int                    `json:"total_count"`
+	Secrets    []ActionsSecretSummary `json:"secrets"`
+}
+
+// summarizeActionsSecrets converts a page of Actions secrets into its compact list form.
+func summarizeActionsSecrets(secrets *github.Secrets) ActionsSecretList {
+	list := ActionsSecretList{
+		TotalCount: secrets.TotalCount,
+		Secrets:    make([]ActionsSecretSummary, 0, len(secrets.Secrets)),
+	}
+	for _, secret := range secrets.Secrets {
+		list.Secrets = append(list.Secrets, ActionsSecretSummary{
+			Name:      secret.Name,
+			CreatedAt: summaryTimestamp(secret.CreatedAt),
+			UpdatedAt: summaryTimestamp(secret.UpdatedAt),
+		})
+	}
+	return list
+}
+
+// listEnvSecrets lists the secrets of an environment. go-github only has the variant of this endpoint
+// addressed by repository ID, so the request is built directly to avoid looking the ID up first.
+func listEnvSecrets(ctx context.Context, client *github.Client, owner, repo, environment string, opts *github.ListOptions) (*github.Secrets, *github.Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/environments/%v/secrets?page=%d&per_page=%d", owner, repo, url.PathEscape(environment), opts.Page, opts.PerPage)
+	req, err := client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	secrets := new(github.Secrets)
+	resp, err := client.Do(ctx, req, secrets)
+	if err != nil {
+		return nil, resp, err
+	}
+	return secrets, resp, nil
+}
+
+// ListActionsSecrets creates a tool to list the names of the Actions secrets available to a repository
+func ListActionsSecrets(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_actions_secrets",
+			mcp.WithDescription(t("TOOL_LIST_ACTIONS_SECRETS_DESCRIPTION", "List the names of the GitHub Actions secrets of a repository, and those of an environment or the organization secrets shared with the repository when asked for. Only names and timestamps are returned, secret values are never available")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_ACTIONS_SECRETS_USER_TITLE", "List Actions secrets"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("environment",
+				mcp.Description("Name of an environment of the repository whose secrets to list as well, such as production"),
+			),
+			mcp.WithBoolean("include_org",
+				mcp.Description("Also list the organization secrets shared with the repository"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			environment, err := OptionalParam[string](request, "environment")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			includeOrg, err := OptionalParam[bool](request, "include_org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			opts := &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			}
+
+			secrets, resp, err := client.Actions.ListRepoSecrets(ctx, owner, repo, opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list repository secrets", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			result := map[string]any{
+				"repository_secrets": summarizeActionsSecrets(secrets),
+			}
+
+			if environment != "" {
+				secrets, resp, err := listEnvSecrets(ctx, client, owner, repo, environment, opts)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to list secrets of environment %s", environment), resp, err), nil
+				}
+				_ = resp.Body.Close()
+
+				result["environment"] = environment
+				result["environment_secrets"] = summarizeActionsSecrets(secrets)
+			}
+
+			if includeOrg {
+				secrets, resp, err := client.Actions.ListRepoOrgSecrets(ctx, owner, repo, opts)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list organization secrets", resp, err), nil
+				}
+				_ = resp.Body.Close()
+
+				result["organization_secrets"] = summarizeActionsSecrets(secrets)
+			}
+
+			return MarshalledTextResult(result), nil
+		}
+}
@@ -1,11 +1,20 @@
 package github
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v73/github"
@@ -125,6 +134,406 @@ func Test_ListWorkflows(t *testing.T) {
 	}
 }
 
+func Test_ListWorkflowRuns(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListWorkflowRuns(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_workflow_runs", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "workflow_id")
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.Contains(t, tool.InputSchema.Properties, "event")
+	assert.Contains(t, tool.InputSchema.Properties, "status")
+	assert.Contains(t, tool.InputSchema.Properties, "actor")
+	assert.Contains(t, tool.InputSchema.Properties, "created")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockRuns := &github.WorkflowRuns{
+		TotalCount: github.Ptr(42),
+		WorkflowRuns: []*github.WorkflowRun{
+			{
+				ID:         github.Ptr(int64(1001)),
+				RunNumber:  github.Ptr(7),
+				Name:       github.Ptr("Nightly"),
+				HeadBranch: github.Ptr("main"),
+				HeadSHA:    github.Ptr("abc123"),
+				Event:      github.Ptr("schedule"),
+				Status:     github.Ptr("completed"),
+				Conclusion: github.Ptr("success"),
+				CreatedAt:  &github.Timestamp{Time: time.Date(2025, 5, 1, 2, 0, 0, 0, time.UTC)},
+				UpdatedAt:  &github.Timestamp{Time: time.Date(2025, 5, 1, 2, 30, 0, 0, time.UTC)},
+				HTMLURL:    github.Ptr("https://github.com/owner/repo/actions/runs/1001"),
+			},
+		},
+	}
+	expectedRuns := WorkflowRunList{
+		TotalCount: 42,
+		WorkflowRuns: []WorkflowRunSummary{
+			{
+				ID:           1001,
+				RunNumber:    7,
+				WorkflowName: "Nightly",
+				HeadBranch:   "main",
+				HeadSHA:      "abc123",
+				Event:        "schedule",
+				Status:       "completed",
+				Conclusion:   "success",
+				CreatedAt:    "2025-05-01T02:00:00Z",
+				UpdatedAt:    "2025-05-01T02:30:00Z",
+				HTMLURL:      "https://github.com/owner/repo/actions/runs/1001",
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedResult WorkflowRunList
+		expectedErrMsg string
+	}{
+		{
+			name: "runs of a workflow with filters",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsWorkflowsRunsByOwnerByRepoByWorkflowId,
+					expectPath(t, "/repos/owner/repo/actions/workflows/nightly.yml/runs").andThen(
+						expectQueryParams(t, map[string]string{
+							"branch":   "main",
+							"event":    "schedule",
+							"status":   "failure",
+							"actor":    "octocat",
+							"created":  ">=2025-05-01",
+							"page":     "1",
+							"per_page": "30",
+						}).andThen(
+							mockResponse(t, http.StatusOK, mockRuns),
+						),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"workflow_id": "nightly.yml",
+				"branch":      "main",
+				"event":       "schedule",
+				"status":      "failure",
+				"actor":       "octocat",
+				"created":     ">=2025-05-01",
+			},
+			expectError:    false,
+			expectedResult: expectedRuns,
+		},
+		{
+			name: "runs of all workflows",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsRunsByOwnerByRepo,
+					mockRuns,
+				),
+			),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    false,
+			expectedResult: expectedRuns,
+		},
+		{
+			name: "list workflow runs fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsWorkflowsRunsByOwnerByRepoByWorkflowId,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"workflow_id": "missing.yml",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list workflow runs",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListWorkflowRuns(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned WorkflowRunList
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_GetWorkflowRun(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetWorkflowRun(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_workflow_run", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "run_id")
+	assert.Contains(t, tool.InputSchema.Properties, "attempt")
+	assert.Contains(t, tool.InputSchema.Properties, "include_jobs")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "run_id"})
+
+	workflowRun := func(attempt int, conclusion string) *github.WorkflowRun {
+		return &github.WorkflowRun{
+			ID:           github.Ptr(int64(1001)),
+			RunNumber:    github.Ptr(7),
+			RunAttempt:   github.Ptr(attempt),
+			Name:         github.Ptr("CI"),
+			HeadBranch:   github.Ptr("main"),
+			HeadSHA:      github.Ptr("abc123"),
+			Event:        github.Ptr("push"),
+			Status:       github.Ptr("completed"),
+			Conclusion:   github.Ptr(conclusion),
+			Actor:        &github.User{Login: github.Ptr("octocat")},
+			RunStartedAt: &github.Timestamp{Time: time.Date(2025, 5, 1, 2, 0, 0, 0, time.UTC)},
+			HTMLURL:      github.Ptr("https://github.com/owner/repo/actions/runs/1001"),
+		}
+	}
+	runDetails := func(attempt, latest int, conclusion string) WorkflowRunDetails {
+		return WorkflowRunDetails{
+			WorkflowRunSummary: WorkflowRunSummary{
+				ID:           1001,
+				RunNumber:    7,
+				WorkflowName: "CI",
+				HeadBranch:   "main",
+				HeadSHA:      "abc123",
+				Event:        "push",
+				Status:       "completed",
+				Conclusion:   conclusion,
+				HTMLURL:      "https://github.com/owner/repo/actions/runs/1001",
+			},
+			Actor:              "octocat",
+			RunStartedAt:       "2025-05-01T02:00:00Z",
+			RunAttempt:         attempt,
+			LatestAttempt:      latest,
+			NewerAttemptExists: attempt < latest,
+		}
+	}
+
+	mockJobs := &github.Jobs{
+		TotalCount: github.Ptr(2),
+		Jobs: []*github.WorkflowJob{
+			{
+				ID:          github.Ptr(int64(1)),
+				Name:        github.Ptr("build"),
+				Status:      github.Ptr("completed"),
+				Conclusion:  github.Ptr("success"),
+				StartedAt:   &github.Timestamp{Time: time.Date(2025, 5, 1, 2, 0, 0, 0, time.UTC)},
+				CompletedAt: &github.Timestamp{Time: time.Date(2025, 5, 1, 2, 5, 0, 0, time.UTC)},
+				HTMLURL:     github.Ptr("https://github.com/owner/repo/actions/runs/1001/job/1"),
+				Steps: []*github.TaskStep{
+					{Name: github.Ptr("Checkout"), Conclusion: github.Ptr("success")},
+				},
+			},
+			{
+				ID:          github.Ptr(int64(2)),
+				Name:        github.Ptr("test"),
+				Status:      github.Ptr("completed"),
+				Conclusion:  github.Ptr("failure"),
+				StartedAt:   &github.Timestamp{Time: time.Date(2025, 5, 1, 2, 0, 0, 0, time.UTC)},
+				CompletedAt: &github.Timestamp{Time: time.Date(2025, 5, 1, 2, 9, 0, 0, time.UTC)},
+				HTMLURL:     github.Ptr("https://github.com/owner/repo/actions/runs/1001/job/2"),
+				Steps: []*github.TaskStep{
+					{Name: github.Ptr("Checkout"), Conclusion: github.Ptr("success")},
+					{Name: github.Ptr("Run unit tests"), Conclusion: github.Ptr("failure")},
+					{Name: github.Ptr("Run integration tests"), Conclusion: github.Ptr("timed_out")},
+					{Name: github.Ptr("Upload results"), Conclusion: github.Ptr("skipped")},
+				},
+			},
+		},
+	}
+
+	withJobs := runDetails(2, 2, "failure")
+	withJobs.Jobs = []WorkflowJobSummary{
+		{
+			ID:          1,
+			Name:        "build",
+			Status:      "completed",
+			Conclusion:  "success",
+			StartedAt:   "2025-05-01T02:00:00Z",
+			CompletedAt: "2025-05-01T02:05:00Z",
+			HTMLURL:     "https://github.com/owner/repo/actions/runs/1001/job/1",
+		},
+		{
+			ID:          2,
+			Name:        "test",
+			Status:      "completed",
+			Conclusion:  "failure",
+			StartedAt:   "2025-05-01T02:00:00Z",
+			CompletedAt: "2025-05-01T02:09:00Z",
+			FailedSteps: []string{"Run unit tests", "Run integration tests"},
+			HTMLURL:     "https://github.com/owner/repo/actions/runs/1001/job/2",
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedResult WorkflowRunDetails
+		expectedErrMsg string
+	}{
+		{
+			name: "latest attempt",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsRunsByOwnerByRepoByRunId,
+					workflowRun(2, "failure"),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(1001),
+			},
+			expectError:    false,
+			expectedResult: runDetails(2, 2, "failure"),
+		},
+		{
+			name: "earlier attempt",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsRunsByOwnerByRepoByRunId,
+					workflowRun(2, "success"),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsAttemptsByOwnerByRepoByRunIdByAttemptNumber,
+					expectPath(t, "/repos/owner/repo/actions/runs/1001/attempts/1").andThen(
+						mockResponse(t, http.StatusOK, workflowRun(1, "failure")),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":   "owner",
+				"repo":    "repo",
+				"run_id":  float64(1001),
+				"attempt": float64(1),
+			},
+			expectError:    false,
+			expectedResult: runDetails(1, 2, "failure"),
+		},
+		{
+			name: "with jobs and failed steps",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsRunsByOwnerByRepoByRunId,
+					workflowRun(2, "failure"),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsAttemptsJobsByOwnerByRepoByRunIdByAttemptNumber,
+					expectPath(t, "/repos/owner/repo/actions/runs/1001/attempts/2/jobs").andThen(
+						mockResponse(t, http.StatusOK, mockJobs),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"run_id":       float64(1001),
+				"include_jobs": true,
+			},
+			expectError:    false,
+			expectedResult: withJobs,
+		},
+		{
+			name: "attempt that does not exist",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsRunsByOwnerByRepoByRunId,
+					workflowRun(2, "failure"),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":   "owner",
+				"repo":    "repo",
+				"run_id":  float64(1001),
+				"attempt": float64(3),
+			},
+			expectError:    true,
+			expectedErrMsg: "workflow run 1001 has no attempt 3, its latest attempt is 2",
+		},
+		{
+			name: "get workflow run fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsByOwnerByRepoByRunId,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(1001),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get workflow run",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetWorkflowRun(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned WorkflowRunDetails
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
 func Test_RunWorkflow(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
@@ -301,160 +710,317 @@ func Test_RunWorkflow_WithFilename(t *testing.T) {
 	}
 }
 
-func Test_CancelWorkflowRun(t *testing.T) {
-	// Verify tool definition once
-	mockClient := github.NewClient(nil)
-	tool, _ := CancelWorkflowRun(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+func Test_RunWorkflow_InputsAndWaitForRun(t *testing.T) {
+	originalNow, originalInterval, originalTimeout := timeNow, workflowRunPollInterval, workflowRunPollTimeout
+	timeNow = func() time.Time { return time.Date(2025, 5, 1, 2, 0, 0, 0, time.UTC) }
+	workflowRunPollInterval = time.Millisecond
+	t.Cleanup(func() {
+		timeNow, workflowRunPollInterval, workflowRunPollTimeout = originalNow, originalInterval, originalTimeout
+	})
 
-	assert.Equal(t, "cancel_workflow_run", tool.Name)
-	assert.NotEmpty(t, tool.Description)
-	assert.Contains(t, tool.InputSchema.Properties, "owner")
-	assert.Contains(t, tool.InputSchema.Properties, "repo")
-	assert.Contains(t, tool.InputSchema.Properties, "run_id")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "run_id"})
+	dispatched := expectRequestBody(t, map[string]any{
+		"ref": "main",
+		"inputs": map[string]any{
+			"environment": "production",
+			"replicas":    "3",
+			"dry_run":     "false",
+		},
+	}).andThen(
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		},
+	)
 
 	tests := []struct {
 		name           string
 		mockedClient   *http.Client
 		requestArgs    map[string]any
+		pollTimeout    time.Duration
 		expectError    bool
+		expectedResult map[string]any
 		expectedErrMsg string
 	}{
 		{
-			name: "successful workflow run cancellation",
+			name: "inputs are stringified and the new run is found",
 			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposActionsWorkflowsDispatchesByOwnerByRepoByWorkflowId,
+					dispatched,
+				),
 				mock.WithRequestMatch(
-					mock.EndpointPattern{
-						Pattern: "/repos/owner/repo/actions/runs/12345/cancel",
-						Method:  "POST",
+					mock.GetUser,
+					&github.User{Login: github.Ptr("octocat")},
+				),
+				mock.WithRequestMatch(
+					mock.GetReposActionsWorkflowsRunsByOwnerByRepoByWorkflowId,
+					&github.WorkflowRuns{TotalCount: github.Ptr(0), WorkflowRuns: []*github.WorkflowRun{}},
+					&github.WorkflowRuns{
+						TotalCount: github.Ptr(1),
+						WorkflowRuns: []*github.WorkflowRun{
+							{
+								ID:      github.Ptr(int64(2001)),
+								HTMLURL: github.Ptr("https://github.com/owner/repo/actions/runs/2001"),
+							},
+						},
 					},
-					"", // Empty response body for 202 Accepted
 				),
 			),
 			requestArgs: map[string]any{
-				"owner":  "owner",
-				"repo":   "repo",
-				"run_id": float64(12345),
+				"owner":       "owner",
+				"repo":        "repo",
+				"workflow_id": "deploy.yml",
+				"ref":         "main",
+				"inputs": map[string]any{
+					"environment": "production",
+					"replicas":    float64(3),
+					"dry_run":     false,
+				},
+				"wait_for_run": true,
 			},
+			pollTimeout: time.Minute,
 			expectError: false,
+			expectedResult: map[string]any{
+				"message":       "Workflow run has been queued",
+				"workflow_type": "workflow_file",
+				"workflow_id":   "deploy.yml",
+				"ref":           "main",
+				"inputs": map[string]any{
+					"environment": "production",
+					"replicas":    "3",
+					"dry_run":     "false",
+				},
+				"status":      "204 No Content",
+				"status_code": float64(204),
+				"run_id":      float64(2001),
+				"run_url":     "https://github.com/owner/repo/actions/runs/2001",
+			},
 		},
 		{
-			name:         "missing required parameter run_id",
+			name: "new run does not show up in time",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposActionsWorkflowsDispatchesByOwnerByRepoByWorkflowId,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNoContent)
+					}),
+				),
+				mock.WithRequestMatch(
+					mock.GetUser,
+					&github.User{Login: github.Ptr("octocat")},
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsWorkflowsRunsByOwnerByRepoByWorkflowId,
+					expectQueryParams(t, map[string]string{
+						"actor":    "octocat",
+						"branch":   "main",
+						"event":    "workflow_dispatch",
+						"created":  ">=2025-05-01T02:00:00Z",
+						"per_page": "1",
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.WorkflowRuns{TotalCount: github.Ptr(0), WorkflowRuns: []*github.WorkflowRun{}}),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"workflow_id":  "12345",
+				"ref":          "refs/heads/main",
+				"wait_for_run": true,
+			},
+			pollTimeout: 0,
+			expectError: false,
+			expectedResult: map[string]any{
+				"message":       "Workflow run has been queued, but it did not show up within 0s; use list_workflow_runs to find it",
+				"workflow_type": "workflow_id",
+				"workflow_id":   "12345",
+				"ref":           "refs/heads/main",
+				"inputs":        nil,
+				"status":        "204 No Content",
+				"status_code":   float64(204),
+			},
+		},
+		{
+			name: "authenticated user lookup fails before dispatching",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUser,
+					mockResponse(t, http.StatusUnauthorized, `{"message": "Bad credentials"}`),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"workflow_id":  "deploy.yml",
+				"ref":          "main",
+				"wait_for_run": true,
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get the authenticated user",
+		},
+		{
+			name:         "nested inputs are rejected",
 			mockedClient: mock.NewMockedHTTPClient(),
 			requestArgs: map[string]any{
-				"owner": "owner",
-				"repo":  "repo",
+				"owner":       "owner",
+				"repo":        "repo",
+				"workflow_id": "deploy.yml",
+				"ref":         "main",
+				"inputs": map[string]any{
+					"targets": []any{"eu", "us"},
+				},
 			},
 			expectError:    true,
-			expectedErrMsg: "missing required parameter: run_id",
+			expectedErrMsg: `input "targets" must be a string, number or boolean`,
 		},
-	}
-
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			// Setup client with mock
-			client := github.NewClient(tc.mockedClient)
-			_, handler := CancelWorkflowRun(stubGetClientFn(client), translations.NullTranslationHelper)
+		{
+			name: "workflow without workflow_dispatch trigger",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposActionsWorkflowsDispatchesByOwnerByRepoByWorkflowId,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						_, _ = w.Write([]byte(`{"message": "Workflow does not have 'workflow_dispatch' trigger"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"workflow_id": "ci.yml",
+				"ref":         "main",
+			},
+			expectError:    true,
+			expectedErrMsg: "workflow ci.yml cannot be run manually because it has no workflow_dispatch trigger on main",
+		},
+	}
 
-			// Create call request
-			request := createMCPRequest(tc.requestArgs)
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			workflowRunPollTimeout = tc.pollTimeout
 
-			// Call handler
-			result, err := handler(context.Background(), request)
+			client := github.NewClient(tc.mockedClient)
+			_, handler := RunWorkflow(stubGetClientFn(client), translations.NullTranslationHelper)
 
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
 			require.NoError(t, err)
-			require.Equal(t, tc.expectError, result.IsError)
 
-			// Parse the result and get the text content
-			textContent := getTextResult(t, result)
-
-			if tc.expectedErrMsg != "" {
-				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
 				return
 			}
 
-			// Unmarshal and verify the result
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
 			var response map[string]any
 			err = json.Unmarshal([]byte(textContent.Text), &response)
 			require.NoError(t, err)
-			assert.Equal(t, "Workflow run has been cancelled", response["message"])
-			assert.Equal(t, float64(12345), response["run_id"])
+			assert.Equal(t, tc.expectedResult, response)
 		})
 	}
 }
 
-func Test_ListWorkflowRunArtifacts(t *testing.T) {
+func Test_RerunWorkflowRun(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := ListWorkflowRunArtifacts(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := RerunWorkflowRun(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 
-	assert.Equal(t, "list_workflow_run_artifacts", tool.Name)
+	assert.Equal(t, "rerun_workflow_run", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "run_id")
-	assert.Contains(t, tool.InputSchema.Properties, "perPage")
-	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "enable_debug_logging")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "run_id"})
 
+	mockRun := &github.WorkflowRun{
+		ID:      github.Ptr(int64(12345)),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/actions/runs/12345"),
+	}
+
 	tests := []struct {
 		name           string
 		mockedClient   *http.Client
 		requestArgs    map[string]any
 		expectError    bool
+		expectedResult map[string]any
 		expectedErrMsg string
 	}{
 		{
-			name: "successful artifacts listing",
+			name: "successful rerun",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.GetReposActionsRunsArtifactsByOwnerByRepoByRunId,
+					mock.PostReposActionsRunsRerunByOwnerByRepoByRunId,
+					expectPath(t, "/repos/owner/repo/actions/runs/12345/rerun").andThen(
+						func(w http.ResponseWriter, _ *http.Request) {
+							w.WriteHeader(http.StatusCreated)
+						},
+					),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposActionsRunsByOwnerByRepoByRunId,
+					mockRun,
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(12345),
+			},
+			expectError: false,
+			expectedResult: map[string]any{
+				"message":     "Workflow run has been queued for re-run; poll get_workflow_run with this run_id to follow its progress",
+				"run_id":      float64(12345),
+				"status":      "201 Created",
+				"status_code": float64(201),
+				"html_url":    "https://github.com/owner/repo/actions/runs/12345",
+			},
+		},
+		{
+			name: "rerun with debug logging",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposActionsRunsRerunByOwnerByRepoByRunId,
+					expectRequestBody(t, map[string]any{
+						"enable_debug_logging": true,
+					}).andThen(
+						func(w http.ResponseWriter, _ *http.Request) {
+							w.WriteHeader(http.StatusCreated)
+						},
+					),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposActionsRunsByOwnerByRepoByRunId,
+					mockRun,
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":                "owner",
+				"repo":                 "repo",
+				"run_id":               float64(12345),
+				"enable_debug_logging": true,
+			},
+			expectError: false,
+			expectedResult: map[string]any{
+				"message":     "Workflow run has been queued for re-run; poll get_workflow_run with this run_id to follow its progress",
+				"run_id":      float64(12345),
+				"status":      "201 Created",
+				"status_code": float64(201),
+				"html_url":    "https://github.com/owner/repo/actions/runs/12345",
+			},
+		},
+		{
+			name: "run still in progress",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposActionsRunsRerunByOwnerByRepoByRunId,
 					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						artifacts := &github.ArtifactList{
-							TotalCount: github.Ptr(int64(2)),
-							Artifacts: []*github.Artifact{
-								{
-									ID:                 github.Ptr(int64(1)),
-									NodeID:             github.Ptr("A_1"),
-									Name:               github.Ptr("build-artifacts"),
-									SizeInBytes:        github.Ptr(int64(1024)),
-									URL:                github.Ptr("https://api.github.com/repos/owner/repo/actions/artifacts/1"),
-									ArchiveDownloadURL: github.Ptr("https://api.github.com/repos/owner/repo/actions/artifacts/1/zip"),
-									Expired:            github.Ptr(false),
-									CreatedAt:          &github.Timestamp{},
-									UpdatedAt:          &github.Timestamp{},
-									ExpiresAt:          &github.Timestamp{},
-									WorkflowRun: &github.ArtifactWorkflowRun{
-										ID:               github.Ptr(int64(12345)),
-										RepositoryID:     github.Ptr(int64(1)),
-										HeadRepositoryID: github.Ptr(int64(1)),
-										HeadBranch:       github.Ptr("main"),
-										HeadSHA:          github.Ptr("abc123"),
-									},
-								},
-								{
-									ID:                 github.Ptr(int64(2)),
-									NodeID:             github.Ptr("A_2"),
-									Name:               github.Ptr("test-results"),
-									SizeInBytes:        github.Ptr(int64(512)),
-									URL:                github.Ptr("https://api.github.com/repos/owner/repo/actions/artifacts/2"),
-									ArchiveDownloadURL: github.Ptr("https://api.github.com/repos/owner/repo/actions/artifacts/2/zip"),
-									Expired:            github.Ptr(false),
-									CreatedAt:          &github.Timestamp{},
-									UpdatedAt:          &github.Timestamp{},
-									ExpiresAt:          &github.Timestamp{},
-									WorkflowRun: &github.ArtifactWorkflowRun{
-										ID:               github.Ptr(int64(12345)),
-										RepositoryID:     github.Ptr(int64(1)),
-										HeadRepositoryID: github.Ptr(int64(1)),
-										HeadBranch:       github.Ptr("main"),
-										HeadSHA:          github.Ptr("abc123"),
-									},
-								},
-							},
-						}
-						w.WriteHeader(http.StatusOK)
-						_ = json.NewEncoder(w).Encode(artifacts)
+						w.WriteHeader(http.StatusConflict)
+						_, _ = w.Write([]byte(`{"message": "This workflow is already running"}`))
 					}),
 				),
 			),
@@ -463,320 +1029,2312 @@ func Test_ListWorkflowRunArtifacts(t *testing.T) {
 				"repo":   "repo",
 				"run_id": float64(12345),
 			},
-			expectError: false,
+			expectError:    true,
+			expectedErrMsg: "failed to rerun workflow run: This workflow is already running",
 		},
 		{
-			name:         "missing required parameter run_id",
-			mockedClient: mock.NewMockedHTTPClient(),
+			name: "run older than the retention window",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposActionsRunsRerunByOwnerByRepoByRunId,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Unable to retry this workflow run because it was created over a month ago"}`))
+					}),
+				),
+			),
 			requestArgs: map[string]any{
-				"owner": "owner",
-				"repo":  "repo",
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(12345),
 			},
 			expectError:    true,
-			expectedErrMsg: "missing required parameter: run_id",
+			expectedErrMsg: "failed to rerun workflow run: Unable to retry this workflow run because it was created over a month ago",
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := ListWorkflowRunArtifacts(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := RerunWorkflowRun(stubGetClientFn(client), translations.NullTranslationHelper)
 
-			// Create call request
 			request := createMCPRequest(tc.requestArgs)
-
-			// Call handler
 			result, err := handler(context.Background(), request)
-
 			require.NoError(t, err)
-			require.Equal(t, tc.expectError, result.IsError)
-
-			// Parse the result and get the text content if no error
-			textContent := getTextResult(t, result)
 
-			if tc.expectedErrMsg != "" {
-				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Equal(t, tc.expectedErrMsg, errorContent.Text)
 				return
 			}
 
-			// Unmarshal and verify the result
-			var response github.ArtifactList
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var response map[string]any
 			err = json.Unmarshal([]byte(textContent.Text), &response)
 			require.NoError(t, err)
-			assert.NotNil(t, response.TotalCount)
-			assert.Greater(t, *response.TotalCount, int64(0))
-			assert.NotEmpty(t, response.Artifacts)
+			assert.Equal(t, tc.expectedResult, response)
 		})
 	}
 }
 
-func Test_DownloadWorkflowRunArtifact(t *testing.T) {
+func Test_RerunFailedJobs(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := DownloadWorkflowRunArtifact(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := RerunFailedJobs(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 
-	assert.Equal(t, "download_workflow_run_artifact", tool.Name)
+	assert.Equal(t, "rerun_failed_jobs", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
-	assert.Contains(t, tool.InputSchema.Properties, "artifact_id")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "artifact_id"})
+	assert.Contains(t, tool.InputSchema.Properties, "run_id")
+	assert.Contains(t, tool.InputSchema.Properties, "enable_debug_logging")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "run_id"})
 
 	tests := []struct {
 		name           string
 		mockedClient   *http.Client
 		requestArgs    map[string]any
 		expectError    bool
+		expectedResult map[string]any
 		expectedErrMsg string
 	}{
 		{
-			name: "successful artifact download URL",
+			name: "successful rerun of failed jobs with debug logging",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.EndpointPattern{
-						Pattern: "/repos/owner/repo/actions/artifacts/123/zip",
-						Method:  "GET",
+					mock.PostReposActionsRunsRerunFailedJobsByOwnerByRepoByRunId,
+					expectRequestBody(t, map[string]any{
+						"enable_debug_logging": true,
+					}).andThen(
+						func(w http.ResponseWriter, _ *http.Request) {
+							w.WriteHeader(http.StatusCreated)
+						},
+					),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposActionsRunsByOwnerByRepoByRunId,
+					&github.WorkflowRun{
+						ID:      github.Ptr(int64(12345)),
+						HTMLURL: github.Ptr("https://github.com/owner/repo/actions/runs/12345"),
 					},
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":                "owner",
+				"repo":                 "repo",
+				"run_id":               float64(12345),
+				"enable_debug_logging": true,
+			},
+			expectError: false,
+			expectedResult: map[string]any{
+				"message":     "Failed jobs have been queued for re-run; poll get_workflow_run with this run_id to follow its progress",
+				"run_id":      float64(12345),
+				"status":      "201 Created",
+				"status_code": float64(201),
+				"html_url":    "https://github.com/owner/repo/actions/runs/12345",
+			},
+		},
+		{
+			name: "run still in progress",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposActionsRunsRerunFailedJobsByOwnerByRepoByRunId,
 					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						// GitHub returns a 302 redirect to the download URL
-						w.Header().Set("Location", "https://api.github.com/repos/owner/repo/actions/artifacts/123/download")
-						w.WriteHeader(http.StatusFound)
+						w.WriteHeader(http.StatusConflict)
+						_, _ = w.Write([]byte(`{"message": "This workflow is already running"}`))
 					}),
 				),
 			),
 			requestArgs: map[string]any{
-				"owner":       "owner",
-				"repo":        "repo",
-				"artifact_id": float64(123),
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(12345),
 			},
-			expectError: false,
+			expectError:    true,
+			expectedErrMsg: "failed to rerun failed jobs: This workflow is already running",
 		},
 		{
-			name:         "missing required parameter artifact_id",
-			mockedClient: mock.NewMockedHTTPClient(),
+			name: "run not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposActionsRunsRerunFailedJobsByOwnerByRepoByRunId,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
 			requestArgs: map[string]any{
-				"owner": "owner",
-				"repo":  "repo",
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(12345),
 			},
 			expectError:    true,
-			expectedErrMsg: "missing required parameter: artifact_id",
+			expectedErrMsg: "failed to rerun failed jobs",
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := DownloadWorkflowRunArtifact(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := RerunFailedJobs(stubGetClientFn(client), translations.NullTranslationHelper)
 
-			// Create call request
 			request := createMCPRequest(tc.requestArgs)
-
-			// Call handler
 			result, err := handler(context.Background(), request)
-
 			require.NoError(t, err)
-			require.Equal(t, tc.expectError, result.IsError)
-
-			// Parse the result and get the text content if no error
-			textContent := getTextResult(t, result)
 
-			if tc.expectedErrMsg != "" {
-				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
 				return
 			}
 
-			// Unmarshal and verify the result
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
 			var response map[string]any
 			err = json.Unmarshal([]byte(textContent.Text), &response)
 			require.NoError(t, err)
-			assert.Contains(t, response, "download_url")
-			assert.Contains(t, response, "message")
-			assert.Equal(t, "Artifact is available for download", response["message"])
-			assert.Equal(t, float64(123), response["artifact_id"])
+			assert.Equal(t, tc.expectedResult, response)
+		})
+	}
+}
+
+func Test_CancelWorkflowRun(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CancelWorkflowRun(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "cancel_workflow_run", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "run_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "run_id"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedResult map[string]any
+		expectedErrMsg string
+	}{
+		{
+			name: "successful workflow run cancellation",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.EndpointPattern{
+						Pattern: "/repos/owner/repo/actions/runs/12345/cancel",
+						Method:  "POST",
+					},
+					"", // Empty response body for 202 Accepted
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(12345),
+			},
+			expectError: false,
+			expectedResult: map[string]any{
+				"message":     "Workflow run has been cancelled",
+				"accepted":    true,
+				"run_id":      float64(12345),
+				"status":      "200 OK",
+				"status_code": float64(200),
+			},
+		},
+		{
+			name: "cancellation accepted",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposActionsRunsCancelByOwnerByRepoByRunId,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusAccepted)
+						_, _ = w.Write([]byte(`{}`))
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(12345),
+			},
+			expectError: false,
+			expectedResult: map[string]any{
+				"message":     "Workflow run has been cancelled",
+				"accepted":    true,
+				"run_id":      float64(12345),
+				"status":      "202 Accepted",
+				"status_code": float64(202),
+			},
+		},
+		{
+			name: "run already finished",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposActionsRunsCancelByOwnerByRepoByRunId,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusConflict)
+						_, _ = w.Write([]byte(`{"message": "Cannot cancel a workflow run that is completed."}`))
+					}),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposActionsRunsByOwnerByRepoByRunId,
+					&github.WorkflowRun{
+						ID:         github.Ptr(int64(12345)),
+						Status:     github.Ptr("completed"),
+						Conclusion: github.Ptr("success"),
+						HTMLURL:    github.Ptr("https://github.com/owner/repo/actions/runs/12345"),
+					},
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(12345),
+			},
+			expectError: false,
+			expectedResult: map[string]any{
+				"message":    "Workflow run has already finished with conclusion success",
+				"accepted":   false,
+				"run_id":     float64(12345),
+				"conclusion": "success",
+				"html_url":   "https://github.com/owner/repo/actions/runs/12345",
+			},
+		},
+		{
+			name:         "missing required parameter run_id",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: run_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CancelWorkflowRun(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectError, result.IsError)
+
+			// Parse the result and get the text content
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var response map[string]any
+			err = json.Unmarshal([]byte(textContent.Text), &response)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, response)
+		})
+	}
+}
+
+func Test_ListWorkflowRunArtifacts(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListWorkflowRunArtifacts(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_workflow_run_artifacts", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "run_id")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "run_id"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedResult ArtifactSummaryList
+		expectedErrMsg string
+	}{
+		{
+			name: "successful artifacts listing",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsArtifactsByOwnerByRepoByRunId,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						artifacts := &github.ArtifactList{
+							TotalCount: github.Ptr(int64(2)),
+							Artifacts: []*github.Artifact{
+								{
+									ID:                 github.Ptr(int64(1)),
+									NodeID:             github.Ptr("A_1"),
+									Name:               github.Ptr("build-artifacts"),
+									SizeInBytes:        github.Ptr(int64(1024)),
+									URL:                github.Ptr("https://api.github.com/repos/owner/repo/actions/artifacts/1"),
+									ArchiveDownloadURL: github.Ptr("https://api.github.com/repos/owner/repo/actions/artifacts/1/zip"),
+									Expired:            github.Ptr(false),
+									CreatedAt:          &github.Timestamp{Time: time.Date(2025, 5, 1, 2, 0, 0, 0, time.UTC)},
+									UpdatedAt:          &github.Timestamp{Time: time.Date(2025, 5, 1, 2, 0, 0, 0, time.UTC)},
+									ExpiresAt:          &github.Timestamp{Time: time.Date(2025, 7, 30, 2, 0, 0, 0, time.UTC)},
+									WorkflowRun: &github.ArtifactWorkflowRun{
+										ID:               github.Ptr(int64(12345)),
+										RepositoryID:     github.Ptr(int64(1)),
+										HeadRepositoryID: github.Ptr(int64(1)),
+										HeadBranch:       github.Ptr("main"),
+										HeadSHA:          github.Ptr("abc123"),
+									},
+								},
+								{
+									ID:                 github.Ptr(int64(2)),
+									NodeID:             github.Ptr("A_2"),
+									Name:               github.Ptr("test-results"),
+									SizeInBytes:        github.Ptr(int64(512)),
+									URL:                github.Ptr("https://api.github.com/repos/owner/repo/actions/artifacts/2"),
+									ArchiveDownloadURL: github.Ptr("https://api.github.com/repos/owner/repo/actions/artifacts/2/zip"),
+									Expired:            github.Ptr(true),
+									CreatedAt:          &github.Timestamp{},
+									UpdatedAt:          &github.Timestamp{},
+									ExpiresAt:          &github.Timestamp{},
+									WorkflowRun: &github.ArtifactWorkflowRun{
+										ID:               github.Ptr(int64(12345)),
+										RepositoryID:     github.Ptr(int64(1)),
+										HeadRepositoryID: github.Ptr(int64(1)),
+										HeadBranch:       github.Ptr("main"),
+										HeadSHA:          github.Ptr("abc123"),
+									},
+								},
+							},
+						}
+						w.WriteHeader(http.StatusOK)
+						_ = json.NewEncoder(w).Encode(artifacts)
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(12345),
+			},
+			expectError: false,
+			expectedResult: ArtifactSummaryList{
+				TotalCount: 2,
+				Artifacts: []ArtifactSummary{
+					{
+						ID:                 1,
+						Name:               "build-artifacts",
+						SizeInBytes:        1024,
+						Expired:            false,
+						CreatedAt:          "2025-05-01T02:00:00Z",
+						ExpiresAt:          "2025-07-30T02:00:00Z",
+						ArchiveDownloadURL: "https://api.github.com/repos/owner/repo/actions/artifacts/1/zip",
+					},
+					{
+						ID:                 2,
+						Name:               "test-results",
+						SizeInBytes:        512,
+						Expired:            true,
+						ArchiveDownloadURL: "https://api.github.com/repos/owner/repo/actions/artifacts/2/zip",
+					},
+				},
+			},
+		},
+		{
+			name:         "missing required parameter run_id",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: run_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListWorkflowRunArtifacts(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectError, result.IsError)
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var response ArtifactSummaryList
+			err = json.Unmarshal([]byte(textContent.Text), &response)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, response)
+		})
+	}
+}
+
+func Test_DownloadWorkflowRunArtifact(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := DownloadWorkflowRunArtifact(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "download_workflow_run_artifact", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "artifact_id")
+	assert.NotContains(t, tool.InputSchema.Properties, "dest_path")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "artifact_id"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "successful artifact download URL",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{
+						Pattern: "/repos/owner/repo/actions/artifacts/123/zip",
+						Method:  "GET",
+					},
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						// GitHub returns a 302 redirect to the download URL
+						w.Header().Set("Location", "https://api.github.com/repos/owner/repo/actions/artifacts/123/download")
+						w.WriteHeader(http.StatusFound)
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"artifact_id": float64(123),
+			},
+			expectError: false,
+		},
+		{
+			name:         "missing required parameter artifact_id",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: artifact_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := DownloadWorkflowRunArtifact(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectError, result.IsError)
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var response map[string]any
+			err = json.Unmarshal([]byte(textContent.Text), &response)
+			require.NoError(t, err)
+			assert.Contains(t, response, "download_url")
+			assert.Contains(t, response, "message")
+			assert.Equal(t, "Artifact is available for download", response["message"])
+			assert.Equal(t, float64(123), response["artifact_id"])
+		})
+	}
+}
+
+func Test_DownloadWorkflowRunArtifact_Content(t *testing.T) {
+	var archive bytes.Buffer
+	zipWriter := zip.NewWriter(&archive)
+	for name, content := range map[string][]byte{
+		"results/report.json": []byte(`{"passed": 41, "failed": 1}`),
+		"results/large.txt":   bytes.Repeat([]byte("a"), maxArtifactExtractBytes+1),
+		"screenshot.png":      {0x89, 'P', 'N', 'G', 0x00, 0x01},
+	} {
+		w, err := zipWriter.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write(content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, zipWriter.Close())
+
+	downloadServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/expired" {
+			w.WriteHeader(http.StatusGone)
+			return
+		}
+		w.Header().Set("Content-Disposition", "attachment; filename=test-results.zip")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(archive.Bytes())
+	}))
+	defer downloadServer.Close()
+
+	artifactLink := func(downloadPath string) *http.Client {
+		return mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetReposActionsArtifactsByOwnerByRepoByArtifactIdByArchiveFormat,
+				http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.Header().Set("Location", downloadServer.URL+downloadPath)
+					w.WriteHeader(http.StatusFound)
+				}),
+			),
+		)
+	}
+
+	destDir := t.TempDir()
+	existingFile := filepath.Join(destDir, "existing.zip")
+	require.NoError(t, os.WriteFile(existingFile, []byte("keep me"), 0o600))
+
+	tests := []struct {
+		name         string
+		mockedClient *http.Client
+		// save selects save_workflow_run_artifact instead of download_workflow_run_artifact.
+		save           bool
+		requestArgs    map[string]any
+		expectError    bool
+		expectedResult ArtifactDownload
+		expectedErrMsg string
+	}{
+		{
+			name:         "extract a text file by name",
+			mockedClient: artifactLink("/artifact"),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"artifact_id":  float64(123),
+				"extract_file": "report.json",
+			},
+			expectError: false,
+			expectedResult: ArtifactDownload{
+				ArtifactID: 123,
+				Size:       int64(archive.Len()),
+				File: &ArtifactFile{
+					Name:    "results/report.json",
+					Size:    27,
+					Content: `{"passed": 41, "failed": 1}`,
+				},
+			},
+		},
+		{
+			name:         "binary file is not inlined",
+			mockedClient: artifactLink("/artifact"),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"artifact_id":  float64(123),
+				"extract_file": "screenshot.png",
+			},
+			expectError: false,
+			expectedResult: ArtifactDownload{
+				ArtifactID: 123,
+				Size:       int64(archive.Len()),
+				File: &ArtifactFile{
+					Name: "screenshot.png",
+					Size: 6,
+					Note: "File is binary, so its content is not returned; use save_workflow_run_artifact to save the artifact",
+				},
+			},
+		},
+		{
+			name:         "file larger than the extraction limit is not inlined",
+			mockedClient: artifactLink("/artifact"),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"artifact_id":  float64(123),
+				"extract_file": "results/large.txt",
+			},
+			expectError: false,
+			expectedResult: ArtifactDownload{
+				ArtifactID: 123,
+				Size:       int64(archive.Len()),
+				File: &ArtifactFile{
+					Name: "results/large.txt",
+					Size: maxArtifactExtractBytes + 1,
+					Note: "File is larger than 1048576 bytes, so its content is not returned; use save_workflow_run_artifact to save the artifact",
+				},
+			},
+		},
+		{
+			name:         "save the artifact into a directory",
+			mockedClient: artifactLink("/artifact"),
+			save:         true,
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"artifact_id": float64(123),
+				"dest_path":   destDir,
+			},
+			expectError: false,
+			expectedResult: ArtifactDownload{
+				ArtifactID: 123,
+				Path:       filepath.Join(destDir, "test-results.zip"),
+				Size:       int64(archive.Len()),
+			},
+		},
+		{
+			name:         "file not in the artifact",
+			mockedClient: artifactLink("/artifact"),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"artifact_id":  float64(123),
+				"extract_file": "coverage.xml",
+			},
+			expectError:    true,
+			expectedErrMsg: "the artifact has no file coverage.xml, it contains:",
+		},
+		{
+			name: "expired artifact",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsArtifactsByOwnerByRepoByArtifactIdByArchiveFormat,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusGone)
+						_, _ = w.Write([]byte(`{"message": "Artifact has expired"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"artifact_id":  float64(123),
+				"extract_file": "report.json",
+			},
+			expectError:    true,
+			expectedErrMsg: "artifact 123 has expired and can no longer be downloaded",
+		},
+		{
+			name:         "save and extract a file",
+			mockedClient: artifactLink("/artifact"),
+			save:         true,
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"artifact_id":  float64(123),
+				"dest_path":    filepath.Join(destDir, "results.zip"),
+				"extract_file": "report.json",
+			},
+			expectError: false,
+			expectedResult: ArtifactDownload{
+				ArtifactID: 123,
+				Path:       filepath.Join(destDir, "results.zip"),
+				Size:       int64(archive.Len()),
+				File: &ArtifactFile{
+					Name:    "results/report.json",
+					Size:    27,
+					Content: `{"passed": 41, "failed": 1}`,
+				},
+			},
+		},
+		{
+			name:         "existing file is not overwritten",
+			mockedClient: artifactLink("/artifact"),
+			save:         true,
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"artifact_id": float64(123),
+				"dest_path":   existingFile,
+			},
+			expectError:    true,
+			expectedErrMsg: "already exists",
+		},
+		{
+			name:         "expired artifact download",
+			mockedClient: artifactLink("/expired"),
+			save:         true,
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"artifact_id": float64(123),
+				"dest_path":   filepath.Join(destDir, "expired.zip"),
+			},
+			expectError:    true,
+			expectedErrMsg: "artifact 123 has expired and can no longer be downloaded",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := DownloadWorkflowRunArtifact(stubGetClientFn(client), translations.NullTranslationHelper)
+			if tc.save {
+				_, handler = SaveWorkflowRunArtifact(stubGetClientFn(client), translations.NullTranslationHelper)
+			}
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var response ArtifactDownload
+			err = json.Unmarshal([]byte(textContent.Text), &response)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, response)
+
+			if response.Path != "" {
+				saved, err := os.ReadFile(response.Path)
+				require.NoError(t, err)
+				assert.Equal(t, archive.Bytes(), saved)
+			}
+		})
+	}
+
+	kept, err := os.ReadFile(existingFile)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("keep me"), kept)
+}
+
+func Test_SaveWorkflowRunArtifact(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := SaveWorkflowRunArtifact(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "save_workflow_run_artifact", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "artifact_id")
+	assert.Contains(t, tool.InputSchema.Properties, "dest_path")
+	assert.Contains(t, tool.InputSchema.Properties, "extract_file")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "artifact_id", "dest_path"})
+	// It writes to the local filesystem, so it must not be offered in read-only mode.
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	_, handler := SaveWorkflowRunArtifact(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":       "owner",
+		"repo":        "repo",
+		"artifact_id": float64(123),
+	}))
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	assert.Equal(t, "missing required parameter: dest_path", getTextResult(t, result).Text)
+}
+
+func Test_DeleteWorkflowRunLogs(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := DeleteWorkflowRunLogs(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "delete_workflow_run_logs", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "run_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "run_id"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "successful logs deletion",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposActionsRunsLogsByOwnerByRepoByRunId,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNoContent)
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(12345),
+			},
+			expectError: false,
+		},
+		{
+			name:         "missing required parameter run_id",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: run_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := DeleteWorkflowRunLogs(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectError, result.IsError)
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var response map[string]any
+			err = json.Unmarshal([]byte(textContent.Text), &response)
+			require.NoError(t, err)
+			assert.Equal(t, "Workflow run logs have been deleted", response["message"])
+			assert.Equal(t, float64(12345), response["run_id"])
+		})
+	}
+}
+
+func Test_DeleteArtifact(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := DeleteArtifact(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "delete_artifact", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "artifact_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "artifact_id"})
+	assert.True(t, *tool.Annotations.DestructiveHint)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "successful artifact deletion",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposActionsArtifactsByOwnerByRepoByArtifactId,
+					expectPath(t, "/repos/owner/repo/actions/artifacts/123").andThen(
+						func(w http.ResponseWriter, _ *http.Request) {
+							w.WriteHeader(http.StatusNoContent)
+						},
+					),
+				),
+			),
+			expectError: false,
+		},
+		{
+			name: "artifact not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposActionsArtifactsByOwnerByRepoByArtifactId,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to delete artifact",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := DeleteArtifact(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"artifact_id": float64(123),
+			})
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var response map[string]any
+			err = json.Unmarshal([]byte(textContent.Text), &response)
+			require.NoError(t, err)
+			assert.Equal(t, "Artifact has been deleted", response["message"])
+			assert.Equal(t, float64(123), response["artifact_id"])
+		})
+	}
+}
+
+func Test_CleanupArtifacts(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CleanupArtifacts(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "cleanup_artifacts", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "older_than")
+	assert.Contains(t, tool.InputSchema.Properties, "name_pattern")
+	assert.Contains(t, tool.InputSchema.Properties, "max_deletions")
+	assert.Contains(t, tool.InputSchema.Properties, "confirm")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "confirm"})
+	assert.True(t, *tool.Annotations.DestructiveHint)
+
+	originalNow := timeNow
+	timeNow = func() time.Time { return time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC) }
+	t.Cleanup(func() { timeNow = originalNow })
+
+	artifact := func(id int64, name string, created time.Time, expired bool) *github.Artifact {
+		return &github.Artifact{
+			ID:          github.Ptr(id),
+			Name:        github.Ptr(name),
+			SizeInBytes: github.Ptr(id * 100),
+			Expired:     github.Ptr(expired),
+			CreatedAt:   &github.Timestamp{Time: created},
+		}
+	}
+	recent := time.Date(2025, 6, 20, 0, 0, 0, 0, time.UTC)
+	old := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	mockArtifacts := []*github.Artifact{
+		artifact(1, "coverage-linux", recent, false),
+		artifact(2, "coverage-linux", old, false),
+		artifact(3, "test-results", old, false),
+		artifact(4, "coverage-windows", old, false),
+		artifact(5, "coverage-macos", old, true),
+	}
+
+	deleteHandler := func(notFound ...string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			for _, id := range notFound {
+				if strings.HasSuffix(r.URL.Path, "/"+id) {
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					return
+				}
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedResult ArtifactCleanupReport
+		expectedErrMsg string
+	}{
+		{
+			name: "delete old artifacts past a missing one",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchPages(
+					mock.GetReposActionsArtifactsByOwnerByRepo,
+					&github.ArtifactList{TotalCount: github.Ptr(int64(5)), Artifacts: mockArtifacts[:3]},
+					&github.ArtifactList{TotalCount: github.Ptr(int64(5)), Artifacts: mockArtifacts[3:]},
+				),
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposActionsArtifactsByOwnerByRepoByArtifactId,
+					deleteHandler("3"),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"older_than": "30d",
+				"confirm":    true,
+			},
+			expectError: false,
+			expectedResult: ArtifactCleanupReport{
+				Deleted:    2,
+				NotFound:   1,
+				FreedBytes: 600,
+				Items: []ArtifactCleanupItem{
+					{ID: 2, Name: "coverage-linux", SizeInBytes: 200, CreatedAt: "2025-04-01T00:00:00Z", Result: "deleted"},
+					{ID: 3, Name: "test-results", SizeInBytes: 300, CreatedAt: "2025-04-01T00:00:00Z", Result: "not_found"},
+					{ID: 4, Name: "coverage-windows", SizeInBytes: 400, CreatedAt: "2025-04-01T00:00:00Z", Result: "deleted"},
+				},
+			},
+		},
+		{
+			name: "name pattern and deletion cap",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsArtifactsByOwnerByRepo,
+					&github.ArtifactList{TotalCount: github.Ptr(int64(5)), Artifacts: mockArtifacts},
+				),
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposActionsArtifactsByOwnerByRepoByArtifactId,
+					deleteHandler(),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":         "owner",
+				"repo":          "repo",
+				"name_pattern":  "coverage-*",
+				"max_deletions": float64(2),
+				"confirm":       true,
+			},
+			expectError: false,
+			expectedResult: ArtifactCleanupReport{
+				Deleted:    2,
+				FreedBytes: 300,
+				Items: []ArtifactCleanupItem{
+					{ID: 1, Name: "coverage-linux", SizeInBytes: 100, CreatedAt: "2025-06-20T00:00:00Z", Result: "deleted"},
+					{ID: 2, Name: "coverage-linux", SizeInBytes: 200, CreatedAt: "2025-04-01T00:00:00Z", Result: "deleted"},
+				},
+				LimitReached: true,
+			},
+		},
+		{
+			name: "listing is capped",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsArtifactsByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+						artifacts := make([]*github.Artifact, fetchAllPerPage)
+						for i := range artifacts {
+							artifacts[i] = artifact(int64(i+1), "build", recent, false)
+						}
+						if page == 1 {
+							artifacts[0] = mockArtifacts[3]
+						}
+						w.Header().Set("Link", fmt.Sprintf(`<https://api.github.com/repos/owner/repo/actions/artifacts?page=%d>; rel="next"`, page+1))
+						w.WriteHeader(http.StatusOK)
+						_ = json.NewEncoder(w).Encode(&github.ArtifactList{TotalCount: github.Ptr(int64(5000)), Artifacts: artifacts})
+					}),
+				),
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposActionsArtifactsByOwnerByRepoByArtifactId,
+					deleteHandler(),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"name_pattern": "coverage-*",
+				"confirm":      true,
+			},
+			expectError: false,
+			expectedResult: ArtifactCleanupReport{
+				Deleted:    1,
+				FreedBytes: 400,
+				Items: []ArtifactCleanupItem{
+					{ID: 4, Name: "coverage-windows", SizeInBytes: 400, CreatedAt: "2025-04-01T00:00:00Z", Result: "deleted"},
+				},
+				ListingTruncated: true,
+			},
+		},
+		{
+			name: "deletion failure stops the cleanup",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsArtifactsByOwnerByRepo,
+					&github.ArtifactList{TotalCount: github.Ptr(int64(5)), Artifacts: mockArtifacts},
+				),
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposActionsArtifactsByOwnerByRepoByArtifactId,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Resource not accessible by integration"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"older_than": "2025-05-01",
+				"confirm":    true,
+			},
+			expectError: false,
+			expectedResult: ArtifactCleanupReport{
+				Items: []ArtifactCleanupItem{},
+				Error: "failed to delete artifact 2, stopping the cleanup: DELETE",
+			},
+		},
+		{
+			name:         "confirm is false",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"older_than": "30d",
+				"confirm":    false,
+			},
+			expectError:    true,
+			expectedErrMsg: "confirm must be true to delete artifacts",
+		},
+		{
+			name:         "no filter",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":   "owner",
+				"repo":    "repo",
+				"confirm": true,
+			},
+			expectError:    true,
+			expectedErrMsg: "at least one of older_than or name_pattern is required",
+		},
+		{
+			name:         "max_deletions above the maximum",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":         "owner",
+				"repo":          "repo",
+				"older_than":    "30d",
+				"max_deletions": float64(101),
+				"confirm":       true,
+			},
+			expectError:    true,
+			expectedErrMsg: "max_deletions must be between 1 and 100",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CleanupArtifacts(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var response ArtifactCleanupReport
+			err = json.Unmarshal([]byte(textContent.Text), &response)
+			require.NoError(t, err)
+			if tc.expectedResult.Error != "" {
+				assert.Contains(t, response.Error, tc.expectedResult.Error)
+				response.Error = tc.expectedResult.Error
+			}
+			assert.Equal(t, tc.expectedResult, response)
+		})
+	}
+}
+
+func Test_GetWorkflowRunUsage(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetWorkflowRunUsage(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_workflow_run_usage", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "run_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "run_id"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "successful workflow run usage",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsTimingByOwnerByRepoByRunId,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						usage := &github.WorkflowRunUsage{
+							Billable: &github.WorkflowRunBillMap{
+								"UBUNTU": &github.WorkflowRunBill{
+									TotalMS: github.Ptr(int64(120000)),
+									Jobs:    github.Ptr(2),
+									JobRuns: []*github.WorkflowRunJobRun{
+										{
+											JobID:      github.Ptr(1),
+											DurationMS: github.Ptr(int64(60000)),
+										},
+										{
+											JobID:      github.Ptr(2),
+											DurationMS: github.Ptr(int64(60000)),
+										},
+									},
+								},
+							},
+							RunDurationMS: github.Ptr(int64(120000)),
+						}
+						w.WriteHeader(http.StatusOK)
+						_ = json.NewEncoder(w).Encode(usage)
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(12345),
+			},
+			expectError: false,
+		},
+		{
+			name:         "missing required parameter run_id",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: run_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetWorkflowRunUsage(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectError, result.IsError)
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var response github.WorkflowRunUsage
+			err = json.Unmarshal([]byte(textContent.Text), &response)
+			require.NoError(t, err)
+			assert.NotNil(t, response.RunDurationMS)
+			assert.NotNil(t, response.Billable)
+		})
+	}
+}
+
+func Test_GetPendingDeployments(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetPendingDeployments(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_pending_deployments", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "run_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "run_id"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedResult []PendingDeploymentSummary
+		expectedErrMsg string
+	}{
+		{
+			name: "successful pending deployments retrieval",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsPendingDeploymentsByOwnerByRepoByRunId,
+					expectPath(t, "/repos/owner/repo/actions/runs/12345/pending_deployments").andThen(
+						mockResponse(t, http.StatusOK, []map[string]any{
+							{
+								"environment": map[string]any{
+									"id":   161088068,
+									"name": "production",
+								},
+								"wait_timer":               30,
+								"wait_timer_started_at":    "2024-01-01T10:00:00Z",
+								"current_user_can_approve": true,
+								"reviewers": []map[string]any{
+									{"type": "User", "reviewer": map[string]any{"login": "octocat", "id": 1}},
+									{"type": "Team", "reviewer": map[string]any{"slug": "release-managers", "id": 2}},
+								},
+							},
+						}),
+					),
+				),
+			),
+			expectError: false,
+			expectedResult: []PendingDeploymentSummary{
+				{
+					EnvironmentID:         161088068,
+					Environment:           "production",
+					WaitTimer:             30,
+					WaitTimerStartedAt:    "2024-01-01T10:00:00Z",
+					CurrentUserCanApprove: true,
+					Reviewers: []PendingDeploymentReviewer{
+						{Type: "User", Name: "octocat"},
+						{Type: "Team", Name: "release-managers"},
+					},
+				},
+			},
+		},
+		{
+			name: "workflow run not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsPendingDeploymentsByOwnerByRepoByRunId,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to get pending deployments",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetPendingDeployments(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(12345),
+			})
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned []PendingDeploymentSummary
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_ReviewPendingDeployments(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ReviewPendingDeployments(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "approve_pending_deployments", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "run_id")
+	assert.Contains(t, tool.InputSchema.Properties, "environment_ids")
+	assert.Contains(t, tool.InputSchema.Properties, "state")
+	assert.Contains(t, tool.InputSchema.Properties, "comment")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "run_id", "environment_ids", "state"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedResult map[string]any
+		expectedErrMsg string
+	}{
+		{
+			name: "approve deployments",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposActionsRunsPendingDeploymentsByOwnerByRepoByRunId,
+					expectRequestBody(t, map[string]any{
+						"environment_ids": []any{float64(161088068)},
+						"state":           "approved",
+						"comment":         "Ship it!",
+					}).andThen(
+						mockResponse(t, http.StatusOK, []*github.Deployment{
+							{
+								ID:          github.Ptr(int64(42)),
+								Environment: github.Ptr("production"),
+								Ref:         github.Ptr("main"),
+								SHA:         github.Ptr("abc123"),
+								CreatedAt:   &github.Timestamp{Time: time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)},
+							},
+						}),
+					),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposActionsRunsByOwnerByRepoByRunId,
+					&github.WorkflowRun{
+						ID:     github.Ptr(int64(12345)),
+						Status: github.Ptr("in_progress"),
+					},
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":           "owner",
+				"repo":            "repo",
+				"run_id":          float64(12345),
+				"environment_ids": []any{float64(161088068)},
+				"state":           "approved",
+				"comment":         "Ship it!",
+			},
+			expectError: false,
+			expectedResult: map[string]any{
+				"run_id": float64(12345),
+				"state":  "approved",
+				"deployments": []any{
+					map[string]any{
+						"id":          float64(42),
+						"environment": "production",
+						"ref":         "main",
+						"sha":         "abc123",
+						"created_at":  "2024-01-01T10:00:00Z",
+					},
+				},
+				"run_status":     "in_progress",
+				"run_conclusion": "",
+			},
+		},
+		{
+			name:         "invalid state",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":           "owner",
+				"repo":            "repo",
+				"run_id":          float64(12345),
+				"environment_ids": []any{float64(161088068)},
+				"state":           "pending",
+			},
+			expectError:    true,
+			expectedErrMsg: `invalid state "pending", must be approved or rejected`,
+		},
+		{
+			name:         "no environment IDs",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":           "owner",
+				"repo":            "repo",
+				"run_id":          float64(12345),
+				"environment_ids": []any{},
+				"state":           "approved",
+			},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: environment_ids",
+		},
+		{
+			name: "not a required reviewer",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposActionsRunsPendingDeploymentsByOwnerByRepoByRunId,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Resource not accessible by integration"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":           "owner",
+				"repo":            "repo",
+				"run_id":          float64(12345),
+				"environment_ids": []any{float64(161088068)},
+				"state":           "rejected",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to review pending deployments: reviewing deployments requires being a required reviewer of the environments: Resource not accessible by integration",
+		},
+		{
+			name: "secondary rate limit",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposActionsRunsPendingDeploymentsByOwnerByRepoByRunId,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.Header().Set("Retry-After", "60")
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "You have exceeded a secondary rate limit.", "documentation_url": "https://docs.github.com/rest/overview/rate-limits-for-the-rest-api#about-secondary-rate-limits"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":           "owner",
+				"repo":            "repo",
+				"run_id":          float64(12345),
+				"environment_ids": []any{float64(161088068)},
+				"state":           "approved",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to review pending deployments: secondary rate limit exceeded, retry in 1m0s",
+		},
+		{
+			name:         "fractional environment ID",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":           "owner",
+				"repo":            "repo",
+				"run_id":          float64(12345),
+				"environment_ids": []any{float64(161088068.5)},
+				"state":           "approved",
+			},
+			expectError:    true,
+			expectedErrMsg: "environment_ids must be an array of positive whole numbers, got 1.610880685e+08",
+		},
+		{
+			name:         "non-numeric environment ID",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":           "owner",
+				"repo":            "repo",
+				"run_id":          float64(12345),
+				"environment_ids": []any{"production"},
+				"state":           "approved",
+			},
+			expectError:    true,
+			expectedErrMsg: "environment_ids must be an array of positive whole numbers, got production",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ReviewPendingDeployments(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned map[string]any
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_ApproveWorkflowRun(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ApproveWorkflowRun(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "approve_workflow_run", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "run_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "run_id"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedResult map[string]any
+		expectedErrMsg string
+	}{
+		{
+			name: "successful approval",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposActionsRunsApproveByOwnerByRepoByRunId,
+					expectPath(t, "/repos/owner/repo/actions/runs/12345/approve").andThen(
+						mockResponse(t, http.StatusCreated, map[string]any{}),
+					),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposActionsRunsByOwnerByRepoByRunId,
+					&github.WorkflowRun{
+						ID:      github.Ptr(int64(12345)),
+						Status:  github.Ptr("queued"),
+						HTMLURL: github.Ptr("https://github.com/owner/repo/actions/runs/12345"),
+					},
+				),
+			),
+			expectError: false,
+			expectedResult: map[string]any{
+				"message":    "Workflow run has been approved",
+				"run_id":     float64(12345),
+				"status":     "queued",
+				"conclusion": "",
+				"html_url":   "https://github.com/owner/repo/actions/runs/12345",
+			},
+		},
+		{
+			name: "no write access",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposActionsRunsApproveByOwnerByRepoByRunId,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Must have admin rights to Repository."}`))
+					}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to approve workflow run: approving workflow runs requires write access to the repository",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ApproveWorkflowRun(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(12345),
+			})
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned map[string]any
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_ListActionsVariables(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListActionsVariables(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_actions_variables", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "environment")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	updatedAt := &github.Timestamp{Time: time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)}
+	repoVariables := &github.ActionsVariables{
+		TotalCount: 1,
+		Variables: []*github.ActionsVariable{
+			{Name: "DEPLOY_ENV", Value: "staging", UpdatedAt: updatedAt},
+		},
+	}
+	envVariables := &github.ActionsVariables{
+		TotalCount: 1,
+		Variables: []*github.ActionsVariable{
+			{Name: "DEPLOY_ENV", Value: "production", UpdatedAt: updatedAt},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedResult map[string]any
+		expectedErrMsg string
+	}{
+		{
+			name: "repository variables",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsVariablesByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, repoVariables),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError: false,
+			expectedResult: map[string]any{
+				"repository_variables": map[string]any{
+					"total_count": float64(1),
+					"variables": []any{
+						map[string]any{"name": "DEPLOY_ENV", "value": "staging", "updated_at": "2024-01-01T10:00:00Z"},
+					},
+				},
+			},
+		},
+		{
+			name: "repository and environment variables",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsVariablesByOwnerByRepo,
+					repoVariables,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposEnvironmentsVariablesByOwnerByRepoByEnvironmentName,
+					expectPath(t, "/repos/owner/repo/environments/production/variables").andThen(
+						mockResponse(t, http.StatusOK, envVariables),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"environment": "production",
+			},
+			expectError: false,
+			expectedResult: map[string]any{
+				"repository_variables": map[string]any{
+					"total_count": float64(1),
+					"variables": []any{
+						map[string]any{"name": "DEPLOY_ENV", "value": "staging", "updated_at": "2024-01-01T10:00:00Z"},
+					},
+				},
+				"environment": "production",
+				"environment_variables": map[string]any{
+					"total_count": float64(1),
+					"variables": []any{
+						map[string]any{"name": "DEPLOY_ENV", "value": "production", "updated_at": "2024-01-01T10:00:00Z"},
+					},
+				},
+			},
+		},
+		{
+			name: "environment not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsVariablesByOwnerByRepo,
+					repoVariables,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposEnvironmentsVariablesByOwnerByRepoByEnvironmentName,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"environment": "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list variables of environment missing",
+		},
+		{
+			name: "repository variables forbidden",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsVariablesByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Resource not accessible by integration"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list repository variables",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListActionsVariables(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned map[string]any
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
 		})
 	}
 }
 
-func Test_DeleteWorkflowRunLogs(t *testing.T) {
+func Test_ListActionsSecrets(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := DeleteWorkflowRunLogs(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := ListActionsSecrets(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 
-	assert.Equal(t, "delete_workflow_run_logs", tool.Name)
+	assert.Equal(t, "list_actions_secrets", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
-	assert.Contains(t, tool.InputSchema.Properties, "run_id")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "run_id"})
+	assert.Contains(t, tool.InputSchema.Properties, "environment")
+	assert.Contains(t, tool.InputSchema.Properties, "include_org")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	timestamp := github.Timestamp{Time: time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)}
+	secrets := func(names ...string) *github.Secrets {
+		result := &github.Secrets{TotalCount: len(names)}
+		for _, name := range names {
+			result.Secrets = append(result.Secrets, &github.Secret{Name: name, CreatedAt: timestamp, UpdatedAt: timestamp})
+		}
+		return result
+	}
+	summaries := func(names ...string) map[string]any {
+		list := []any{}
+		for _, name := range names {
+			list = append(list, map[string]any{"name": name, "created_at": "2024-01-01T10:00:00Z", "updated_at": "2024-01-01T10:00:00Z"})
+		}
+		return map[string]any{"total_count": float64(len(names)), "secrets": list}
+	}
 
 	tests := []struct {
 		name           string
 		mockedClient   *http.Client
 		requestArgs    map[string]any
 		expectError    bool
+		expectedResult map[string]any
 		expectedErrMsg string
 	}{
 		{
-			name: "successful logs deletion",
+			name: "repository secrets",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.DeleteReposActionsRunsLogsByOwnerByRepoByRunId,
+					mock.GetReposActionsSecretsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, secrets("NPM_TOKEN")),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError: false,
+			expectedResult: map[string]any{
+				"repository_secrets": summaries("NPM_TOKEN"),
+			},
+		},
+		{
+			name: "environment and organization secrets",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsSecretsByOwnerByRepo,
+					secrets("NPM_TOKEN"),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposEnvironmentsSecretsByOwnerByRepoByEnvironmentName,
+					expectPath(t, "/repos/owner/repo/environments/production/secrets").andThen(
+						mockResponse(t, http.StatusOK, secrets("DEPLOY_KEY")),
+					),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposActionsOrganizationSecretsByOwnerByRepo,
+					secrets("SLACK_WEBHOOK", "SONAR_TOKEN"),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"environment": "production",
+				"include_org": true,
+			},
+			expectError: false,
+			expectedResult: map[string]any{
+				"repository_secrets":   summaries("NPM_TOKEN"),
+				"environment":          "production",
+				"environment_secrets":  summaries("DEPLOY_KEY"),
+				"organization_secrets": summaries("SLACK_WEBHOOK", "SONAR_TOKEN"),
+			},
+		},
+		{
+			name: "environment not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsSecretsByOwnerByRepo,
+					secrets("NPM_TOKEN"),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposEnvironmentsSecretsByOwnerByRepoByEnvironmentName,
 					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusNoContent)
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
 					}),
 				),
 			),
 			requestArgs: map[string]any{
-				"owner":  "owner",
-				"repo":   "repo",
-				"run_id": float64(12345),
+				"owner":       "owner",
+				"repo":        "repo",
+				"environment": "missing",
 			},
-			expectError: false,
+			expectError:    true,
+			expectedErrMsg: "failed to list secrets of environment missing",
 		},
 		{
-			name:         "missing required parameter run_id",
-			mockedClient: mock.NewMockedHTTPClient(),
+			name: "repository secrets forbidden",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsSecretsByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Resource not accessible by integration"}`))
+					}),
+				),
+			),
 			requestArgs: map[string]any{
 				"owner": "owner",
 				"repo":  "repo",
 			},
 			expectError:    true,
-			expectedErrMsg: "missing required parameter: run_id",
+			expectedErrMsg: "failed to list repository secrets",
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := DeleteWorkflowRunLogs(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := ListActionsSecrets(stubGetClientFn(client), translations.NullTranslationHelper)
 
-			// Create call request
 			request := createMCPRequest(tc.requestArgs)
-
-			// Call handler
 			result, err := handler(context.Background(), request)
-
 			require.NoError(t, err)
-			require.Equal(t, tc.expectError, result.IsError)
 
-			// Parse the result and get the text content if no error
-			textContent := getTextResult(t, result)
-
-			if tc.expectedErrMsg != "" {
-				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
 				return
 			}
 
-			// Unmarshal and verify the result
-			var response map[string]any
-			err = json.Unmarshal([]byte(textContent.Text), &response)
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned map[string]any
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
 			require.NoError(t, err)
-			assert.Equal(t, "Workflow run logs have been deleted", response["message"])
-			assert.Equal(t, float64(12345), response["run_id"])
+			assert.Equal(t, tc.expectedResult, returned)
 		})
 	}
 }
 
-func Test_GetWorkflowRunUsage(t *testing.T) {
+func Test_ListWorkflowJobs(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := GetWorkflowRunUsage(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := ListWorkflowJobs(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 
-	assert.Equal(t, "get_workflow_run_usage", tool.Name)
+	assert.Equal(t, "list_workflow_jobs", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "run_id")
+	assert.Contains(t, tool.InputSchema.Properties, "filter")
+	assert.Contains(t, tool.InputSchema.Properties, "conclusion")
+	assert.Contains(t, tool.InputSchema.Properties, "failed_only")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "run_id"})
 
+	mockJobs := &github.Jobs{
+		TotalCount: github.Ptr(3),
+		Jobs: []*github.WorkflowJob{
+			{
+				ID:          github.Ptr(int64(1)),
+				Name:        github.Ptr("build (ubuntu)"),
+				Status:      github.Ptr("completed"),
+				Conclusion:  github.Ptr("success"),
+				RunnerName:  github.Ptr("runner-1"),
+				StartedAt:   &github.Timestamp{Time: time.Date(2025, 5, 1, 2, 0, 0, 0, time.UTC)},
+				CompletedAt: &github.Timestamp{Time: time.Date(2025, 5, 1, 2, 5, 0, 0, time.UTC)},
+				HTMLURL:     github.Ptr("https://github.com/owner/repo/actions/runs/1001/job/1"),
+				Steps: []*github.TaskStep{
+					{Number: github.Ptr(int64(1)), Name: github.Ptr("Checkout"), Status: github.Ptr("completed"), Conclusion: github.Ptr("success")},
+				},
+			},
+			{
+				ID:          github.Ptr(int64(2)),
+				Name:        github.Ptr("build (windows)"),
+				Status:      github.Ptr("completed"),
+				Conclusion:  github.Ptr("failure"),
+				RunnerName:  github.Ptr("runner-2"),
+				StartedAt:   &github.Timestamp{Time: time.Date(2025, 5, 1, 2, 0, 0, 0, time.UTC)},
+				CompletedAt: &github.Timestamp{Time: time.Date(2025, 5, 1, 2, 9, 0, 0, time.UTC)},
+				HTMLURL:     github.Ptr("https://github.com/owner/repo/actions/runs/1001/job/2"),
+				Steps: []*github.TaskStep{
+					{Number: github.Ptr(int64(1)), Name: github.Ptr("Checkout"), Status: github.Ptr("completed"), Conclusion: github.Ptr("success")},
+					{Number: github.Ptr(int64(2)), Name: github.Ptr("Run tests"), Status: github.Ptr("completed"), Conclusion: github.Ptr("failure")},
+					{Number: github.Ptr(int64(3)), Name: github.Ptr("Upload results"), Status: github.Ptr("completed"), Conclusion: github.Ptr("skipped")},
+				},
+			},
+			{
+				ID:         github.Ptr(int64(3)),
+				Name:       github.Ptr("deploy"),
+				Status:     github.Ptr("completed"),
+				Conclusion: github.Ptr("skipped"),
+				HTMLURL:    github.Ptr("https://github.com/owner/repo/actions/runs/1001/job/3"),
+			},
+		},
+	}
+
+	successfulJob := WorkflowJobDetails{
+		ID:          1,
+		Name:        "build (ubuntu)",
+		Status:      "completed",
+		Conclusion:  "success",
+		RunnerName:  "runner-1",
+		StartedAt:   "2025-05-01T02:00:00Z",
+		CompletedAt: "2025-05-01T02:05:00Z",
+		HTMLURL:     "https://github.com/owner/repo/actions/runs/1001/job/1",
+		Steps: []WorkflowJobStep{
+			{Number: 1, Name: "Checkout", Status: "completed", Conclusion: "success"},
+		},
+	}
+	failedJob := WorkflowJobDetails{
+		ID:          2,
+		Name:        "build (windows)",
+		Status:      "completed",
+		Conclusion:  "failure",
+		RunnerName:  "runner-2",
+		StartedAt:   "2025-05-01T02:00:00Z",
+		CompletedAt: "2025-05-01T02:09:00Z",
+		HTMLURL:     "https://github.com/owner/repo/actions/runs/1001/job/2",
+		Steps: []WorkflowJobStep{
+			{Number: 1, Name: "Checkout", Status: "completed", Conclusion: "success"},
+			{Number: 2, Name: "Run tests", Status: "completed", Conclusion: "failure"},
+			{Number: 3, Name: "Upload results", Status: "completed", Conclusion: "skipped"},
+		},
+	}
+	skippedJob := WorkflowJobDetails{
+		ID:         3,
+		Name:       "deploy",
+		Status:     "completed",
+		Conclusion: "skipped",
+		HTMLURL:    "https://github.com/owner/repo/actions/runs/1001/job/3",
+		Steps:      []WorkflowJobStep{},
+	}
+	failedJobFailedSteps := failedJob
+	failedJobFailedSteps.Steps = []WorkflowJobStep{
+		{Number: 2, Name: "Run tests", Status: "completed", Conclusion: "failure"},
+	}
+
 	tests := []struct {
 		name           string
 		mockedClient   *http.Client
 		requestArgs    map[string]any
 		expectError    bool
+		expectedJobs   []WorkflowJobDetails
 		expectedErrMsg string
 	}{
 		{
-			name: "successful workflow run usage",
+			name: "list all jobs of all attempts",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.GetReposActionsRunsTimingByOwnerByRepoByRunId,
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						usage := &github.WorkflowRunUsage{
-							Billable: &github.WorkflowRunBillMap{
-								"UBUNTU": &github.WorkflowRunBill{
-									TotalMS: github.Ptr(int64(120000)),
-									Jobs:    github.Ptr(2),
-									JobRuns: []*github.WorkflowRunJobRun{
-										{
-											JobID:      github.Ptr(1),
-											DurationMS: github.Ptr(int64(60000)),
-										},
-										{
-											JobID:      github.Ptr(2),
-											DurationMS: github.Ptr(int64(60000)),
-										},
-									},
-								},
-							},
-							RunDurationMS: github.Ptr(int64(120000)),
-						}
-						w.WriteHeader(http.StatusOK)
-						_ = json.NewEncoder(w).Encode(usage)
-					}),
+					mock.GetReposActionsRunsJobsByOwnerByRepoByRunId,
+					expectQueryParams(t, map[string]string{
+						"filter":   "all",
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockJobs),
+					),
 				),
 			),
 			requestArgs: map[string]any{
 				"owner":  "owner",
 				"repo":   "repo",
-				"run_id": float64(12345),
+				"run_id": float64(1001),
+				"filter": "all",
 			},
-			expectError: false,
+			expectError:  false,
+			expectedJobs: []WorkflowJobDetails{successfulJob, failedJob, skippedJob},
 		},
 		{
-			name:         "missing required parameter run_id",
-			mockedClient: mock.NewMockedHTTPClient(),
+			name: "filter by conclusion",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsRunsJobsByOwnerByRepoByRunId,
+					mockJobs,
+				),
+			),
 			requestArgs: map[string]any{
-				"owner": "owner",
-				"repo":  "repo",
+				"owner":      "owner",
+				"repo":       "repo",
+				"run_id":     float64(1001),
+				"conclusion": "skipped",
+			},
+			expectError:  false,
+			expectedJobs: []WorkflowJobDetails{skippedJob},
+		},
+		{
+			name: "failed jobs with their failed steps",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsRunsJobsByOwnerByRepoByRunId,
+					mockJobs,
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"run_id":      float64(1001),
+				"failed_only": true,
+			},
+			expectError:  false,
+			expectedJobs: []WorkflowJobDetails{failedJobFailedSteps},
+		},
+		{
+			name: "list jobs fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsJobsByOwnerByRepoByRunId,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(1001),
 			},
 			expectError:    true,
-			expectedErrMsg: "missing required parameter: run_id",
+			expectedErrMsg: "failed to list workflow jobs",
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := GetWorkflowRunUsage(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := ListWorkflowJobs(stubGetClientFn(client), translations.NullTranslationHelper)
 
-			// Create call request
 			request := createMCPRequest(tc.requestArgs)
-
-			// Call handler
 			result, err := handler(context.Background(), request)
-
 			require.NoError(t, err)
-			require.Equal(t, tc.expectError, result.IsError)
-
-			// Parse the result and get the text content if no error
-			textContent := getTextResult(t, result)
 
-			if tc.expectedErrMsg != "" {
-				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
 				return
 			}
 
-			// Unmarshal and verify the result
-			var response github.WorkflowRunUsage
-			err = json.Unmarshal([]byte(textContent.Text), &response)
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned WorkflowJobList
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
 			require.NoError(t, err)
-			assert.NotNil(t, response.RunDurationMS)
-			assert.NotNil(t, response.Billable)
+			assert.Equal(t, 3, returned.TotalCount)
+			assert.Equal(t, tc.expectedJobs, returned.Jobs)
+			assert.Contains(t, returned.OptimizationTip, "run_id=1001")
 		})
 	}
 }
@@ -794,6 +3352,9 @@ func Test_GetJobLogs(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "run_id")
 	assert.Contains(t, tool.InputSchema.Properties, "failed_only")
 	assert.Contains(t, tool.InputSchema.Properties, "return_content")
+	assert.Contains(t, tool.InputSchema.Properties, "tail_lines")
+	assert.Contains(t, tool.InputSchema.Properties, "strip_timestamps")
+	assert.Contains(t, tool.InputSchema.Properties, "max_total_bytes")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
 
 	tests := []struct {
@@ -946,6 +3507,18 @@ func Test_GetJobLogs(t *testing.T) {
 			expectError:    true,
 			expectedErrMsg: "run_id is required when failed_only is true",
 		},
+		{
+			name:         "tail_lines above the maximum",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"job_id":     float64(123),
+				"tail_lines": float64(5001),
+			},
+			expectError:    true,
+			expectedErrMsg: "tail_lines must be between 1 and 5000",
+		},
 		{
 			name:         "missing required parameter owner",
 			mockedClient: mock.NewMockedHTTPClient(),
@@ -1092,10 +3665,128 @@ func Test_GetJobLogs_WithContentReturn(t *testing.T) {
 
 	assert.Equal(t, float64(123), response["job_id"])
 	assert.Equal(t, logContent, response["logs_content"])
+	assert.Equal(t, false, response["truncated"])
 	assert.Equal(t, "Job logs content retrieved successfully", response["message"])
 	assert.NotContains(t, response, "logs_url") // Should not have URL when returning content
 }
 
+func Test_GetJobLogs_StripTimestamps(t *testing.T) {
+	logContent := "\ufeff2023-01-01T10:00:00.0000000Z Starting job...\n2023-01-01T10:00:01.0000000Z Running tests...\n2023-01-01T10:00:02.0000000Z Job completed successfully\n"
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(logContent))
+	}))
+	defer testServer.Close()
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposActionsJobsLogsByOwnerByRepoByJobId,
+			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Location", testServer.URL)
+				w.WriteHeader(http.StatusFound)
+			}),
+		),
+	)
+
+	client := github.NewClient(mockedClient)
+	_, handler := GetJobLogs(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	request := createMCPRequest(map[string]any{
+		"owner":            "owner",
+		"repo":             "repo",
+		"job_id":           float64(123),
+		"return_content":   true,
+		"tail_lines":       float64(2),
+		"strip_timestamps": true,
+	})
+
+	result, err := handler(context.Background(), request)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	textContent := getTextResult(t, result)
+	var response map[string]any
+	err = json.Unmarshal([]byte(textContent.Text), &response)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Running tests...\nJob completed successfully", response["logs_content"])
+	assert.Equal(t, true, response["truncated"])
+}
+
+func Test_GetJobLogs_FailedJobsByteBudget(t *testing.T) {
+	logs := map[string]string{
+		"/logs/1": "build line 1\nbuild line 2\nbuild failed",
+		"/logs/2": "test line 1\ntest line 2\ntests failed",
+		"/logs/3": "lint failed",
+	}
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(logs[r.URL.Path]))
+	}))
+	defer testServer.Close()
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetReposActionsRunsJobsByOwnerByRepoByRunId,
+			&github.Jobs{
+				TotalCount: github.Ptr(4),
+				Jobs: []*github.WorkflowJob{
+					{ID: github.Ptr(int64(1)), Name: github.Ptr("build"), Conclusion: github.Ptr("failure")},
+					{ID: github.Ptr(int64(2)), Name: github.Ptr("test"), Conclusion: github.Ptr("timed_out")},
+					{ID: github.Ptr(int64(3)), Name: github.Ptr("lint"), Conclusion: github.Ptr("failure")},
+					{ID: github.Ptr(int64(4)), Name: github.Ptr("docs"), Conclusion: github.Ptr("success")},
+				},
+			},
+		),
+		mock.WithRequestMatchHandler(
+			mock.GetReposActionsJobsLogsByOwnerByRepoByJobId,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Location", testServer.URL+"/logs/"+path.Base(path.Dir(r.URL.Path)))
+				w.WriteHeader(http.StatusFound)
+			}),
+		),
+	)
+
+	client := github.NewClient(mockedClient)
+	_, handler := GetJobLogs(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	request := createMCPRequest(map[string]any{
+		"owner":           "owner",
+		"repo":            "repo",
+		"run_id":          float64(456),
+		"failed_only":     true,
+		"return_content":  true,
+		"max_total_bytes": float64(60),
+	})
+
+	result, err := handler(context.Background(), request)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	textContent := getTextResult(t, result)
+	var response struct {
+		FailedJobs int              `json:"failed_jobs"`
+		Truncated  bool             `json:"truncated"`
+		Logs       []map[string]any `json:"logs"`
+	}
+	err = json.Unmarshal([]byte(textContent.Text), &response)
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, response.FailedJobs)
+	assert.True(t, response.Truncated)
+	require.Len(t, response.Logs, 3)
+
+	// The first log fits whole, the second is cut at a line boundary and the third is left out.
+	assert.Equal(t, "build line 1\nbuild line 2\nbuild failed", response.Logs[0]["logs_content"])
+	assert.Equal(t, false, response.Logs[0]["truncated"])
+	assert.Equal(t, "tests failed", response.Logs[1]["logs_content"])
+	assert.Equal(t, true, response.Logs[1]["truncated"])
+	assert.Equal(t, "lint", response.Logs[2]["job_name"])
+	assert.NotContains(t, response.Logs[2], "logs_content")
+	assert.Equal(t, true, response.Logs[2]["truncated"])
+}
+
 func Test_GetJobLogs_WithContentReturnAndTailLines(t *testing.T) {
 	// Test the return_content functionality with a mock HTTP server
 	logContent := "2023-01-01T10:00:00.000Z Starting job...\n2023-01-01T10:00:01.000Z Running tests...\n2023-01-01T10:00:02.000Z Job completed successfully"
@@ -1140,6 +3831,7 @@ func Test_GetJobLogs_WithContentReturnAndTailLines(t *testing.T) {
 
 	assert.Equal(t, float64(123), response["job_id"])
 	assert.Equal(t, float64(1), response["original_length"])
+	assert.Equal(t, true, response["truncated"])
 	assert.Equal(t, expectedLogContent, response["logs_content"])
 	assert.Equal(t, "Job logs content retrieved successfully", response["message"])
 	assert.NotContains(t, response, "logs_url") // Should not have URL when returning content
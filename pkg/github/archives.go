@@ -0,0 +1,239 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v73/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// defaultArchiveMaxBytes is the largest archive download_repository_archive saves unless max_bytes is given.
+const defaultArchiveMaxBytes = 500 << 20
+
+// commitSHARegexp matches a full or abbreviated commit SHA.
+var commitSHARegexp = regexp.MustCompile(`^[0-9a-f]{7,40}$`)
+
+// ArchiveDownload describes a repository archive saved by download_repository_archive.
+type ArchiveDownload struct {
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	Format string `json:"format"`
+	Ref    string `json:"ref,omitempty"`
+	// CommitSHA is the commit the archive was made from. GitHub names the archive after the abbreviated
+	// SHA, so it is only complete when ref is a full SHA.
+	CommitSHA string `json:"commit_sha,omitempty"`
+}
+
+// errArchiveTooLarge is returned when an archive is larger than the size allowed for the download.
+var errArchiveTooLarge = errors.New("archive is larger than max_bytes")
+
+// archiveFileName gets the name GitHub gives to an archive from the Content-Disposition header of its download.
+func archiveFileName(contentDisposition string) string {
+	_, params, err := mime.ParseMediaType(contentDisposition)
+	if err != nil {
+		return ""
+	}
+	return filepath.Base(params["filename"])
+}
+
+// archiveCommitSHA gets the commit an archive was made from, either from the download URL when the ref
+// is a full SHA, or from the archive name, which GitHub suffixes with the abbreviated SHA.
+func archiveCommitSHA(downloadPath, fileName string) string {
+	if sha := path.Base(downloadPath); len(sha) == 40 && commitSHARegexp.MatchString(sha) {
+		return sha
+	}
+	name := strings.TrimSuffix(strings.TrimSuffix(fileName, ".tar.gz"), ".zip")
+	if i := strings.LastIndex(name, "-"); i >= 0 && commitSHARegexp.MatchString(name[i+1:]) {
+		return name[i+1:]
+	}
+	return ""
+}
+
+// createNewFile creates a file for a tool to save a download to. It fails rather than overwrite an
+// existing file, so that a file the caller of the tool didn't mean to replace is never lost.
+func createNewFile(filePath string) (*os.File, error) {
+	file, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600) //#nosec G304 -- the destination is chosen by the caller of the tool
+	if errors.Is(err, fs.ErrExist) {
+		return nil, fmt.Errorf("%s already exists", filePath)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s: %w", filePath, err)
+	}
+	return file, nil
+}
+
+// saveArchive streams the body of an archive download to a new file, removing the file again when
+// the archive turns out to be larger than maxBytes.
+func saveArchive(body io.Reader, filePath string, maxBytes int64) (int64, error) {
+	file, err := createNewFile(filePath)
+	if err != nil {
+		return 0, err
+	}
+
+	// Copy one byte more than allowed to detect an archive that is too large.
+	written, err := io.Copy(file, io.LimitReader(body, maxBytes+1))
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil && written > maxBytes {
+		err = errArchiveTooLarge
+	}
+	if err != nil {
+		_ = os.Remove(filePath)
+		return 0, err
+	}
+	return written, nil
+}
+
+// DownloadRepositoryArchive creates a tool to download the archive of a repository to a local path.
+func DownloadRepositoryArchive(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("download_repository_archive",
+			mcp.WithDescription(t("TOOL_DOWNLOAD_REPOSITORY_ARCHIVE_DESCRIPTION", "Download a tarball or zipball of a GitHub repository to a local path and return where it was saved, its size and the commit it was made from. The archive content is never returned")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_DOWNLOAD_REPOSITORY_ARCHIVE_USER_TITLE", "Download repository archive"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("format",
+				mcp.Description("Archive format"),
+				mcp.Enum("tar.gz", "zip"),
+				mcp.DefaultString("tar.gz"),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Branch, tag or commit SHA to archive. Defaults to the default branch"),
+			),
+			mcp.WithString("dest_path",
+				mcp.Description("New file or existing directory to save the archive to. Existing files are not overwritten. Defaults to a new temporary directory"),
+			),
+			mcp.WithNumber("max_bytes",
+				mcp.Description("Largest archive to save, in bytes. Larger archives are not saved"),
+				mcp.DefaultNumber(defaultArchiveMaxBytes),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			format, err := OptionalParam[string](request, "format")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			archiveFormat := github.Tarball
+			extension := ".tar.gz"
+			switch format {
+			case "", "tar.gz":
+				format = "tar.gz"
+			case "zip":
+				archiveFormat = github.Zipball
+				extension = ".zip"
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("invalid format %q, must be tar.gz or zip", format)), nil
+			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			destPath, err := OptionalParam[string](request, "dest_path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxBytes, err := OptionalIntParamWithDefault(request, "max_bytes", defaultArchiveMaxBytes)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if maxBytes < 1 {
+				return mcp.NewToolResultError("max_bytes must be at least 1"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			archiveURL, resp, err := client.Repositories.GetArchiveLink(ctx, owner, repo, archiveFormat, &github.RepositoryContentGetOptions{Ref: ref}, 1)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get archive link", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			// The archive link is a short-lived signed URL, so it is downloaded without the GitHub credentials.
+			downloadReq, err := http.NewRequestWithContext(ctx, http.MethodGet, archiveURL.String(), nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create archive download request: %w", err)
+			}
+			downloadResp, err := http.DefaultClient.Do(downloadReq)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to download archive: %s", err)), nil
+			}
+			defer func() { _ = downloadResp.Body.Close() }()
+
+			if downloadResp.StatusCode != http.StatusOK {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to download archive: HTTP %d", downloadResp.StatusCode)), nil
+			}
+			if downloadResp.ContentLength > int64(maxBytes) {
+				return mcp.NewToolResultError(fmt.Sprintf("archive of %s/%s is %d bytes, larger than max_bytes %d", owner, repo, downloadResp.ContentLength, maxBytes)), nil
+			}
+
+			fileName := archiveFileName(downloadResp.Header.Get("Content-Disposition"))
+			if fileName == "" || fileName == "." || fileName == string(filepath.Separator) {
+				fileName = owner + "-" + repo + extension
+			}
+
+			filePath := destPath
+			tempDir := ""
+			if destPath == "" {
+				tempDir, err = os.MkdirTemp("", "github-mcp-archive-")
+				if err != nil {
+					return nil, fmt.Errorf("failed to create temporary directory: %w", err)
+				}
+				filePath = filepath.Join(tempDir, fileName)
+			} else if info, err := os.Stat(destPath); err == nil && info.IsDir() {
+				filePath = filepath.Join(destPath, fileName)
+			}
+
+			size, err := saveArchive(downloadResp.Body, filePath, int64(maxBytes))
+			if err != nil && tempDir != "" {
+				_ = os.Remove(tempDir)
+			}
+			if errors.Is(err, errArchiveTooLarge) {
+				return mcp.NewToolResultError(fmt.Sprintf("archive of %s/%s is larger than max_bytes %d", owner, repo, maxBytes)), nil
+			}
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to save archive: %s", err)), nil
+			}
+
+			return MarshalledTextResult(ArchiveDownload{
+				Path:      filePath,
+				Size:      size,
+				Format:    format,
+				Ref:       ref,
+				CommitSHA: archiveCommitSHA(archiveURL.Path, fileName),
+			}), nil
+		}
+}
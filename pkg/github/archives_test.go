@@ -0,0 +1,260 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v73/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DownloadRepositoryArchive(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := DownloadRepositoryArchive(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "download_repository_archive", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "format")
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.Contains(t, tool.InputSchema.Properties, "dest_path")
+	assert.Contains(t, tool.InputSchema.Properties, "max_bytes")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+	// It writes to the local filesystem, so it must not be offered in read-only mode.
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	archiveContent := []byte("archive content")
+	downloadServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := "owner-repo-abc1234.tar.gz"
+		if strings.Contains(r.URL.Path, "/legacy.zip/") {
+			name = "owner-repo-abc1234.zip"
+		}
+		w.Header().Set("Content-Disposition", "attachment; filename="+name)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(archiveContent)
+	}))
+	defer downloadServer.Close()
+
+	archiveLink := func(downloadPath string) http.HandlerFunc {
+		return func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Location", downloadServer.URL+downloadPath)
+			w.WriteHeader(http.StatusFound)
+		}
+	}
+
+	destDir := t.TempDir()
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult ArchiveDownload
+		expectedErrMsg string
+	}{
+		{
+			name: "download tarball into a directory",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposTarballByOwnerByRepoByRef,
+					expectPath(t, "/repos/owner/repo/tarball/main").andThen(
+						archiveLink("/owner/repo/legacy.tar.gz/refs/heads/main"),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"ref":       "main",
+				"dest_path": destDir,
+			},
+			expectError: false,
+			expectedResult: ArchiveDownload{
+				Path:      filepath.Join(destDir, "owner-repo-abc1234.tar.gz"),
+				Size:      int64(len(archiveContent)),
+				Format:    "tar.gz",
+				Ref:       "main",
+				CommitSHA: "abc1234",
+			},
+		},
+		{
+			name: "download zipball of a commit to a file",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposZipballByOwnerByRepoByRef,
+					archiveLink("/owner/repo/legacy.zip/0123456789abcdef0123456789abcdef01234567"),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"format":    "zip",
+				"ref":       "0123456789abcdef0123456789abcdef01234567",
+				"dest_path": filepath.Join(destDir, "snapshot.zip"),
+			},
+			expectError: false,
+			expectedResult: ArchiveDownload{
+				Path:      filepath.Join(destDir, "snapshot.zip"),
+				Size:      int64(len(archiveContent)),
+				Format:    "zip",
+				Ref:       "0123456789abcdef0123456789abcdef01234567",
+				CommitSHA: "0123456789abcdef0123456789abcdef01234567",
+			},
+		},
+		{
+			name: "archive larger than max_bytes",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposTarballByOwnerByRepoByRef,
+					archiveLink("/owner/repo/legacy.tar.gz/refs/heads/main"),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"ref":       "main",
+				"dest_path": filepath.Join(destDir, "too-large.tar.gz"),
+				"max_bytes": float64(4),
+			},
+			expectError:    true,
+			expectedErrMsg: "larger than max_bytes 4",
+		},
+		{
+			name:         "invalid max_bytes",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"max_bytes": float64(-1),
+			},
+			expectError:    true,
+			expectedErrMsg: "max_bytes must be at least 1",
+		},
+		{
+			name: "archive link fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposTarballByOwnerByRepoByRef,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get archive link",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := DownloadRepositoryArchive(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				if destPath, ok := tc.requestArgs["dest_path"].(string); ok {
+					assert.NoFileExists(t, destPath)
+				}
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned ArchiveDownload
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+
+			saved, err := os.ReadFile(returned.Path)
+			require.NoError(t, err)
+			assert.Equal(t, archiveContent, saved)
+		})
+	}
+
+	t.Run("existing file is not overwritten", func(t *testing.T) {
+		existing := filepath.Join(destDir, "existing.tar.gz")
+		require.NoError(t, os.WriteFile(existing, []byte("keep me"), 0o600))
+
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetReposTarballByOwnerByRepoByRef,
+				archiveLink("/owner/repo/legacy.tar.gz/refs/heads/main"),
+			),
+		))
+		_, handler := DownloadRepositoryArchive(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner":     "owner",
+			"repo":      "repo",
+			"ref":       "main",
+			"dest_path": existing,
+		}))
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getErrorResult(t, result).Text, "already exists")
+
+		kept, err := os.ReadFile(existing)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("keep me"), kept)
+	})
+}
+
+func Test_ArchiveCommitSHA(t *testing.T) {
+	tests := []struct {
+		name         string
+		downloadPath string
+		fileName     string
+		expected     string
+	}{
+		{
+			name:         "full SHA in the download path",
+			downloadPath: "/owner/repo/legacy.tar.gz/0123456789abcdef0123456789abcdef01234567",
+			fileName:     "owner-repo-0123456.tar.gz",
+			expected:     "0123456789abcdef0123456789abcdef01234567",
+		},
+		{
+			name:         "abbreviated SHA in the archive name",
+			downloadPath: "/owner/repo/legacy.zip/refs/heads/main",
+			fileName:     "owner-my-repo-abc1234.zip",
+			expected:     "abc1234",
+		},
+		{
+			name:         "no SHA",
+			downloadPath: "/owner/repo/legacy.tar.gz/refs/heads/main",
+			fileName:     "owner-repo.tar.gz",
+			expected:     "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, archiveCommitSHA(tc.downloadPath, tc.fileName))
+		})
+	}
+}
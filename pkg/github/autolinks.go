@@ -0,0 +1,232 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v73/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// autolinkNumberPlaceholder is replaced by the reference number in the URL template of an autolink.
+const autolinkNumberPlaceholder = "<num>"
+
+// autolinkErrorResponse describes a failed autolink request, explaining a 403 other than a rate limit as
+// missing admin access.
+func autolinkErrorResponse(ctx context.Context, owner, repo, message string, resp *github.Response, err error) *mcp.CallToolResult {
+	return permissionErrorResponse(ctx, message, fmt.Sprintf("managing the autolinks of %s/%s requires admin access to the repository", owner, repo), resp, err)
+}
+
+// findAutolink looks up the autolink of a repository with the given key prefix, which GitHub compares ignoring case.
+func findAutolink(ctx context.Context, client *github.Client, owner, repo, keyPrefix string) (*github.Autolink, error) {
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		autolinks, resp, err := client.Repositories.ListAutolinks(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, err
+		}
+		_ = resp.Body.Close()
+
+		for _, autolink := range autolinks {
+			if strings.EqualFold(autolink.GetKeyPrefix(), keyPrefix) {
+				return autolink, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			return nil, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// ListAutolinks creates a tool to list the autolink references of a repository.
+func ListAutolinks(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_autolinks",
+			mcp.WithDescription(t("TOOL_LIST_AUTOLINKS_DESCRIPTION", "List the autolink references of a GitHub repository, which turn references such as JIRA-123 into links to external systems. Requires admin access to the repository")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_AUTOLINKS_USER_TITLE", "List autolinks"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			autolinks, resp, err := client.Repositories.ListAutolinks(ctx, owner, repo, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return autolinkErrorResponse(ctx, owner, repo, "failed to list autolinks", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(autolinks), nil
+		}
+}
+
+// CreateAutolink creates a tool to add an autolink reference to a repository.
+func CreateAutolink(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_autolink",
+			mcp.WithDescription(t("TOOL_CREATE_AUTOLINK_DESCRIPTION", "Create an autolink reference in a GitHub repository, so that references starting with key_prefix link to url_template. Requires admin access to the repository")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_AUTOLINK_USER_TITLE", "Create autolink"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("key_prefix",
+				mcp.Required(),
+				mcp.Description("Prefix of the references to link, such as JIRA-"),
+			),
+			mcp.WithString("url_template",
+				mcp.Required(),
+				mcp.Description("URL to link references to, containing <num> where the reference number goes, such as https://example.atlassian.net/browse/JIRA-<num>"),
+			),
+			mcp.WithBoolean("is_alphanumeric",
+				mcp.Description("Whether the reference number can contain letters as well as digits (default: true)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			keyPrefix, err := RequiredParam[string](request, "key_prefix")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			urlTemplate, err := RequiredParam[string](request, "url_template")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if !strings.Contains(urlTemplate, autolinkNumberPlaceholder) {
+				return mcp.NewToolResultError(fmt.Sprintf("url_template must contain %s where the reference number goes", autolinkNumberPlaceholder)), nil
+			}
+			isAlphanumeric, isAlphanumericSet, err := OptionalParamOK[bool](request, "is_alphanumeric")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.AutolinkOptions{
+				KeyPrefix:   github.Ptr(keyPrefix),
+				URLTemplate: github.Ptr(urlTemplate),
+			}
+			if isAlphanumericSet {
+				opts.IsAlphanumeric = github.Ptr(isAlphanumeric)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			autolink, resp, err := client.Repositories.AddAutolink(ctx, owner, repo, opts)
+			if err != nil {
+				// A key prefix can only be used by one autolink. The existing one is included so it can be
+				// deleted and created again if it needs to change.
+				if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity {
+					if existing, findErr := findAutolink(ctx, client, owner, repo, keyPrefix); findErr == nil && existing != nil {
+						message := fmt.Sprintf("%s/%s already has autolink %d for key prefix %s, linking to %s with is_alphanumeric %t",
+							owner, repo, existing.GetID(), existing.GetKeyPrefix(), existing.GetURLTemplate(), existing.GetIsAlphanumeric())
+						_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, message, resp, err)
+						return mcp.NewToolResultError(message), nil
+					}
+				}
+				return autolinkErrorResponse(ctx, owner, repo, "failed to create autolink", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(autolink), nil
+		}
+}
+
+// DeleteAutolink creates a tool to delete an autolink reference of a repository.
+func DeleteAutolink(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("delete_autolink",
+			mcp.WithDescription(t("TOOL_DELETE_AUTOLINK_DESCRIPTION", "Delete an autolink reference of a GitHub repository. Requires admin access to the repository")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_DELETE_AUTOLINK_USER_TITLE", "Delete autolink"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithNumber("autolink_id",
+				mcp.Required(),
+				mcp.Description("ID of the autolink, as returned by list_autolinks"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			autolinkID, err := RequiredBigInt(request, "autolink_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.Repositories.DeleteAutolink(ctx, owner, repo, autolinkID)
+			if err != nil {
+				return autolinkErrorResponse(ctx, owner, repo, fmt.Sprintf("failed to delete autolink %d", autolinkID), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf("autolink %d deleted from %s/%s", autolinkID, owner, repo)), nil
+		}
+}
@@ -3,9 +3,13 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/translations"
@@ -14,9 +18,172 @@ import (
 	"github.com/mark3labs/mcp-go/server"
 )
 
+// CodeScanningAlertSummary is the compact form of a code scanning alert, as returned by list_code_scanning_alerts.
+type CodeScanningAlertSummary struct {
+	Number          int    `json:"number"`
+	RuleID          string `json:"rule_id"`
+	RuleDescription string `json:"rule_description"`
+	Severity        string `json:"severity"`
+	State           string `json:"state"`
+	Path            string `json:"path,omitempty"`
+	StartLine       int    `json:"start_line,omitempty"`
+	HTMLURL         string `json:"html_url"`
+}
+
+// summarizeCodeScanningAlert converts a code scanning alert into its compact summary form. The
+// security severity is preferred over the rule severity, which only security rules have.
+func summarizeCodeScanningAlert(alert *github.Alert) CodeScanningAlertSummary {
+	severity := alert.GetRule().GetSecuritySeverityLevel()
+	if severity == "" {
+		severity = alert.GetRule().GetSeverity()
+	}
+	location := alert.GetMostRecentInstance().GetLocation()
+	return CodeScanningAlertSummary{
+		Number:          alert.GetNumber(),
+		RuleID:          alert.GetRule().GetID(),
+		RuleDescription: alert.GetRule().GetDescription(),
+		Severity:        severity,
+		State:           alert.GetState(),
+		Path:            location.GetPath(),
+		StartLine:       location.GetStartLine(),
+		HTMLURL:         alert.GetHTMLURL(),
+	}
+}
+
+const (
+	// codeScanningReadPermission explains a 403 from reading code scanning alerts.
+	codeScanningReadPermission = "reading code scanning alerts requires the security_events scope, or the repo scope for private repositories"
+	// codeScanningWritePermission explains a 403 from updating a code scanning alert.
+	codeScanningWritePermission = "updating code scanning alerts requires write access to the repository's security events, through the security_events scope or the repo scope for private repositories"
+)
+
+// codeScanningErrorResponse describes a failed code scanning request. Rate limits are reported as such.
+// Otherwise GitHub's message is kept and explained: as code scanning not being set up when the message
+// says so, and as the given permission missing for any other 403.
+func codeScanningErrorResponse(ctx context.Context, message, permission string, resp *github.Response, err error) *mcp.CallToolResult {
+	if result, ok := rateLimitErrorResult(ctx, message, resp, err); ok {
+		return result
+	}
+	var errResp *github.ErrorResponse
+	if resp == nil || !errors.As(err, &errResp) {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, message, resp, err)
+	}
+	switch {
+	case isCodeScanningNotConfiguredMessage(errResp.Message):
+		message = fmt.Sprintf("%s: code scanning is not configured for this repository: %s", message, errResp.Message)
+	case resp.StatusCode == http.StatusForbidden:
+		message = fmt.Sprintf("%s: %s: %s", message, permission, errResp.Message)
+	default:
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, message, resp, err)
+	}
+	_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, message, resp, err)
+	return mcp.NewToolResultError(message)
+}
+
+// isCodeScanningNotConfiguredMessage reports whether an API error message says that code scanning, or the
+// Advanced Security it needs, isn't set up for the repository, e.g. "no analysis found" or "Advanced
+// Security must be enabled for this repository to use code scanning".
+func isCodeScanningNotConfiguredMessage(message string) bool {
+	message = strings.ToLower(message)
+	return strings.Contains(message, "no analysis found") ||
+		strings.Contains(message, "not enabled") ||
+		strings.Contains(message, "must be enabled")
+}
+
+// codeScanningRuleHelpMaxBytes caps the help text of a rule returned by get_code_scanning_alert, which
+// can run to several pages of markdown.
+const codeScanningRuleHelpMaxBytes = 4096
+
+// CodeScanningRule is the rule that raised a code scanning alert, as returned by get_code_scanning_alert.
+type CodeScanningRule struct {
+	ID                    string `json:"id"`
+	Name                  string `json:"name,omitempty"`
+	Severity              string `json:"severity,omitempty"`
+	SecuritySeverityLevel string `json:"security_severity_level,omitempty"`
+	Description           string `json:"description,omitempty"`
+	FullDescription       string `json:"full_description,omitempty"`
+	Help                  string `json:"help,omitempty"`
+	HelpTruncated         bool   `json:"help_truncated,omitempty"`
+}
+
+// CodeScanningAlertInstance is one occurrence of a code scanning alert on a ref.
+type CodeScanningAlertInstance struct {
+	Ref       string `json:"ref"`
+	State     string `json:"state,omitempty"`
+	CommitSHA string `json:"commit_sha,omitempty"`
+	Path      string `json:"path,omitempty"`
+	StartLine int    `json:"start_line,omitempty"`
+	EndLine   int    `json:"end_line,omitempty"`
+	Message   string `json:"message,omitempty"`
+}
+
+// CodeScanningAlertDetails is a code scanning alert, as returned by get_code_scanning_alert.
+type CodeScanningAlertDetails struct {
+	Number           int                         `json:"number"`
+	State            string                      `json:"state"`
+	Rule             CodeScanningRule            `json:"rule"`
+	Tool             string                      `json:"tool,omitempty"`
+	CreatedAt        string                      `json:"created_at,omitempty"`
+	FixedAt          string                      `json:"fixed_at,omitempty"`
+	DismissedBy      string                      `json:"dismissed_by,omitempty"`
+	DismissedAt      string                      `json:"dismissed_at,omitempty"`
+	DismissedReason  string                      `json:"dismissed_reason,omitempty"`
+	DismissedComment string                      `json:"dismissed_comment,omitempty"`
+	Instances        []CodeScanningAlertInstance `json:"instances"`
+	HTMLURL          string                      `json:"html_url"`
+}
+
+// newCodeScanningAlertInstance converts an instance of a code scanning alert into its compact form.
+func newCodeScanningAlertInstance(instance *github.MostRecentInstance) CodeScanningAlertInstance {
+	return CodeScanningAlertInstance{
+		Ref:       instance.GetRef(),
+		State:     instance.GetState(),
+		CommitSHA: instance.GetCommitSHA(),
+		Path:      instance.GetLocation().GetPath(),
+		StartLine: instance.GetLocation().GetStartLine(),
+		EndLine:   instance.GetLocation().GetEndLine(),
+		Message:   instance.GetMessage().GetText(),
+	}
+}
+
+// newCodeScanningAlertDetails converts a code scanning alert into its detailed form, with the given instances.
+func newCodeScanningAlertDetails(alert *github.Alert, instances []*github.MostRecentInstance) CodeScanningAlertDetails {
+	rule := alert.GetRule()
+	help, helpTruncated := truncateContent([]byte(rule.GetHelp()), codeScanningRuleHelpMaxBytes)
+	details := CodeScanningAlertDetails{
+		Number: alert.GetNumber(),
+		State:  alert.GetState(),
+		Rule: CodeScanningRule{
+			ID:                    rule.GetID(),
+			Name:                  rule.GetName(),
+			Severity:              rule.GetSeverity(),
+			SecuritySeverityLevel: rule.GetSecuritySeverityLevel(),
+			Description:           rule.GetDescription(),
+			FullDescription:       rule.GetFullDescription(),
+			Help:                  string(help),
+			HelpTruncated:         helpTruncated,
+		},
+		Tool:      alert.GetTool().GetName(),
+		CreatedAt: summaryTimestamp(alert.GetCreatedAt()),
+		FixedAt:   summaryTimestamp(alert.GetFixedAt()),
+		Instances: make([]CodeScanningAlertInstance, 0, len(instances)),
+		HTMLURL:   alert.GetHTMLURL(),
+	}
+	if alert.GetState() == "dismissed" {
+		details.DismissedBy = alert.GetDismissedBy().GetLogin()
+		details.DismissedAt = summaryTimestamp(alert.GetDismissedAt())
+		details.DismissedReason = alert.GetDismissedReason()
+		details.DismissedComment = alert.GetDismissedComment()
+	}
+	for _, instance := range instances {
+		details.Instances = append(details.Instances, newCodeScanningAlertInstance(instance))
+	}
+	return details
+}
+
 func GetCodeScanningAlert(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("get_code_scanning_alert",
-			mcp.WithDescription(t("TOOL_GET_CODE_SCANNING_ALERT_DESCRIPTION", "Get details of a specific code scanning alert in a GitHub repository.")),
+			mcp.WithDescription(t("TOOL_GET_CODE_SCANNING_ALERT_DESCRIPTION", "Get details of a specific code scanning alert in a GitHub repository, including its rule and help text. Only the most recent instance is included unless include_instances is set.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_GET_CODE_SCANNING_ALERT_USER_TITLE", "Get code scanning alert"),
 				ReadOnlyHint: ToBoolPtr(true),
@@ -33,6 +200,10 @@ func GetCodeScanningAlert(getClient GetClientFn, t translations.TranslationHelpe
 				mcp.Required(),
 				mcp.Description("The number of the alert."),
 			),
+			mcp.WithBoolean("include_instances",
+				mcp.Description("List every instance of the alert across refs, paginated with page and perPage, instead of only the most recent one."),
+			),
+			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](request, "owner")
@@ -43,7 +214,15 @@ func GetCodeScanningAlert(getClient GetClientFn, t translations.TranslationHelpe
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			alertNumber, err := RequiredInt(request, "alertNumber")
+			alertNumber, err := RequiredBigInt(request, "alertNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			includeInstances, err := OptionalParam[bool](request, "include_instances")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
@@ -53,13 +232,9 @@ func GetCodeScanningAlert(getClient GetClientFn, t translations.TranslationHelpe
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
 
-			alert, resp, err := client.CodeScanning.GetAlert(ctx, owner, repo, int64(alertNumber))
+			alert, resp, err := client.CodeScanning.GetAlert(ctx, owner, repo, alertNumber)
 			if err != nil {
-				return ghErrors.NewGitHubAPIErrorResponse(ctx,
-					"failed to get alert",
-					resp,
-					err,
-				), nil
+				return codeScanningErrorResponse(ctx, "failed to get alert", codeScanningReadPermission, resp, err), nil
 			}
 			defer func() { _ = resp.Body.Close() }()
 
@@ -71,7 +246,24 @@ func GetCodeScanningAlert(getClient GetClientFn, t translations.TranslationHelpe
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get alert: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(alert)
+			var instances []*github.MostRecentInstance
+			if includeInstances {
+				var instancesResp *github.Response
+				instances, instancesResp, err = client.CodeScanning.ListAlertInstances(ctx, owner, repo, alertNumber, &github.AlertInstancesListOptions{
+					ListOptions: github.ListOptions{
+						Page:    pagination.Page,
+						PerPage: pagination.PerPage,
+					},
+				})
+				if err != nil {
+					return codeScanningErrorResponse(ctx, "failed to list alert instances", codeScanningReadPermission, instancesResp, err), nil
+				}
+				_ = instancesResp.Body.Close()
+			} else if alert.MostRecentInstance != nil {
+				instances = []*github.MostRecentInstance{alert.MostRecentInstance}
+			}
+
+			r, err := json.Marshal(newCodeScanningAlertDetails(alert, instances))
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal alert: %w", err)
 			}
@@ -82,7 +274,7 @@ func GetCodeScanningAlert(getClient GetClientFn, t translations.TranslationHelpe
 
 func ListCodeScanningAlerts(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("list_code_scanning_alerts",
-			mcp.WithDescription(t("TOOL_LIST_CODE_SCANNING_ALERTS_DESCRIPTION", "List code scanning alerts in a GitHub repository.")),
+			mcp.WithDescription(t("TOOL_LIST_CODE_SCANNING_ALERTS_DESCRIPTION", "List code scanning alerts in a GitHub repository. Each alert has its rule, severity, state and the location of its most recent instance; use get_code_scanning_alert for the details of an alert.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_LIST_CODE_SCANNING_ALERTS_USER_TITLE", "List code scanning alerts"),
 				ReadOnlyHint: ToBoolPtr(true),
@@ -110,6 +302,7 @@ func ListCodeScanningAlerts(getClient GetClientFn, t translations.TranslationHel
 			mcp.WithString("tool_name",
 				mcp.Description("The name of the tool used for code scanning."),
 			),
+			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](request, "owner")
@@ -136,18 +329,27 @@ func ListCodeScanningAlerts(getClient GetClientFn, t translations.TranslationHel
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			alerts, resp, err := client.CodeScanning.ListAlertsForRepo(ctx, owner, repo, &github.AlertListOptions{Ref: ref, State: state, Severity: severity, ToolName: toolName})
+			alerts, resp, err := client.CodeScanning.ListAlertsForRepo(ctx, owner, repo, &github.AlertListOptions{
+				Ref:      ref,
+				State:    state,
+				Severity: severity,
+				ToolName: toolName,
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			})
 			if err != nil {
-				return ghErrors.NewGitHubAPIErrorResponse(ctx,
-					"failed to list alerts",
-					resp,
-					err,
-				), nil
+				return codeScanningErrorResponse(ctx, "failed to list alerts", codeScanningReadPermission, resp, err), nil
 			}
 			defer func() { _ = resp.Body.Close() }()
 
@@ -159,7 +361,12 @@ func ListCodeScanningAlerts(getClient GetClientFn, t translations.TranslationHel
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list alerts: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(alerts)
+			summaries := make([]CodeScanningAlertSummary, 0, len(alerts))
+			for _, alert := range alerts {
+				summaries = append(summaries, summarizeCodeScanningAlert(alert))
+			}
+
+			r, err := json.Marshal(summaries)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal alerts: %w", err)
 			}
@@ -167,3 +374,321 @@ func ListCodeScanningAlerts(getClient GetClientFn, t translations.TranslationHel
 			return mcp.NewToolResultText(string(r)), nil
 		}
 }
+
+func UpdateCodeScanningAlert(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_code_scanning_alert",
+			mcp.WithDescription(t("TOOL_UPDATE_CODE_SCANNING_ALERT_DESCRIPTION", "Dismiss or reopen a code scanning alert in a GitHub repository. Dismissing requires a reason.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UPDATE_CODE_SCANNING_ALERT_USER_TITLE", "Update code scanning alert"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("The owner of the repository."),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("The name of the repository."),
+			),
+			mcp.WithNumber("alertNumber",
+				mcp.Required(),
+				mcp.Description("The number of the alert."),
+			),
+			mcp.WithString("state",
+				mcp.Required(),
+				mcp.Description("The new state of the alert."),
+				mcp.Enum("open", "dismissed"),
+			),
+			mcp.WithString("dismissed_reason",
+				mcp.Description("The reason for dismissing the alert. Required when state is dismissed."),
+				mcp.Enum("false positive", "won't fix", "used in tests"),
+			),
+			mcp.WithString("dismissed_comment",
+				mcp.Description("A comment explaining why the alert is dismissed."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			alertNumber, err := RequiredBigInt(request, "alertNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			state, err := RequiredParam[string](request, "state")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			dismissedReason, err := OptionalParam[string](request, "dismissed_reason")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			dismissedComment, err := OptionalParam[string](request, "dismissed_comment")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			stateInfo := &github.CodeScanningAlertState{State: state}
+			switch state {
+			case "open":
+				if dismissedReason != "" || dismissedComment != "" {
+					return mcp.NewToolResultError("dismissed_reason and dismissed_comment can only be set when state is dismissed"), nil
+				}
+			case "dismissed":
+				switch dismissedReason {
+				case "":
+					return mcp.NewToolResultError("dismissed_reason is required when state is dismissed"), nil
+				case "false positive", "won't fix", "used in tests":
+				default:
+					return mcp.NewToolResultError(fmt.Sprintf("invalid dismissed_reason %q, must be one of false positive, won't fix, used in tests", dismissedReason)), nil
+				}
+				stateInfo.DismissedReason = github.Ptr(dismissedReason)
+				if dismissedComment != "" {
+					stateInfo.DismissedComment = github.Ptr(dismissedComment)
+				}
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("invalid state %q, must be open or dismissed", state)), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			alert, resp, err := client.CodeScanning.UpdateAlert(ctx, owner, repo, alertNumber, stateInfo)
+			if err != nil {
+				return codeScanningErrorResponse(ctx, "failed to update alert", codeScanningWritePermission, resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			var instances []*github.MostRecentInstance
+			if alert.MostRecentInstance != nil {
+				instances = []*github.MostRecentInstance{alert.MostRecentInstance}
+			}
+
+			r, err := json.Marshal(newCodeScanningAlertDetails(alert, instances))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal alert: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// codeScanningDefaultSetupPermission explains a 403 from reading the code scanning default setup.
+const codeScanningDefaultSetupPermission = "reading the code scanning default setup requires the repo scope, or the public_repo scope for public repositories"
+
+// CodeScanningAnalysisSummary is the compact form of a code scanning analysis, as returned by
+// list_code_scanning_analyses.
+type CodeScanningAnalysisSummary struct {
+	ID           int64  `json:"id"`
+	CreatedAt    string `json:"created_at"`
+	CommitSHA    string `json:"commit_sha"`
+	Ref          string `json:"ref"`
+	Category     string `json:"category,omitempty"`
+	Tool         string `json:"tool"`
+	ToolVersion  string `json:"tool_version,omitempty"`
+	ResultsCount int    `json:"results_count"`
+	RulesCount   int    `json:"rules_count"`
+	Error        string `json:"error,omitempty"`
+	Warning      string `json:"warning,omitempty"`
+}
+
+// CodeScanningDefaultSetup is the default setup configuration of code scanning for a repository, as
+// returned by get_code_scanning_default_setup.
+type CodeScanningDefaultSetup struct {
+	State      string   `json:"state"`
+	Languages  []string `json:"languages"`
+	QuerySuite string   `json:"query_suite,omitempty"`
+	Schedule   string   `json:"schedule,omitempty"`
+	UpdatedAt  string   `json:"updated_at,omitempty"`
+}
+
+// summarizeCodeScanningAnalysis converts a code scanning analysis into its compact summary form.
+func summarizeCodeScanningAnalysis(analysis *github.ScanningAnalysis) CodeScanningAnalysisSummary {
+	return CodeScanningAnalysisSummary{
+		ID:           analysis.GetID(),
+		CreatedAt:    summaryTimestamp(analysis.GetCreatedAt()),
+		CommitSHA:    analysis.GetCommitSHA(),
+		Ref:          analysis.GetRef(),
+		Category:     analysis.GetCategory(),
+		Tool:         analysis.GetTool().GetName(),
+		ToolVersion:  analysis.GetTool().GetVersion(),
+		ResultsCount: analysis.GetResultsCount(),
+		RulesCount:   analysis.GetRulesCount(),
+		Error:        analysis.GetError(),
+		Warning:      analysis.GetWarning(),
+	}
+}
+
+// listCodeScanningAnalyses lists the code scanning analyses of a repository. go-github can't filter
+// analyses by tool, so the request is built directly.
+func listCodeScanningAnalyses(ctx context.Context, client *github.Client, owner, repo, ref, toolName string, opts *github.ListOptions) ([]*github.ScanningAnalysis, *github.Response, error) {
+	query := url.Values{}
+	if ref != "" {
+		query.Set("ref", ref)
+	}
+	if toolName != "" {
+		query.Set("tool_name", toolName)
+	}
+	query.Set("page", strconv.Itoa(opts.Page))
+	query.Set("per_page", strconv.Itoa(opts.PerPage))
+
+	req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("repos/%v/%v/code-scanning/analyses?%s", owner, repo, query.Encode()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var analyses []*github.ScanningAnalysis
+	resp, err := client.Do(ctx, req, &analyses)
+	if err != nil {
+		return nil, resp, err
+	}
+	return analyses, resp, nil
+}
+
+func ListCodeScanningAnalyses(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_code_scanning_analyses",
+			mcp.WithDescription(t("TOOL_LIST_CODE_SCANNING_ANALYSES_DESCRIPTION", "List code scanning analyses in a GitHub repository, newest first, with the ref and commit each analysed and how many results it found. Use this to check when code scanning last ran.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_CODE_SCANNING_ANALYSES_USER_TITLE", "List code scanning analyses"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("The owner of the repository."),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("The name of the repository."),
+			),
+			mcp.WithString("ref",
+				mcp.Description("The Git reference for the analyses you want to list."),
+			),
+			mcp.WithString("tool_name",
+				mcp.Description("The name of the tool used for code scanning."),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			toolName, err := OptionalParam[string](request, "tool_name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			analyses, resp, err := listCodeScanningAnalyses(ctx, client, owner, repo, ref, toolName, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return codeScanningErrorResponse(ctx, "failed to list analyses", codeScanningReadPermission, resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			summaries := make([]CodeScanningAnalysisSummary, 0, len(analyses))
+			for _, analysis := range analyses {
+				summaries = append(summaries, summarizeCodeScanningAnalysis(analysis))
+			}
+
+			r, err := json.Marshal(summaries)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal analyses: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+func GetCodeScanningDefaultSetup(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_code_scanning_default_setup",
+			mcp.WithDescription(t("TOOL_GET_CODE_SCANNING_DEFAULT_SETUP_DESCRIPTION", "Get whether code scanning default setup is enabled in a GitHub repository, with the languages it analyses, its query suite and schedule.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_CODE_SCANNING_DEFAULT_SETUP_USER_TITLE", "Get code scanning default setup"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("The owner of the repository."),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("The name of the repository."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// go-github leaves the schedule out of the configuration, so the request is made directly.
+			req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("repos/%v/%v/code-scanning/default-setup", owner, repo), nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create request: %w", err)
+			}
+			var setup struct {
+				State      string           `json:"state"`
+				Languages  []string         `json:"languages"`
+				QuerySuite string           `json:"query_suite"`
+				Schedule   string           `json:"schedule"`
+				UpdatedAt  github.Timestamp `json:"updated_at"`
+			}
+			resp, err := client.Do(ctx, req, &setup)
+			if err != nil {
+				return permissionErrorResponse(ctx, "failed to get default setup", codeScanningDefaultSetupPermission, resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := CodeScanningDefaultSetup{
+				State:      setup.State,
+				Languages:  setup.Languages,
+				QuerySuite: setup.QuerySuite,
+				Schedule:   setup.Schedule,
+				UpdatedAt:  summaryTimestamp(setup.UpdatedAt),
+			}
+			if result.Languages == nil {
+				result.Languages = []string{}
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal default setup: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
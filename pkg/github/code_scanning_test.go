@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/github/github-mcp-server/internal/toolsnaps"
 	"github.com/github/github-mcp-server/pkg/translations"
@@ -25,22 +27,64 @@ func Test_GetCodeScanningAlert(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "alertNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "include_instances")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "alertNumber"})
 
 	// Setup mock alert for success case
 	mockAlert := &github.Alert{
-		Number:  github.Ptr(42),
-		State:   github.Ptr("open"),
-		Rule:    &github.Rule{ID: github.Ptr("test-rule"), Description: github.Ptr("Test Rule Description")},
+		Number: github.Ptr(42),
+		State:  github.Ptr("dismissed"),
+		Rule: &github.Rule{
+			ID:          github.Ptr("go/sql-injection"),
+			Name:        github.Ptr("SQL injection"),
+			Severity:    github.Ptr("error"),
+			Description: github.Ptr("Database query built from user-controlled sources"),
+			Help:        github.Ptr("# Database query built from user-controlled sources\n" + strings.Repeat("x", codeScanningRuleHelpMaxBytes)),
+		},
+		Tool:             &github.Tool{Name: github.Ptr("CodeQL")},
+		DismissedBy:      &github.User{Login: github.Ptr("octocat")},
+		DismissedReason:  github.Ptr("false positive"),
+		DismissedComment: github.Ptr("Input is validated upstream"),
+		MostRecentInstance: &github.MostRecentInstance{
+			Ref:      github.Ptr("refs/heads/main"),
+			State:    github.Ptr("dismissed"),
+			Message:  &github.Message{Text: github.Ptr("This query depends on a user-provided value.")},
+			Location: &github.Location{Path: github.Ptr("pkg/db/query.go"), StartLine: github.Ptr(17), EndLine: github.Ptr(18)},
+		},
 		HTMLURL: github.Ptr("https://github.com/owner/repo/security/code-scanning/42"),
 	}
+	mockInstances := []*github.MostRecentInstance{
+		mockAlert.MostRecentInstance,
+		{
+			Ref:      github.Ptr("refs/heads/release"),
+			State:    github.Ptr("open"),
+			Location: &github.Location{Path: github.Ptr("pkg/db/query.go"), StartLine: github.Ptr(12), EndLine: github.Ptr(12)},
+		},
+	}
+
+	expectedRule := CodeScanningRule{
+		ID:            "go/sql-injection",
+		Name:          "SQL injection",
+		Severity:      "error",
+		Description:   "Database query built from user-controlled sources",
+		Help:          ("# Database query built from user-controlled sources\n" + strings.Repeat("x", codeScanningRuleHelpMaxBytes))[:codeScanningRuleHelpMaxBytes],
+		HelpTruncated: true,
+	}
+	mostRecentInstance := CodeScanningAlertInstance{
+		Ref:       "refs/heads/main",
+		State:     "dismissed",
+		Path:      "pkg/db/query.go",
+		StartLine: 17,
+		EndLine:   18,
+		Message:   "This query depends on a user-provided value.",
+	}
 
 	tests := []struct {
 		name           string
 		mockedClient   *http.Client
 		requestArgs    map[string]interface{}
 		expectError    bool
-		expectedAlert  *github.Alert
+		expectedAlert  CodeScanningAlertDetails
 		expectedErrMsg string
 	}{
 		{
@@ -56,8 +100,57 @@ func Test_GetCodeScanningAlert(t *testing.T) {
 				"repo":        "repo",
 				"alertNumber": float64(42),
 			},
-			expectError:   false,
-			expectedAlert: mockAlert,
+			expectError: false,
+			expectedAlert: CodeScanningAlertDetails{
+				Number:           42,
+				State:            "dismissed",
+				Rule:             expectedRule,
+				Tool:             "CodeQL",
+				DismissedBy:      "octocat",
+				DismissedReason:  "false positive",
+				DismissedComment: "Input is validated upstream",
+				Instances:        []CodeScanningAlertInstance{mostRecentInstance},
+				HTMLURL:          "https://github.com/owner/repo/security/code-scanning/42",
+			},
+		},
+		{
+			name: "alert fetch with all instances",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposCodeScanningAlertsByOwnerByRepoByAlertNumber,
+					mockAlert,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCodeScanningAlertsInstancesByOwnerByRepoByAlertNumber,
+					expectQueryParams(t, map[string]string{
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockInstances),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"alertNumber":       float64(42),
+				"include_instances": true,
+			},
+			expectError: false,
+			expectedAlert: CodeScanningAlertDetails{
+				Number:           42,
+				State:            "dismissed",
+				Rule:             expectedRule,
+				Tool:             "CodeQL",
+				DismissedBy:      "octocat",
+				DismissedReason:  "false positive",
+				DismissedComment: "Input is validated upstream",
+				Instances: []CodeScanningAlertInstance{
+					mostRecentInstance,
+					{Ref: "refs/heads/release", State: "open", Path: "pkg/db/query.go", StartLine: 12, EndLine: 12},
+				},
+				HTMLURL: "https://github.com/owner/repo/security/code-scanning/42",
+			},
 		},
 		{
 			name: "alert fetch fails",
@@ -108,14 +201,10 @@ func Test_GetCodeScanningAlert(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedAlert github.Alert
+			var returnedAlert CodeScanningAlertDetails
 			err = json.Unmarshal([]byte(textContent.Text), &returnedAlert)
 			assert.NoError(t, err)
-			assert.Equal(t, *tc.expectedAlert.Number, *returnedAlert.Number)
-			assert.Equal(t, *tc.expectedAlert.State, *returnedAlert.State)
-			assert.Equal(t, *tc.expectedAlert.Rule.ID, *returnedAlert.Rule.ID)
-			assert.Equal(t, *tc.expectedAlert.HTMLURL, *returnedAlert.HTMLURL)
-
+			assert.Equal(t, tc.expectedAlert, returnedAlert)
 		})
 	}
 }
@@ -134,20 +223,32 @@ func Test_ListCodeScanningAlerts(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "state")
 	assert.Contains(t, tool.InputSchema.Properties, "severity")
 	assert.Contains(t, tool.InputSchema.Properties, "tool_name")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
 
 	// Setup mock alerts for success case
 	mockAlerts := []*github.Alert{
 		{
-			Number:  github.Ptr(42),
-			State:   github.Ptr("open"),
-			Rule:    &github.Rule{ID: github.Ptr("test-rule-1"), Description: github.Ptr("Test Rule 1")},
-			HTMLURL: github.Ptr("https://github.com/owner/repo/security/code-scanning/42"),
+			Number: github.Ptr(42),
+			State:  github.Ptr("open"),
+			Rule: &github.Rule{
+				ID:                    github.Ptr("go/sql-injection"),
+				Description:           github.Ptr("Database query built from user-controlled sources"),
+				Severity:              github.Ptr("error"),
+				SecuritySeverityLevel: github.Ptr("high"),
+			},
+			MostRecentInstance: &github.MostRecentInstance{
+				Ref:      github.Ptr("refs/heads/main"),
+				Location: &github.Location{Path: github.Ptr("pkg/db/query.go"), StartLine: github.Ptr(17), EndLine: github.Ptr(17)},
+			},
+			Instances: []*github.MostRecentInstance{{Ref: github.Ptr("refs/heads/main")}},
+			HTMLURL:   github.Ptr("https://github.com/owner/repo/security/code-scanning/42"),
 		},
 		{
 			Number:  github.Ptr(43),
 			State:   github.Ptr("fixed"),
-			Rule:    &github.Rule{ID: github.Ptr("test-rule-2"), Description: github.Ptr("Test Rule 2")},
+			Rule:    &github.Rule{ID: github.Ptr("test-rule-2"), Description: github.Ptr("Test Rule 2"), Severity: github.Ptr("warning")},
 			HTMLURL: github.Ptr("https://github.com/owner/repo/security/code-scanning/43"),
 		},
 	}
@@ -157,7 +258,7 @@ func Test_ListCodeScanningAlerts(t *testing.T) {
 		mockedClient   *http.Client
 		requestArgs    map[string]interface{}
 		expectError    bool
-		expectedAlerts []*github.Alert
+		expectedAlerts []CodeScanningAlertSummary
 		expectedErrMsg string
 	}{
 		{
@@ -170,6 +271,8 @@ func Test_ListCodeScanningAlerts(t *testing.T) {
 						"state":     "open",
 						"severity":  "high",
 						"tool_name": "codeql",
+						"page":      "2",
+						"per_page":  "10",
 					}).andThen(
 						mockResponse(t, http.StatusOK, mockAlerts),
 					),
@@ -182,9 +285,30 @@ func Test_ListCodeScanningAlerts(t *testing.T) {
 				"state":     "open",
 				"severity":  "high",
 				"tool_name": "codeql",
+				"page":      float64(2),
+				"perPage":   float64(10),
+			},
+			expectError: false,
+			expectedAlerts: []CodeScanningAlertSummary{
+				{
+					Number:          42,
+					RuleID:          "go/sql-injection",
+					RuleDescription: "Database query built from user-controlled sources",
+					Severity:        "high",
+					State:           "open",
+					Path:            "pkg/db/query.go",
+					StartLine:       17,
+					HTMLURL:         "https://github.com/owner/repo/security/code-scanning/42",
+				},
+				{
+					Number:          43,
+					RuleID:          "test-rule-2",
+					RuleDescription: "Test Rule 2",
+					Severity:        "warning",
+					State:           "fixed",
+					HTMLURL:         "https://github.com/owner/repo/security/code-scanning/43",
+				},
 			},
-			expectError:    false,
-			expectedAlerts: mockAlerts,
 		},
 		{
 			name: "alerts listing fails",
@@ -204,6 +328,91 @@ func Test_ListCodeScanningAlerts(t *testing.T) {
 			expectError:    true,
 			expectedErrMsg: "failed to list alerts",
 		},
+		{
+			name: "code scanning not configured",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCodeScanningAlertsByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "no analysis found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list alerts: code scanning is not configured for this repository",
+		},
+		{
+			name: "missing security_events scope",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCodeScanningAlertsByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Resource not accessible by integration"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "requires the security_events scope, or the repo scope for private repositories: Resource not accessible by integration",
+		},
+		{
+			name: "advanced security not enabled",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCodeScanningAlertsByOwnerByRepo,
+					mockResponse(t, http.StatusForbidden, `{"message": "Advanced Security must be enabled for this repository to use code scanning."}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list alerts: code scanning is not configured for this repository: Advanced Security must be enabled for this repository to use code scanning.",
+		},
+		{
+			name: "repository not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCodeScanningAlertsByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list alerts: GET",
+		},
+		{
+			name: "secondary rate limit",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCodeScanningAlertsByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.Header().Set("Retry-After", "60")
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "You have exceeded a secondary rate limit.", "documentation_url": "https://docs.github.com/rest/overview/rate-limits-for-the-rest-api#about-secondary-rate-limits"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list alerts: secondary rate limit exceeded, retry in 1m0s",
+		},
 	}
 
 	for _, tc := range tests {
@@ -234,16 +443,437 @@ func Test_ListCodeScanningAlerts(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedAlerts []*github.Alert
+			var returnedAlerts []CodeScanningAlertSummary
 			err = json.Unmarshal([]byte(textContent.Text), &returnedAlerts)
 			assert.NoError(t, err)
-			assert.Len(t, returnedAlerts, len(tc.expectedAlerts))
-			for i, alert := range returnedAlerts {
-				assert.Equal(t, *tc.expectedAlerts[i].Number, *alert.Number)
-				assert.Equal(t, *tc.expectedAlerts[i].State, *alert.State)
-				assert.Equal(t, *tc.expectedAlerts[i].Rule.ID, *alert.Rule.ID)
-				assert.Equal(t, *tc.expectedAlerts[i].HTMLURL, *alert.HTMLURL)
+			assert.Equal(t, tc.expectedAlerts, returnedAlerts)
+		})
+	}
+}
+
+func Test_UpdateCodeScanningAlert(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateCodeScanningAlert(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_code_scanning_alert", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "alertNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "state")
+	assert.Contains(t, tool.InputSchema.Properties, "dismissed_reason")
+	assert.Contains(t, tool.InputSchema.Properties, "dismissed_comment")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "alertNumber", "state"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	dismissedAlert := &github.Alert{
+		Number:           github.Ptr(42),
+		State:            github.Ptr("dismissed"),
+		Rule:             &github.Rule{ID: github.Ptr("go/sql-injection")},
+		DismissedBy:      &github.User{Login: github.Ptr("octocat")},
+		DismissedReason:  github.Ptr("false positive"),
+		DismissedComment: github.Ptr("Input is validated upstream"),
+		HTMLURL:          github.Ptr("https://github.com/owner/repo/security/code-scanning/42"),
+	}
+	reopenedAlert := &github.Alert{
+		Number:  github.Ptr(42),
+		State:   github.Ptr("open"),
+		Rule:    &github.Rule{ID: github.Ptr("go/sql-injection")},
+		HTMLURL: github.Ptr("https://github.com/owner/repo/security/code-scanning/42"),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedAlert  CodeScanningAlertDetails
+		expectedErrMsg string
+	}{
+		{
+			name: "dismiss alert",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposCodeScanningAlertsByOwnerByRepoByAlertNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"state":             "dismissed",
+						"dismissed_reason":  "false positive",
+						"dismissed_comment": "Input is validated upstream",
+					}).andThen(
+						mockResponse(t, http.StatusOK, dismissedAlert),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"alertNumber":       float64(42),
+				"state":             "dismissed",
+				"dismissed_reason":  "false positive",
+				"dismissed_comment": "Input is validated upstream",
+			},
+			expectError: false,
+			expectedAlert: CodeScanningAlertDetails{
+				Number:           42,
+				State:            "dismissed",
+				Rule:             CodeScanningRule{ID: "go/sql-injection"},
+				DismissedBy:      "octocat",
+				DismissedReason:  "false positive",
+				DismissedComment: "Input is validated upstream",
+				Instances:        []CodeScanningAlertInstance{},
+				HTMLURL:          "https://github.com/owner/repo/security/code-scanning/42",
+			},
+		},
+		{
+			name: "reopen alert",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposCodeScanningAlertsByOwnerByRepoByAlertNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"state": "open",
+					}).andThen(
+						mockResponse(t, http.StatusOK, reopenedAlert),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"alertNumber": float64(42),
+				"state":       "open",
+			},
+			expectError: false,
+			expectedAlert: CodeScanningAlertDetails{
+				Number:    42,
+				State:     "open",
+				Rule:      CodeScanningRule{ID: "go/sql-injection"},
+				Instances: []CodeScanningAlertInstance{},
+				HTMLURL:   "https://github.com/owner/repo/security/code-scanning/42",
+			},
+		},
+		{
+			name:         "dismiss without reason",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"alertNumber": float64(42),
+				"state":       "dismissed",
+			},
+			expectError:    true,
+			expectedErrMsg: "dismissed_reason is required when state is dismissed",
+		},
+		{
+			name:         "invalid dismissed reason",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":            "owner",
+				"repo":             "repo",
+				"alertNumber":      float64(42),
+				"state":            "dismissed",
+				"dismissed_reason": "not a bug",
+			},
+			expectError:    true,
+			expectedErrMsg: `invalid dismissed_reason "not a bug"`,
+		},
+		{
+			name:         "reason when reopening",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":            "owner",
+				"repo":             "repo",
+				"alertNumber":      float64(42),
+				"state":            "open",
+				"dismissed_reason": "won't fix",
+			},
+			expectError:    true,
+			expectedErrMsg: "dismissed_reason and dismissed_comment can only be set when state is dismissed",
+		},
+		{
+			name:         "invalid state",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"alertNumber": float64(42),
+				"state":       "fixed",
+			},
+			expectError:    true,
+			expectedErrMsg: `invalid state "fixed", must be open or dismissed`,
+		},
+		{
+			name: "missing security write permission",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposCodeScanningAlertsByOwnerByRepoByAlertNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Resource not accessible by integration"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":            "owner",
+				"repo":             "repo",
+				"alertNumber":      float64(42),
+				"state":            "dismissed",
+				"dismissed_reason": "used in tests",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to update alert: updating code scanning alerts requires write access",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdateCodeScanningAlert(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returnedAlert CodeScanningAlertDetails
+			err = json.Unmarshal([]byte(textContent.Text), &returnedAlert)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedAlert, returnedAlert)
+		})
+	}
+}
+
+func Test_ListCodeScanningAnalyses(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListCodeScanningAnalyses(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_code_scanning_analyses", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.Contains(t, tool.InputSchema.Properties, "tool_name")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockAnalyses := []*github.ScanningAnalysis{
+		{
+			ID:           github.Ptr(int64(201)),
+			Ref:          github.Ptr("refs/heads/main"),
+			CommitSHA:    github.Ptr("d99612c3e1f2970085cfbaeadf8f010ef69bad83"),
+			Category:     github.Ptr(".github/workflows/codeql.yml:analyze/language:go"),
+			CreatedAt:    &github.Timestamp{Time: time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)},
+			ResultsCount: github.Ptr(3),
+			RulesCount:   github.Ptr(67),
+			Tool:         &github.Tool{Name: github.Ptr("CodeQL"), Version: github.Ptr("2.15.5")},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectError      bool
+		expectedAnalyses []CodeScanningAnalysisSummary
+		expectedErrMsg   string
+	}{
+		{
+			name: "successful analyses listing",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCodeScanningAnalysesByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"ref":       "refs/heads/main",
+						"tool_name": "CodeQL",
+						"page":      "1",
+						"per_page":  "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockAnalyses),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"ref":       "refs/heads/main",
+				"tool_name": "CodeQL",
+			},
+			expectError: false,
+			expectedAnalyses: []CodeScanningAnalysisSummary{
+				{
+					ID:           201,
+					CreatedAt:    "2024-01-01T10:00:00Z",
+					CommitSHA:    "d99612c3e1f2970085cfbaeadf8f010ef69bad83",
+					Ref:          "refs/heads/main",
+					Category:     ".github/workflows/codeql.yml:analyze/language:go",
+					Tool:         "CodeQL",
+					ToolVersion:  "2.15.5",
+					ResultsCount: 3,
+					RulesCount:   67,
+				},
+			},
+		},
+		{
+			name: "code scanning not configured",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCodeScanningAnalysesByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "no analysis found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list analyses: code scanning is not configured for this repository",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListCodeScanningAnalyses(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
 			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returnedAnalyses []CodeScanningAnalysisSummary
+			err = json.Unmarshal([]byte(textContent.Text), &returnedAnalyses)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedAnalyses, returnedAnalyses)
+		})
+	}
+}
+
+func Test_GetCodeScanningDefaultSetup(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetCodeScanningDefaultSetup(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_code_scanning_default_setup", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedSetup  CodeScanningDefaultSetup
+		expectedErrMsg string
+	}{
+		{
+			name: "default setup enabled",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposCodeScanningDefaultSetupByOwnerByRepo,
+					map[string]any{
+						"state":       "configured",
+						"languages":   []string{"go", "javascript-typescript"},
+						"query_suite": "default",
+						"schedule":    "weekly",
+						"updated_at":  "2024-01-01T10:00:00Z",
+					},
+				),
+			),
+			expectError: false,
+			expectedSetup: CodeScanningDefaultSetup{
+				State:      "configured",
+				Languages:  []string{"go", "javascript-typescript"},
+				QuerySuite: "default",
+				Schedule:   "weekly",
+				UpdatedAt:  "2024-01-01T10:00:00Z",
+			},
+		},
+		{
+			name: "default setup not configured",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposCodeScanningDefaultSetupByOwnerByRepo,
+					map[string]any{
+						"state":     "not-configured",
+						"languages": []string{},
+					},
+				),
+			),
+			expectError: false,
+			expectedSetup: CodeScanningDefaultSetup{
+				State:     "not-configured",
+				Languages: []string{},
+			},
+		},
+		{
+			name: "missing repo scope",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCodeScanningDefaultSetupByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Resource not accessible by integration"}`))
+					}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to get default setup: reading the code scanning default setup requires the repo scope",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetCodeScanningDefaultSetup(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			})
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returnedSetup CodeScanningDefaultSetup
+			err = json.Unmarshal([]byte(textContent.Text), &returnedSetup)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedSetup, returnedSetup)
 		})
 	}
 }
@@ -0,0 +1,322 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v73/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// repositoryPermissions are the repository permissions from the highest to the lowest.
+var repositoryPermissions = []string{"admin", "maintain", "push", "triage", "pull"}
+
+// legacyRepositoryPermissions maps the legacy permission levels to repository permissions.
+var legacyRepositoryPermissions = map[string]string{
+	"admin": "admin",
+	"write": "push",
+	"read":  "pull",
+}
+
+// highestPermission returns the highest repository permission granted in permissions, or "none".
+func highestPermission(permissions map[string]bool) string {
+	for _, permission := range repositoryPermissions {
+		if permissions[permission] {
+			return permission
+		}
+	}
+	return "none"
+}
+
+// Collaborator is the compact form of a collaborator returned by list_collaborators.
+type Collaborator struct {
+	Login string `json:"login"`
+	// Permission is the highest of admin, maintain, push, triage and pull.
+	Permission string `json:"permission"`
+	RoleName   string `json:"role_name,omitempty"`
+}
+
+// CollaboratorPermission is the result of get_collaborator_permission.
+type CollaboratorPermission struct {
+	Username string `json:"username"`
+	// Permission is the highest of admin, maintain, push, triage and pull, or none.
+	Permission string `json:"permission"`
+	// RoleName is the name of the user's role, which may be a custom repository role.
+	RoleName string `json:"role_name,omitempty"`
+}
+
+// ListCollaborators creates a tool to list the collaborators of a repository.
+func ListCollaborators(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_collaborators",
+			mcp.WithDescription(t("TOOL_LIST_COLLABORATORS_DESCRIPTION", "List the collaborators of a GitHub repository with their permission")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_COLLABORATORS_USER_TITLE", "List collaborators"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("affiliation",
+				mcp.Description("Filter by affiliation: outside collaborators of an organization repository, direct collaborators regardless of organization membership, or all (default: all)"),
+				mcp.Enum("outside", "direct", "all"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			affiliation, err := OptionalParam[string](request, "affiliation")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			users, resp, err := client.Repositories.ListCollaborators(ctx, owner, repo, &github.ListCollaboratorsOptions{
+				Affiliation: affiliation,
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list collaborators", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			collaborators := make([]Collaborator, 0, len(users))
+			for _, user := range users {
+				collaborators = append(collaborators, Collaborator{
+					Login:      user.GetLogin(),
+					Permission: highestPermission(user.GetPermissions()),
+					RoleName:   user.GetRoleName(),
+				})
+			}
+
+			return MarshalledTextResult(collaborators), nil
+		}
+}
+
+// GetCollaboratorPermission creates a tool to get the permission a user has on a repository.
+func GetCollaboratorPermission(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_collaborator_permission",
+			mcp.WithDescription(t("TOOL_GET_COLLABORATOR_PERMISSION_DESCRIPTION", "Get the permission a user has on a GitHub repository: admin, maintain, push, triage, pull or none. Check it before assigning issues, requesting reviews or calling write tools on behalf of a user")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_COLLABORATOR_PERMISSION_USER_TITLE", "Get collaborator permission"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("Username to check"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			username, err := RequiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			level, resp, err := client.Repositories.GetPermissionLevel(ctx, owner, repo, username)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get permission of %s", username), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			permission := highestPermission(level.GetUser().GetPermissions())
+			if permission == "none" {
+				// Fall back to the legacy admin, write or read level when the user's permissions are missing.
+				if legacy, ok := legacyRepositoryPermissions[level.GetPermission()]; ok {
+					permission = legacy
+				}
+			}
+
+			return MarshalledTextResult(CollaboratorPermission{
+				Username:   username,
+				Permission: permission,
+				RoleName:   level.GetRoleName(),
+			}), nil
+		}
+}
+
+// CollaboratorAddition is the result of add_collaborator.
+type CollaboratorAddition struct {
+	Username string `json:"username"`
+	// Invited is set when an invitation was created. Otherwise the user already had access to
+	// the repository, or is an organization member who was granted the permission directly.
+	Invited              bool   `json:"invited"`
+	InvitationID         int64  `json:"invitation_id,omitempty"`
+	InvitationURL        string `json:"invitation_url,omitempty"`
+	InvitationPermission string `json:"invitation_permission,omitempty"`
+}
+
+// AddCollaborator creates a tool to invite a user to collaborate on a repository.
+func AddCollaborator(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("add_collaborator",
+			mcp.WithDescription(t("TOOL_ADD_COLLABORATOR_DESCRIPTION", "Invite a user to collaborate on a GitHub repository with the given permission, or update the permission of an existing collaborator. Reports whether an invitation was created or the user already had access")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_ADD_COLLABORATOR_USER_TITLE", "Add collaborator"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("Username of the user to add"),
+			),
+			mcp.WithString("permission",
+				mcp.Description("Permission to grant (default: push)"),
+				mcp.Enum(repositoryPermissions...),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			username, err := RequiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			permission, err := OptionalParam[string](request, "permission")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			invitation, resp, err := client.Repositories.AddCollaborator(ctx, owner, repo, username, &github.RepositoryAddCollaboratorOptions{
+				Permission: permission,
+			})
+			if err != nil {
+				// Organizations can forbid adding outside collaborators, which the API reports as a 403.
+				var errResp *github.ErrorResponse
+				if resp != nil && resp.StatusCode == http.StatusForbidden && errors.As(err, &errResp) {
+					message := fmt.Sprintf("failed to add %s to %s/%s: %s. The organization may not allow outside collaborators, or you may lack admin access to the repository", username, owner, repo, errResp.Message)
+					_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, message, resp, err)
+					return mcp.NewToolResultError(message), nil
+				}
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to add %s as a collaborator", username), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			// The API answers 201 with the invitation it created, and 204 without one when the user
+			// already has access.
+			result := CollaboratorAddition{Username: username}
+			if resp.StatusCode == http.StatusCreated {
+				result.Invited = true
+				result.InvitationID = invitation.GetID()
+				result.InvitationURL = invitation.GetHTMLURL()
+				result.InvitationPermission = invitation.GetPermissions()
+			}
+
+			return MarshalledTextResult(result), nil
+		}
+}
+
+// RemoveCollaborator creates a tool to remove a collaborator from a repository.
+func RemoveCollaborator(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("remove_collaborator",
+			mcp.WithDescription(t("TOOL_REMOVE_COLLABORATOR_DESCRIPTION", "Remove a collaborator from a GitHub repository, revoking their access and any pending invitation")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_REMOVE_COLLABORATOR_USER_TITLE", "Remove collaborator"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("Username of the collaborator to remove"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			username, err := RequiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.Repositories.RemoveCollaborator(ctx, owner, repo, username)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to remove %s as a collaborator", username), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf("%s removed from the collaborators of %s/%s", username, owner, repo)), nil
+		}
+}
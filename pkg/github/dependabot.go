@@ -3,9 +3,11 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/translations"
@@ -17,7 +19,7 @@ import (
 func GetDependabotAlert(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool(
 			"get_dependabot_alert",
-			mcp.WithDescription(t("TOOL_GET_DEPENDABOT_ALERT_DESCRIPTION", "Get details of a specific dependabot alert in a GitHub repository.")),
+			mcp.WithDescription(t("TOOL_GET_DEPENDABOT_ALERT_DESCRIPTION", "Get details of a specific dependabot alert in a GitHub repository, including the advisory summary, CVSS score, vulnerable version range and first patched version.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_GET_DEPENDABOT_ALERT_USER_TITLE", "Get dependabot alert"),
 				ReadOnlyHint: ToBoolPtr(true),
@@ -72,7 +74,7 @@ func GetDependabotAlert(getClient GetClientFn, t translations.TranslationHelperF
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get alert: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(alert)
+			r, err := json.Marshal(newDependabotAlertDetails(alert))
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal alert: %w", err)
 			}
@@ -81,10 +83,91 @@ func GetDependabotAlert(getClient GetClientFn, t translations.TranslationHelperF
 		}
 }
 
+// DependabotAlertSummary is the compact form of a Dependabot alert, as returned by list_dependabot_alerts.
+type DependabotAlertSummary struct {
+	Number       int    `json:"number"`
+	Package      string `json:"package"`
+	Ecosystem    string `json:"ecosystem"`
+	ManifestPath string `json:"manifest_path,omitempty"`
+	GHSAID       string `json:"ghsa_id"`
+	CVEID        string `json:"cve_id,omitempty"`
+	Severity     string `json:"severity"`
+	State        string `json:"state"`
+	FixedIn      string `json:"fixed_in,omitempty"`
+	HTMLURL      string `json:"html_url"`
+}
+
+// summarizeDependabotAlert converts a Dependabot alert into its compact summary form.
+func summarizeDependabotAlert(alert *github.DependabotAlert) DependabotAlertSummary {
+	severity := alert.GetSecurityAdvisory().GetSeverity()
+	if severity == "" {
+		severity = alert.GetSecurityVulnerability().GetSeverity()
+	}
+	return DependabotAlertSummary{
+		Number:       alert.GetNumber(),
+		Package:      alert.GetDependency().GetPackage().GetName(),
+		Ecosystem:    alert.GetDependency().GetPackage().GetEcosystem(),
+		ManifestPath: alert.GetDependency().GetManifestPath(),
+		GHSAID:       alert.GetSecurityAdvisory().GetGHSAID(),
+		CVEID:        alert.GetSecurityAdvisory().GetCVEID(),
+		Severity:     severity,
+		State:        alert.GetState(),
+		FixedIn:      alert.GetSecurityVulnerability().GetFirstPatchedVersion().GetIdentifier(),
+		HTMLURL:      alert.GetHTMLURL(),
+	}
+}
+
+// DependabotAlertDetails is a Dependabot alert, as returned by get_dependabot_alert and
+// update_dependabot_alert.
+type DependabotAlertDetails struct {
+	DependabotAlertSummary
+	AdvisorySummary        string   `json:"advisory_summary,omitempty"`
+	CVSSScore              *float64 `json:"cvss_score,omitempty"`
+	CVSSVector             string   `json:"cvss_vector,omitempty"`
+	VulnerableVersionRange string   `json:"vulnerable_version_range,omitempty"`
+	DismissedBy            string   `json:"dismissed_by,omitempty"`
+	DismissedReason        string   `json:"dismissed_reason,omitempty"`
+	DismissedComment       string   `json:"dismissed_comment,omitempty"`
+}
+
+// newDependabotAlertDetails converts a Dependabot alert into its detailed form.
+func newDependabotAlertDetails(alert *github.DependabotAlert) DependabotAlertDetails {
+	return DependabotAlertDetails{
+		DependabotAlertSummary: summarizeDependabotAlert(alert),
+		AdvisorySummary:        alert.GetSecurityAdvisory().GetSummary(),
+		CVSSScore:              alert.GetSecurityAdvisory().GetCVSS().GetScore(),
+		CVSSVector:             alert.GetSecurityAdvisory().GetCVSS().GetVectorString(),
+		VulnerableVersionRange: alert.GetSecurityVulnerability().GetVulnerableVersionRange(),
+		DismissedBy:            alert.GetDismissedBy().GetLogin(),
+		DismissedReason:        alert.GetDismissedReason(),
+		DismissedComment:       alert.GetDismissedComment(),
+	}
+}
+
+// dependabotErrorResponse describes a failed Dependabot request, telling Dependabot alerts being
+// disabled apart from the token lacking permission.
+func dependabotErrorResponse(ctx context.Context, message string, resp *github.Response, err error) *mcp.CallToolResult {
+	if resp == nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, message, resp, err)
+	}
+	var ghErr *github.ErrorResponse
+	switch {
+	case resp.StatusCode == http.StatusNotFound,
+		resp.StatusCode == http.StatusForbidden && errors.As(err, &ghErr) && strings.Contains(strings.ToLower(ghErr.Message), "disabled"):
+		message = fmt.Sprintf("%s: Dependabot alerts are not enabled for this repository", message)
+	case resp.StatusCode == http.StatusForbidden:
+		message = fmt.Sprintf("%s: reading Dependabot alerts requires the security_events scope, or the repo scope for private repositories", message)
+	default:
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, message, resp, err)
+	}
+	_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, message, resp, err)
+	return mcp.NewToolResultError(message)
+}
+
 func ListDependabotAlerts(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool(
 			"list_dependabot_alerts",
-			mcp.WithDescription(t("TOOL_LIST_DEPENDABOT_ALERTS_DESCRIPTION", "List dependabot alerts in a GitHub repository.")),
+			mcp.WithDescription(t("TOOL_LIST_DEPENDABOT_ALERTS_DESCRIPTION", "List dependabot alerts in a GitHub repository, with the vulnerable package, advisory IDs, severity and the version that fixes it. Use get_dependabot_alert for the advisory details. Pages are selected with the after and before cursors returned in pageInfo.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_LIST_DEPENDABOT_ALERTS_USER_TITLE", "List dependabot alerts"),
 				ReadOnlyHint: ToBoolPtr(true),
@@ -106,6 +189,28 @@ func ListDependabotAlerts(getClient GetClientFn, t translations.TranslationHelpe
 				mcp.Description("Filter dependabot alerts by severity"),
 				mcp.Enum("low", "medium", "high", "critical"),
 			),
+			mcp.WithString("ecosystem",
+				mcp.Description("Filter dependabot alerts by package ecosystem, such as npm or pip"),
+			),
+			mcp.WithString("package",
+				mcp.Description("Filter dependabot alerts by package name"),
+			),
+			mcp.WithString("scope",
+				mcp.Description("Filter dependabot alerts by the scope of the vulnerable dependency"),
+				mcp.Enum("development", "runtime"),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Sort dependabot alerts by when they were created or updated, or by EPSS percentage"),
+				mcp.Enum("created", "updated", "epss_percentage"),
+			),
+			mcp.WithString("direction",
+				mcp.Description("The direction to sort the results by"),
+				mcp.Enum("asc", "desc"),
+			),
+			WithCursorPagination(),
+			mcp.WithString("before",
+				mcp.Description("Cursor for the previous page, from the startCursor of the current page's pageInfo."),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](request, "owner")
@@ -124,6 +229,37 @@ func ListDependabotAlerts(getClient GetClientFn, t translations.TranslationHelpe
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			ecosystem, err := OptionalParam[string](request, "ecosystem")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pkg, err := OptionalParam[string](request, "package")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			scope, err := OptionalParam[string](request, "scope")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sort, err := OptionalParam[string](request, "sort")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			direction, err := OptionalParam[string](request, "direction")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalCursorPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			before, err := OptionalParam[string](request, "before")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if pagination.After != "" && before != "" {
+				return mcp.NewToolResultError("after and before cannot be used together"), nil
+			}
 
 			client, err := getClient(ctx)
 			if err != nil {
@@ -131,11 +267,21 @@ func ListDependabotAlerts(getClient GetClientFn, t translations.TranslationHelpe
 			}
 
 			alerts, resp, err := client.Dependabot.ListRepoAlerts(ctx, owner, repo, &github.ListAlertsOptions{
-				State:    ToStringPtr(state),
-				Severity: ToStringPtr(severity),
+				State:     ToStringPtr(state),
+				Severity:  ToStringPtr(severity),
+				Ecosystem: ToStringPtr(ecosystem),
+				Package:   ToStringPtr(pkg),
+				Scope:     ToStringPtr(scope),
+				Sort:      ToStringPtr(sort),
+				Direction: ToStringPtr(direction),
+				ListCursorOptions: github.ListCursorOptions{
+					PerPage: pagination.PerPage,
+					After:   pagination.After,
+					Before:  before,
+				},
 			})
 			if err != nil {
-				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+				return dependabotErrorResponse(ctx,
 					fmt.Sprintf("failed to list alerts for repository '%s/%s'", owner, repo),
 					resp,
 					err,
@@ -151,7 +297,21 @@ func ListDependabotAlerts(getClient GetClientFn, t translations.TranslationHelpe
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list alerts: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(alerts)
+			summaries := make([]DependabotAlertSummary, 0, len(alerts))
+			for _, alert := range alerts {
+				summaries = append(summaries, summarizeDependabotAlert(alert))
+			}
+
+			// The cursors of the neighbouring pages come from the Link header.
+			r, err := json.Marshal(map[string]any{
+				"alerts": summaries,
+				"pageInfo": map[string]any{
+					"hasNextPage":     resp.After != "",
+					"hasPreviousPage": resp.Before != "",
+					"endCursor":       resp.After,
+					"startCursor":     resp.Before,
+				},
+			})
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal alerts: %w", err)
 			}
@@ -159,3 +319,106 @@ func ListDependabotAlerts(getClient GetClientFn, t translations.TranslationHelpe
 			return mcp.NewToolResultText(string(r)), nil
 		}
 }
+
+func UpdateDependabotAlert(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"update_dependabot_alert",
+			mcp.WithDescription(t("TOOL_UPDATE_DEPENDABOT_ALERT_DESCRIPTION", "Dismiss or reopen a dependabot alert in a GitHub repository. Dismissing requires a dismissed_reason.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UPDATE_DEPENDABOT_ALERT_USER_TITLE", "Update dependabot alert"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("The owner of the repository."),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("The name of the repository."),
+			),
+			mcp.WithNumber("alertNumber",
+				mcp.Required(),
+				mcp.Description("The number of the alert."),
+			),
+			mcp.WithString("state",
+				mcp.Required(),
+				mcp.Description("The new state of the alert."),
+				mcp.Enum("open", "dismissed"),
+			),
+			mcp.WithString("dismissed_reason",
+				mcp.Description("The reason for dismissing the alert. Required when state is dismissed."),
+				mcp.Enum("fix_started", "inaccurate", "no_bandwidth", "not_used", "tolerable_risk"),
+			),
+			mcp.WithString("dismissed_comment",
+				mcp.Description("A comment explaining the dismissal."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			alertNumber, err := RequiredInt(request, "alertNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			state, err := RequiredParam[string](request, "state")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			dismissedReason, err := OptionalParam[string](request, "dismissed_reason")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			dismissedComment, err := OptionalParam[string](request, "dismissed_comment")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			stateInfo := &github.DependabotAlertState{State: state}
+			switch state {
+			case "open":
+				if dismissedReason != "" || dismissedComment != "" {
+					return mcp.NewToolResultError("dismissed_reason and dismissed_comment can only be set when state is dismissed"), nil
+				}
+			case "dismissed":
+				switch dismissedReason {
+				case "":
+					return mcp.NewToolResultError("dismissed_reason is required when state is dismissed"), nil
+				case "fix_started", "inaccurate", "no_bandwidth", "not_used", "tolerable_risk":
+				default:
+					return mcp.NewToolResultError(fmt.Sprintf("invalid dismissed_reason %q, must be one of fix_started, inaccurate, no_bandwidth, not_used, tolerable_risk", dismissedReason)), nil
+				}
+				stateInfo.DismissedReason = github.Ptr(dismissedReason)
+				stateInfo.DismissedComment = ToStringPtr(dismissedComment)
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("invalid state %q, must be open or dismissed", state)), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			alert, resp, err := client.Dependabot.UpdateAlert(ctx, owner, repo, alertNumber, stateInfo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to update alert with number '%d'", alertNumber),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(newDependabotAlertDetails(alert))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal alert: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
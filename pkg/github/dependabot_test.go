@@ -33,6 +33,24 @@ func Test_GetDependabotAlert(t *testing.T) {
 		Number:  github.Ptr(42),
 		State:   github.Ptr("open"),
 		HTMLURL: github.Ptr("https://github.com/owner/repo/security/dependabot/42"),
+		Dependency: &github.Dependency{
+			Package:      &github.VulnerabilityPackage{Ecosystem: github.Ptr("npm"), Name: github.Ptr("lodash")},
+			ManifestPath: github.Ptr("package-lock.json"),
+		},
+		SecurityAdvisory: &github.DependabotSecurityAdvisory{
+			GHSAID:   github.Ptr("GHSA-jf85-cpcp-j695"),
+			CVEID:    github.Ptr("CVE-2019-10744"),
+			Summary:  github.Ptr("Prototype Pollution in lodash"),
+			Severity: github.Ptr("critical"),
+			CVSS: &github.AdvisoryCVSS{
+				Score:        github.Ptr(9.1),
+				VectorString: github.Ptr("CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:H/A:H"),
+			},
+		},
+		SecurityVulnerability: &github.AdvisoryVulnerability{
+			VulnerableVersionRange: github.Ptr("< 4.17.12"),
+			FirstPatchedVersion:    &github.FirstPatchedVersion{Identifier: github.Ptr("4.17.12")},
+		},
 	}
 
 	tests := []struct {
@@ -40,7 +58,7 @@ func Test_GetDependabotAlert(t *testing.T) {
 		mockedClient   *http.Client
 		requestArgs    map[string]interface{}
 		expectError    bool
-		expectedAlert  *github.DependabotAlert
+		expectedAlert  DependabotAlertDetails
 		expectedErrMsg string
 	}{
 		{
@@ -56,8 +74,25 @@ func Test_GetDependabotAlert(t *testing.T) {
 				"repo":        "repo",
 				"alertNumber": float64(42),
 			},
-			expectError:   false,
-			expectedAlert: mockAlert,
+			expectError: false,
+			expectedAlert: DependabotAlertDetails{
+				DependabotAlertSummary: DependabotAlertSummary{
+					Number:       42,
+					Package:      "lodash",
+					Ecosystem:    "npm",
+					ManifestPath: "package-lock.json",
+					GHSAID:       "GHSA-jf85-cpcp-j695",
+					CVEID:        "CVE-2019-10744",
+					Severity:     "critical",
+					State:        "open",
+					FixedIn:      "4.17.12",
+					HTMLURL:      "https://github.com/owner/repo/security/dependabot/42",
+				},
+				AdvisorySummary:        "Prototype Pollution in lodash",
+				CVSSScore:              github.Ptr(9.1),
+				CVSSVector:             "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:H/A:H",
+				VulnerableVersionRange: "< 4.17.12",
+			},
 		},
 		{
 			name: "alert fetch fails",
@@ -108,12 +143,10 @@ func Test_GetDependabotAlert(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedAlert github.DependabotAlert
+			var returnedAlert DependabotAlertDetails
 			err = json.Unmarshal([]byte(textContent.Text), &returnedAlert)
-			assert.NoError(t, err)
-			assert.Equal(t, *tc.expectedAlert.Number, *returnedAlert.Number)
-			assert.Equal(t, *tc.expectedAlert.State, *returnedAlert.State)
-			assert.Equal(t, *tc.expectedAlert.HTMLURL, *returnedAlert.HTMLURL)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedAlert, returnedAlert)
 		})
 	}
 }
@@ -130,6 +163,14 @@ func Test_ListDependabotAlerts(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "state")
 	assert.Contains(t, tool.InputSchema.Properties, "severity")
+	assert.Contains(t, tool.InputSchema.Properties, "ecosystem")
+	assert.Contains(t, tool.InputSchema.Properties, "package")
+	assert.Contains(t, tool.InputSchema.Properties, "scope")
+	assert.Contains(t, tool.InputSchema.Properties, "sort")
+	assert.Contains(t, tool.InputSchema.Properties, "direction")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.Contains(t, tool.InputSchema.Properties, "after")
+	assert.Contains(t, tool.InputSchema.Properties, "before")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
 
 	// Setup mock alerts for success case
@@ -137,26 +178,69 @@ func Test_ListDependabotAlerts(t *testing.T) {
 		Number:  github.Ptr(1),
 		HTMLURL: github.Ptr("https://github.com/owner/repo/security/dependabot/1"),
 		State:   github.Ptr("open"),
+		Dependency: &github.Dependency{
+			Package:      &github.VulnerabilityPackage{Ecosystem: github.Ptr("npm"), Name: github.Ptr("lodash")},
+			ManifestPath: github.Ptr("package-lock.json"),
+		},
 		SecurityAdvisory: &github.DependabotSecurityAdvisory{
-			Severity: github.Ptr("critical"),
+			GHSAID:      github.Ptr("GHSA-jf85-cpcp-j695"),
+			CVEID:       github.Ptr("CVE-2019-10744"),
+			Severity:    github.Ptr("critical"),
+			Description: github.Ptr("Versions of lodash before 4.17.12 are vulnerable to Prototype Pollution."),
+		},
+		SecurityVulnerability: &github.AdvisoryVulnerability{
+			FirstPatchedVersion: &github.FirstPatchedVersion{Identifier: github.Ptr("4.17.12")},
 		},
 	}
 	highSeverityAlert := github.DependabotAlert{
 		Number:  github.Ptr(2),
 		HTMLURL: github.Ptr("https://github.com/owner/repo/security/dependabot/2"),
 		State:   github.Ptr("fixed"),
+		Dependency: &github.Dependency{
+			Package: &github.VulnerabilityPackage{Ecosystem: github.Ptr("pip"), Name: github.Ptr("django")},
+		},
 		SecurityAdvisory: &github.DependabotSecurityAdvisory{
+			GHSAID:   github.Ptr("GHSA-2gwj-7jmv-h26r"),
 			Severity: github.Ptr("high"),
 		},
 	}
+	criticalSummary := DependabotAlertSummary{
+		Number:       1,
+		Package:      "lodash",
+		Ecosystem:    "npm",
+		ManifestPath: "package-lock.json",
+		GHSAID:       "GHSA-jf85-cpcp-j695",
+		CVEID:        "CVE-2019-10744",
+		Severity:     "critical",
+		State:        "open",
+		FixedIn:      "4.17.12",
+		HTMLURL:      "https://github.com/owner/repo/security/dependabot/1",
+	}
+	highSeveritySummary := DependabotAlertSummary{
+		Number:    2,
+		Package:   "django",
+		Ecosystem: "pip",
+		GHSAID:    "GHSA-2gwj-7jmv-h26r",
+		Severity:  "high",
+		State:     "fixed",
+		HTMLURL:   "https://github.com/owner/repo/security/dependabot/2",
+	}
+
+	type pageInfo struct {
+		HasNextPage     bool   `json:"hasNextPage"`
+		HasPreviousPage bool   `json:"hasPreviousPage"`
+		EndCursor       string `json:"endCursor"`
+		StartCursor     string `json:"startCursor"`
+	}
 
 	tests := []struct {
-		name           string
-		mockedClient   *http.Client
-		requestArgs    map[string]interface{}
-		expectError    bool
-		expectedAlerts []*github.DependabotAlert
-		expectedErrMsg string
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectError      bool
+		expectedAlerts   []DependabotAlertSummary
+		expectedPageInfo pageInfo
+		expectedErrMsg   string
 	}{
 		{
 			name: "successful open alerts listing",
@@ -164,7 +248,8 @@ func Test_ListDependabotAlerts(t *testing.T) {
 				mock.WithRequestMatchHandler(
 					mock.GetReposDependabotAlertsByOwnerByRepo,
 					expectQueryParams(t, map[string]string{
-						"state": "open",
+						"state":    "open",
+						"per_page": "30",
 					}).andThen(
 						mockResponse(t, http.StatusOK, []*github.DependabotAlert{&criticalAlert}),
 					),
@@ -176,44 +261,82 @@ func Test_ListDependabotAlerts(t *testing.T) {
 				"state": "open",
 			},
 			expectError:    false,
-			expectedAlerts: []*github.DependabotAlert{&criticalAlert},
+			expectedAlerts: []DependabotAlertSummary{criticalSummary},
 		},
 		{
-			name: "successful severity filtered listing",
+			name: "successful filtered listing",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
 					mock.GetReposDependabotAlertsByOwnerByRepo,
 					expectQueryParams(t, map[string]string{
-						"severity": "high",
+						"severity":  "high",
+						"ecosystem": "pip",
+						"package":   "django",
+						"scope":     "runtime",
+						"sort":      "updated",
+						"direction": "asc",
+						"per_page":  "30",
 					}).andThen(
 						mockResponse(t, http.StatusOK, []*github.DependabotAlert{&highSeverityAlert}),
 					),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner":    "owner",
-				"repo":     "repo",
-				"severity": "high",
+				"owner":     "owner",
+				"repo":      "repo",
+				"severity":  "high",
+				"ecosystem": "pip",
+				"package":   "django",
+				"scope":     "runtime",
+				"sort":      "updated",
+				"direction": "asc",
 			},
 			expectError:    false,
-			expectedAlerts: []*github.DependabotAlert{&highSeverityAlert},
+			expectedAlerts: []DependabotAlertSummary{highSeveritySummary},
 		},
 		{
-			name: "successful all alerts listing",
+			name: "successful page listing with cursors",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
 					mock.GetReposDependabotAlertsByOwnerByRepo,
-					expectQueryParams(t, map[string]string{}).andThen(
-						mockResponse(t, http.StatusOK, []*github.DependabotAlert{&criticalAlert, &highSeverityAlert}),
+					expectQueryParams(t, map[string]string{
+						"after":    "Y3Vyc29yOjE=",
+						"per_page": "2",
+					}).andThen(
+						func(w http.ResponseWriter, _ *http.Request) {
+							w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/dependabot/alerts?per_page=2&after=Y3Vyc29yOjM%3D>; rel="next", `+
+								`<https://api.github.com/repos/owner/repo/dependabot/alerts?per_page=2&before=Y3Vyc29yOjI%3D>; rel="prev"`)
+							mockResponse(t, http.StatusOK, []*github.DependabotAlert{&criticalAlert, &highSeverityAlert})(w, nil)
+						},
 					),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner": "owner",
-				"repo":  "repo",
+				"owner":   "owner",
+				"repo":    "repo",
+				"perPage": float64(2),
+				"after":   "Y3Vyc29yOjE=",
 			},
 			expectError:    false,
-			expectedAlerts: []*github.DependabotAlert{&criticalAlert, &highSeverityAlert},
+			expectedAlerts: []DependabotAlertSummary{criticalSummary, highSeveritySummary},
+			expectedPageInfo: pageInfo{
+				HasNextPage:     true,
+				HasPreviousPage: true,
+				EndCursor:       "Y3Vyc29yOjM=",
+				StartCursor:     "Y3Vyc29yOjI=",
+			},
+		},
+		{
+			name:         "after and before together",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"after":  "Y3Vyc29yOjE=",
+				"before": "Y3Vyc29yOjI=",
+			},
+			expectError:    true,
+			expectedErrMsg: "after and before cannot be used together",
 		},
 		{
 			name: "alerts listing fails",
@@ -233,6 +356,60 @@ func Test_ListDependabotAlerts(t *testing.T) {
 			expectError:    true,
 			expectedErrMsg: "failed to list alerts",
 		},
+		{
+			name: "dependabot alerts disabled",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposDependabotAlertsByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Dependabot alerts are disabled for this repository."}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "Dependabot alerts are not enabled for this repository",
+		},
+		{
+			name: "dependabot alerts not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposDependabotAlertsByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "Dependabot alerts are not enabled for this repository",
+		},
+		{
+			name: "missing permission",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposDependabotAlertsByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Resource not accessible by integration"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "reading Dependabot alerts requires the security_events scope",
+		},
 	}
 
 	for _, tc := range tests {
@@ -257,20 +434,208 @@ func Test_ListDependabotAlerts(t *testing.T) {
 
 			textContent := getTextResult(t, result)
 
+			// The advisory description belongs to get_dependabot_alert
+			assert.NotContains(t, textContent.Text, "Prototype Pollution")
+
 			// Unmarshal and verify the result
-			var returnedAlerts []*github.DependabotAlert
-			err = json.Unmarshal([]byte(textContent.Text), &returnedAlerts)
-			assert.NoError(t, err)
-			assert.Len(t, returnedAlerts, len(tc.expectedAlerts))
-			for i, alert := range returnedAlerts {
-				assert.Equal(t, *tc.expectedAlerts[i].Number, *alert.Number)
-				assert.Equal(t, *tc.expectedAlerts[i].HTMLURL, *alert.HTMLURL)
-				assert.Equal(t, *tc.expectedAlerts[i].State, *alert.State)
-				if tc.expectedAlerts[i].SecurityAdvisory != nil && tc.expectedAlerts[i].SecurityAdvisory.Severity != nil &&
-					alert.SecurityAdvisory != nil && alert.SecurityAdvisory.Severity != nil {
-					assert.Equal(t, *tc.expectedAlerts[i].SecurityAdvisory.Severity, *alert.SecurityAdvisory.Severity)
-				}
+			var returned struct {
+				Alerts   []DependabotAlertSummary `json:"alerts"`
+				PageInfo pageInfo                 `json:"pageInfo"`
+			}
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedAlerts, returned.Alerts)
+			assert.Equal(t, tc.expectedPageInfo, returned.PageInfo)
+		})
+	}
+}
+
+func Test_UpdateDependabotAlert(t *testing.T) {
+	// Verify tool definition
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateDependabotAlert(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_dependabot_alert", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "alertNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "state")
+	assert.Contains(t, tool.InputSchema.Properties, "dismissed_reason")
+	assert.Contains(t, tool.InputSchema.Properties, "dismissed_comment")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "alertNumber", "state"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	dismissedAlert := &github.DependabotAlert{
+		Number:           github.Ptr(42),
+		State:            github.Ptr("dismissed"),
+		HTMLURL:          github.Ptr("https://github.com/owner/repo/security/dependabot/42"),
+		DismissedBy:      &github.User{Login: github.Ptr("octocat")},
+		DismissedReason:  github.Ptr("tolerable_risk"),
+		DismissedComment: github.Ptr("Only used in build tooling"),
+	}
+	openAlert := &github.DependabotAlert{
+		Number:  github.Ptr(42),
+		State:   github.Ptr("open"),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/security/dependabot/42"),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedAlert  DependabotAlertDetails
+		expectedErrMsg string
+	}{
+		{
+			name: "dismiss alert",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposDependabotAlertsByOwnerByRepoByAlertNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"state":             "dismissed",
+						"dismissed_reason":  "tolerable_risk",
+						"dismissed_comment": "Only used in build tooling",
+					}).andThen(
+						mockResponse(t, http.StatusOK, dismissedAlert),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"alertNumber":       float64(42),
+				"state":             "dismissed",
+				"dismissed_reason":  "tolerable_risk",
+				"dismissed_comment": "Only used in build tooling",
+			},
+			expectError: false,
+			expectedAlert: DependabotAlertDetails{
+				DependabotAlertSummary: DependabotAlertSummary{
+					Number:  42,
+					State:   "dismissed",
+					HTMLURL: "https://github.com/owner/repo/security/dependabot/42",
+				},
+				DismissedBy:      "octocat",
+				DismissedReason:  "tolerable_risk",
+				DismissedComment: "Only used in build tooling",
+			},
+		},
+		{
+			name: "reopen alert",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposDependabotAlertsByOwnerByRepoByAlertNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"state": "open",
+					}).andThen(
+						mockResponse(t, http.StatusOK, openAlert),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"alertNumber": float64(42),
+				"state":       "open",
+			},
+			expectError: false,
+			expectedAlert: DependabotAlertDetails{
+				DependabotAlertSummary: DependabotAlertSummary{
+					Number:  42,
+					State:   "open",
+					HTMLURL: "https://github.com/owner/repo/security/dependabot/42",
+				},
+			},
+		},
+		{
+			name:         "dismiss without reason",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"alertNumber": float64(42),
+				"state":       "dismissed",
+			},
+			expectError:    true,
+			expectedErrMsg: "dismissed_reason is required when state is dismissed",
+		},
+		{
+			name:         "invalid dismissed reason",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":            "owner",
+				"repo":             "repo",
+				"alertNumber":      float64(42),
+				"state":            "dismissed",
+				"dismissed_reason": "won't fix",
+			},
+			expectError:    true,
+			expectedErrMsg: "invalid dismissed_reason",
+		},
+		{
+			name:         "reopen with reason",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":            "owner",
+				"repo":             "repo",
+				"alertNumber":      float64(42),
+				"state":            "open",
+				"dismissed_reason": "not_used",
+			},
+			expectError:    true,
+			expectedErrMsg: "can only be set when state is dismissed",
+		},
+		{
+			name: "update fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposDependabotAlertsByOwnerByRepoByAlertNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Resource not accessible by integration"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"alertNumber": float64(42),
+				"state":       "open",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to update alert with number '42'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdateDependabotAlert(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+
+			result, err := handler(context.Background(), request)
+
+			if tc.expectError {
+				require.NoError(t, err)
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
 			}
+
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			textContent := getTextResult(t, result)
+
+			var returnedAlert DependabotAlertDetails
+			err = json.Unmarshal([]byte(textContent.Text), &returnedAlert)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedAlert, returnedAlert)
 		})
 	}
 }
@@ -0,0 +1,503 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v73/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// defaultSBOMMaxInlineBytes is the largest SBOM get_repository_sbom returns inline unless max_inline_bytes is given.
+const defaultSBOMMaxInlineBytes = 1 << 20
+
+// sbomSummaryTopCount is how many ecosystems and licenses the summary of an SBOM lists.
+const sbomSummaryTopCount = 10
+
+// SBOMCount is the number of packages of an ecosystem or with a license.
+type SBOMCount struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// SBOMSummary answers the common questions about an SBOM without the whole document.
+type SBOMSummary struct {
+	Name          string      `json:"name,omitempty"`
+	SPDXVersion   string      `json:"spdx_version,omitempty"`
+	Created       string      `json:"created,omitempty"`
+	TotalPackages int         `json:"total_packages"`
+	TopEcosystems []SBOMCount `json:"top_ecosystems"`
+	TopLicenses   []SBOMCount `json:"top_licenses"`
+}
+
+// RepositorySBOM is the result of get_repository_sbom, with the SBOM inline, and of
+// save_repository_sbom, with the SBOM saved to Path.
+type RepositorySBOM struct {
+	Summary SBOMSummary     `json:"summary"`
+	Size    int             `json:"size"`
+	Path    string          `json:"path,omitempty"`
+	SBOM    json.RawMessage `json:"sbom,omitempty"`
+	Note    string          `json:"note,omitempty"`
+}
+
+// sbomPackageEcosystem gets the ecosystem of an SBOM package from the type of its package URL.
+func sbomPackageEcosystem(pkg *github.RepoDependencies) string {
+	for _, ref := range pkg.ExternalRefs {
+		if ref.ReferenceType != "purl" {
+			continue
+		}
+		purlType, _, found := strings.Cut(strings.TrimPrefix(ref.ReferenceLocator, "pkg:"), "/")
+		if found && purlType != "" {
+			return purlType
+		}
+	}
+	return "unknown"
+}
+
+// sbomPackageLicense gets the license of an SBOM package, preferring the concluded license to the declared one.
+func sbomPackageLicense(pkg *github.RepoDependencies) string {
+	if license := pkg.GetLicenseConcluded(); license != "" && license != "NOASSERTION" {
+		return license
+	}
+	if license := pkg.GetLicenseDeclared(); license != "" && license != "NOASSERTION" {
+		return license
+	}
+	return "NOASSERTION"
+}
+
+// topSBOMCounts sorts counts from the most to the least packages, by name on ties, and keeps the first few.
+func topSBOMCounts(counts map[string]int) []SBOMCount {
+	top := make([]SBOMCount, 0, len(counts))
+	for name, count := range counts {
+		top = append(top, SBOMCount{Name: name, Count: count})
+	}
+	sort.Slice(top, func(i, j int) bool {
+		if top[i].Count != top[j].Count {
+			return top[i].Count > top[j].Count
+		}
+		return top[i].Name < top[j].Name
+	})
+	if len(top) > sbomSummaryTopCount {
+		top = top[:sbomSummaryTopCount]
+	}
+	return top
+}
+
+// summarizeSBOM counts the packages of an SBOM by ecosystem and license. The packages the document
+// describes are the repository itself, so they are not counted.
+func summarizeSBOM(sbom *github.SBOM) SBOMSummary {
+	info := sbom.GetSBOM()
+	summary := SBOMSummary{
+		Name:        info.GetName(),
+		SPDXVersion: info.GetSPDXVersion(),
+	}
+	if created := info.GetCreationInfo().GetCreated(); !created.IsZero() {
+		summary.Created = summaryTimestamp(created)
+	}
+
+	described := make(map[string]bool, len(info.DocumentDescribes))
+	for _, id := range info.DocumentDescribes {
+		described[id] = true
+	}
+	ecosystems := make(map[string]int)
+	licenses := make(map[string]int)
+	for _, pkg := range info.Packages {
+		if described[pkg.GetSPDXID()] {
+			continue
+		}
+		summary.TotalPackages++
+		ecosystems[sbomPackageEcosystem(pkg)]++
+		licenses[sbomPackageLicense(pkg)]++
+	}
+	summary.TopEcosystems = topSBOMCounts(ecosystems)
+	summary.TopLicenses = topSBOMCounts(licenses)
+	return summary
+}
+
+// dependencyGraphErrorResponse describes a failed dependency graph request, explaining a 404 as the
+// dependency graph not being enabled.
+func dependencyGraphErrorResponse(ctx context.Context, message string, resp *github.Response, err error) *mcp.CallToolResult {
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		message = fmt.Sprintf("%s: the dependency graph is not enabled for this repository, or the repository does not exist", message)
+		_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, message, resp, err)
+		return mcp.NewToolResultError(message)
+	}
+	return ghErrors.NewGitHubAPIErrorResponse(ctx, message, resp, err)
+}
+
+// getRepositorySBOM gets the SBOM of a repository and its JSON encoding. When the request fails, it
+// returns the error result for the tool instead.
+func getRepositorySBOM(ctx context.Context, client *github.Client, owner, repo string) (*github.SBOM, []byte, *mcp.CallToolResult, error) {
+	sbom, resp, err := client.DependencyGraph.GetSBOM(ctx, owner, repo)
+	if err != nil {
+		return nil, nil, dependencyGraphErrorResponse(ctx, fmt.Sprintf("failed to get SBOM for repository '%s/%s'", owner, repo), resp, err), nil
+	}
+	_ = resp.Body.Close()
+
+	data, err := json.Marshal(sbom)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to marshal SBOM: %w", err)
+	}
+	return sbom, data, nil, nil
+}
+
+// GetRepositorySBOM creates a tool to export the SPDX software bill of materials of a repository.
+func GetRepositorySBOM(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_repository_sbom",
+			mcp.WithDescription(t("TOOL_GET_REPOSITORY_SBOM_DESCRIPTION", "Export the SPDX software bill of materials (SBOM) of a GitHub repository from its dependency graph. Always returns a summary of the package count, top ecosystems and licenses. The SBOM itself is returned inline when it is no larger than max_inline_bytes; use save_repository_sbom to save it to a local path")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_REPOSITORY_SBOM_USER_TITLE", "Get repository SBOM"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithNumber("max_inline_bytes",
+				mcp.Description("Largest SBOM to return inline, in bytes. Only the summary is returned for larger SBOMs"),
+				mcp.DefaultNumber(defaultSBOMMaxInlineBytes),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxInlineBytes, err := OptionalIntParamWithDefault(request, "max_inline_bytes", defaultSBOMMaxInlineBytes)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if maxInlineBytes < 0 {
+				return mcp.NewToolResultError("max_inline_bytes must not be negative"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			sbom, data, errResult, err := getRepositorySBOM(ctx, client, owner, repo)
+			if err != nil {
+				return nil, err
+			}
+			if errResult != nil {
+				return errResult, nil
+			}
+
+			result := RepositorySBOM{
+				Summary: summarizeSBOM(sbom),
+				Size:    len(data),
+			}
+			if len(data) <= maxInlineBytes {
+				result.SBOM = data
+			} else {
+				result.Note = fmt.Sprintf("SBOM is %d bytes, larger than max_inline_bytes %d, so it is not returned; use save_repository_sbom to save it", len(data), maxInlineBytes)
+			}
+
+			return MarshalledTextResult(result), nil
+		}
+}
+
+// SaveRepositorySBOM creates a tool to save the SPDX software bill of materials of a repository to a local path.
+func SaveRepositorySBOM(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("save_repository_sbom",
+			mcp.WithDescription(t("TOOL_SAVE_REPOSITORY_SBOM_DESCRIPTION", "Save the SPDX software bill of materials (SBOM) of a GitHub repository to a local path, returning a summary of the package count, top ecosystems and licenses")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SAVE_REPOSITORY_SBOM_USER_TITLE", "Save repository SBOM"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("dest_path",
+				mcp.Required(),
+				mcp.Description("New file or existing directory to save the SBOM to. Existing files are not overwritten"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			destPath, err := RequiredParam[string](request, "dest_path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			sbom, data, errResult, err := getRepositorySBOM(ctx, client, owner, repo)
+			if err != nil {
+				return nil, err
+			}
+			if errResult != nil {
+				return errResult, nil
+			}
+
+			filePath := destPath
+			if info, err := os.Stat(destPath); err == nil && info.IsDir() {
+				filePath = filepath.Join(destPath, owner+"-"+repo+".spdx.json")
+			}
+			file, err := createNewFile(filePath)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to save SBOM: %s", err)), nil
+			}
+			_, err = file.Write(data)
+			if closeErr := file.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				_ = os.Remove(filePath)
+				return mcp.NewToolResultError(fmt.Sprintf("failed to save SBOM: %s", err)), nil
+			}
+
+			return MarshalledTextResult(RepositorySBOM{
+				Summary: summarizeSBOM(sbom),
+				Size:    len(data),
+				Path:    filePath,
+			}), nil
+		}
+}
+
+// DependencyVulnerability is a known vulnerability of a dependency.
+type DependencyVulnerability struct {
+	Severity        string `json:"severity"`
+	AdvisoryGHSAID  string `json:"advisory_ghsa_id"`
+	AdvisorySummary string `json:"advisory_summary"`
+	AdvisoryURL     string `json:"advisory_url,omitempty"`
+}
+
+// dependencyGraphChange is a dependency added or removed between two refs, as returned by the
+// dependency graph compare endpoint.
+type dependencyGraphChange struct {
+	ChangeType      string                    `json:"change_type"`
+	Manifest        string                    `json:"manifest"`
+	Ecosystem       string                    `json:"ecosystem"`
+	Name            string                    `json:"name"`
+	Version         string                    `json:"version"`
+	License         *string                   `json:"license"`
+	Scope           string                    `json:"scope"`
+	Vulnerabilities []DependencyVulnerability `json:"vulnerabilities"`
+}
+
+// DependencyChange is a dependency that changed between two refs. Version is the version at head,
+// or at base for a removed dependency, and PreviousVersion the version at base of a changed one.
+type DependencyChange struct {
+	Ecosystem       string                    `json:"ecosystem"`
+	Name            string                    `json:"name"`
+	Manifest        string                    `json:"manifest"`
+	Version         string                    `json:"version"`
+	PreviousVersion string                    `json:"previous_version,omitempty"`
+	License         string                    `json:"license,omitempty"`
+	Scope           string                    `json:"scope,omitempty"`
+	Vulnerabilities []DependencyVulnerability `json:"vulnerabilities,omitempty"`
+}
+
+// DependencyDiff is the result of get_dependency_diff.
+type DependencyDiff struct {
+	Base    string             `json:"base"`
+	Head    string             `json:"head"`
+	Added   []DependencyChange `json:"added"`
+	Removed []DependencyChange `json:"removed"`
+	Changed []DependencyChange `json:"changed"`
+}
+
+// compareDependencies gets the dependencies added and removed between two refs. go-github has no
+// method for the dependency graph compare endpoint, so the request is built directly.
+func compareDependencies(ctx context.Context, client *github.Client, owner, repo, base, head string) ([]dependencyGraphChange, *github.Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/dependency-graph/compare/%v...%v", owner, repo, url.PathEscape(base), url.PathEscape(head))
+	req, err := client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var changes []dependencyGraphChange
+	resp, err := client.Do(ctx, req, &changes)
+	if err != nil {
+		return nil, resp, err
+	}
+	return changes, resp, nil
+}
+
+// newDependencyChange converts a change reported by the dependency graph compare endpoint into a DependencyChange.
+func newDependencyChange(change dependencyGraphChange) DependencyChange {
+	dependency := DependencyChange{
+		Ecosystem:       change.Ecosystem,
+		Name:            change.Name,
+		Manifest:        change.Manifest,
+		Version:         change.Version,
+		Scope:           change.Scope,
+		Vulnerabilities: change.Vulnerabilities,
+	}
+	if change.License != nil {
+		dependency.License = *change.License
+	}
+	return dependency
+}
+
+// newDependencyDiff groups the changes between two refs into added, removed and changed dependencies.
+// The compare endpoint reports a version change as the removal of the old version and the addition
+// of the new one, so a removal and an addition of the same package in the same manifest are paired.
+func newDependencyDiff(base, head string, changes []dependencyGraphChange, vulnerabilitiesOnly bool) DependencyDiff {
+	diff := DependencyDiff{
+		Base:    base,
+		Head:    head,
+		Added:   []DependencyChange{},
+		Removed: []DependencyChange{},
+		Changed: []DependencyChange{},
+	}
+
+	packageKey := func(change dependencyGraphChange) string {
+		return change.Manifest + "\x00" + change.Ecosystem + "\x00" + change.Name
+	}
+	removed := make(map[string]dependencyGraphChange)
+	for _, change := range changes {
+		if change.ChangeType == "removed" {
+			removed[packageKey(change)] = change
+		}
+	}
+
+	paired := make(map[string]bool)
+	for _, change := range changes {
+		if change.ChangeType != "added" {
+			continue
+		}
+		if vulnerabilitiesOnly && len(change.Vulnerabilities) == 0 {
+			continue
+		}
+		dependency := newDependencyChange(change)
+		if previous, ok := removed[packageKey(change)]; ok {
+			paired[packageKey(change)] = true
+			dependency.PreviousVersion = previous.Version
+			diff.Changed = append(diff.Changed, dependency)
+			continue
+		}
+		diff.Added = append(diff.Added, dependency)
+	}
+
+	if vulnerabilitiesOnly {
+		return diff
+	}
+	for _, change := range changes {
+		if change.ChangeType != "removed" || paired[packageKey(change)] {
+			continue
+		}
+		diff.Removed = append(diff.Removed, newDependencyChange(change))
+	}
+	return diff
+}
+
+// GetDependencyDiff creates a tool to compare the dependencies of two refs of a repository.
+func GetDependencyDiff(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_dependency_diff",
+			mcp.WithDescription(t("TOOL_GET_DEPENDENCY_DIFF_DESCRIPTION", "Compare the dependencies of two refs of a GitHub repository, or of the base and head of a pull request, and return the added, removed and changed packages with their versions, licenses and known vulnerabilities. Use it as a pre-merge risk check")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_DEPENDENCY_DIFF_USER_TITLE", "Get dependency diff"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("base",
+				mcp.Description("Base branch, tag or commit SHA. Required unless pullNumber is given"),
+			),
+			mcp.WithString("head",
+				mcp.Description("Head branch, tag or commit SHA. Required unless pullNumber is given"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Description("Pull request number to compare the base and head commits of, instead of base and head"),
+			),
+			mcp.WithBoolean("vulnerabilities_only",
+				mcp.Description("Only return added and changed packages with known vulnerabilities"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			base, err := OptionalParam[string](request, "base")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			head, err := OptionalParam[string](request, "head")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := OptionalIntParam(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			vulnerabilitiesOnly, err := OptionalParam[bool](request, "vulnerabilities_only")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			switch {
+			case pullNumber != 0 && (base != "" || head != ""):
+				return mcp.NewToolResultError("only one of pullNumber or base and head can be provided"), nil
+			case pullNumber == 0 && (base == "" || head == ""):
+				return mcp.NewToolResultError("either pullNumber or both base and head are required"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			if pullNumber != 0 {
+				pr, resp, err := client.PullRequests.Get(ctx, owner, repo, pullNumber)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get pull request %d", pullNumber), resp, err), nil
+				}
+				_ = resp.Body.Close()
+				base = pr.GetBase().GetSHA()
+				head = pr.GetHead().GetSHA()
+			}
+
+			changes, resp, err := compareDependencies(ctx, client, owner, repo, base, head)
+			if err != nil {
+				return dependencyGraphErrorResponse(ctx, fmt.Sprintf("failed to compare dependencies of %s...%s", base, head), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(newDependencyDiff(base, head, changes, vulnerabilitiesOnly)), nil
+		}
+}
@@ -0,0 +1,466 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v73/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sbomFixture returns an SBOM with packages from several ecosystems and licenses, and its summary.
+func sbomFixture() (*github.SBOM, SBOMSummary) {
+	purl := func(locator string) []*github.PackageExternalRef {
+		return []*github.PackageExternalRef{
+			{ReferenceCategory: "PACKAGE-MANAGER", ReferenceType: "purl", ReferenceLocator: locator},
+		}
+	}
+	created := time.Date(2025, 5, 6, 7, 8, 9, 0, time.UTC)
+	mockSBOM := &github.SBOM{
+		SBOM: &github.SBOMInfo{
+			SPDXID:            github.Ptr("SPDXRef-DOCUMENT"),
+			SPDXVersion:       github.Ptr("SPDX-2.3"),
+			Name:              github.Ptr("com.github.owner/repo"),
+			CreationInfo:      &github.CreationInfo{Created: &github.Timestamp{Time: created}},
+			DocumentDescribes: []string{"SPDXRef-github-owner-repo"},
+			Packages: []*github.RepoDependencies{
+				{
+					SPDXID:       github.Ptr("SPDXRef-github-owner-repo"),
+					Name:         github.Ptr("com.github.owner/repo"),
+					ExternalRefs: purl("pkg:github/owner/repo"),
+				},
+				{
+					SPDXID:           github.Ptr("SPDXRef-npm-lodash"),
+					Name:             github.Ptr("npm:lodash"),
+					LicenseConcluded: github.Ptr("MIT"),
+					ExternalRefs:     purl("pkg:npm/lodash@4.17.21"),
+				},
+				{
+					SPDXID:          github.Ptr("SPDXRef-npm-express"),
+					Name:            github.Ptr("npm:express"),
+					LicenseDeclared: github.Ptr("MIT"),
+					ExternalRefs:    purl("pkg:npm/express@4.19.2"),
+				},
+				{
+					SPDXID:           github.Ptr("SPDXRef-golang-cobra"),
+					Name:             github.Ptr("go:github.com/spf13/cobra"),
+					LicenseConcluded: github.Ptr("Apache-2.0"),
+					ExternalRefs:     purl("pkg:golang/github.com/spf13/cobra@1.8.1"),
+				},
+				{
+					SPDXID:           github.Ptr("SPDXRef-unknown"),
+					Name:             github.Ptr("vendored"),
+					LicenseConcluded: github.Ptr("NOASSERTION"),
+				},
+			},
+		},
+	}
+	expectedSummary := SBOMSummary{
+		Name:          "com.github.owner/repo",
+		SPDXVersion:   "SPDX-2.3",
+		Created:       summaryTimestamp(github.Timestamp{Time: created}),
+		TotalPackages: 4,
+		TopEcosystems: []SBOMCount{
+			{Name: "npm", Count: 2},
+			{Name: "golang", Count: 1},
+			{Name: "unknown", Count: 1},
+		},
+		TopLicenses: []SBOMCount{
+			{Name: "MIT", Count: 2},
+			{Name: "Apache-2.0", Count: 1},
+			{Name: "NOASSERTION", Count: 1},
+		},
+	}
+	return mockSBOM, expectedSummary
+}
+
+func Test_GetRepositorySBOM(t *testing.T) {
+	// Verify tool definition
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRepositorySBOM(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_repository_sbom", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.NotContains(t, tool.InputSchema.Properties, "dest_path")
+	assert.Contains(t, tool.InputSchema.Properties, "max_inline_bytes")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	mockSBOM, expectedSummary := sbomFixture()
+
+	t.Run("inline SBOM", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(mock.GetReposDependencyGraphSbomByOwnerByRepo, mockSBOM),
+		))
+		_, handler := GetRepositorySBOM(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner": "owner",
+			"repo":  "repo",
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var returned RepositorySBOM
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+		assert.Equal(t, expectedSummary, returned.Summary)
+		assert.Empty(t, returned.Path)
+		assert.Empty(t, returned.Note)
+
+		var sbom github.SBOM
+		require.NoError(t, json.Unmarshal(returned.SBOM, &sbom))
+		assert.Len(t, sbom.GetSBOM().Packages, 5)
+		assert.Equal(t, len(returned.SBOM), returned.Size)
+	})
+
+	t.Run("SBOM larger than max_inline_bytes", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(mock.GetReposDependencyGraphSbomByOwnerByRepo, mockSBOM),
+		))
+		_, handler := GetRepositorySBOM(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner":            "owner",
+			"repo":             "repo",
+			"max_inline_bytes": float64(100),
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var returned RepositorySBOM
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+		assert.Equal(t, expectedSummary, returned.Summary)
+		assert.Nil(t, returned.SBOM)
+		assert.Contains(t, returned.Note, "larger than max_inline_bytes 100")
+	})
+
+	t.Run("dependency graph not enabled", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetReposDependencyGraphSbomByOwnerByRepo,
+				http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+				}),
+			),
+		))
+		_, handler := GetRepositorySBOM(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner": "owner",
+			"repo":  "repo",
+		}))
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getErrorResult(t, result).Text, "the dependency graph is not enabled for this repository")
+	})
+}
+
+func Test_SaveRepositorySBOM(t *testing.T) {
+	// Verify tool definition
+	mockClient := github.NewClient(nil)
+	tool, _ := SaveRepositorySBOM(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "save_repository_sbom", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "dest_path")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "dest_path"})
+	// It writes to the local filesystem, so it must not be offered in read-only mode.
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	mockSBOM, expectedSummary := sbomFixture()
+
+	t.Run("SBOM saved to directory", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(mock.GetReposDependencyGraphSbomByOwnerByRepo, mockSBOM),
+		))
+		_, handler := SaveRepositorySBOM(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		destDir := t.TempDir()
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner":     "owner",
+			"repo":      "repo",
+			"dest_path": destDir,
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var returned RepositorySBOM
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+		assert.Equal(t, expectedSummary, returned.Summary)
+		assert.Equal(t, filepath.Join(destDir, "owner-repo.spdx.json"), returned.Path)
+		assert.Nil(t, returned.SBOM)
+
+		data, err := os.ReadFile(returned.Path)
+		require.NoError(t, err)
+		assert.Equal(t, returned.Size, len(data))
+		var sbom github.SBOM
+		require.NoError(t, json.Unmarshal(data, &sbom))
+		assert.Equal(t, "SPDX-2.3", sbom.GetSBOM().GetSPDXVersion())
+	})
+
+	t.Run("existing file is not overwritten", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(mock.GetReposDependencyGraphSbomByOwnerByRepo, mockSBOM),
+		))
+		_, handler := SaveRepositorySBOM(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		existing := filepath.Join(t.TempDir(), "sbom.json")
+		require.NoError(t, os.WriteFile(existing, []byte("keep me"), 0o600))
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner":     "owner",
+			"repo":      "repo",
+			"dest_path": existing,
+		}))
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getErrorResult(t, result).Text, "already exists")
+
+		kept, err := os.ReadFile(existing)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("keep me"), kept)
+	})
+}
+
+func Test_GetDependencyDiff(t *testing.T) {
+	// Verify tool definition
+	mockClient := github.NewClient(nil)
+	tool, _ := GetDependencyDiff(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_dependency_diff", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "base")
+	assert.Contains(t, tool.InputSchema.Properties, "head")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "vulnerabilities_only")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	lodashVulnerability := DependencyVulnerability{
+		Severity:        "critical",
+		AdvisoryGHSAID:  "GHSA-jf85-cpcp-j695",
+		AdvisorySummary: "Prototype Pollution in lodash",
+		AdvisoryURL:     "https://github.com/advisories/GHSA-jf85-cpcp-j695",
+	}
+	mockChanges := []dependencyGraphChange{
+		{
+			ChangeType: "removed",
+			Manifest:   "package-lock.json",
+			Ecosystem:  "npm",
+			Name:       "express",
+			Version:    "4.18.2",
+			License:    github.Ptr("MIT"),
+			Scope:      "runtime",
+		},
+		{
+			ChangeType: "added",
+			Manifest:   "package-lock.json",
+			Ecosystem:  "npm",
+			Name:       "express",
+			Version:    "4.19.2",
+			License:    github.Ptr("MIT"),
+			Scope:      "runtime",
+		},
+		{
+			ChangeType:      "added",
+			Manifest:        "package-lock.json",
+			Ecosystem:       "npm",
+			Name:            "lodash",
+			Version:         "4.17.11",
+			License:         github.Ptr("MIT"),
+			Scope:           "runtime",
+			Vulnerabilities: []DependencyVulnerability{lodashVulnerability},
+		},
+		{
+			ChangeType: "removed",
+			Manifest:   "go.mod",
+			Ecosystem:  "gomod",
+			Name:       "github.com/pkg/errors",
+			Version:    "0.9.1",
+			Scope:      "runtime",
+		},
+	}
+	expressChange := DependencyChange{
+		Ecosystem:       "npm",
+		Name:            "express",
+		Manifest:        "package-lock.json",
+		Version:         "4.19.2",
+		PreviousVersion: "4.18.2",
+		License:         "MIT",
+		Scope:           "runtime",
+	}
+	lodashAddition := DependencyChange{
+		Ecosystem:       "npm",
+		Name:            "lodash",
+		Manifest:        "package-lock.json",
+		Version:         "4.17.11",
+		License:         "MIT",
+		Scope:           "runtime",
+		Vulnerabilities: []DependencyVulnerability{lodashVulnerability},
+	}
+	errorsRemoval := DependencyChange{
+		Ecosystem: "gomod",
+		Name:      "github.com/pkg/errors",
+		Manifest:  "go.mod",
+		Version:   "0.9.1",
+		Scope:     "runtime",
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedDiff   DependencyDiff
+		expectedErrMsg string
+	}{
+		{
+			name: "compare refs",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposDependencyGraphCompareByOwnerByRepoByBasehead,
+					expectPath(t, "/repos/owner/repo/dependency-graph/compare/main...feature").andThen(
+						mockResponse(t, http.StatusOK, mockChanges),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"base":  "main",
+				"head":  "feature",
+			},
+			expectError: false,
+			expectedDiff: DependencyDiff{
+				Base:    "main",
+				Head:    "feature",
+				Added:   []DependencyChange{lodashAddition},
+				Removed: []DependencyChange{errorsRemoval},
+				Changed: []DependencyChange{expressChange},
+			},
+		},
+		{
+			name: "compare pull request with vulnerabilities only",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					&github.PullRequest{
+						Number: github.Ptr(42),
+						Base:   &github.PullRequestBranch{SHA: github.Ptr("abc123")},
+						Head:   &github.PullRequestBranch{SHA: github.Ptr("def456")},
+					},
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposDependencyGraphCompareByOwnerByRepoByBasehead,
+					expectPath(t, "/repos/owner/repo/dependency-graph/compare/abc123...def456").andThen(
+						mockResponse(t, http.StatusOK, mockChanges),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":                "owner",
+				"repo":                 "repo",
+				"pullNumber":           float64(42),
+				"vulnerabilities_only": true,
+			},
+			expectError: false,
+			expectedDiff: DependencyDiff{
+				Base:    "abc123",
+				Head:    "def456",
+				Added:   []DependencyChange{lodashAddition},
+				Removed: []DependencyChange{},
+				Changed: []DependencyChange{},
+			},
+		},
+		{
+			name:         "missing head",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"base":  "main",
+			},
+			expectError:    true,
+			expectedErrMsg: "either pullNumber or both base and head are required",
+		},
+		{
+			name:         "pull request and refs together",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"base":       "main",
+				"pullNumber": float64(42),
+			},
+			expectError:    true,
+			expectedErrMsg: "only one of pullNumber or base and head can be provided",
+		},
+		{
+			name: "dependency graph not enabled",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposDependencyGraphCompareByOwnerByRepoByBasehead,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"base":  "main",
+				"head":  "feature",
+			},
+			expectError:    true,
+			expectedErrMsg: "the dependency graph is not enabled for this repository",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetDependencyDiff(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+
+			result, err := handler(context.Background(), request)
+
+			if tc.expectError {
+				require.NoError(t, err)
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			textContent := getTextResult(t, result)
+
+			var returned DependencyDiff
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedDiff, returned)
+		})
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/go-viper/mapstructure/v2"
 	"github.com/google/go-github/v73/github"
@@ -15,9 +17,72 @@ import (
 
 const DefaultGraphQLPageSize = 30
 
+// DiscussionSummary is the compact form of a discussion, as returned by list_discussions.
+type DiscussionSummary struct {
+	Number     int    `json:"number"`
+	Title      string `json:"title"`
+	Category   string `json:"category"`
+	Author     string `json:"author,omitempty"`
+	CreatedAt  string `json:"created_at"`
+	IsAnswered bool   `json:"is_answered"`
+	Comments   int    `json:"comments"`
+	Upvotes    int    `json:"upvotes"`
+	URL        string `json:"url"`
+}
+
+// discussionsNotEnabledError is returned when a repository has discussions turned off.
+func discussionsNotEnabledError(owner, repo string) error {
+	return fmt.Errorf("discussions are not enabled for %s/%s", owner, repo)
+}
+
+// discussionsErrorResponse returns the error response for a failed discussions query, telling
+// apart repositories that have discussions turned off.
+func discussionsErrorResponse(ctx context.Context, message, owner, repo string, err error) *mcp.CallToolResult {
+	lower := strings.ToLower(err.Error())
+	if strings.Contains(lower, "discussions") && (strings.Contains(lower, "disabled") || strings.Contains(lower, "not enabled")) {
+		err = discussionsNotEnabledError(owner, repo)
+	}
+	return ghErrors.NewGitHubGraphQLErrorResponse(ctx, message, err)
+}
+
+// resolveDiscussionCategoryID returns the ID of the discussion category with the given name,
+// compared case-insensitively. A category ID is accepted too and returned as is.
+func resolveDiscussionCategoryID(ctx context.Context, client *githubv4.Client, owner, repo, category string) (githubv4.ID, error) {
+	var q struct {
+		Repository struct {
+			HasDiscussionsEnabled bool
+			DiscussionCategories  struct {
+				Nodes []struct {
+					ID   githubv4.ID
+					Name githubv4.String
+				}
+			} `graphql:"discussionCategories(first: 100)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+	vars := map[string]interface{}{
+		"owner": githubv4.String(owner),
+		"repo":  githubv4.String(repo),
+	}
+	if err := client.Query(ctx, &q, vars); err != nil {
+		return nil, err
+	}
+	if !q.Repository.HasDiscussionsEnabled {
+		return nil, discussionsNotEnabledError(owner, repo)
+	}
+
+	names := make([]string, 0, len(q.Repository.DiscussionCategories.Nodes))
+	for _, c := range q.Repository.DiscussionCategories.Nodes {
+		if strings.EqualFold(string(c.Name), category) || fmt.Sprint(c.ID) == category {
+			return c.ID, nil
+		}
+		names = append(names, string(c.Name))
+	}
+	return nil, fmt.Errorf("discussion category %q not found in %s/%s, available categories: %s", category, owner, repo, strings.Join(names, ", "))
+}
+
 func ListDiscussions(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("list_discussions",
-			mcp.WithDescription(t("TOOL_LIST_DISCUSSIONS_DESCRIPTION", "List discussions for a repository")),
+			mcp.WithDescription(t("TOOL_LIST_DISCUSSIONS_DESCRIPTION", "List discussions for a repository, with their category, author, answer state, comment count and upvotes")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_LIST_DISCUSSIONS_USER_TITLE", "List discussions"),
 				ReadOnlyHint: ToBoolPtr(true),
@@ -31,7 +96,19 @@ func ListDiscussions(getGQLClient GetGQLClientFn, t translations.TranslationHelp
 				mcp.Description("Repository name"),
 			),
 			mcp.WithString("category",
-				mcp.Description("Optional filter by discussion category ID. If provided, only discussions with this category are listed."),
+				mcp.Description("Optional filter by discussion category name, such as Q&A, or category ID. If provided, only discussions with this category are listed."),
+			),
+			mcp.WithBoolean("answered",
+				mcp.Description("Optional filter on whether discussions have an answer marked. Only meaningful for categories that accept answers."),
+			),
+			mcp.WithString("orderBy",
+				mcp.Description("Field to order discussions by"),
+				mcp.Enum("created", "updated"),
+			),
+			mcp.WithString("direction",
+				mcp.Description("Order direction, only used with orderBy"),
+				mcp.Enum("asc", "desc"),
+				mcp.DefaultString("desc"),
 			),
 			WithCursorPagination(),
 		),
@@ -51,6 +128,18 @@ func ListDiscussions(getGQLClient GetGQLClientFn, t translations.TranslationHelp
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			answered, answeredSet, err := OptionalParamOK[bool](request, "answered")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			orderBy, err := OptionalParam[string](request, "orderBy")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			direction, err := OptionalParam[string](request, "direction")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
 			// Get pagination parameters and convert to GraphQL format
 			pagination, err := OptionalCursorPaginationParams(request)
@@ -67,162 +156,185 @@ func ListDiscussions(getGQLClient GetGQLClientFn, t translations.TranslationHelp
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
 			}
 
-			// If category filter is specified, use it as the category ID for server-side filtering
-			var categoryID *githubv4.ID
-			if category != "" {
-				id := githubv4.ID(category)
-				categoryID = &id
+			vars := map[string]interface{}{
+				"owner":      githubv4.String(owner),
+				"repo":       githubv4.String(repo),
+				"first":      githubv4.Int(*paginationParams.First),
+				"after":      (*githubv4.String)(nil),
+				"categoryId": (*githubv4.ID)(nil),
+				"answered":   (*githubv4.Boolean)(nil),
+				"orderBy":    (*githubv4.DiscussionOrder)(nil),
+			}
+			// The optional variables are always passed as pointers, so that the query declares them
+			// as nullable whether they are set or not.
+			if paginationParams.After != nil {
+				vars["after"] = githubv4.NewString(githubv4.String(*paginationParams.After))
 			}
-
-			var out []byte
-
-			var discussions []*github.Discussion
-			if categoryID != nil {
-				// Query with category filter (server-side filtering)
-				var query struct {
-					Repository struct {
-						Discussions struct {
-							Nodes []struct {
-								Number    githubv4.Int
-								Title     githubv4.String
-								CreatedAt githubv4.DateTime
-								Category  struct {
-									Name githubv4.String
-								} `graphql:"category"`
-								URL githubv4.String `graphql:"url"`
-							}
-							PageInfo struct {
-								HasNextPage     bool
-								HasPreviousPage bool
-								StartCursor     string
-								EndCursor       string
-							}
-							TotalCount int
-						} `graphql:"discussions(first: $first, after: $after, categoryId: $categoryId)"`
-					} `graphql:"repository(owner: $owner, name: $repo)"`
-				}
-				vars := map[string]interface{}{
-					"owner":      githubv4.String(owner),
-					"repo":       githubv4.String(repo),
-					"categoryId": *categoryID,
-					"first":      githubv4.Int(*paginationParams.First),
-				}
-				if paginationParams.After != nil {
-					vars["after"] = githubv4.String(*paginationParams.After)
-				} else {
-					vars["after"] = (*githubv4.String)(nil)
+			if category != "" {
+				categoryID, err := resolveDiscussionCategoryID(ctx, client, owner, repo, category)
+				if err != nil {
+					return discussionsErrorResponse(ctx, "failed to resolve discussion category", owner, repo, err), nil
 				}
-				if err := client.Query(ctx, &query, vars); err != nil {
-					return mcp.NewToolResultError(err.Error()), nil
+				vars["categoryId"] = githubv4.NewID(categoryID)
+			}
+			if answeredSet {
+				vars["answered"] = githubv4.NewBoolean(githubv4.Boolean(answered))
+			}
+			if orderBy != "" {
+				order := githubv4.DiscussionOrder{
+					Field:     githubv4.DiscussionOrderFieldCreatedAt,
+					Direction: githubv4.OrderDirectionDesc,
 				}
-
-				// Map nodes to GitHub Discussion objects
-				for _, n := range query.Repository.Discussions.Nodes {
-					di := &github.Discussion{
-						Number:    github.Ptr(int(n.Number)),
-						Title:     github.Ptr(string(n.Title)),
-						HTMLURL:   github.Ptr(string(n.URL)),
-						CreatedAt: &github.Timestamp{Time: n.CreatedAt.Time},
-						DiscussionCategory: &github.DiscussionCategory{
-							Name: github.Ptr(string(n.Category.Name)),
-						},
-					}
-					discussions = append(discussions, di)
+				if orderBy == "updated" {
+					order.Field = githubv4.DiscussionOrderFieldUpdatedAt
 				}
-
-				// Create response with pagination info
-				response := map[string]interface{}{
-					"discussions": discussions,
-					"pageInfo": map[string]interface{}{
-						"hasNextPage":     query.Repository.Discussions.PageInfo.HasNextPage,
-						"hasPreviousPage": query.Repository.Discussions.PageInfo.HasPreviousPage,
-						"startCursor":     query.Repository.Discussions.PageInfo.StartCursor,
-						"endCursor":       query.Repository.Discussions.PageInfo.EndCursor,
-					},
-					"totalCount": query.Repository.Discussions.TotalCount,
+				if direction == "asc" {
+					order.Direction = githubv4.OrderDirectionAsc
 				}
+				vars["orderBy"] = &order
+			}
 
-				out, err = json.Marshal(response)
-				if err != nil {
-					return nil, fmt.Errorf("failed to marshal discussions: %w", err)
-				}
-			} else {
-				// Query without category filter
-				var query struct {
-					Repository struct {
-						Discussions struct {
-							Nodes []struct {
-								Number    githubv4.Int
-								Title     githubv4.String
-								CreatedAt githubv4.DateTime
-								Category  struct {
-									Name githubv4.String
-								} `graphql:"category"`
-								URL githubv4.String `graphql:"url"`
+			var query struct {
+				Repository struct {
+					HasDiscussionsEnabled bool
+					Discussions           struct {
+						Nodes []struct {
+							Number    githubv4.Int
+							Title     githubv4.String
+							CreatedAt githubv4.DateTime
+							Category  struct {
+								Name githubv4.String
+							} `graphql:"category"`
+							Author struct {
+								Login githubv4.String
 							}
-							PageInfo struct {
-								HasNextPage     bool
-								HasPreviousPage bool
-								StartCursor     string
-								EndCursor       string
+							IsAnswered  githubv4.Boolean
+							UpvoteCount githubv4.Int
+							Comments    struct {
+								TotalCount githubv4.Int
 							}
-							TotalCount int
-						} `graphql:"discussions(first: $first, after: $after)"`
-					} `graphql:"repository(owner: $owner, name: $repo)"`
-				}
-				vars := map[string]interface{}{
-					"owner": githubv4.String(owner),
-					"repo":  githubv4.String(repo),
-					"first": githubv4.Int(*paginationParams.First),
-				}
-				if paginationParams.After != nil {
-					vars["after"] = githubv4.String(*paginationParams.After)
-				} else {
-					vars["after"] = (*githubv4.String)(nil)
-				}
-				if err := client.Query(ctx, &query, vars); err != nil {
-					return mcp.NewToolResultError(err.Error()), nil
-				}
-
-				// Map nodes to GitHub Discussion objects
-				for _, n := range query.Repository.Discussions.Nodes {
-					di := &github.Discussion{
-						Number:    github.Ptr(int(n.Number)),
-						Title:     github.Ptr(string(n.Title)),
-						HTMLURL:   github.Ptr(string(n.URL)),
-						CreatedAt: &github.Timestamp{Time: n.CreatedAt.Time},
-						DiscussionCategory: &github.DiscussionCategory{
-							Name: github.Ptr(string(n.Category.Name)),
-						},
-					}
-					discussions = append(discussions, di)
-				}
-
-				// Create response with pagination info
-				response := map[string]interface{}{
-					"discussions": discussions,
-					"pageInfo": map[string]interface{}{
-						"hasNextPage":     query.Repository.Discussions.PageInfo.HasNextPage,
-						"hasPreviousPage": query.Repository.Discussions.PageInfo.HasPreviousPage,
-						"startCursor":     query.Repository.Discussions.PageInfo.StartCursor,
-						"endCursor":       query.Repository.Discussions.PageInfo.EndCursor,
-					},
-					"totalCount": query.Repository.Discussions.TotalCount,
-				}
+							URL githubv4.String `graphql:"url"`
+						}
+						PageInfo struct {
+							HasNextPage     bool
+							HasPreviousPage bool
+							StartCursor     string
+							EndCursor       string
+						}
+						TotalCount int
+					} `graphql:"discussions(first: $first, after: $after, categoryId: $categoryId, answered: $answered, orderBy: $orderBy)"`
+				} `graphql:"repository(owner: $owner, name: $repo)"`
+			}
+			if err := client.Query(ctx, &query, vars); err != nil {
+				return discussionsErrorResponse(ctx, "failed to list discussions", owner, repo, err), nil
+			}
+			if !query.Repository.HasDiscussionsEnabled {
+				return mcp.NewToolResultError(discussionsNotEnabledError(owner, repo).Error()), nil
+			}
+
+			discussions := make([]DiscussionSummary, 0, len(query.Repository.Discussions.Nodes))
+			for _, n := range query.Repository.Discussions.Nodes {
+				discussions = append(discussions, DiscussionSummary{
+					Number:     int(n.Number),
+					Title:      string(n.Title),
+					Category:   string(n.Category.Name),
+					Author:     string(n.Author.Login),
+					CreatedAt:  summaryTimestamp(github.Timestamp{Time: n.CreatedAt.Time}),
+					IsAnswered: bool(n.IsAnswered),
+					Comments:   int(n.Comments.TotalCount),
+					Upvotes:    int(n.UpvoteCount),
+					URL:        string(n.URL),
+				})
+			}
 
-				out, err = json.Marshal(response)
-				if err != nil {
-					return nil, fmt.Errorf("failed to marshal discussions: %w", err)
-				}
+			// Create response with pagination info
+			response := map[string]interface{}{
+				"discussions": discussions,
+				"pageInfo": map[string]interface{}{
+					"hasNextPage":     query.Repository.Discussions.PageInfo.HasNextPage,
+					"hasPreviousPage": query.Repository.Discussions.PageInfo.HasPreviousPage,
+					"startCursor":     query.Repository.Discussions.PageInfo.StartCursor,
+					"endCursor":       query.Repository.Discussions.PageInfo.EndCursor,
+				},
+				"totalCount": query.Repository.Discussions.TotalCount,
 			}
 
-			return mcp.NewToolResultText(string(out)), nil
+			return MarshalledTextResult(response), nil
 		}
 }
 
+// DiscussionReply is a reply to a discussion comment, as returned by get_discussion.
+type DiscussionReply struct {
+	ID        string `json:"id"`
+	Author    string `json:"author,omitempty"`
+	Body      string `json:"body"`
+	CreatedAt string `json:"created_at"`
+	URL       string `json:"url"`
+}
+
+// DiscussionComment is a top-level discussion comment with its first replies, as returned by get_discussion.
+type DiscussionComment struct {
+	DiscussionReply
+	IsAnswer     bool              `json:"is_answer"`
+	Upvotes      int               `json:"upvotes"`
+	Replies      []DiscussionReply `json:"replies"`
+	TotalReplies int               `json:"total_replies"`
+}
+
+// DiscussionDetails is a discussion with its first comments, as returned by get_discussion.
+type DiscussionDetails struct {
+	Number        int                 `json:"number"`
+	Title         string              `json:"title"`
+	Body          string              `json:"body"`
+	Author        string              `json:"author,omitempty"`
+	Category      string              `json:"category"`
+	Labels        []string            `json:"labels"`
+	CreatedAt     string              `json:"created_at"`
+	URL           string              `json:"url"`
+	Closed        bool                `json:"closed"`
+	IsAnswered    bool                `json:"is_answered"`
+	Comments      []DiscussionComment `json:"comments"`
+	TotalComments int                 `json:"total_comments"`
+	// CommentsPageInfo tells whether there are more comments than comments_limit, which can be
+	// listed with get_discussion_comments starting from endCursor.
+	CommentsPageInfo map[string]interface{} `json:"commentsPageInfo"`
+}
+
+const (
+	defaultDiscussionCommentsLimit = 10
+	defaultDiscussionRepliesLimit  = 5
+)
+
+// discussionPost is the part of discussions, comments and replies that get_discussion reads.
+type discussionPost struct {
+	ID     githubv4.ID
+	Author struct {
+		Login githubv4.String
+	}
+	Body      githubv4.String
+	BodyText  githubv4.String
+	CreatedAt githubv4.DateTime
+	URL       githubv4.String `graphql:"url"`
+}
+
+// reply converts the post, choosing the plain text body when plainText is set.
+func (p discussionPost) reply(plainText bool) DiscussionReply {
+	body := p.Body
+	if plainText {
+		body = p.BodyText
+	}
+	return DiscussionReply{
+		ID:        fmt.Sprint(p.ID),
+		Author:    string(p.Author.Login),
+		Body:      string(body),
+		CreatedAt: summaryTimestamp(github.Timestamp{Time: p.CreatedAt.Time}),
+		URL:       string(p.URL),
+	}
+}
+
 func GetDiscussion(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("get_discussion",
-			mcp.WithDescription(t("TOOL_GET_DISCUSSION_DESCRIPTION", "Get a specific discussion by ID")),
+			mcp.WithDescription(t("TOOL_GET_DISCUSSION_DESCRIPTION", "Get a specific discussion by number, with its body, labels and first comments and replies, including which comment is the accepted answer")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_GET_DISCUSSION_USER_TITLE", "Get discussion"),
 				ReadOnlyHint: ToBoolPtr(true),
@@ -239,6 +351,21 @@ func GetDiscussion(getGQLClient GetGQLClientFn, t translations.TranslationHelper
 				mcp.Required(),
 				mcp.Description("Discussion Number"),
 			),
+			mcp.WithNumber("comments_limit",
+				mcp.Description("Number of top-level comments to include (max 100)"),
+				mcp.Min(0),
+				mcp.Max(100),
+				mcp.DefaultNumber(defaultDiscussionCommentsLimit),
+			),
+			mcp.WithNumber("replies_limit",
+				mcp.Description("Number of replies to include for each comment (max 100)"),
+				mcp.Min(0),
+				mcp.Max(100),
+				mcp.DefaultNumber(defaultDiscussionRepliesLimit),
+			),
+			mcp.WithBoolean("plain_text",
+				mcp.Description("Return bodies as plain text instead of markdown, to save tokens"),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			// Decode params
@@ -250,6 +377,22 @@ func GetDiscussion(getGQLClient GetGQLClientFn, t translations.TranslationHelper
 			if err := mapstructure.Decode(request.Params.Arguments, &params); err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			commentsLimit, err := OptionalIntParamWithDefault(request, "comments_limit", defaultDiscussionCommentsLimit)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repliesLimit, err := OptionalIntParamWithDefault(request, "replies_limit", defaultDiscussionRepliesLimit)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if commentsLimit < 0 || commentsLimit > 100 || repliesLimit < 0 || repliesLimit > 100 {
+				return mcp.NewToolResultError("comments_limit and replies_limit must be between 0 and 100"), nil
+			}
+			plainText, err := OptionalParam[bool](request, "plain_text")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
 			client, err := getGQLClient(ctx)
 			if err != nil {
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
@@ -258,13 +401,35 @@ func GetDiscussion(getGQLClient GetGQLClientFn, t translations.TranslationHelper
 			var q struct {
 				Repository struct {
 					Discussion struct {
-						Number    githubv4.Int
-						Body      githubv4.String
-						CreatedAt githubv4.DateTime
-						URL       githubv4.String `graphql:"url"`
-						Category  struct {
+						discussionPost
+						Number     githubv4.Int
+						Title      githubv4.String
+						Closed     githubv4.Boolean
+						IsAnswered githubv4.Boolean
+						Category   struct {
 							Name githubv4.String
 						} `graphql:"category"`
+						Labels struct {
+							Nodes []struct {
+								Name githubv4.String
+							}
+						} `graphql:"labels(first: 20)"`
+						Comments struct {
+							Nodes []struct {
+								discussionPost
+								IsAnswer    githubv4.Boolean
+								UpvoteCount githubv4.Int
+								Replies     struct {
+									Nodes      []discussionPost
+									TotalCount githubv4.Int
+								} `graphql:"replies(first: $repliesLimit)"`
+							}
+							PageInfo struct {
+								HasNextPage githubv4.Boolean
+								EndCursor   githubv4.String
+							}
+							TotalCount githubv4.Int
+						} `graphql:"comments(first: $commentsLimit)"`
 					} `graphql:"discussion(number: $discussionNumber)"`
 				} `graphql:"repository(owner: $owner, name: $repo)"`
 			}
@@ -272,26 +437,51 @@ func GetDiscussion(getGQLClient GetGQLClientFn, t translations.TranslationHelper
 				"owner":            githubv4.String(params.Owner),
 				"repo":             githubv4.String(params.Repo),
 				"discussionNumber": githubv4.Int(params.DiscussionNumber),
+				"commentsLimit":    githubv4.Int(commentsLimit),
+				"repliesLimit":     githubv4.Int(repliesLimit),
 			}
 			if err := client.Query(ctx, &q, vars); err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
+				return discussionsErrorResponse(ctx, "failed to get discussion", params.Owner, params.Repo, err), nil
 			}
 			d := q.Repository.Discussion
-			discussion := &github.Discussion{
-				Number:    github.Ptr(int(d.Number)),
-				Body:      github.Ptr(string(d.Body)),
-				HTMLURL:   github.Ptr(string(d.URL)),
-				CreatedAt: &github.Timestamp{Time: d.CreatedAt.Time},
-				DiscussionCategory: &github.DiscussionCategory{
-					Name: github.Ptr(string(d.Category.Name)),
+
+			post := d.reply(plainText)
+			discussion := DiscussionDetails{
+				Number:        int(d.Number),
+				Title:         string(d.Title),
+				Body:          post.Body,
+				Author:        post.Author,
+				Category:      string(d.Category.Name),
+				Labels:        make([]string, 0, len(d.Labels.Nodes)),
+				CreatedAt:     post.CreatedAt,
+				URL:           post.URL,
+				Closed:        bool(d.Closed),
+				IsAnswered:    bool(d.IsAnswered),
+				Comments:      make([]DiscussionComment, 0, len(d.Comments.Nodes)),
+				TotalComments: int(d.Comments.TotalCount),
+				CommentsPageInfo: map[string]interface{}{
+					"hasNextPage": bool(d.Comments.PageInfo.HasNextPage),
+					"endCursor":   string(d.Comments.PageInfo.EndCursor),
 				},
 			}
-			out, err := json.Marshal(discussion)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal discussion: %w", err)
+			for _, label := range d.Labels.Nodes {
+				discussion.Labels = append(discussion.Labels, string(label.Name))
+			}
+			for _, c := range d.Comments.Nodes {
+				comment := DiscussionComment{
+					DiscussionReply: c.reply(plainText),
+					IsAnswer:        bool(c.IsAnswer),
+					Upvotes:         int(c.UpvoteCount),
+					Replies:         make([]DiscussionReply, 0, len(c.Replies.Nodes)),
+					TotalReplies:    int(c.Replies.TotalCount),
+				}
+				for _, r := range c.Replies.Nodes {
+					comment.Replies = append(comment.Replies, r.reply(plainText))
+				}
+				discussion.Comments = append(discussion.Comments, comment)
 			}
 
-			return mcp.NewToolResultText(string(out)), nil
+			return MarshalledTextResult(discussion), nil
 		}
 }
 
@@ -487,3 +677,248 @@ func ListDiscussionCategories(getGQLClient GetGQLClientFn, t translations.Transl
 			return mcp.NewToolResultText(string(out)), nil
 		}
 }
+
+// isNestedDiscussionReplyError reports whether err is the API rejecting a reply to a reply.
+// Discussions only allow one level of nesting.
+func isNestedDiscussionReplyError(err error) bool {
+	lower := strings.ToLower(err.Error())
+	return strings.Contains(lower, "already in a thread") || strings.Contains(lower, "cannot reply to a reply")
+}
+
+func AddDiscussionComment(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("add_discussion_comment",
+			mcp.WithDescription(t("TOOL_ADD_DISCUSSION_COMMENT_DESCRIPTION", "Add a comment to a discussion, or reply to a top-level comment of it. Returns the new comment's id and URL.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_ADD_DISCUSSION_COMMENT_USER_TITLE", "Add discussion comment"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("discussionNumber",
+				mcp.Required(),
+				mcp.Description("Discussion Number"),
+			),
+			mcp.WithString("body",
+				mcp.Required(),
+				mcp.Description("Comment content, in markdown"),
+			),
+			mcp.WithString("reply_to_comment_id",
+				mcp.Description("Node ID of a top-level comment to reply to, as returned by get_discussion. Replies to replies are not allowed."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			discussionNumber, err := RequiredInt(request, "discussionNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			body, err := RequiredParam[string](request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			replyToID, err := OptionalParam[string](request, "reply_to_comment_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			// Given our owner, repo and discussion number, lookup the GQL ID of the discussion.
+			var getDiscussionQuery struct {
+				Repository struct {
+					Discussion struct {
+						ID githubv4.ID
+					} `graphql:"discussion(number: $discussionNumber)"`
+				} `graphql:"repository(owner: $owner, name: $repo)"`
+			}
+			if err := client.Query(ctx, &getDiscussionQuery, map[string]interface{}{
+				"owner":            githubv4.String(owner),
+				"repo":             githubv4.String(repo),
+				"discussionNumber": githubv4.Int(discussionNumber),
+			}); err != nil {
+				return discussionsErrorResponse(ctx, "failed to get discussion", owner, repo, err), nil
+			}
+
+			var addDiscussionCommentMutation struct {
+				AddDiscussionComment struct {
+					Comment struct {
+						ID  githubv4.ID
+						URL githubv4.String `graphql:"url"`
+					}
+				} `graphql:"addDiscussionComment(input: $input)"`
+			}
+			input := githubv4.AddDiscussionCommentInput{
+				DiscussionID: getDiscussionQuery.Repository.Discussion.ID,
+				Body:         githubv4.String(body),
+			}
+			if replyToID != "" {
+				input.ReplyToID = githubv4.NewID(replyToID)
+			}
+			if err := client.Mutate(ctx, &addDiscussionCommentMutation, input, nil); err != nil {
+				if replyToID != "" && isNestedDiscussionReplyError(err) {
+					return mcp.NewToolResultError(fmt.Sprintf("comment %s is itself a reply, and discussions only allow one level of nesting: reply to the top-level comment it belongs to instead", replyToID)), nil
+				}
+				return discussionsErrorResponse(ctx, "failed to add discussion comment", owner, repo, err), nil
+			}
+
+			return MarshalledTextResult(map[string]interface{}{
+				"id":  addDiscussionCommentMutation.AddDiscussionComment.Comment.ID,
+				"url": string(addDiscussionCommentMutation.AddDiscussionComment.Comment.URL),
+			}), nil
+		}
+}
+
+// SearchedDiscussion is a discussion found by search_discussions.
+type SearchedDiscussion struct {
+	DiscussionSummary
+	Repository string `json:"repository"`
+	Snippet    string `json:"snippet,omitempty"`
+}
+
+func SearchDiscussions(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("search_discussions",
+			mcp.WithDescription(t("TOOL_SEARCH_DISCUSSIONS_DESCRIPTION", "Search for discussions across GitHub repositories, for example to check whether a question has already been asked")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SEARCH_DISCUSSIONS_USER_TITLE", "Search discussions"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("query",
+				mcp.Required(),
+				mcp.Description("Search query using GitHub discussions search syntax, such as 'memory leak is:unanswered'"),
+			),
+			mcp.WithString("owner",
+				mcp.Description("Optional repository owner. Scopes the search to this owner's repositories, or to a single repository when repo is also given. Ignored if the query already contains a repo:, org: or user: qualifier."),
+			),
+			mcp.WithString("repo",
+				mcp.Description("Optional repository name. Must be used together with owner to scope the search to a single repository."),
+			),
+			WithCursorPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			query, err := RequiredParam[string](request, "query")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := OptionalParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := OptionalParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalCursorPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			paginationParams, err := pagination.ToGraphQLParams()
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			query = scopeSearchQuery(query, owner, repo)
+			vars := map[string]interface{}{
+				"query": githubv4.String(query),
+				"first": githubv4.Int(*paginationParams.First),
+				"after": (*githubv4.String)(nil),
+			}
+			if paginationParams.After != nil {
+				vars["after"] = githubv4.NewString(githubv4.String(*paginationParams.After))
+			}
+
+			var q struct {
+				Search struct {
+					Edges []struct {
+						TextMatches []struct {
+							Fragment githubv4.String
+						}
+						Node struct {
+							Discussion struct {
+								Number     githubv4.Int
+								Title      githubv4.String
+								CreatedAt  githubv4.DateTime
+								Repository struct {
+									NameWithOwner githubv4.String
+								}
+								Category struct {
+									Name githubv4.String
+								} `graphql:"category"`
+								Author struct {
+									Login githubv4.String
+								}
+								IsAnswered  githubv4.Boolean
+								UpvoteCount githubv4.Int
+								Comments    struct {
+									TotalCount githubv4.Int
+								}
+								URL githubv4.String `graphql:"url"`
+							} `graphql:"... on Discussion"`
+						}
+					}
+					PageInfo struct {
+						HasNextPage bool
+						EndCursor   string
+					}
+					DiscussionCount int
+				} `graphql:"search(query: $query, type: DISCUSSION, first: $first, after: $after)"`
+			}
+			if err := client.Query(ctx, &q, vars); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, fmt.Sprintf("failed to search discussions with query '%s'", query), err), nil
+			}
+
+			discussions := make([]SearchedDiscussion, 0, len(q.Search.Edges))
+			for _, edge := range q.Search.Edges {
+				d := edge.Node.Discussion
+				discussion := SearchedDiscussion{
+					DiscussionSummary: DiscussionSummary{
+						Number:     int(d.Number),
+						Title:      string(d.Title),
+						Category:   string(d.Category.Name),
+						Author:     string(d.Author.Login),
+						CreatedAt:  summaryTimestamp(github.Timestamp{Time: d.CreatedAt.Time}),
+						IsAnswered: bool(d.IsAnswered),
+						Comments:   int(d.Comments.TotalCount),
+						Upvotes:    int(d.UpvoteCount),
+						URL:        string(d.URL),
+					},
+					Repository: string(d.Repository.NameWithOwner),
+				}
+				if len(edge.TextMatches) > 0 {
+					discussion.Snippet = string(edge.TextMatches[0].Fragment)
+				}
+				discussions = append(discussions, discussion)
+			}
+
+			response := map[string]interface{}{
+				"discussions": discussions,
+				"pageInfo": map[string]interface{}{
+					"hasNextPage": q.Search.PageInfo.HasNextPage,
+					"endCursor":   q.Search.PageInfo.EndCursor,
+				},
+				"totalCount": q.Search.DiscussionCount,
+			}
+
+			return MarshalledTextResult(response), nil
+		}
+}
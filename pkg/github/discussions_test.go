@@ -5,9 +5,9 @@ import (
 	"encoding/json"
 	"net/http"
 	"testing"
-	"time"
 
 	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v73/github"
 	"github.com/shurcooL/githubv4"
@@ -17,44 +17,38 @@ import (
 
 var (
 	discussionsGeneral = []map[string]any{
-		{"number": 1, "title": "Discussion 1 title", "createdAt": "2023-01-01T00:00:00Z", "url": "https://github.com/owner/repo/discussions/1", "category": map[string]any{"name": "General"}},
-		{"number": 3, "title": "Discussion 3 title", "createdAt": "2023-03-01T00:00:00Z", "url": "https://github.com/owner/repo/discussions/3", "category": map[string]any{"name": "General"}},
+		{"number": 1, "title": "Discussion 1 title", "createdAt": "2023-01-01T00:00:00Z", "url": "https://github.com/owner/repo/discussions/1", "category": map[string]any{"name": "General"}, "author": map[string]any{"login": "octocat"}, "isAnswered": false, "upvoteCount": 4, "comments": map[string]any{"totalCount": 2}},
+		{"number": 3, "title": "Discussion 3 title", "createdAt": "2023-03-01T00:00:00Z", "url": "https://github.com/owner/repo/discussions/3", "category": map[string]any{"name": "General"}, "author": map[string]any{"login": "hubot"}, "isAnswered": false, "upvoteCount": 0, "comments": map[string]any{"totalCount": 0}},
 	}
 	discussionsAll = []map[string]any{
-		{"number": 1, "title": "Discussion 1 title", "createdAt": "2023-01-01T00:00:00Z", "url": "https://github.com/owner/repo/discussions/1", "category": map[string]any{"name": "General"}},
-		{"number": 2, "title": "Discussion 2 title", "createdAt": "2023-02-01T00:00:00Z", "url": "https://github.com/owner/repo/discussions/2", "category": map[string]any{"name": "Questions"}},
-		{"number": 3, "title": "Discussion 3 title", "createdAt": "2023-03-01T00:00:00Z", "url": "https://github.com/owner/repo/discussions/3", "category": map[string]any{"name": "General"}},
+		discussionsGeneral[0],
+		{"number": 2, "title": "Discussion 2 title", "createdAt": "2023-02-01T00:00:00Z", "url": "https://github.com/owner/repo/discussions/2", "category": map[string]any{"name": "Q&A"}, "author": map[string]any{"login": "monalisa"}, "isAnswered": true, "upvoteCount": 7, "comments": map[string]any{"totalCount": 5}},
+		discussionsGeneral[1],
 	}
-	mockResponseListAll = githubv4mock.DataResponse(map[string]any{
-		"repository": map[string]any{
-			"discussions": map[string]any{
-				"nodes": discussionsAll,
-				"pageInfo": map[string]any{
-					"hasNextPage":     false,
-					"hasPreviousPage": false,
-					"startCursor":     "",
-					"endCursor":       "",
-				},
-				"totalCount": 3,
-			},
-		},
-	})
-	mockResponseListGeneral = githubv4mock.DataResponse(map[string]any{
+	discussionCategories = []map[string]any{
+		{"id": "DIC_kwDOABC123", "name": "General"},
+		{"id": "DIC_kwDOABC456", "name": "Q&A"},
+	}
+)
+
+// discussionsListResponse builds the response to the list_discussions query.
+func discussionsListResponse(enabled bool, nodes []map[string]any, hasNextPage bool, endCursor string) githubv4mock.GQLResponse {
+	return githubv4mock.DataResponse(map[string]any{
 		"repository": map[string]any{
+			"hasDiscussionsEnabled": enabled,
 			"discussions": map[string]any{
-				"nodes": discussionsGeneral,
+				"nodes": nodes,
 				"pageInfo": map[string]any{
-					"hasNextPage":     false,
+					"hasNextPage":     hasNextPage,
 					"hasPreviousPage": false,
 					"startCursor":     "",
-					"endCursor":       "",
+					"endCursor":       endCursor,
 				},
-				"totalCount": 2,
+				"totalCount": len(nodes),
 			},
 		},
 	})
-	mockErrorRepoNotFound = githubv4mock.ErrorResponse("repository not found")
-)
+}
 
 func Test_ListDiscussions(t *testing.T) {
 	mockClient := githubv4.NewClient(nil)
@@ -64,64 +58,160 @@ func Test_ListDiscussions(t *testing.T) {
 	assert.NotEmpty(t, toolDef.Description)
 	assert.Contains(t, toolDef.InputSchema.Properties, "owner")
 	assert.Contains(t, toolDef.InputSchema.Properties, "repo")
+	assert.Contains(t, toolDef.InputSchema.Properties, "category")
+	assert.Contains(t, toolDef.InputSchema.Properties, "answered")
+	assert.Contains(t, toolDef.InputSchema.Properties, "orderBy")
+	assert.Contains(t, toolDef.InputSchema.Properties, "direction")
+	assert.Contains(t, toolDef.InputSchema.Properties, "after")
 	assert.ElementsMatch(t, toolDef.InputSchema.Required, []string{"owner", "repo"})
 
 	// Use exact string queries that match implementation output (from error messages)
-	qDiscussions := "query($after:String$first:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussions(first: $first, after: $after){nodes{number,title,createdAt,category{name},url},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}"
-
-	qDiscussionsFiltered := "query($after:String$categoryId:ID!$first:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussions(first: $first, after: $after, categoryId: $categoryId){nodes{number,title,createdAt,category{name},url},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}"
+	qDiscussions := "query($after:String$answered:Boolean$categoryId:ID$first:Int!$orderBy:DiscussionOrder$owner:String!$repo:String!){repository(owner: $owner, name: $repo){hasDiscussionsEnabled,discussions(first: $first, after: $after, categoryId: $categoryId, answered: $answered, orderBy: $orderBy){nodes{number,title,createdAt,category{name},author{login},isAnswered,upvoteCount,comments{totalCount},url},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}"
+	qCategories := "query($owner:String!$repo:String!){repository(owner: $owner, name: $repo){hasDiscussionsEnabled,discussionCategories(first: 100){nodes{id,name}}}}"
 
 	// Variables matching what GraphQL receives after JSON marshaling/unmarshaling
-	varsListAll := map[string]interface{}{
-		"owner": "owner",
-		"repo":  "repo",
-		"first": float64(30),
-		"after": (*string)(nil),
+	listVars := func(overrides map[string]any) map[string]any {
+		vars := map[string]any{
+			"owner":      "owner",
+			"repo":       "repo",
+			"first":      float64(30),
+			"after":      (*string)(nil),
+			"categoryId": (*string)(nil),
+			"answered":   (*bool)(nil),
+			"orderBy":    (*map[string]any)(nil),
+		}
+		for k, v := range overrides {
+			vars[k] = v
+		}
+		return vars
 	}
-
-	varsRepoNotFound := map[string]interface{}{
+	categoriesVars := map[string]any{
 		"owner": "owner",
-		"repo":  "nonexistent-repo",
-		"first": float64(30),
-		"after": (*string)(nil),
-	}
-
-	varsDiscussionsFiltered := map[string]interface{}{
-		"owner":      "owner",
-		"repo":       "repo",
-		"categoryId": "DIC_kwDOABC123",
-		"first":      float64(30),
-		"after":      (*string)(nil),
+		"repo":  "repo",
 	}
+	categoriesResponse := githubv4mock.DataResponse(map[string]any{
+		"repository": map[string]any{
+			"hasDiscussionsEnabled": true,
+			"discussionCategories":  map[string]any{"nodes": discussionCategories},
+		},
+	})
 
 	tests := []struct {
-		name          string
-		reqParams     map[string]interface{}
-		expectError   bool
-		errContains   string
-		expectedCount int
+		name             string
+		mockedClient     *http.Client
+		reqParams        map[string]interface{}
+		expectError      bool
+		errContains      string
+		expectedNumbers  []int
+		expectedNextPage bool
+		expectedCursor   string
 	}{
 		{
 			name: "list all discussions without category filter",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(qDiscussions, listVars(nil), discussionsListResponse(true, discussionsAll, false, "")),
+			),
 			reqParams: map[string]interface{}{
 				"owner": "owner",
 				"repo":  "repo",
 			},
-			expectError:   false,
-			expectedCount: 3, // All discussions
+			expectError:     false,
+			expectedNumbers: []int{1, 2, 3},
+		},
+		{
+			name: "filter by category name",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(qCategories, categoriesVars, categoriesResponse),
+				githubv4mock.NewQueryMatcher(qDiscussions, listVars(map[string]any{"categoryId": "DIC_kwDOABC123"}), discussionsListResponse(true, discussionsGeneral, false, "")),
+			),
+			reqParams: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"category": "general",
+			},
+			expectError:     false,
+			expectedNumbers: []int{1, 3},
 		},
 		{
 			name: "filter by category ID",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(qCategories, categoriesVars, categoriesResponse),
+				githubv4mock.NewQueryMatcher(qDiscussions, listVars(map[string]any{"categoryId": "DIC_kwDOABC123"}), discussionsListResponse(true, discussionsGeneral, false, "")),
+			),
 			reqParams: map[string]interface{}{
 				"owner":    "owner",
 				"repo":     "repo",
 				"category": "DIC_kwDOABC123",
 			},
-			expectError:   false,
-			expectedCount: 2, // Only General discussions (matching the category ID)
+			expectError:     false,
+			expectedNumbers: []int{1, 3},
+		},
+		{
+			name: "answered, ordered and paginated",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(qDiscussions, listVars(map[string]any{
+					"first":    float64(1),
+					"after":    "Y3Vyc29yOjE=",
+					"answered": true,
+					"orderBy":  map[string]any{"field": "UPDATED_AT", "direction": "ASC"},
+				}), discussionsListResponse(true, discussionsAll[1:2], true, "Y3Vyc29yOjI=")),
+			),
+			reqParams: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"answered":  true,
+				"orderBy":   "updated",
+				"direction": "asc",
+				"perPage":   float64(1),
+				"after":     "Y3Vyc29yOjE=",
+			},
+			expectError:      false,
+			expectedNumbers:  []int{2},
+			expectedNextPage: true,
+			expectedCursor:   "Y3Vyc29yOjI=",
+		},
+		{
+			name: "unknown category",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(qCategories, categoriesVars, categoriesResponse),
+			),
+			reqParams: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"category": "Ideas",
+			},
+			expectError: true,
+			errContains: `discussion category "Ideas" not found in owner/repo, available categories: General, Q&A`,
+		},
+		{
+			name: "discussions not enabled",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(qDiscussions, listVars(nil), discussionsListResponse(false, []map[string]any{}, false, "")),
+			),
+			reqParams: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError: true,
+			errContains: "discussions are not enabled for owner/repo",
+		},
+		{
+			name: "discussions disabled error",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(qDiscussions, listVars(nil), githubv4mock.ErrorResponse("Discussions are disabled for this repository")),
+			),
+			reqParams: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError: true,
+			errContains: "discussions are not enabled for owner/repo",
 		},
 		{
 			name: "repository not found error",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(qDiscussions, listVars(map[string]any{"repo": "nonexistent-repo"}), githubv4mock.ErrorResponse("repository not found")),
+			),
 			reqParams: map[string]interface{}{
 				"owner": "owner",
 				"repo":  "nonexistent-repo",
@@ -133,27 +223,12 @@ func Test_ListDiscussions(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			var httpClient *http.Client
-
-			switch tc.name {
-			case "list all discussions without category filter":
-				// Simple case - no category filter
-				matcher := githubv4mock.NewQueryMatcher(qDiscussions, varsListAll, mockResponseListAll)
-				httpClient = githubv4mock.NewMockedHTTPClient(matcher)
-			case "filter by category ID":
-				// Simple case - category filter using category ID directly
-				matcher := githubv4mock.NewQueryMatcher(qDiscussionsFiltered, varsDiscussionsFiltered, mockResponseListGeneral)
-				httpClient = githubv4mock.NewMockedHTTPClient(matcher)
-			case "repository not found error":
-				matcher := githubv4mock.NewQueryMatcher(qDiscussions, varsRepoNotFound, mockErrorRepoNotFound)
-				httpClient = githubv4mock.NewMockedHTTPClient(matcher)
-			}
-
-			gqlClient := githubv4.NewClient(httpClient)
+			gqlClient := githubv4.NewClient(tc.mockedClient)
 			_, handler := ListDiscussions(stubGetGQLClientFn(gqlClient), translations.NullTranslationHelper)
 
 			req := createMCPRequest(tc.reqParams)
 			res, err := handler(context.Background(), req)
+			require.NoError(t, err)
 			text := getTextResult(t, res).Text
 
 			if tc.expectError {
@@ -161,11 +236,11 @@ func Test_ListDiscussions(t *testing.T) {
 				assert.Contains(t, text, tc.errContains)
 				return
 			}
-			require.NoError(t, err)
+			require.False(t, res.IsError, text)
 
 			// Parse the structured response with pagination info
 			var response struct {
-				Discussions []*github.Discussion `json:"discussions"`
+				Discussions []DiscussionSummary `json:"discussions"`
 				PageInfo    struct {
 					HasNextPage     bool   `json:"hasNextPage"`
 					HasPreviousPage bool   `json:"hasPreviousPage"`
@@ -177,17 +252,41 @@ func Test_ListDiscussions(t *testing.T) {
 			err = json.Unmarshal([]byte(text), &response)
 			require.NoError(t, err)
 
-			assert.Len(t, response.Discussions, tc.expectedCount, "Expected %d discussions, got %d", tc.expectedCount, len(response.Discussions))
-
-			// Verify that all returned discussions have a category if filtered
-			if _, hasCategory := tc.reqParams["category"]; hasCategory {
-				for _, discussion := range response.Discussions {
-					require.NotNil(t, discussion.DiscussionCategory, "Discussion should have category")
-					assert.NotEmpty(t, *discussion.DiscussionCategory.Name, "Discussion should have category name")
-				}
+			numbers := make([]int, 0, len(response.Discussions))
+			for _, discussion := range response.Discussions {
+				numbers = append(numbers, discussion.Number)
 			}
+			assert.Equal(t, tc.expectedNumbers, numbers)
+			assert.Equal(t, tc.expectedNextPage, response.PageInfo.HasNextPage)
+			assert.Equal(t, tc.expectedCursor, response.PageInfo.EndCursor)
 		})
 	}
+
+	// The compact form of a discussion
+	gqlClient := githubv4.NewClient(githubv4mock.NewMockedHTTPClient(
+		githubv4mock.NewQueryMatcher(qDiscussions, listVars(nil), discussionsListResponse(true, discussionsAll[1:2], false, "")),
+	))
+	_, handler := ListDiscussions(stubGetGQLClientFn(gqlClient), translations.NullTranslationHelper)
+	res, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner": "owner",
+		"repo":  "repo",
+	}))
+	require.NoError(t, err)
+	var response struct {
+		Discussions []DiscussionSummary `json:"discussions"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, res).Text), &response))
+	assert.Equal(t, []DiscussionSummary{{
+		Number:     2,
+		Title:      "Discussion 2 title",
+		Category:   "Q&A",
+		Author:     "monalisa",
+		CreatedAt:  "2023-02-01T00:00:00Z",
+		IsAnswered: true,
+		Comments:   5,
+		Upvotes:    7,
+		URL:        "https://github.com/owner/repo/discussions/2",
+	}}, response.Discussions)
 }
 
 func Test_GetDiscussion(t *testing.T) {
@@ -198,60 +297,136 @@ func Test_GetDiscussion(t *testing.T) {
 	assert.Contains(t, toolDef.InputSchema.Properties, "owner")
 	assert.Contains(t, toolDef.InputSchema.Properties, "repo")
 	assert.Contains(t, toolDef.InputSchema.Properties, "discussionNumber")
+	assert.Contains(t, toolDef.InputSchema.Properties, "comments_limit")
+	assert.Contains(t, toolDef.InputSchema.Properties, "replies_limit")
+	assert.Contains(t, toolDef.InputSchema.Properties, "plain_text")
 	assert.ElementsMatch(t, toolDef.InputSchema.Required, []string{"owner", "repo", "discussionNumber"})
 
 	// Use exact string query that matches implementation output
-	qGetDiscussion := "query($discussionNumber:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussion(number: $discussionNumber){number,body,createdAt,url,category{name}}}}"
-
-	vars := map[string]interface{}{
-		"owner":            "owner",
-		"repo":             "repo",
-		"discussionNumber": float64(1),
+	qGetDiscussion := "query($commentsLimit:Int!$discussionNumber:Int!$owner:String!$repliesLimit:Int!$repo:String!){repository(owner: $owner, name: $repo){discussion(number: $discussionNumber){id,author{login},body,bodyText,createdAt,url,number,title,closed,isAnswered,category{name},labels(first: 20){nodes{name}},comments(first: $commentsLimit){nodes{id,author{login},body,bodyText,createdAt,url,isAnswer,upvoteCount,replies(first: $repliesLimit){nodes{id,author{login},body,bodyText,createdAt,url},totalCount}},pageInfo{hasNextPage,endCursor},totalCount}}}}"
+
+	discussion := map[string]any{
+		"number":     1,
+		"title":      "How do I configure this?",
+		"author":     map[string]any{"login": "octocat"},
+		"body":       "This is a **test** discussion",
+		"bodyText":   "This is a test discussion",
+		"url":        "https://github.com/owner/repo/discussions/1",
+		"createdAt":  "2025-04-25T12:00:00Z",
+		"closed":     false,
+		"isAnswered": true,
+		"category":   map[string]any{"name": "Q&A"},
+		"labels":     map[string]any{"nodes": []map[string]any{{"name": "question"}}},
+		"comments": map[string]any{
+			"nodes": []map[string]any{
+				{
+					"id":          "DC_kwDOA1",
+					"author":      map[string]any{"login": "hubot"},
+					"body":        "Use the `--config` flag",
+					"bodyText":    "Use the --config flag",
+					"url":         "https://github.com/owner/repo/discussions/1#discussioncomment-1",
+					"createdAt":   "2025-04-25T13:00:00Z",
+					"isAnswer":    true,
+					"upvoteCount": 3,
+					"replies": map[string]any{
+						"nodes": []map[string]any{
+							{
+								"id":        "DC_kwDOA2",
+								"author":    map[string]any{"login": "octocat"},
+								"body":      "_Thanks!_",
+								"bodyText":  "Thanks!",
+								"url":       "https://github.com/owner/repo/discussions/1#discussioncomment-2",
+								"createdAt": "2025-04-25T14:00:00Z",
+							},
+						},
+						"totalCount": 4,
+					},
+				},
+			},
+			"pageInfo":   map[string]any{"hasNextPage": true, "endCursor": "cursor-1"},
+			"totalCount": 2,
+		},
 	}
+
 	tests := []struct {
-		name        string
-		response    githubv4mock.GQLResponse
-		expectError bool
-		expected    *github.Discussion
-		errContains string
+		name         string
+		requestArgs  map[string]interface{}
+		vars         map[string]interface{}
+		response     githubv4mock.GQLResponse
+		expectError  bool
+		errContains  string
+		expectedBody string
+		expectedText string
 	}{
 		{
-			name: "successful retrieval",
-			response: githubv4mock.DataResponse(map[string]any{
-				"repository": map[string]any{"discussion": map[string]any{
-					"number":    1,
-					"body":      "This is a test discussion",
-					"url":       "https://github.com/owner/repo/discussions/1",
-					"createdAt": "2025-04-25T12:00:00Z",
-					"category":  map[string]any{"name": "General"},
-				}},
-			}),
-			expectError: false,
-			expected: &github.Discussion{
-				HTMLURL:   github.Ptr("https://github.com/owner/repo/discussions/1"),
-				Number:    github.Ptr(1),
-				Body:      github.Ptr("This is a test discussion"),
-				CreatedAt: &github.Timestamp{Time: time.Date(2025, 4, 25, 12, 0, 0, 0, time.UTC)},
-				DiscussionCategory: &github.DiscussionCategory{
-					Name: github.Ptr("General"),
-				},
+			name:        "successful retrieval with default limits",
+			requestArgs: map[string]interface{}{"owner": "owner", "repo": "repo", "discussionNumber": int32(1)},
+			vars: map[string]interface{}{
+				"owner":            "owner",
+				"repo":             "repo",
+				"discussionNumber": float64(1),
+				"commentsLimit":    float64(10),
+				"repliesLimit":     float64(5),
 			},
+			response:     githubv4mock.DataResponse(map[string]any{"repository": map[string]any{"discussion": discussion}}),
+			expectedBody: "This is a **test** discussion",
+			expectedText: "_Thanks!_",
+		},
+		{
+			name: "plain text with custom limits",
+			requestArgs: map[string]interface{}{
+				"owner":            "owner",
+				"repo":             "repo",
+				"discussionNumber": int32(1),
+				"comments_limit":   float64(1),
+				"replies_limit":    float64(1),
+				"plain_text":       true,
+			},
+			vars: map[string]interface{}{
+				"owner":            "owner",
+				"repo":             "repo",
+				"discussionNumber": float64(1),
+				"commentsLimit":    float64(1),
+				"repliesLimit":     float64(1),
+			},
+			response:     githubv4mock.DataResponse(map[string]any{"repository": map[string]any{"discussion": discussion}}),
+			expectedBody: "This is a test discussion",
+			expectedText: "Thanks!",
 		},
 		{
 			name:        "discussion not found",
+			requestArgs: map[string]interface{}{"owner": "owner", "repo": "repo", "discussionNumber": int32(1)},
+			vars: map[string]interface{}{
+				"owner":            "owner",
+				"repo":             "repo",
+				"discussionNumber": float64(1),
+				"commentsLimit":    float64(10),
+				"repliesLimit":     float64(5),
+			},
 			response:    githubv4mock.ErrorResponse("discussion not found"),
 			expectError: true,
 			errContains: "discussion not found",
 		},
+		{
+			name: "limit out of range",
+			requestArgs: map[string]interface{}{
+				"owner":            "owner",
+				"repo":             "repo",
+				"discussionNumber": int32(1),
+				"comments_limit":   float64(101),
+			},
+			expectError: true,
+			errContains: "comments_limit and replies_limit must be between 0 and 100",
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			matcher := githubv4mock.NewQueryMatcher(qGetDiscussion, vars, tc.response)
+			matcher := githubv4mock.NewQueryMatcher(qGetDiscussion, tc.vars, tc.response)
 			httpClient := githubv4mock.NewMockedHTTPClient(matcher)
 			gqlClient := githubv4.NewClient(httpClient)
 			_, handler := GetDiscussion(stubGetGQLClientFn(gqlClient), translations.NullTranslationHelper)
 
-			req := createMCPRequest(map[string]interface{}{"owner": "owner", "repo": "repo", "discussionNumber": int32(1)})
+			req := createMCPRequest(tc.requestArgs)
 			res, err := handler(context.Background(), req)
 			text := getTextResult(t, res).Text
 
@@ -262,13 +437,30 @@ func Test_GetDiscussion(t *testing.T) {
 			}
 
 			require.NoError(t, err)
-			var out github.Discussion
+			var out DiscussionDetails
 			require.NoError(t, json.Unmarshal([]byte(text), &out))
-			assert.Equal(t, *tc.expected.HTMLURL, *out.HTMLURL)
-			assert.Equal(t, *tc.expected.Number, *out.Number)
-			assert.Equal(t, *tc.expected.Body, *out.Body)
-			// Check category label
-			assert.Equal(t, *tc.expected.DiscussionCategory.Name, *out.DiscussionCategory.Name)
+			assert.Equal(t, 1, out.Number)
+			assert.Equal(t, "How do I configure this?", out.Title)
+			assert.Equal(t, "octocat", out.Author)
+			assert.Equal(t, tc.expectedBody, out.Body)
+			assert.Equal(t, "Q&A", out.Category)
+			assert.Equal(t, []string{"question"}, out.Labels)
+			assert.Equal(t, "2025-04-25T12:00:00Z", out.CreatedAt)
+			assert.True(t, out.IsAnswered)
+			assert.Equal(t, 2, out.TotalComments)
+			assert.Equal(t, true, out.CommentsPageInfo["hasNextPage"])
+			assert.Equal(t, "cursor-1", out.CommentsPageInfo["endCursor"])
+
+			require.Len(t, out.Comments, 1)
+			comment := out.Comments[0]
+			assert.Equal(t, "DC_kwDOA1", comment.ID)
+			assert.Equal(t, "hubot", comment.Author)
+			assert.True(t, comment.IsAnswer)
+			assert.Equal(t, 3, comment.Upvotes)
+			assert.Equal(t, 4, comment.TotalReplies)
+			require.Len(t, comment.Replies, 1)
+			assert.Equal(t, "DC_kwDOA2", comment.Replies[0].ID)
+			assert.Equal(t, tc.expectedText, comment.Replies[0].Body)
 		})
 	}
 }
@@ -413,3 +605,322 @@ func Test_ListDiscussionCategories(t *testing.T) {
 	assert.Equal(t, "456", response.Categories[1]["id"])
 	assert.Equal(t, "CategoryTwo", response.Categories[1]["name"])
 }
+
+func Test_AddDiscussionComment(t *testing.T) {
+	// Verify tool definition and schema
+	toolDef, _ := AddDiscussionComment(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(toolDef.Name, toolDef))
+
+	assert.Equal(t, "add_discussion_comment", toolDef.Name)
+	assert.NotEmpty(t, toolDef.Description)
+	assert.Contains(t, toolDef.InputSchema.Properties, "owner")
+	assert.Contains(t, toolDef.InputSchema.Properties, "repo")
+	assert.Contains(t, toolDef.InputSchema.Properties, "discussionNumber")
+	assert.Contains(t, toolDef.InputSchema.Properties, "body")
+	assert.Contains(t, toolDef.InputSchema.Properties, "reply_to_comment_id")
+	assert.ElementsMatch(t, toolDef.InputSchema.Required, []string{"owner", "repo", "discussionNumber", "body"})
+
+	discussionIDQuery := func(response githubv4mock.GQLResponse) githubv4mock.Matcher {
+		return githubv4mock.NewQueryMatcher(
+			struct {
+				Repository struct {
+					Discussion struct {
+						ID githubv4.ID
+					} `graphql:"discussion(number: $discussionNumber)"`
+				} `graphql:"repository(owner: $owner, name: $repo)"`
+			}{},
+			map[string]any{
+				"owner":            githubv4.String("owner"),
+				"repo":             githubv4.String("repo"),
+				"discussionNumber": githubv4.Int(1),
+			},
+			response,
+		)
+	}
+	discussionID := githubv4mock.DataResponse(map[string]any{
+		"repository": map[string]any{"discussion": map[string]any{"id": "D_kwDOA1"}},
+	})
+	addCommentMutation := func(input githubv4.AddDiscussionCommentInput, response githubv4mock.GQLResponse) githubv4mock.Matcher {
+		return githubv4mock.NewMutationMatcher(
+			struct {
+				AddDiscussionComment struct {
+					Comment struct {
+						ID  githubv4.ID
+						URL githubv4.String `graphql:"url"`
+					}
+				} `graphql:"addDiscussionComment(input: $input)"`
+			}{},
+			input,
+			nil,
+			response,
+		)
+	}
+	createdComment := githubv4mock.DataResponse(map[string]any{
+		"addDiscussionComment": map[string]any{"comment": map[string]any{
+			"id":  "DC_kwDOA3",
+			"url": "https://github.com/owner/repo/discussions/1#discussioncomment-3",
+		}},
+	})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "add top-level comment",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				discussionIDQuery(discussionID),
+				addCommentMutation(githubv4.AddDiscussionCommentInput{
+					DiscussionID: githubv4.ID("D_kwDOA1"),
+					Body:         githubv4.String("Try restarting"),
+				}, createdComment),
+			),
+			requestArgs: map[string]any{
+				"owner":            "owner",
+				"repo":             "repo",
+				"discussionNumber": float64(1),
+				"body":             "Try restarting",
+			},
+		},
+		{
+			name: "reply to a comment",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				discussionIDQuery(discussionID),
+				addCommentMutation(githubv4.AddDiscussionCommentInput{
+					DiscussionID: githubv4.ID("D_kwDOA1"),
+					Body:         githubv4.String("Try restarting"),
+					ReplyToID:    githubv4.NewID("DC_kwDOA1"),
+				}, createdComment),
+			),
+			requestArgs: map[string]any{
+				"owner":               "owner",
+				"repo":                "repo",
+				"discussionNumber":    float64(1),
+				"body":                "Try restarting",
+				"reply_to_comment_id": "DC_kwDOA1",
+			},
+		},
+		{
+			name: "reply to a reply",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				discussionIDQuery(discussionID),
+				addCommentMutation(githubv4.AddDiscussionCommentInput{
+					DiscussionID: githubv4.ID("D_kwDOA1"),
+					Body:         githubv4.String("Try restarting"),
+					ReplyToID:    githubv4.NewID("DC_kwDOA2"),
+				}, githubv4mock.ErrorResponse("Parent comment is already in a thread, cannot reply to it")),
+			),
+			requestArgs: map[string]any{
+				"owner":               "owner",
+				"repo":                "repo",
+				"discussionNumber":    float64(1),
+				"body":                "Try restarting",
+				"reply_to_comment_id": "DC_kwDOA2",
+			},
+			expectError:    true,
+			expectedErrMsg: "reply to the top-level comment it belongs to instead",
+		},
+		{
+			name: "discussion not found",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				discussionIDQuery(githubv4mock.ErrorResponse("Could not resolve to a Discussion with the number of 1.")),
+			),
+			requestArgs: map[string]any{
+				"owner":            "owner",
+				"repo":             "repo",
+				"discussionNumber": float64(1),
+				"body":             "Try restarting",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get discussion",
+		},
+		{
+			name:         "missing body",
+			mockedClient: githubv4mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":            "owner",
+				"repo":             "repo",
+				"discussionNumber": float64(1),
+			},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: body",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := githubv4.NewClient(tc.mockedClient)
+			_, handler := AddDiscussionComment(stubGetGQLClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var out map[string]string
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &out))
+			assert.Equal(t, "DC_kwDOA3", out["id"])
+			assert.Equal(t, "https://github.com/owner/repo/discussions/1#discussioncomment-3", out["url"])
+		})
+	}
+}
+
+func Test_SearchDiscussions(t *testing.T) {
+	// Verify tool definition and schema
+	toolDef, _ := SearchDiscussions(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(toolDef.Name, toolDef))
+
+	assert.Equal(t, "search_discussions", toolDef.Name)
+	assert.NotEmpty(t, toolDef.Description)
+	assert.Contains(t, toolDef.InputSchema.Properties, "query")
+	assert.Contains(t, toolDef.InputSchema.Properties, "owner")
+	assert.Contains(t, toolDef.InputSchema.Properties, "repo")
+	assert.Contains(t, toolDef.InputSchema.Properties, "perPage")
+	assert.Contains(t, toolDef.InputSchema.Properties, "after")
+	assert.ElementsMatch(t, toolDef.InputSchema.Required, []string{"query"})
+
+	qSearchDiscussions := "query($after:String$first:Int!$query:String!){search(query: $query, type: DISCUSSION, first: $first, after: $after){edges{textMatches{fragment},node{... on Discussion{number,title,createdAt,repository{nameWithOwner},category{name},author{login},isAnswered,upvoteCount,comments{totalCount},url}}},pageInfo{hasNextPage,endCursor},discussionCount}}"
+
+	searchResponse := githubv4mock.DataResponse(map[string]any{
+		"search": map[string]any{
+			"edges": []map[string]any{
+				{
+					"textMatches": []map[string]any{{"fragment": "seeing a memory leak after upgrading"}},
+					"node": map[string]any{
+						"number":      7,
+						"title":       "Memory leak in v2",
+						"createdAt":   "2025-04-25T12:00:00Z",
+						"repository":  map[string]any{"nameWithOwner": "owner/repo"},
+						"category":    map[string]any{"name": "Q&A"},
+						"author":      map[string]any{"login": "octocat"},
+						"isAnswered":  true,
+						"upvoteCount": 5,
+						"comments":    map[string]any{"totalCount": 3},
+						"url":         "https://github.com/owner/repo/discussions/7",
+					},
+				},
+				{
+					"textMatches": []map[string]any{},
+					"node": map[string]any{
+						"number":      2,
+						"title":       "Leaking file handles",
+						"createdAt":   "2025-03-01T12:00:00Z",
+						"repository":  map[string]any{"nameWithOwner": "owner/other"},
+						"category":    map[string]any{"name": "General"},
+						"author":      map[string]any{"login": "hubot"},
+						"isAnswered":  false,
+						"upvoteCount": 0,
+						"comments":    map[string]any{"totalCount": 0},
+						"url":         "https://github.com/owner/other/discussions/2",
+					},
+				},
+			},
+			"pageInfo":        map[string]any{"hasNextPage": true, "endCursor": "cursor-2"},
+			"discussionCount": 12,
+		},
+	})
+
+	tests := []struct {
+		name           string
+		requestArgs    map[string]interface{}
+		vars           map[string]interface{}
+		response       githubv4mock.GQLResponse
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name:        "search scoped to repository",
+			requestArgs: map[string]interface{}{"query": "memory leak", "owner": "owner", "repo": "repo"},
+			vars: map[string]interface{}{
+				"query": "repo:owner/repo memory leak",
+				"first": float64(30),
+				"after": (*string)(nil),
+			},
+			response: searchResponse,
+		},
+		{
+			name:        "existing scope is kept",
+			requestArgs: map[string]interface{}{"query": "memory leak org:owner", "owner": "other", "perPage": float64(2), "after": "cursor-1"},
+			vars: map[string]interface{}{
+				"query": "memory leak org:owner",
+				"first": float64(2),
+				"after": "cursor-1",
+			},
+			response: searchResponse,
+		},
+		{
+			name:        "search fails",
+			requestArgs: map[string]interface{}{"query": "memory leak"},
+			vars: map[string]interface{}{
+				"query": "memory leak",
+				"first": float64(30),
+				"after": (*string)(nil),
+			},
+			response:       githubv4mock.ErrorResponse("search is unavailable"),
+			expectError:    true,
+			expectedErrMsg: "failed to search discussions with query 'memory leak'",
+		},
+		{
+			name:           "missing query",
+			requestArgs:    map[string]interface{}{"owner": "owner"},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: query",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			matcher := githubv4mock.NewQueryMatcher(qSearchDiscussions, tc.vars, tc.response)
+			httpClient := githubv4mock.NewMockedHTTPClient(matcher)
+			gqlClient := githubv4.NewClient(httpClient)
+			_, handler := SearchDiscussions(stubGetGQLClientFn(gqlClient), translations.NullTranslationHelper)
+
+			req := createMCPRequest(tc.requestArgs)
+			res, err := handler(context.Background(), req)
+			require.NoError(t, err)
+			text := getTextResult(t, res).Text
+
+			if tc.expectError {
+				require.True(t, res.IsError)
+				assert.Contains(t, text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, res.IsError, text)
+			var out struct {
+				Discussions []SearchedDiscussion `json:"discussions"`
+				PageInfo    struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+				TotalCount int `json:"totalCount"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(text), &out))
+			assert.Equal(t, 12, out.TotalCount)
+			assert.True(t, out.PageInfo.HasNextPage)
+			assert.Equal(t, "cursor-2", out.PageInfo.EndCursor)
+
+			require.Len(t, out.Discussions, 2)
+			first := out.Discussions[0]
+			assert.Equal(t, 7, first.Number)
+			assert.Equal(t, "Memory leak in v2", first.Title)
+			assert.Equal(t, "owner/repo", first.Repository)
+			assert.Equal(t, "Q&A", first.Category)
+			assert.True(t, first.IsAnswered)
+			assert.Equal(t, "2025-04-25T12:00:00Z", first.CreatedAt)
+			assert.Equal(t, "seeing a memory leak after upgrading", first.Snippet)
+			assert.Equal(t, "owner/other", out.Discussions[1].Repository)
+			assert.Empty(t, out.Discussions[1].Snippet)
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// WithFields adds a "fields" parameter to a tool, allowing callers to trim large
+// responses down to the fields they actually need. Tools that opt in should read it
+// with OptionalStringArrayParam and pass the result to projectFields.
+func WithFields() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithArray("fields",
+			mcp.Description("Only return the given fields of each result, using dotted paths for nested objects and arrays (e.g. [\"number\", \"title\", \"user.login\", \"labels.name\"]). Unknown fields are ignored. Returns all fields if omitted."),
+			mcp.Items(
+				map[string]any{
+					"type": "string",
+				},
+			),
+		)(tool)
+	}
+}
+
+// fieldTree is a parsed set of dotted field paths. A nil subtree means the whole
+// value at that key is selected.
+type fieldTree map[string]fieldTree
+
+func newFieldTree(fields []string) fieldTree {
+	tree := fieldTree{}
+	for _, path := range fields {
+		parts := strings.Split(path, ".")
+		node := tree
+		for i, part := range parts {
+			child, exists := node[part]
+			if exists && child == nil {
+				// A shorter path already selects the whole value.
+				break
+			}
+			if i == len(parts)-1 {
+				node[part] = nil
+				break
+			}
+			if !exists {
+				child = fieldTree{}
+				node[part] = child
+			}
+			node = child
+		}
+	}
+	return tree
+}
+
+// apply projects a decoded JSON value onto the tree. Arrays are projected
+// element-wise. The boolean is false when nothing in the value matched.
+func (t fieldTree) apply(v any) (any, bool) {
+	switch val := v.(type) {
+	case []any:
+		out := make([]any, 0, len(val))
+		for _, elem := range val {
+			if projected, ok := t.apply(elem); ok {
+				out = append(out, projected)
+			}
+		}
+		return out, true
+	case map[string]any:
+		out := make(map[string]any, len(t))
+		for key, subtree := range t {
+			fieldValue, ok := val[key]
+			if !ok {
+				continue
+			}
+			if subtree == nil {
+				out[key] = fieldValue
+				continue
+			}
+			if projected, ok := subtree.apply(fieldValue); ok {
+				out[key] = projected
+			}
+		}
+		return out, true
+	default:
+		// Paths that descend into scalars (or nulls) don't exist, so they are dropped.
+		return nil, false
+	}
+}
+
+// projectFields returns v reduced to the given dotted field paths. If no fields are
+// given v is returned unchanged, otherwise v is round-tripped through JSON so the
+// projection works on the same field names that would be returned to the client.
+func projectFields(v any, fields []string) (any, error) {
+	if len(fields) == 0 {
+		return v, nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal value for field projection: %w", err)
+	}
+	var decoded any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal value for field projection: %w", err)
+	}
+
+	projected, _ := newFieldTree(fields).apply(decoded)
+	return projected, nil
+}
+
+// projectFields returns a copy of the result with each item projected onto the given fields.
+func (r *FetchAllResult[T]) projectFields(fields []string) (*FetchAllResult[any], error) {
+	projected := &FetchAllResult[any]{
+		Items:     make([]any, len(r.Items)),
+		Truncated: r.Truncated,
+		APICalls:  r.APICalls,
+	}
+	for i, item := range r.Items {
+		p, err := projectFields(item, fields)
+		if err != nil {
+			return nil, err
+		}
+		projected.Items[i] = p
+	}
+	return projected, nil
+}
@@ -0,0 +1,150 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v73/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ProjectFields(t *testing.T) {
+	issue := map[string]any{
+		"number": 42,
+		"title":  "Found a bug",
+		"user": map[string]any{
+			"login":      "octocat",
+			"id":         1,
+			"avatar_url": "https://example.com/avatar",
+		},
+		"labels": []any{
+			map[string]any{"name": "bug", "color": "f29513"},
+			map[string]any{"name": "help wanted", "color": "008672"},
+		},
+		"milestone": nil,
+	}
+
+	tests := []struct {
+		name     string
+		value    any
+		fields   []string
+		expected any
+	}{
+		{
+			name:     "no fields returns value unchanged",
+			value:    issue,
+			fields:   nil,
+			expected: issue,
+		},
+		{
+			name:   "top-level fields",
+			value:  issue,
+			fields: []string{"number", "title"},
+			expected: map[string]any{
+				"number": float64(42),
+				"title":  "Found a bug",
+			},
+		},
+		{
+			name:   "nested object path",
+			value:  issue,
+			fields: []string{"user.login"},
+			expected: map[string]any{
+				"user": map[string]any{"login": "octocat"},
+			},
+		},
+		{
+			name:   "array elements are projected element-wise",
+			value:  issue,
+			fields: []string{"labels.name"},
+			expected: map[string]any{
+				"labels": []any{
+					map[string]any{"name": "bug"},
+					map[string]any{"name": "help wanted"},
+				},
+			},
+		},
+		{
+			name:   "shorter path wins over a nested one",
+			value:  issue,
+			fields: []string{"user.login", "user"},
+			expected: map[string]any{
+				"user": map[string]any{
+					"login":      "octocat",
+					"id":         float64(1),
+					"avatar_url": "https://example.com/avatar",
+				},
+			},
+		},
+		{
+			name:   "unknown and scalar-descending paths are ignored",
+			value:  issue,
+			fields: []string{"number", "nope", "title.length", "milestone.title", "user.nope"},
+			expected: map[string]any{
+				"number": float64(42),
+				"user":   map[string]any{},
+			},
+		},
+		{
+			name:   "top-level arrays are projected element-wise",
+			value:  []any{issue, issue},
+			fields: []string{"number", "user.login"},
+			expected: []any{
+				map[string]any{"number": float64(42), "user": map[string]any{"login": "octocat"}},
+				map[string]any{"number": float64(42), "user": map[string]any{"login": "octocat"}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			projected, err := projectFields(tc.value, tc.fields)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, projected)
+		})
+	}
+}
+
+func Test_GetIssue_WithFields(t *testing.T) {
+	mockIssue := &github.Issue{
+		Number: github.Ptr(42),
+		Title:  github.Ptr("Test Issue"),
+		Body:   github.Ptr("This is a test issue"),
+		User: &github.User{
+			Login: github.Ptr("testuser"),
+			ID:    github.Ptr(int64(7)),
+		},
+		Labels: []*github.Label{
+			{Name: github.Ptr("bug"), Color: github.Ptr("f29513")},
+		},
+	}
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetReposIssuesByOwnerByRepoByIssueNumber,
+			mockIssue,
+		),
+	))
+	_, handler := GetIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":        "owner",
+		"repo":         "repo",
+		"issue_number": float64(42),
+		"fields":       []any{"number", "user.login", "labels.name"},
+	}))
+	require.NoError(t, err)
+	textContent := getTextResult(t, result)
+
+	var returned map[string]any
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+	assert.Equal(t, map[string]any{
+		"number": float64(42),
+		"user":   map[string]any{"login": "testuser"},
+		"labels": []any{map[string]any{"name": "bug"}},
+	}, returned)
+}
@@ -2,6 +2,7 @@ package github
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"testing"
 
@@ -150,16 +151,61 @@ func getTextResourceResult(t *testing.T, result *mcp.CallToolResult) mcp.TextRes
 	return resource.Resource.(mcp.TextResourceContents)
 }
 
-// getBlobResourceResult is a helper function that returns a blob result from a tool call.
-func getBlobResourceResult(t *testing.T, result *mcp.CallToolResult) mcp.BlobResourceContents {
+// requireStructuredContentMatchesSchema is a helper function that checks that a tool call
+// result has structured content conforming to the tool's output schema. Only the subset of
+// JSON Schema that is generated for our output types (type, properties, required, items)
+// is checked.
+func requireStructuredContentMatchesSchema(t *testing.T, tool mcp.Tool, result *mcp.CallToolResult) {
 	t.Helper()
-	assert.NotNil(t, result)
-	require.Len(t, result.Content, 2)
-	content := result.Content[1]
-	require.IsType(t, mcp.EmbeddedResource{}, content)
-	resource := content.(mcp.EmbeddedResource)
-	require.IsType(t, mcp.BlobResourceContents{}, resource.Resource)
-	return resource.Resource.(mcp.BlobResourceContents)
+	require.NotNil(t, result)
+	require.NotNil(t, result.StructuredContent, "expected tool call result to have structured content")
+	require.NotEmpty(t, tool.RawOutputSchema, "expected tool to declare an output schema")
+
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal(tool.RawOutputSchema, &schema))
+
+	data, err := json.Marshal(result.StructuredContent)
+	require.NoError(t, err)
+	var value any
+	require.NoError(t, json.Unmarshal(data, &value))
+
+	validateSchemaValue(t, "$", schema, value)
+}
+
+func validateSchemaValue(t *testing.T, path string, schema map[string]any, value any) {
+	t.Helper()
+	switch schema["type"] {
+	case "object":
+		obj, ok := value.(map[string]any)
+		require.Truef(t, ok, "%s: expected object, got %T", path, value)
+		if required, ok := schema["required"].([]any); ok {
+			for _, name := range required {
+				require.Containsf(t, obj, name, "%s: missing required property", path)
+			}
+		}
+		properties, _ := schema["properties"].(map[string]any)
+		for name, propValue := range obj {
+			propSchema, ok := properties[name].(map[string]any)
+			require.Truef(t, ok, "%s: unexpected property %q", path, name)
+			validateSchemaValue(t, path+"."+name, propSchema, propValue)
+		}
+	case "array":
+		arr, ok := value.([]any)
+		require.Truef(t, ok, "%s: expected array, got %T", path, value)
+		items, _ := schema["items"].(map[string]any)
+		for i, item := range arr {
+			validateSchemaValue(t, fmt.Sprintf("%s[%d]", path, i), items, item)
+		}
+	case "string":
+		require.IsTypef(t, "", value, "%s: expected string", path)
+	case "integer":
+		n, ok := value.(float64)
+		require.Truef(t, ok && n == float64(int64(n)), "%s: expected integer, got %v", path, value)
+	case "number":
+		require.IsTypef(t, float64(0), value, "%s: expected number", path)
+	case "boolean":
+		require.IsTypef(t, false, value, "%s: expected boolean", path)
+	}
 }
 
 func TestOptionalParamOK(t *testing.T) {
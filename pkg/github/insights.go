@@ -0,0 +1,745 @@
+package github
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v73/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// trafficSections are the datasets get_repository_traffic can return.
+var trafficSections = []string{"views", "clones", "paths", "referrers"}
+
+// TrafficPoint is the traffic of a single day or week.
+type TrafficPoint struct {
+	Timestamp string `json:"timestamp"`
+	Count     int    `json:"count"`
+	Uniques   int    `json:"uniques"`
+}
+
+// TrafficSeries is the view or clone traffic over the last 14 days.
+type TrafficSeries struct {
+	// Count and Uniques are the totals over the whole window.
+	Count   int            `json:"count"`
+	Uniques int            `json:"uniques"`
+	Peak    *TrafficPoint  `json:"peak,omitempty"`
+	Points  []TrafficPoint `json:"points"`
+}
+
+// TrafficSource is a popular path or referrer over the last 14 days.
+type TrafficSource struct {
+	Name    string `json:"name"`
+	Title   string `json:"title,omitempty"`
+	Count   int    `json:"count"`
+	Uniques int    `json:"uniques"`
+}
+
+// RepositoryTraffic is the result of get_repository_traffic. Sections that were not requested or
+// have no entries are omitted.
+type RepositoryTraffic struct {
+	Views        *TrafficSeries  `json:"views,omitempty"`
+	Clones       *TrafficSeries  `json:"clones,omitempty"`
+	PopularPaths []TrafficSource `json:"popular_paths,omitempty"`
+	Referrers    []TrafficSource `json:"referrers,omitempty"`
+}
+
+// newTrafficSeries summarizes the traffic data points of a window.
+func newTrafficSeries(data []*github.TrafficData, count, uniques int) *TrafficSeries {
+	series := &TrafficSeries{
+		Count:   count,
+		Uniques: uniques,
+		Points:  make([]TrafficPoint, 0, len(data)),
+	}
+	for _, d := range data {
+		point := TrafficPoint{
+			Timestamp: summaryTimestamp(d.GetTimestamp()),
+			Count:     d.GetCount(),
+			Uniques:   d.GetUniques(),
+		}
+		if series.Peak == nil || point.Count > series.Peak.Count {
+			peak := point
+			series.Peak = &peak
+		}
+		series.Points = append(series.Points, point)
+	}
+	return series
+}
+
+// trafficErrorResponse reports a failed traffic request, explaining the push access the traffic API requires.
+func trafficErrorResponse(ctx context.Context, owner, repo, section string, resp *github.Response, err error) *mcp.CallToolResult {
+	if resp != nil && resp.StatusCode == http.StatusForbidden {
+		message := fmt.Sprintf("failed to get repository %s: reading the traffic of %s/%s requires push access to the repository", section, owner, repo)
+		_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, message, resp, err)
+		return mcp.NewToolResultError(message)
+	}
+	return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get repository %s", section), resp, err)
+}
+
+// GetRepositoryTraffic creates a tool to get the views, clones, popular paths and referrers of a repository.
+func GetRepositoryTraffic(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_repository_traffic",
+			mcp.WithDescription(t("TOOL_GET_REPOSITORY_TRAFFIC_DESCRIPTION", "Get the traffic of a GitHub repository over the last 14 days: views and clones with their totals, and the most popular paths and referrers. Requires push access to the repository")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_REPOSITORY_TRAFFIC_USER_TITLE", "Get repository traffic"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("per",
+				mcp.Description("Granularity of the views and clones time series (default: day)"),
+				mcp.Enum("day", "week"),
+			),
+			mcp.WithArray("sections",
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+						"enum": trafficSections,
+					},
+				),
+				mcp.Description("Datasets to include (default: all)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			per, err := OptionalParam[string](request, "per")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if per != "" && per != "day" && per != "week" {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid per %q: must be day or week", per)), nil
+			}
+			sections, err := OptionalStringArrayParam(request, "sections")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(sections) == 0 {
+				sections = trafficSections
+			}
+			for _, section := range sections {
+				if !slices.Contains(trafficSections, section) {
+					return mcp.NewToolResultError(fmt.Sprintf("invalid section %q: must be one of views, clones, paths or referrers", section)), nil
+				}
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			opts := &github.TrafficBreakdownOptions{Per: per}
+			var traffic RepositoryTraffic
+
+			if slices.Contains(sections, "views") {
+				views, resp, err := client.Repositories.ListTrafficViews(ctx, owner, repo, opts)
+				if err != nil {
+					return trafficErrorResponse(ctx, owner, repo, "views", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				traffic.Views = newTrafficSeries(views.Views, views.GetCount(), views.GetUniques())
+			}
+
+			if slices.Contains(sections, "clones") {
+				clones, resp, err := client.Repositories.ListTrafficClones(ctx, owner, repo, opts)
+				if err != nil {
+					return trafficErrorResponse(ctx, owner, repo, "clones", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				traffic.Clones = newTrafficSeries(clones.Clones, clones.GetCount(), clones.GetUniques())
+			}
+
+			if slices.Contains(sections, "paths") {
+				paths, resp, err := client.Repositories.ListTrafficPaths(ctx, owner, repo)
+				if err != nil {
+					return trafficErrorResponse(ctx, owner, repo, "popular paths", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				traffic.PopularPaths = make([]TrafficSource, 0, len(paths))
+				for _, p := range paths {
+					traffic.PopularPaths = append(traffic.PopularPaths, TrafficSource{
+						Name:    p.GetPath(),
+						Title:   p.GetTitle(),
+						Count:   p.GetCount(),
+						Uniques: p.GetUniques(),
+					})
+				}
+			}
+
+			if slices.Contains(sections, "referrers") {
+				referrers, resp, err := client.Repositories.ListTrafficReferrers(ctx, owner, repo)
+				if err != nil {
+					return trafficErrorResponse(ctx, owner, repo, "referrers", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				traffic.Referrers = make([]TrafficSource, 0, len(referrers))
+				for _, r := range referrers {
+					traffic.Referrers = append(traffic.Referrers, TrafficSource{
+						Name:    r.GetReferrer(),
+						Count:   r.GetCount(),
+						Uniques: r.GetUniques(),
+					})
+				}
+			}
+
+			return MarshalledTextResult(traffic), nil
+		}
+}
+
+// activityDatasets are the datasets get_repository_activity_stats can return.
+var activityDatasets = []string{"commit_activity", "code_frequency", "participation"}
+
+var (
+	// repositoryStatsAttempts and repositoryStatsBackoff control how often the statistics endpoints are retried
+	// while GitHub generates the statistics. The backoff doubles after every attempt, and is a variable so tests
+	// can shorten it.
+	repositoryStatsAttempts = 4
+	repositoryStatsBackoff  = time.Second
+)
+
+// errRepositoryStatsNotReady is returned when GitHub is still generating statistics after every attempt.
+var errRepositoryStatsNotReady = errors.New("statistics are still being generated")
+
+// fetchRepositoryStats calls fetch until GitHub has generated the statistics it returns, giving up with
+// errRepositoryStatsNotReady after repositoryStatsAttempts.
+func fetchRepositoryStats[T any](ctx context.Context, fetch func() (T, *github.Response, error)) (T, *github.Response, error) {
+	backoff := repositoryStatsBackoff
+	for attempt := 1; ; attempt++ {
+		stats, resp, err := fetch()
+		if err == nil || resp == nil || resp.StatusCode != http.StatusAccepted || !isAcceptedError(err) {
+			return stats, resp, err
+		}
+		var zero T
+		if attempt >= repositoryStatsAttempts {
+			return zero, resp, errRepositoryStatsNotReady
+		}
+
+		select {
+		case <-ctx.Done():
+			return zero, nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// statsPeriod returns the bucket a week starting at week belongs to.
+func statsPeriod(week time.Time, granularity string) string {
+	if granularity == "week" {
+		return week.UTC().Format("2006-01-02")
+	}
+	return week.UTC().Format("2006-01")
+}
+
+// CommitActivityBucket is the number of commits in a week or month.
+type CommitActivityBucket struct {
+	Period  string `json:"period"`
+	Commits int    `json:"commits"`
+}
+
+// CommitActivityStats is the commit activity of the last year.
+type CommitActivityStats struct {
+	TotalCommits int                    `json:"total_commits"`
+	Buckets      []CommitActivityBucket `json:"buckets"`
+}
+
+// CodeFrequencyBucket is the number of lines added and deleted in a week or month.
+type CodeFrequencyBucket struct {
+	Period    string `json:"period"`
+	Additions int    `json:"additions"`
+	Deletions int    `json:"deletions"`
+}
+
+// CodeFrequencyStats is the code frequency of the last year.
+type CodeFrequencyStats struct {
+	Additions int                   `json:"additions"`
+	Deletions int                   `json:"deletions"`
+	Buckets   []CodeFrequencyBucket `json:"buckets"`
+}
+
+// ParticipationBucket splits the commits of a week or month between the owner and everyone else.
+type ParticipationBucket struct {
+	Period    string `json:"period"`
+	All       int    `json:"all"`
+	Owner     int    `json:"owner"`
+	Community int    `json:"community"`
+}
+
+// ParticipationStats splits the commits of the last year between the owner and everyone else.
+type ParticipationStats struct {
+	All       int                   `json:"all"`
+	Owner     int                   `json:"owner"`
+	Community int                   `json:"community"`
+	Buckets   []ParticipationBucket `json:"buckets"`
+}
+
+// RepositoryActivityStats is the result of get_repository_activity_stats. Datasets that were not requested are omitted.
+type RepositoryActivityStats struct {
+	Granularity    string               `json:"granularity"`
+	CommitActivity *CommitActivityStats `json:"commit_activity,omitempty"`
+	CodeFrequency  *CodeFrequencyStats  `json:"code_frequency,omitempty"`
+	Participation  *ParticipationStats  `json:"participation,omitempty"`
+}
+
+// summarizeCommitActivity groups the weekly commit activity into buckets.
+func summarizeCommitActivity(weeks []*github.WeeklyCommitActivity, granularity string) *CommitActivityStats {
+	stats := &CommitActivityStats{Buckets: []CommitActivityBucket{}}
+	for _, week := range weeks {
+		period := statsPeriod(week.GetWeek().Time, granularity)
+		if n := len(stats.Buckets); n == 0 || stats.Buckets[n-1].Period != period {
+			stats.Buckets = append(stats.Buckets, CommitActivityBucket{Period: period})
+		}
+		stats.Buckets[len(stats.Buckets)-1].Commits += week.GetTotal()
+		stats.TotalCommits += week.GetTotal()
+	}
+	return stats
+}
+
+// summarizeCodeFrequency groups the weekly additions and deletions since since into buckets. The API
+// reports deletions as negative numbers, they are returned as positive ones.
+func summarizeCodeFrequency(weeks []*github.WeeklyStats, since time.Time, granularity string) *CodeFrequencyStats {
+	stats := &CodeFrequencyStats{Buckets: []CodeFrequencyBucket{}}
+	for _, week := range weeks {
+		if week.GetWeek().Before(since) {
+			continue
+		}
+		period := statsPeriod(week.GetWeek().Time, granularity)
+		if n := len(stats.Buckets); n == 0 || stats.Buckets[n-1].Period != period {
+			stats.Buckets = append(stats.Buckets, CodeFrequencyBucket{Period: period})
+		}
+		additions, deletions := week.GetAdditions(), -week.GetDeletions()
+		stats.Buckets[len(stats.Buckets)-1].Additions += additions
+		stats.Buckets[len(stats.Buckets)-1].Deletions += deletions
+		stats.Additions += additions
+		stats.Deletions += deletions
+	}
+	return stats
+}
+
+// summarizeParticipation groups the weekly participation into buckets. The API lists the weeks from
+// the oldest to the one containing now, without their dates.
+func summarizeParticipation(participation *github.RepositoryParticipation, now time.Time, granularity string) *ParticipationStats {
+	stats := &ParticipationStats{Buckets: []ParticipationBucket{}}
+	now = now.UTC()
+	currentWeek := time.Date(now.Year(), now.Month(), now.Day()-int(now.Weekday()), 0, 0, 0, 0, time.UTC)
+	for i, all := range participation.All {
+		owner := 0
+		if i < len(participation.Owner) {
+			owner = participation.Owner[i]
+		}
+		week := currentWeek.AddDate(0, 0, -7*(len(participation.All)-1-i))
+		period := statsPeriod(week, granularity)
+		if n := len(stats.Buckets); n == 0 || stats.Buckets[n-1].Period != period {
+			stats.Buckets = append(stats.Buckets, ParticipationBucket{Period: period})
+		}
+		bucket := &stats.Buckets[len(stats.Buckets)-1]
+		bucket.All += all
+		bucket.Owner += owner
+		bucket.Community += all - owner
+		stats.All += all
+		stats.Owner += owner
+		stats.Community += all - owner
+	}
+	return stats
+}
+
+// repositoryStatsErrorResponse reports a failed statistics request.
+func repositoryStatsErrorResponse(ctx context.Context, owner, repo, dataset string, resp *github.Response, err error) *mcp.CallToolResult {
+	if errors.Is(err, errRepositoryStatsNotReady) {
+		return mcp.NewToolResultError(fmt.Sprintf("%s statistics for %s/%s are not ready yet: GitHub is still generating them, try again in a minute", dataset, owner, repo))
+	}
+	return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get %s statistics", dataset), resp, err)
+}
+
+// GetRepositoryActivityStats creates a tool to get the commit activity, code frequency and participation of a repository.
+func GetRepositoryActivityStats(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_repository_activity_stats",
+			mcp.WithDescription(t("TOOL_GET_REPOSITORY_ACTIVITY_STATS_DESCRIPTION", "Get the activity of a GitHub repository over the last year: commits, lines added and deleted, and the split of commits between the owner and the community. Useful to tell whether a project is actively maintained")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_REPOSITORY_ACTIVITY_STATS_USER_TITLE", "Get repository activity statistics"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithArray("datasets",
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+						"enum": activityDatasets,
+					},
+				),
+				mcp.Description("Datasets to include (default: all). code_frequency is not available for repositories with 10,000 or more commits"),
+			),
+			mcp.WithString("granularity",
+				mcp.Description("Size of the buckets the weekly statistics are grouped into (default: month)"),
+				mcp.Enum("month", "week"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			datasets, err := OptionalStringArrayParam(request, "datasets")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(datasets) == 0 {
+				datasets = activityDatasets
+			}
+			for _, dataset := range datasets {
+				if !slices.Contains(activityDatasets, dataset) {
+					return mcp.NewToolResultError(fmt.Sprintf("invalid dataset %q: must be one of commit_activity, code_frequency or participation", dataset)), nil
+				}
+			}
+			granularity, err := OptionalParam[string](request, "granularity")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if granularity == "" {
+				granularity = "month"
+			}
+			if granularity != "month" && granularity != "week" {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid granularity %q: must be month or week", granularity)), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			now := timeNow()
+			stats := RepositoryActivityStats{Granularity: granularity}
+
+			if slices.Contains(datasets, "commit_activity") {
+				weeks, resp, err := fetchRepositoryStats(ctx, func() ([]*github.WeeklyCommitActivity, *github.Response, error) {
+					return client.Repositories.ListCommitActivity(ctx, owner, repo)
+				})
+				if err != nil {
+					return repositoryStatsErrorResponse(ctx, owner, repo, "commit activity", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				stats.CommitActivity = summarizeCommitActivity(weeks, granularity)
+			}
+
+			if slices.Contains(datasets, "code_frequency") {
+				weeks, resp, err := fetchRepositoryStats(ctx, func() ([]*github.WeeklyStats, *github.Response, error) {
+					return client.Repositories.ListCodeFrequency(ctx, owner, repo)
+				})
+				if err != nil {
+					return repositoryStatsErrorResponse(ctx, owner, repo, "code frequency", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				stats.CodeFrequency = summarizeCodeFrequency(weeks, now.AddDate(0, 0, -7*52), granularity)
+			}
+
+			if slices.Contains(datasets, "participation") {
+				participation, resp, err := fetchRepositoryStats(ctx, func() (*github.RepositoryParticipation, *github.Response, error) {
+					return client.Repositories.ListParticipation(ctx, owner, repo)
+				})
+				if err != nil {
+					return repositoryStatsErrorResponse(ctx, owner, repo, "participation", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				stats.Participation = summarizeParticipation(participation, now, granularity)
+			}
+
+			return MarshalledTextResult(stats), nil
+		}
+}
+
+const (
+	// maxStargazerAggregatePages caps the pages of stargazers list_stargazers counts when aggregating,
+	// as popular repositories have hundreds of thousands of stars.
+	maxStargazerAggregatePages = 50
+	stargazerAggregatePerPage  = 100
+)
+
+// Stargazer is a user who starred a repository and when they did.
+type Stargazer struct {
+	Login     string `json:"login"`
+	StarredAt string `json:"starred_at,omitempty"`
+}
+
+// StarBucket is the number of stars received in a day or week.
+type StarBucket struct {
+	Period string `json:"period"`
+	Count  int    `json:"count"`
+}
+
+// StargazerAggregate is the result of list_stargazers when aggregating stars per day or week.
+type StargazerAggregate struct {
+	Aggregate string       `json:"aggregate"`
+	Total     int          `json:"total"`
+	Buckets   []StarBucket `json:"buckets"`
+	// Truncated is set when the repository has more stars than could be counted, in which case
+	// only the oldest ones are included.
+	Truncated bool `json:"truncated"`
+}
+
+// starPeriod returns the day, or the Monday of the week, a star given at starredAt belongs to.
+func starPeriod(starredAt time.Time, aggregate string) string {
+	day := starredAt.UTC().Truncate(24 * time.Hour)
+	if aggregate == "weekly" {
+		// time.Weekday starts on Sunday, weeks start on Monday.
+		day = day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	}
+	return day.Format("2006-01-02")
+}
+
+// ListStargazers creates a tool to list the users who starred a repository, or count the stars it received over time.
+func ListStargazers(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_stargazers",
+			mcp.WithDescription(t("TOOL_LIST_STARGAZERS_DESCRIPTION", "List the users who starred a GitHub repository and when, oldest first. Pass aggregate to count the stars received per day or week instead, to see how the repository gained stars over time")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_STARGAZERS_USER_TITLE", "List stargazers"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("aggregate",
+				mcp.Description(fmt.Sprintf("Count the stars per day or week instead of listing stargazers. At most the oldest %d stars are counted, and pagination is ignored", maxStargazerAggregatePages*stargazerAggregatePerPage)),
+				mcp.Enum("daily", "weekly"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			aggregate, err := OptionalParam[string](request, "aggregate")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if aggregate != "" && aggregate != "daily" && aggregate != "weekly" {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid aggregate %q: must be daily or weekly", aggregate)), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			if aggregate == "" {
+				stargazers, resp, err := client.Activity.ListStargazers(ctx, owner, repo, &github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list stargazers", resp, err), nil
+				}
+				defer func() { _ = resp.Body.Close() }()
+
+				result := make([]Stargazer, 0, len(stargazers))
+				for _, stargazer := range stargazers {
+					result = append(result, Stargazer{
+						Login:     stargazer.GetUser().GetLogin(),
+						StarredAt: summaryTimestamp(stargazer.GetStarredAt()),
+					})
+				}
+				return MarshalledTextResult(result), nil
+			}
+
+			counts := make(map[string]int)
+			result := StargazerAggregate{Aggregate: aggregate, Buckets: []StarBucket{}}
+			opts := &github.ListOptions{Page: 1, PerPage: stargazerAggregatePerPage}
+			for pages := 0; ; pages++ {
+				if pages == maxStargazerAggregatePages {
+					result.Truncated = true
+					break
+				}
+				stargazers, resp, err := client.Activity.ListStargazers(ctx, owner, repo, opts)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list stargazers", resp, err), nil
+				}
+				_ = resp.Body.Close()
+
+				for _, stargazer := range stargazers {
+					starredAt := stargazer.GetStarredAt()
+					if starredAt.IsZero() {
+						continue
+					}
+					counts[starPeriod(starredAt.Time, aggregate)]++
+					result.Total++
+				}
+				if resp.NextPage == 0 {
+					break
+				}
+				opts.Page = resp.NextPage
+			}
+
+			for period, count := range counts {
+				result.Buckets = append(result.Buckets, StarBucket{Period: period, Count: count})
+			}
+			slices.SortFunc(result.Buckets, func(a, b StarBucket) int {
+				return strings.Compare(a.Period, b.Period)
+			})
+
+			return MarshalledTextResult(result), nil
+		}
+}
+
+// HealthDocument is a community health document and whether a repository has it.
+type HealthDocument struct {
+	Name    string `json:"name"`
+	Present bool   `json:"present"`
+	URL     string `json:"url,omitempty"`
+}
+
+// HealthLicense is the license GitHub detected for a repository.
+type HealthLicense struct {
+	SPDXID string `json:"spdx_id"`
+	Name   string `json:"name"`
+	URL    string `json:"url,omitempty"`
+}
+
+// RepositoryHealth is the result of get_repository_health.
+type RepositoryHealth struct {
+	// HealthPercentage is omitted when the community profile isn't available.
+	HealthPercentage *int             `json:"health_percentage,omitempty"`
+	License          *HealthLicense   `json:"license"`
+	Documents        []HealthDocument `json:"documents"`
+	Note             string           `json:"note,omitempty"`
+}
+
+// newHealthDocument describes a document of the community profile, which is absent when metric is nil.
+func newHealthDocument(name string, metric *github.Metric) HealthDocument {
+	if metric == nil {
+		return HealthDocument{Name: name}
+	}
+	return HealthDocument{Name: name, Present: true, URL: cmp.Or(metric.GetHTMLURL(), metric.GetURL())}
+}
+
+// GetRepositoryHealth creates a tool to get the community health documents and license of a repository.
+func GetRepositoryHealth(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_repository_health",
+			mcp.WithDescription(t("TOOL_GET_REPOSITORY_HEALTH_DESCRIPTION", "Get the community health of a GitHub repository: its health percentage, detected license, and whether it has a README, license, code of conduct, contributing guide, issue templates and pull request template")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_REPOSITORY_HEALTH_USER_TITLE", "Get repository health"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			health := RepositoryHealth{}
+
+			// A repository without a license file is reported as a 404, like a repository that doesn't
+			// exist or isn't visible to the token. Once either call succeeds the repository is known to exist.
+			repoLicense, resp, err := client.Repositories.License(ctx, owner, repo)
+			switch {
+			case err == nil:
+				_ = resp.Body.Close()
+				health.License = &HealthLicense{
+					SPDXID: repoLicense.GetLicense().GetSPDXID(),
+					Name:   repoLicense.GetLicense().GetName(),
+					URL:    repoLicense.GetHTMLURL(),
+				}
+			case resp != nil && resp.StatusCode == http.StatusNotFound:
+				_ = resp.Body.Close()
+				if errResult := checkRepositoryExists(ctx, client, owner, repo); errResult != nil {
+					return errResult, nil
+				}
+			default:
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get repository license", resp, err), nil
+			}
+
+			metrics, resp, err := client.Repositories.GetCommunityHealthMetrics(ctx, owner, repo)
+			if err != nil {
+				// The community profile is only available for public repositories.
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					health.Documents = []HealthDocument{{Name: "license", Present: health.License != nil}}
+					if health.License != nil {
+						health.Documents[0].URL = health.License.URL
+					}
+					health.Note = fmt.Sprintf("the community profile of %s/%s is not available, which is the case for private repositories, so only its license is reported", owner, repo)
+					return MarshalledTextResult(health), nil
+				}
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get community profile", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			health.HealthPercentage = metrics.HealthPercentage
+			files := metrics.GetFiles()
+			codeOfConduct := files.GetCodeOfConductFile()
+			if codeOfConduct == nil {
+				codeOfConduct = files.GetCodeOfConduct()
+			}
+			health.Documents = []HealthDocument{
+				newHealthDocument("readme", files.GetReadme()),
+				newHealthDocument("license", files.GetLicense()),
+				newHealthDocument("code_of_conduct", codeOfConduct),
+				newHealthDocument("contributing", files.GetContributing()),
+				newHealthDocument("issue_template", files.GetIssueTemplate()),
+				newHealthDocument("pull_request_template", files.GetPullRequestTemplate()),
+			}
+
+			return MarshalledTextResult(health), nil
+		}
+}
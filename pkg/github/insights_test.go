@@ -0,0 +1,764 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v73/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetRepositoryTraffic(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRepositoryTraffic(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_repository_traffic", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "per")
+	assert.Contains(t, tool.InputSchema.Properties, "sections")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	day := func(d int) *github.Timestamp {
+		return &github.Timestamp{Time: time.Date(2024, 5, d, 0, 0, 0, 0, time.UTC)}
+	}
+	mockViews := &github.TrafficViews{
+		Count:   github.Ptr(30),
+		Uniques: github.Ptr(12),
+		Views: []*github.TrafficData{
+			{Timestamp: day(1), Count: github.Ptr(10), Uniques: github.Ptr(5)},
+			{Timestamp: day(2), Count: github.Ptr(20), Uniques: github.Ptr(9)},
+		},
+	}
+	mockClones := &github.TrafficClones{
+		Count:   github.Ptr(4),
+		Uniques: github.Ptr(2),
+		Clones: []*github.TrafficData{
+			{Timestamp: day(1), Count: github.Ptr(4), Uniques: github.Ptr(2)},
+		},
+	}
+	mockPaths := []*github.TrafficPath{
+		{Path: github.Ptr("/owner/repo"), Title: github.Ptr("owner/repo"), Count: github.Ptr(25), Uniques: github.Ptr(10)},
+	}
+	mockReferrers := []*github.TrafficReferrer{
+		{Referrer: github.Ptr("github.com"), Count: github.Ptr(15), Uniques: github.Ptr(7)},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult RepositoryTraffic
+		expectedErrMsg string
+	}{
+		{
+			name: "all sections",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposTrafficViewsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{"per": "week"}).andThen(
+						mockResponse(t, http.StatusOK, mockViews),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposTrafficClonesByOwnerByRepo,
+					expectQueryParams(t, map[string]string{"per": "week"}).andThen(
+						mockResponse(t, http.StatusOK, mockClones),
+					),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposTrafficPopularPathsByOwnerByRepo,
+					mockPaths,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposTrafficPopularReferrersByOwnerByRepo,
+					mockReferrers,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"per":   "week",
+			},
+			expectError: false,
+			expectedResult: RepositoryTraffic{
+				Views: &TrafficSeries{
+					Count:   30,
+					Uniques: 12,
+					Peak:    &TrafficPoint{Timestamp: "2024-05-02T00:00:00Z", Count: 20, Uniques: 9},
+					Points: []TrafficPoint{
+						{Timestamp: "2024-05-01T00:00:00Z", Count: 10, Uniques: 5},
+						{Timestamp: "2024-05-02T00:00:00Z", Count: 20, Uniques: 9},
+					},
+				},
+				Clones: &TrafficSeries{
+					Count:   4,
+					Uniques: 2,
+					Peak:    &TrafficPoint{Timestamp: "2024-05-01T00:00:00Z", Count: 4, Uniques: 2},
+					Points: []TrafficPoint{
+						{Timestamp: "2024-05-01T00:00:00Z", Count: 4, Uniques: 2},
+					},
+				},
+				PopularPaths: []TrafficSource{
+					{Name: "/owner/repo", Title: "owner/repo", Count: 25, Uniques: 10},
+				},
+				Referrers: []TrafficSource{
+					{Name: "github.com", Count: 15, Uniques: 7},
+				},
+			},
+		},
+		{
+			name: "selected sections only",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposTrafficPopularReferrersByOwnerByRepo,
+					mockReferrers,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"sections": []interface{}{"referrers"},
+			},
+			expectError: false,
+			expectedResult: RepositoryTraffic{
+				Referrers: []TrafficSource{
+					{Name: "github.com", Count: 15, Uniques: 7},
+				},
+			},
+		},
+		{
+			name: "missing push access",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposTrafficViewsByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Must have push access to repository"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"sections": []interface{}{"views"},
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get repository views: reading the traffic of owner/repo requires push access to the repository",
+		},
+		{
+			name:         "invalid section",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"sections": []interface{}{"stars"},
+			},
+			expectError:    true,
+			expectedErrMsg: `invalid section "stars"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetRepositoryTraffic(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned RepositoryTraffic
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_GetRepositoryActivityStats(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRepositoryActivityStats(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_repository_activity_stats", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "datasets")
+	assert.Contains(t, tool.InputSchema.Properties, "granularity")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	// Pin the clock to a Friday, so the current week starts on Sunday 2024-03-10.
+	originalNow := timeNow
+	timeNow = func() time.Time { return time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC) }
+	t.Cleanup(func() { timeNow = originalNow })
+
+	backoff := repositoryStatsBackoff
+	repositoryStatsBackoff = time.Millisecond
+	t.Cleanup(func() { repositoryStatsBackoff = backoff })
+
+	week := func(month time.Month, day int) *github.Timestamp {
+		return &github.Timestamp{Time: time.Date(2024, month, day, 0, 0, 0, 0, time.UTC)}
+	}
+	mockCommitActivity := []*github.WeeklyCommitActivity{
+		{Week: week(time.February, 25), Total: github.Ptr(3), Days: []int{0, 1, 1, 1, 0, 0, 0}},
+		{Week: week(time.March, 3), Total: github.Ptr(5), Days: []int{0, 2, 1, 1, 1, 0, 0}},
+		{Week: week(time.March, 10), Total: github.Ptr(2), Days: []int{0, 1, 1, 0, 0, 0, 0}},
+	}
+	// The API returns code frequency as [week, additions, deletions] tuples.
+	mockCodeFrequency := [][]int64{
+		{time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC).Unix(), 1000, -500},
+		{week(time.March, 3).Unix(), 10, -4},
+	}
+	mockParticipation := &github.RepositoryParticipation{
+		All:   []int{3, 5, 2},
+		Owner: []int{1, 0, 2},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult RepositoryActivityStats
+		expectedErrMsg string
+	}{
+		{
+			name: "all datasets in monthly buckets",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposStatsCommitActivityByOwnerByRepo,
+					mockCommitActivity,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposStatsCodeFrequencyByOwnerByRepo,
+					mockCodeFrequency,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposStatsParticipationByOwnerByRepo,
+					mockParticipation,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError: false,
+			expectedResult: RepositoryActivityStats{
+				Granularity: "month",
+				CommitActivity: &CommitActivityStats{
+					TotalCommits: 10,
+					Buckets: []CommitActivityBucket{
+						{Period: "2024-02", Commits: 3},
+						{Period: "2024-03", Commits: 7},
+					},
+				},
+				CodeFrequency: &CodeFrequencyStats{
+					Additions: 10,
+					Deletions: 4,
+					Buckets: []CodeFrequencyBucket{
+						{Period: "2024-03", Additions: 10, Deletions: 4},
+					},
+				},
+				Participation: &ParticipationStats{
+					All:       10,
+					Owner:     3,
+					Community: 7,
+					Buckets: []ParticipationBucket{
+						{Period: "2024-02", All: 3, Owner: 1, Community: 2},
+						{Period: "2024-03", All: 7, Owner: 2, Community: 5},
+					},
+				},
+			},
+		},
+		{
+			name: "weekly participation only",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposStatsParticipationByOwnerByRepo,
+					mockParticipation,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"datasets":    []interface{}{"participation"},
+				"granularity": "week",
+			},
+			expectError: false,
+			expectedResult: RepositoryActivityStats{
+				Granularity: "week",
+				Participation: &ParticipationStats{
+					All:       10,
+					Owner:     3,
+					Community: 7,
+					Buckets: []ParticipationBucket{
+						{Period: "2024-02-25", All: 3, Owner: 1, Community: 2},
+						{Period: "2024-03-03", All: 5, Owner: 0, Community: 5},
+						{Period: "2024-03-10", All: 2, Owner: 2, Community: 0},
+					},
+				},
+			},
+		},
+		{
+			name: "statistics become ready after a retry",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposStatsCommitActivityByOwnerByRepo,
+					statsAcceptedHandler(t, 1, mockCommitActivity[:1]),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"datasets": []interface{}{"commit_activity"},
+			},
+			expectError: false,
+			expectedResult: RepositoryActivityStats{
+				Granularity: "month",
+				CommitActivity: &CommitActivityStats{
+					TotalCommits: 3,
+					Buckets: []CommitActivityBucket{
+						{Period: "2024-02", Commits: 3},
+					},
+				},
+			},
+		},
+		{
+			name: "statistics never become ready",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposStatsCodeFrequencyByOwnerByRepo,
+					statsAcceptedHandler(t, repositoryStatsAttempts, mockCodeFrequency),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"datasets": []interface{}{"code_frequency"},
+			},
+			expectError:    true,
+			expectedErrMsg: "code frequency statistics for owner/repo are not ready yet: GitHub is still generating them, try again in a minute",
+		},
+		{
+			name:         "invalid granularity",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"granularity": "year",
+			},
+			expectError:    true,
+			expectedErrMsg: `invalid granularity "year"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetRepositoryActivityStats(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned RepositoryActivityStats
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+// statsAcceptedHandler answers the first accepted requests with 202 Accepted, as GitHub does while it
+// generates statistics, and the later ones with body.
+func statsAcceptedHandler(t *testing.T, accepted int, body any) http.HandlerFunc {
+	requests := 0
+	return func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests <= accepted {
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{}`))
+			return
+		}
+		mockResponse(t, http.StatusOK, body)(w, r)
+	}
+}
+
+func Test_ListStargazers(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListStargazers(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_stargazers", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "aggregate")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	stargazer := func(login string, starredAt time.Time) *github.Stargazer {
+		return &github.Stargazer{
+			User:      &github.User{Login: github.Ptr(login)},
+			StarredAt: &github.Timestamp{Time: starredAt},
+		}
+	}
+	// 2025-03-03 is a Monday.
+	firstPage := []*github.Stargazer{
+		stargazer("alice", time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC)),
+		stargazer("bob", time.Date(2025, 3, 3, 23, 0, 0, 0, time.UTC)),
+	}
+	secondPage := []*github.Stargazer{
+		stargazer("carol", time.Date(2025, 3, 9, 12, 0, 0, 0, time.UTC)),
+		stargazer("dave", time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult any
+		expectedErrMsg string
+	}{
+		{
+			name: "list stargazers",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposStargazersByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, firstPage),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError: false,
+			expectedResult: []Stargazer{
+				{Login: "alice", StarredAt: "2025-03-03T09:00:00Z"},
+				{Login: "bob", StarredAt: "2025-03-03T23:00:00Z"},
+			},
+		},
+		{
+			name: "daily star counts across pages",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchPages(
+					mock.GetReposStargazersByOwnerByRepo,
+					firstPage,
+					secondPage,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"aggregate": "daily",
+			},
+			expectError: false,
+			expectedResult: StargazerAggregate{
+				Aggregate: "daily",
+				Total:     4,
+				Buckets: []StarBucket{
+					{Period: "2025-03-03", Count: 2},
+					{Period: "2025-03-09", Count: 1},
+					{Period: "2025-03-10", Count: 1},
+				},
+			},
+		},
+		{
+			name: "weekly star counts",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchPages(
+					mock.GetReposStargazersByOwnerByRepo,
+					firstPage,
+					secondPage,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"aggregate": "weekly",
+			},
+			expectError: false,
+			expectedResult: StargazerAggregate{
+				Aggregate: "weekly",
+				Total:     4,
+				Buckets: []StarBucket{
+					{Period: "2025-03-03", Count: 3},
+					{Period: "2025-03-10", Count: 1},
+				},
+			},
+		},
+		{
+			name: "star counts stop at the page cap",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposStargazersByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.Header().Set("Link", `<https://api.github.com/repositories/1/stargazers?page=1000>; rel="next"`)
+						mockResponse(t, http.StatusOK, firstPage[:1])(w, r)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"aggregate": "daily",
+			},
+			expectError: false,
+			expectedResult: StargazerAggregate{
+				Aggregate: "daily",
+				Total:     maxStargazerAggregatePages,
+				Buckets: []StarBucket{
+					{Period: "2025-03-03", Count: maxStargazerAggregatePages},
+				},
+				Truncated: true,
+			},
+		},
+		{
+			name: "list stargazers fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposStargazersByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"aggregate": "weekly",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list stargazers",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListStargazers(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			expected, err := json.Marshal(tc.expectedResult)
+			require.NoError(t, err)
+			assert.JSONEq(t, string(expected), textContent.Text)
+		})
+	}
+}
+
+func Test_GetRepositoryHealth(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRepositoryHealth(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_repository_health", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockLicense := &github.RepositoryLicense{
+		HTMLURL: github.Ptr("https://github.com/owner/repo/blob/main/LICENSE"),
+		License: &github.License{SPDXID: github.Ptr("MIT"), Name: github.Ptr("MIT License")},
+	}
+	mockMetrics := &github.CommunityHealthMetrics{
+		HealthPercentage: github.Ptr(71),
+		Files: &github.CommunityHealthFiles{
+			Readme:  &github.Metric{HTMLURL: github.Ptr("https://github.com/owner/repo/blob/main/README.md")},
+			License: &github.Metric{HTMLURL: github.Ptr("https://github.com/owner/repo/blob/main/LICENSE")},
+			CodeOfConduct: &github.Metric{
+				HTMLURL: github.Ptr("https://github.com/owner/repo/blob/main/CODE_OF_CONDUCT.md"),
+			},
+			IssueTemplate: &github.Metric{URL: github.Ptr("https://api.github.com/repos/owner/repo/contents/.github/ISSUE_TEMPLATE")},
+		},
+	}
+	notFound := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+	})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedResult RepositoryHealth
+		expectedErrMsg string
+	}{
+		{
+			name: "public repository",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposLicenseByOwnerByRepo, mockLicense),
+				mock.WithRequestMatch(mock.GetReposCommunityProfileByOwnerByRepo, mockMetrics),
+			),
+			expectError: false,
+			expectedResult: RepositoryHealth{
+				HealthPercentage: github.Ptr(71),
+				License: &HealthLicense{
+					SPDXID: "MIT",
+					Name:   "MIT License",
+					URL:    "https://github.com/owner/repo/blob/main/LICENSE",
+				},
+				Documents: []HealthDocument{
+					{Name: "readme", Present: true, URL: "https://github.com/owner/repo/blob/main/README.md"},
+					{Name: "license", Present: true, URL: "https://github.com/owner/repo/blob/main/LICENSE"},
+					{Name: "code_of_conduct", Present: true, URL: "https://github.com/owner/repo/blob/main/CODE_OF_CONDUCT.md"},
+					{Name: "contributing", Present: false},
+					{Name: "issue_template", Present: true, URL: "https://api.github.com/repos/owner/repo/contents/.github/ISSUE_TEMPLATE"},
+					{Name: "pull_request_template", Present: false},
+				},
+			},
+		},
+		{
+			name: "private repository without community profile",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposLicenseByOwnerByRepo, mockLicense),
+				mock.WithRequestMatchHandler(mock.GetReposCommunityProfileByOwnerByRepo, notFound),
+			),
+			expectError: false,
+			expectedResult: RepositoryHealth{
+				License: &HealthLicense{
+					SPDXID: "MIT",
+					Name:   "MIT License",
+					URL:    "https://github.com/owner/repo/blob/main/LICENSE",
+				},
+				Documents: []HealthDocument{
+					{Name: "license", Present: true, URL: "https://github.com/owner/repo/blob/main/LICENSE"},
+				},
+				Note: "the community profile of owner/repo is not available, which is the case for private repositories, so only its license is reported",
+			},
+		},
+		{
+			name: "repository without license",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposLicenseByOwnerByRepo, notFound),
+				mock.WithRequestMatch(mock.GetReposByOwnerByRepo, &github.Repository{FullName: github.Ptr("owner/repo")}),
+				mock.WithRequestMatch(mock.GetReposCommunityProfileByOwnerByRepo, &github.CommunityHealthMetrics{
+					HealthPercentage: github.Ptr(14),
+					Files:            &github.CommunityHealthFiles{},
+				}),
+			),
+			expectError: false,
+			expectedResult: RepositoryHealth{
+				HealthPercentage: github.Ptr(14),
+				Documents: []HealthDocument{
+					{Name: "readme"},
+					{Name: "license"},
+					{Name: "code_of_conduct"},
+					{Name: "contributing"},
+					{Name: "issue_template"},
+					{Name: "pull_request_template"},
+				},
+			},
+		},
+		{
+			name: "missing repository is not reported as healthy",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposLicenseByOwnerByRepo, notFound),
+				mock.WithRequestMatchHandler(mock.GetReposByOwnerByRepo, notFound),
+				mock.WithRequestMatchHandler(mock.GetReposCommunityProfileByOwnerByRepo, notFound),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to get repository owner/repo",
+		},
+		{
+			name: "license request fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposLicenseByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Forbidden"}`))
+					}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to get repository license",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetRepositoryHealth(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			})
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned RepositoryHealth
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
@@ -6,7 +6,11 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
@@ -22,6 +26,7 @@ import (
 func GetIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("get_issue",
 			mcp.WithDescription(t("TOOL_GET_ISSUE_DESCRIPTION", "Get details of a specific issue in a GitHub repository.")),
+			mcp.WithOutputSchema[IssueSummary](),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_GET_ISSUE_USER_TITLE", "Get issue details"),
 				ReadOnlyHint: ToBoolPtr(true),
@@ -38,6 +43,8 @@ func GetIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool
 				mcp.Required(),
 				mcp.Description("The number of the issue"),
 			),
+			WithFields(),
+			WithOutputFormat(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](request, "owner")
@@ -52,6 +59,14 @@ func GetIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			fields, err := OptionalStringArrayParam(request, "fields")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			format, err := OptionalOutputFormatParam(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
 			client, err := getClient(ctx)
 			if err != nil {
@@ -71,12 +86,21 @@ func GetIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get issue: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(issue)
+			if format == OutputFormatMarkdown {
+				return withStructuredContent(mcp.NewToolResultText(formatIssueMarkdown(issue)), summarizeIssue(issue)), nil
+			}
+
+			projected, err := projectFields(issue, fields)
+			if err != nil {
+				return nil, err
+			}
+
+			r, err := json.Marshal(projected)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal issue: %w", err)
 			}
 
-			return mcp.NewToolResultText(string(r)), nil
+			return mcp.NewToolResultStructured(summarizeIssue(issue), string(r)), nil
 		}
 }
 
@@ -84,6 +108,7 @@ func GetIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool
 func AddIssueComment(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("add_issue_comment",
 			mcp.WithDescription(t("TOOL_ADD_ISSUE_COMMENT_DESCRIPTION", "Add a comment to a specific issue in a GitHub repository.")),
+			mcp.WithOutputSchema[IssueCommentSummary](),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_ADD_ISSUE_COMMENT_USER_TITLE", "Add comment to issue"),
 				ReadOnlyHint: ToBoolPtr(false),
@@ -150,7 +175,7 @@ func AddIssueComment(getClient GetClientFn, t translations.TranslationHelperFunc
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
 
-			return mcp.NewToolResultText(string(r)), nil
+			return mcp.NewToolResultStructured(summarizeIssueComment(createdComment), string(r)), nil
 		}
 }
 
@@ -268,6 +293,8 @@ func ListSubIssues(getClient GetClientFn, t translations.TranslationHelperFunc)
 			mcp.WithNumber("per_page",
 				mcp.Description("Number of results per page (max 100, default: 30)"),
 			),
+			WithFetchAll(),
+			WithOutputMode(issueSummaryFields),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](request, "owner")
@@ -290,6 +317,14 @@ func ListSubIssues(getClient GetClientFn, t translations.TranslationHelperFunc)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			output, err := OptionalOutputModeParam(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
 			client, err := getClient(ctx)
 			if err != nil {
@@ -303,6 +338,21 @@ func ListSubIssues(getClient GetClientFn, t translations.TranslationHelperFunc)
 				},
 			}
 
+			if pagination.FetchAll {
+				opts.ListOptions.PerPage = fetchAllPerPage
+				result, resp, err := fetchAllPagesFrom(ctx, page, perPage, pagination.MaxItems, func(page int) ([]*github.SubIssue, *github.Response, error) {
+					opts.ListOptions.Page = page
+					return client.SubIssue.ListByIssue(ctx, owner, repo, int64(issueNumber), opts)
+				})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list sub-issues", resp, err), nil
+				}
+				if output == OutputModeSummary {
+					return MarshalledTextResult(mapFetchAllResult(result, summarizeSubIssues)), nil
+				}
+				return MarshalledTextResult(result), nil
+			}
+
 			subIssues, resp, err := client.SubIssue.ListByIssue(ctx, owner, repo, int64(issueNumber), opts)
 			if err != nil {
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
@@ -322,7 +372,12 @@ func ListSubIssues(getClient GetClientFn, t translations.TranslationHelperFunc)
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list sub-issues: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(subIssues)
+			var response any = subIssues
+			if output == OutputModeSummary {
+				response = summarizeSubIssues(subIssues)
+			}
+
+			r, err := json.Marshal(response)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -332,6 +387,85 @@ func ListSubIssues(getClient GetClientFn, t translations.TranslationHelperFunc)
 
 }
 
+// SubIssueProgress is the completion of an issue's sub-issues, as returned by get_sub_issue_progress.
+type SubIssueProgress struct {
+	Total            int            `json:"total"`
+	Completed        int            `json:"completed"`
+	PercentCompleted int            `json:"percent_completed"`
+	OpenSubIssues    []IssueSummary `json:"open_sub_issues"`
+	Truncated        bool           `json:"truncated"`
+}
+
+// GetSubIssueProgress creates a tool to report how many of an issue's sub-issues are completed.
+func GetSubIssueProgress(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_sub_issue_progress",
+			mcp.WithDescription(t("TOOL_GET_SUB_ISSUE_PROGRESS_DESCRIPTION", "Get the progress of an issue broken down into sub-issues: how many sub-issues there are, how many are closed, the percentage completed, and the open sub-issues. truncated is true when the issue has too many sub-issues to read them all.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_SUB_ISSUE_PROGRESS_USER_TITLE", "Get sub-issue progress"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Number of the parent issue"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			opts := &github.IssueListOptions{ListOptions: github.ListOptions{PerPage: fetchAllPerPage}}
+			result, resp, err := fetchAllPages(ctx, 1, DefaultFetchAllMaxItems, func(page int) ([]*github.SubIssue, *github.Response, error) {
+				opts.ListOptions.Page = page
+				return client.SubIssue.ListByIssue(ctx, owner, repo, int64(issueNumber), opts)
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list sub-issues", resp, err), nil
+			}
+
+			progress := SubIssueProgress{
+				Total:         len(result.Items),
+				OpenSubIssues: []IssueSummary{},
+				Truncated:     result.Truncated,
+			}
+			for _, subIssue := range result.Items {
+				issue := (*github.Issue)(subIssue)
+				if issue.GetState() == "closed" {
+					progress.Completed++
+				} else {
+					progress.OpenSubIssues = append(progress.OpenSubIssues, summarizeIssue(issue))
+				}
+			}
+			if progress.Total > 0 {
+				progress.PercentCompleted = progress.Completed * 100 / progress.Total
+			}
+
+			return MarshalledTextResult(progress), nil
+		}
+}
+
 // RemoveSubIssue creates a tool to remove a sub-issue from a parent issue.
 // Unlike other sub-issue tools, this currently uses a direct HTTP DELETE request
 // because of a bug in the go-github library.
@@ -569,10 +703,10 @@ func SearchIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (
 				mcp.Description("Search query using GitHub issues search syntax"),
 			),
 			mcp.WithString("owner",
-				mcp.Description("Optional repository owner. If provided with repo, only notifications for this repository are listed."),
+				mcp.Description("Optional repository owner. Scopes the search to this owner's repositories, or to a single repository when repo is also given. Ignored if the query already contains a repo:, org: or user: qualifier."),
 			),
 			mcp.WithString("repo",
-				mcp.Description("Optional repository name. If provided with owner, only notifications for this repository are listed."),
+				mcp.Description("Optional repository name. Must be used together with owner to scope the search to a single repository."),
 			),
 			mcp.WithString("sort",
 				mcp.Description("Sort field by number of matches of categories, defaults to best match"),
@@ -595,6 +729,8 @@ func SearchIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (
 				mcp.Enum("asc", "desc"),
 			),
 			WithPagination(),
+			WithFields(),
+			WithOutputMode(issueSummaryFields),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			return searchHandler(ctx, getClient, request, "issue", "failed to search issues")
@@ -605,6 +741,7 @@ func SearchIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (
 func CreateIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("create_issue",
 			mcp.WithDescription(t("TOOL_CREATE_ISSUE_DESCRIPTION", "Create a new issue in a GitHub repository.")),
+			mcp.WithOutputSchema[IssueSummary](),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_CREATE_ISSUE_USER_TITLE", "Open new issue"),
 				ReadOnlyHint: ToBoolPtr(false),
@@ -719,7 +856,7 @@ func CreateIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
 
-			return mcp.NewToolResultText(string(r)), nil
+			return mcp.NewToolResultStructured(summarizeIssue(issue), string(r)), nil
 		}
 }
 
@@ -727,6 +864,7 @@ func CreateIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 func ListIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("list_issues",
 			mcp.WithDescription(t("TOOL_LIST_ISSUES_DESCRIPTION", "List issues in a GitHub repository.")),
+			mcp.WithOutputSchema[IssueSummaryList](),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_LIST_ISSUES_USER_TITLE", "List issues"),
 				ReadOnlyHint: ToBoolPtr(true),
@@ -751,6 +889,12 @@ func ListIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 					},
 				),
 			),
+			mcp.WithString("assignee",
+				mcp.Description("Filter by assignee login. Use 'none' for unassigned issues and '*' for issues assigned to anyone"),
+			),
+			mcp.WithString("milestone",
+				mcp.Description("Filter by milestone number or title. Use 'none' for issues without a milestone and '*' for issues with any milestone"),
+			),
 			mcp.WithString("sort",
 				mcp.Description("Sort order"),
 				mcp.Enum("created", "updated", "comments"),
@@ -760,9 +904,13 @@ func ListIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				mcp.Enum("asc", "desc"),
 			),
 			mcp.WithString("since",
-				mcp.Description("Filter by date (ISO 8601 timestamp)"),
+				mcp.Description("Filter by date (ISO 8601 timestamp, or relative such as 24h, 7d, 2w, yesterday)"),
 			),
 			WithPagination(),
+			WithFetchAll(),
+			WithFields(),
+			WithOutputMode(issueSummaryFields),
+			WithOutputFormat(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](request, "owner")
@@ -773,41 +921,35 @@ func ListIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-
-			opts := &github.IssueListByRepoOptions{}
-
-			// Set optional parameters if provided
-			opts.State, err = OptionalParam[string](request, "state")
+			fields, err := OptionalStringArrayParam(request, "fields")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-
-			// Get labels
-			opts.Labels, err = OptionalStringArrayParam(request, "labels")
+			output, err := OptionalOutputModeParam(request)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-
-			opts.Sort, err = OptionalParam[string](request, "sort")
+			format, err := OptionalOutputFormatParam(request)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			opts.Direction, err = OptionalParam[string](request, "direction")
+			filters, err := parseListIssuesFilters(request)
 			if err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list issues: %s", err.Error())), nil
 			}
-
-			since, err := OptionalParam[string](request, "since")
+			milestone, err := OptionalParam[string](request, "milestone")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			if since != "" {
-				timestamp, err := parseISOTimestamp(since)
-				if err != nil {
-					return mcp.NewToolResultError(fmt.Sprintf("failed to list issues: %s", err.Error())), nil
-				}
-				opts.Since = timestamp
+
+			opts := &github.IssueListByRepoOptions{
+				State:     filters.State,
+				Labels:    filters.Labels,
+				Assignee:  filters.Assignee,
+				Sort:      filters.Sort,
+				Direction: filters.Direction,
+				Since:     filters.Since,
 			}
 
 			if page, ok := request.GetArguments()["page"].(float64); ok {
@@ -818,10 +960,48 @@ func ListIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				opts.ListOptions.PerPage = int(perPage)
 			}
 
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
+
+			if milestone != "" {
+				opts.Milestone, err = resolveMilestoneFilter(ctx, client, owner, repo, milestone)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to list issues: %s", err.Error())), nil
+				}
+			}
+
+			if pagination.FetchAll {
+				opts.ListOptions.PerPage = fetchAllPerPage
+				result, resp, err := fetchAllPagesFrom(ctx, pagination.Page, pagination.PerPage, pagination.MaxItems, func(page int) ([]*github.Issue, *github.Response, error) {
+					opts.ListOptions.Page = page
+					return client.Issues.ListByRepo(ctx, owner, repo, opts)
+				})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list issues", resp, err), nil
+				}
+				structured := IssueSummaryList{Issues: summarizeIssues(result.Items)}
+				if format == OutputFormatMarkdown {
+					return withStructuredContent(mcp.NewToolResultText(formatFetchAllMarkdown(formatIssueListMarkdown(result.Items), result)), structured), nil
+				}
+				var projected any
+				if output == OutputModeSummary {
+					projected, err = mapFetchAllResult(result, summarizeIssues).projectFields(fields)
+				} else {
+					projected, err = result.projectFields(fields)
+				}
+				if err != nil {
+					return nil, err
+				}
+				return withStructuredContent(MarshalledTextResult(projected), structured), nil
+			}
+
 			issues, resp, err := client.Issues.ListByRepo(ctx, owner, repo, opts)
 			if err != nil {
 				return nil, fmt.Errorf("failed to list issues: %w", err)
@@ -836,12 +1016,333 @@ func ListIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list issues: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(issues)
+			if format == OutputFormatMarkdown {
+				return withStructuredContent(mcp.NewToolResultText(formatIssueListMarkdown(issues)), IssueSummaryList{Issues: summarizeIssues(issues)}), nil
+			}
+
+			var items any = issues
+			if output == OutputModeSummary {
+				items = summarizeIssues(issues)
+			}
+			projected, err := projectFields(items, fields)
+			if err != nil {
+				return nil, err
+			}
+
+			r, err := json.Marshal(projected)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal issues: %w", err)
 			}
 
-			return mcp.NewToolResultText(string(r)), nil
+			return mcp.NewToolResultStructured(IssueSummaryList{Issues: summarizeIssues(issues)}, string(r)), nil
+		}
+}
+
+// resolveMilestoneFilter turns the milestone filter of list_issues into the form the API expects:
+// a milestone number, "none" or "*". Any other value is looked up as the title of a milestone.
+func resolveMilestoneFilter(ctx context.Context, client *github.Client, owner, repo, milestone string) (string, error) {
+	if milestone == "none" || milestone == "*" {
+		return milestone, nil
+	}
+	if _, err := strconv.Atoi(milestone); err == nil {
+		return milestone, nil
+	}
+
+	opts := &github.MilestoneListOptions{State: "all", ListOptions: github.ListOptions{PerPage: fetchAllPerPage}}
+	result, _, err := fetchAllPages(ctx, 1, DefaultFetchAllMaxItems, func(page int) ([]*github.Milestone, *github.Response, error) {
+		opts.ListOptions.Page = page
+		return client.Issues.ListMilestones(ctx, owner, repo, opts)
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to list milestones: %w", err)
+	}
+	for _, m := range result.Items {
+		if strings.EqualFold(m.GetTitle(), milestone) {
+			return strconv.Itoa(m.GetNumber()), nil
+		}
+	}
+	return "", fmt.Errorf("milestone %q not found in %s/%s", milestone, owner, repo)
+}
+
+// listIssuesFilters are the filter and ordering parameters shared by list_issues and list_issues_graphql.
+type listIssuesFilters struct {
+	State     string
+	Labels    []string
+	Assignee  string
+	Sort      string
+	Direction string
+	Since     time.Time
+}
+
+// parseListIssuesFilters reads and validates the filter and ordering parameters shared by
+// list_issues and list_issues_graphql, so that both engines reject the same invalid input.
+func parseListIssuesFilters(request mcp.CallToolRequest) (listIssuesFilters, error) {
+	var filters listIssuesFilters
+	var err error
+
+	filters.State, err = OptionalParam[string](request, "state")
+	if err != nil {
+		return listIssuesFilters{}, err
+	}
+	switch filters.State {
+	case "", "open", "closed", "all":
+	default:
+		return listIssuesFilters{}, fmt.Errorf("invalid state %q: must be one of open, closed, all", filters.State)
+	}
+
+	filters.Labels, err = OptionalStringArrayParam(request, "labels")
+	if err != nil {
+		return listIssuesFilters{}, err
+	}
+
+	filters.Assignee, err = OptionalParam[string](request, "assignee")
+	if err != nil {
+		return listIssuesFilters{}, err
+	}
+
+	filters.Sort, err = OptionalParam[string](request, "sort")
+	if err != nil {
+		return listIssuesFilters{}, err
+	}
+	switch filters.Sort {
+	case "", "created", "updated", "comments":
+	default:
+		return listIssuesFilters{}, fmt.Errorf("invalid sort %q: must be one of created, updated, comments", filters.Sort)
+	}
+
+	filters.Direction, err = OptionalParam[string](request, "direction")
+	if err != nil {
+		return listIssuesFilters{}, err
+	}
+	switch filters.Direction {
+	case "", "asc", "desc":
+	default:
+		return listIssuesFilters{}, fmt.Errorf("invalid direction %q: must be one of asc, desc", filters.Direction)
+	}
+
+	since, err := OptionalParam[string](request, "since")
+	if err != nil {
+		return listIssuesFilters{}, err
+	}
+	if since != "" {
+		filters.Since, err = parseISOTimestamp(since)
+		if err != nil {
+			return listIssuesFilters{}, err
+		}
+	}
+
+	return filters, nil
+}
+
+// listIssuesQuery is the GraphQL query used by list_issues_graphql. Only the fields needed
+// for triage are selected, which keeps responses much smaller than the REST equivalent.
+type listIssuesQuery struct {
+	Repository struct {
+		Issues struct {
+			Nodes []struct {
+				Number    githubv4.Int
+				Title     githubv4.String
+				State     githubv4.String
+				URL       githubv4.String `graphql:"url"`
+				UpdatedAt githubv4.DateTime
+				Labels    struct {
+					Nodes []struct {
+						Name githubv4.String
+					}
+				} `graphql:"labels(first: 10)"`
+				Assignees struct {
+					Nodes []struct {
+						Login githubv4.String
+					}
+				} `graphql:"assignees(first: 5)"`
+			}
+			PageInfo struct {
+				HasNextPage bool
+				EndCursor   string
+			}
+			TotalCount int
+		} `graphql:"issues(first: $first, after: $after, filterBy: $filterBy, orderBy: $orderBy)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// toGraphQL converts the shared list filters to their GraphQL input equivalents.
+// As with the REST API, only open issues are listed unless a state is given, and issues are
+// ordered by creation time, newest first.
+func (f listIssuesFilters) toGraphQL() (githubv4.IssueFilters, githubv4.IssueOrder) {
+	var filterBy githubv4.IssueFilters
+
+	var states []githubv4.IssueState
+	switch f.State {
+	case "closed":
+		states = []githubv4.IssueState{githubv4.IssueStateClosed}
+	case "all":
+		states = []githubv4.IssueState{githubv4.IssueStateOpen, githubv4.IssueStateClosed}
+	default:
+		states = []githubv4.IssueState{githubv4.IssueStateOpen}
+	}
+	filterBy.States = &states
+
+	if len(f.Labels) > 0 {
+		labels := make([]githubv4.String, len(f.Labels))
+		for i, label := range f.Labels {
+			labels[i] = githubv4.String(label)
+		}
+		filterBy.Labels = &labels
+	}
+	if f.Assignee != "" {
+		filterBy.Assignee = githubv4.NewString(githubv4.String(f.Assignee))
+	}
+	if !f.Since.IsZero() {
+		filterBy.Since = githubv4.NewDateTime(githubv4.DateTime{Time: f.Since})
+	}
+
+	orderBy := githubv4.IssueOrder{
+		Field:     githubv4.IssueOrderFieldCreatedAt,
+		Direction: githubv4.OrderDirectionDesc,
+	}
+	switch f.Sort {
+	case "updated":
+		orderBy.Field = githubv4.IssueOrderFieldUpdatedAt
+	case "comments":
+		orderBy.Field = githubv4.IssueOrderFieldComments
+	}
+	if f.Direction == "asc" {
+		orderBy.Direction = githubv4.OrderDirectionAsc
+	}
+
+	return filterBy, orderBy
+}
+
+// ListIssuesGraphQL creates a tool to list repository issues using the GraphQL API, which
+// returns trimmed issue objects together with the total number of matching issues.
+func ListIssuesGraphQL(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_issues_graphql",
+			mcp.WithDescription(t("TOOL_LIST_ISSUES_GRAPHQL_DESCRIPTION", "List issues in a GitHub repository using the GraphQL API. Returns compact issues (number, title, state, labels, assignees, updatedAt) along with the total number of matching issues. Uses cursor-based pagination: pass the returned endCursor as 'after' to get the next page.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_ISSUES_GRAPHQL_USER_TITLE", "List issues (GraphQL)"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("state",
+				mcp.Description("Filter by state (default: open)"),
+				mcp.Enum("open", "closed", "all"),
+			),
+			mcp.WithArray("labels",
+				mcp.Description("Filter by labels"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+			mcp.WithString("assignee",
+				mcp.Description("Filter by assignee login. Use '*' for issues assigned to anyone. Unassigned issues can't be filtered here; use list_issues with assignee 'none'"),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Sort order (default: created)"),
+				mcp.Enum("created", "updated", "comments"),
+			),
+			mcp.WithString("direction",
+				mcp.Description("Sort direction (default: desc)"),
+				mcp.Enum("asc", "desc"),
+			),
+			mcp.WithString("since",
+				mcp.Description("Only issues updated at or after this time (ISO 8601 timestamp, or relative such as 24h, 7d, 2w, yesterday)"),
+			),
+			WithCursorPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			filters, err := parseListIssuesFilters(request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list issues: %s", err.Error())), nil
+			}
+			// The GraphQL assignee filter has no form for unassigned issues, and would look up "none" as a login.
+			if filters.Assignee == "none" {
+				return mcp.NewToolResultError("failed to list issues: assignee 'none' is not supported by the GraphQL API; use list_issues to list unassigned issues"), nil
+			}
+			pagination, err := OptionalCursorPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			paginationParams, err := pagination.ToGraphQLParams()
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			filterBy, orderBy := filters.toGraphQL()
+			vars := map[string]any{
+				"owner":    githubv4.String(owner),
+				"repo":     githubv4.String(repo),
+				"first":    githubv4.Int(*paginationParams.First),
+				"after":    (*githubv4.String)(paginationParams.After),
+				"filterBy": filterBy,
+				"orderBy":  orderBy,
+			}
+
+			var query listIssuesQuery
+			if err := client.Query(ctx, &query, vars); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to list issues", err), nil
+			}
+
+			type issueSummary struct {
+				Number    int       `json:"number"`
+				Title     string    `json:"title"`
+				State     string    `json:"state"`
+				URL       string    `json:"url"`
+				Labels    []string  `json:"labels"`
+				Assignees []string  `json:"assignees"`
+				UpdatedAt time.Time `json:"updatedAt"`
+			}
+
+			issues := make([]issueSummary, 0, len(query.Repository.Issues.Nodes))
+			for _, n := range query.Repository.Issues.Nodes {
+				labels := make([]string, 0, len(n.Labels.Nodes))
+				for _, l := range n.Labels.Nodes {
+					labels = append(labels, string(l.Name))
+				}
+				assignees := make([]string, 0, len(n.Assignees.Nodes))
+				for _, a := range n.Assignees.Nodes {
+					assignees = append(assignees, string(a.Login))
+				}
+				issues = append(issues, issueSummary{
+					Number:    int(n.Number),
+					Title:     string(n.Title),
+					State:     string(n.State),
+					URL:       string(n.URL),
+					Labels:    labels,
+					Assignees: assignees,
+					UpdatedAt: n.UpdatedAt.Time,
+				})
+			}
+
+			return MarshalledTextResult(map[string]any{
+				"issues":     issues,
+				"totalCount": query.Repository.Issues.TotalCount,
+				"pageInfo": map[string]any{
+					"hasNextPage": query.Repository.Issues.PageInfo.HasNextPage,
+					"endCursor":   query.Repository.Issues.PageInfo.EndCursor,
+				},
+			}), nil
 		}
 }
 
@@ -1011,7 +1512,20 @@ func GetIssueComments(getClient GetClientFn, t translations.TranslationHelperFun
 				mcp.Required(),
 				mcp.Description("Issue number"),
 			),
+			mcp.WithString("direction",
+				mcp.Description("Order of comments by creation time (default: asc). With desc, page 1 holds the newest comments"),
+				mcp.Enum("asc", "desc"),
+			),
+			mcp.WithString("since",
+				mcp.Description("Only comments updated at or after this time (ISO 8601 timestamp, or relative such as 24h, 7d, 2w, yesterday)"),
+			),
+			mcp.WithBoolean("latest",
+				mcp.Description("Return the most recent comments first. Shorthand for direction=desc"),
+			),
 			WithPagination(),
+			WithFetchAll(),
+			WithFields(),
+			WithOutputFormat(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](request, "owner")
@@ -1030,33 +1544,88 @@ func GetIssueComments(getClient GetClientFn, t translations.TranslationHelperFun
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			fields, err := OptionalStringArrayParam(request, "fields")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			format, err := OptionalOutputFormatParam(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
-			opts := &github.IssueListCommentsOptions{
-				ListOptions: github.ListOptions{
-					Page:    pagination.Page,
-					PerPage: pagination.PerPage,
-				},
+			opts, newestFirst, err := issueListCommentsOptions(request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get issue comments: %s", err.Error())), nil
+			}
+			opts.ListOptions = github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
 			}
 
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			comments, resp, err := client.Issues.ListComments(ctx, owner, repo, issueNumber, opts)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get issue comments: %w", err)
-			}
-			defer func() { _ = resp.Body.Close() }()
 
-			if resp.StatusCode != http.StatusOK {
-				body, err := io.ReadAll(resp.Body)
+			if pagination.FetchAll {
+				opts.ListOptions.PerPage = fetchAllPerPage
+				var result *FetchAllResult[*github.IssueComment]
+				var resp *github.Response
+				if newestFirst {
+					result, resp, err = fetchAllIssueCommentsNewestFirst(ctx, client, owner, repo, issueNumber, opts, pagination)
+				} else {
+					result, resp, err = fetchAllPagesFrom(ctx, pagination.Page, pagination.PerPage, pagination.MaxItems, func(page int) ([]*github.IssueComment, *github.Response, error) {
+						opts.ListOptions.Page = page
+						return client.Issues.ListComments(ctx, owner, repo, issueNumber, opts)
+					})
+				}
 				if err != nil {
-					return nil, fmt.Errorf("failed to read response body: %w", err)
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get issue comments", resp, err), nil
 				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to get issue comments: %s", string(body))), nil
-			}
-
-			r, err := json.Marshal(comments)
+				if format == OutputFormatMarkdown {
+					return mcp.NewToolResultText(formatFetchAllMarkdown(formatIssueCommentsMarkdown(result.Items), result)), nil
+				}
+				projected, err := result.projectFields(fields)
+				if err != nil {
+					return nil, err
+				}
+				return MarshalledTextResult(projected), nil
+			}
+
+			var comments []*github.IssueComment
+			if newestFirst {
+				var resp *github.Response
+				comments, resp, err = listIssueCommentsNewestFirst(ctx, client, owner, repo, issueNumber, opts, pagination.Page, pagination.PerPage)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get issue comments", resp, err), nil
+				}
+			} else {
+				var resp *github.Response
+				comments, resp, err = client.Issues.ListComments(ctx, owner, repo, issueNumber, opts)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get issue comments: %w", err)
+				}
+				defer func() { _ = resp.Body.Close() }()
+
+				if resp.StatusCode != http.StatusOK {
+					body, err := io.ReadAll(resp.Body)
+					if err != nil {
+						return nil, fmt.Errorf("failed to read response body: %w", err)
+					}
+					return mcp.NewToolResultError(fmt.Sprintf("failed to get issue comments: %s", string(body))), nil
+				}
+			}
+
+			if format == OutputFormatMarkdown {
+				return mcp.NewToolResultText(formatIssueCommentsMarkdown(comments)), nil
+			}
+
+			projected, err := projectFields(comments, fields)
+			if err != nil {
+				return nil, err
+			}
+
+			r, err := json.Marshal(projected)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -1065,6 +1634,165 @@ func GetIssueComments(getClient GetClientFn, t translations.TranslationHelperFun
 		}
 }
 
+// issueListCommentsOptions reads and validates the direction and since parameters of
+// get_issue_comments, reporting whether the newest comments should come first. The latest
+// flag is shorthand for direction=desc and can't be combined with direction=asc.
+//
+// The issue comments endpoint only lists comments oldest first and ignores sort and
+// direction, so descending order is left to listIssueCommentsNewestFirst.
+func issueListCommentsOptions(request mcp.CallToolRequest) (*github.IssueListCommentsOptions, bool, error) {
+	opts := &github.IssueListCommentsOptions{}
+
+	direction, err := OptionalParam[string](request, "direction")
+	if err != nil {
+		return nil, false, err
+	}
+	switch direction {
+	case "", "asc", "desc":
+	default:
+		return nil, false, fmt.Errorf("invalid direction %q: must be one of asc, desc", direction)
+	}
+
+	latest, err := OptionalParam[bool](request, "latest")
+	if err != nil {
+		return nil, false, err
+	}
+	if latest {
+		if direction == "asc" {
+			return nil, false, fmt.Errorf("latest cannot be combined with direction=%q", direction)
+		}
+		direction = "desc"
+	}
+
+	since, err := OptionalParam[string](request, "since")
+	if err != nil {
+		return nil, false, err
+	}
+	if since != "" {
+		timestamp, err := parseISOTimestamp(since)
+		if err != nil {
+			return nil, false, err
+		}
+		opts.Since = &timestamp
+	}
+
+	return opts, direction == "desc", nil
+}
+
+// issueCommentsPages reads the first and last pages of an issue's comments, for pages of
+// opts.PerPage comments. It returns the number of the last page, the pages read by number so
+// that callers don't request them again, the last response, and the number of API calls made.
+func issueCommentsPages(ctx context.Context, client *github.Client, owner, repo string, issueNumber int, opts *github.IssueListCommentsOptions) (int, map[int][]*github.IssueComment, *github.Response, int, error) {
+	opts.ListOptions.Page = 1
+	comments, resp, err := client.Issues.ListComments(ctx, owner, repo, issueNumber, opts)
+	if err != nil {
+		return 0, nil, resp, 1, err
+	}
+	_ = resp.Body.Close()
+	fetched := map[int][]*github.IssueComment{1: comments}
+	if resp.LastPage <= 1 {
+		return 1, fetched, resp, 1, nil
+	}
+
+	lastPage := resp.LastPage
+	opts.ListOptions.Page = lastPage
+	comments, resp, err = client.Issues.ListComments(ctx, owner, repo, issueNumber, opts)
+	if err != nil {
+		return 0, nil, resp, 2, err
+	}
+	_ = resp.Body.Close()
+	fetched[lastPage] = comments
+	return lastPage, fetched, resp, 2, nil
+}
+
+// listIssueCommentsNewestFirst returns the given page of an issue's comments with the newest
+// first. Pages are counted from the newest comment, so page 1 holds the last perPage comments
+// even when they are split across the last two pages of the API.
+func listIssueCommentsNewestFirst(ctx context.Context, client *github.Client, owner, repo string, issueNumber int, opts *github.IssueListCommentsOptions, page, perPage int) ([]*github.IssueComment, *github.Response, error) {
+	opts.ListOptions.PerPage = perPage
+	lastPage, fetched, resp, _, err := issueCommentsPages(ctx, client, owner, repo, issueNumber, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	total := (lastPage-1)*perPage + len(fetched[lastPage])
+	end := total - (page-1)*perPage
+	start := max(end-perPage, 0)
+
+	comments := []*github.IssueComment{}
+	for i := end - 1; i >= start; i-- {
+		apiPage := i/perPage + 1
+		pageComments, ok := fetched[apiPage]
+		if !ok {
+			opts.ListOptions.Page = apiPage
+			pageComments, resp, err = client.Issues.ListComments(ctx, owner, repo, issueNumber, opts)
+			if err != nil {
+				return nil, resp, err
+			}
+			_ = resp.Body.Close()
+			fetched[apiPage] = pageComments
+		}
+		// Comments added or deleted between requests can shift the pages.
+		if j := i % perPage; j < len(pageComments) {
+			comments = append(comments, pageComments[j])
+		}
+	}
+	return comments, resp, nil
+}
+
+// fetchAllIssueCommentsNewestFirst is the fetch_all counterpart of listIssueCommentsNewestFirst.
+// It walks the API pages backwards from the last one, so that truncating at max_items keeps the
+// newest comments. The caller's page of perPage comments is counted from the newest comment.
+func fetchAllIssueCommentsNewestFirst(ctx context.Context, client *github.Client, owner, repo string, issueNumber int, opts *github.IssueListCommentsOptions, pagination PaginationParams) (*FetchAllResult[*github.IssueComment], *github.Response, error) {
+	lastPage, fetched, lastResp, apiCalls, err := issueCommentsPages(ctx, client, owner, repo, issueNumber, opts)
+	if err != nil {
+		return nil, lastResp, err
+	}
+	lastComments := fetched[lastPage]
+
+	// Walking backwards, the first page holds the comments of the last API page and the
+	// following ones hold fetchAllPerPage comments each.
+	offset := (max(pagination.Page, 1) - 1) * max(pagination.PerPage, 1)
+	if offset >= (lastPage-1)*fetchAllPerPage+len(lastComments) {
+		return &FetchAllResult[*github.IssueComment]{Items: []*github.IssueComment{}, APICalls: apiCalls}, lastResp, nil
+	}
+	startPage, skip := 1, offset
+	if offset >= len(lastComments) {
+		offset -= len(lastComments)
+		startPage, skip = offset/fetchAllPerPage+2, offset%fetchAllPerPage
+	}
+
+	result, resp, err := fetchAllPagesSkipping(ctx, startPage, skip, pagination.MaxItems, func(page int) ([]*github.IssueComment, *github.Response, error) {
+		opts.ListOptions.Page = lastPage - page + 1
+		comments, ok := fetched[opts.ListOptions.Page]
+		resp := lastResp
+		if ok {
+			// Reuse the pages already read, with a copy of the last response for the pagination below.
+			comments = slices.Clone(comments)
+			respCopy := *lastResp
+			resp = &respCopy
+		} else {
+			var err error
+			comments, resp, err = client.Issues.ListComments(ctx, owner, repo, issueNumber, opts)
+			apiCalls++
+			if err != nil {
+				return nil, resp, err
+			}
+		}
+		slices.Reverse(comments)
+		resp.NextPage = 0
+		if opts.ListOptions.Page > 1 {
+			resp.NextPage = page + 1
+		}
+		return comments, resp, nil
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+	result.APICalls = apiCalls
+	return result, resp, nil
+}
+
 // mvpDescription is an MVP idea for generating tool descriptions from structured data in a shared format.
 // It is not intended for widespread usage and is not a complete implementation.
 type mvpDescription struct {
@@ -1095,6 +1823,125 @@ func (d *mvpDescription) String() string {
 	return sb.String()
 }
 
+// copilotBotLogin is the login of the copilot coding agent bot, which is supposed to be the same on each host.
+const copilotBotLogin = "copilot-swe-agent"
+
+const (
+	// copilotAssigneeCacheTTL is how long a found copilot bot ID is cached for a repository.
+	copilotAssigneeCacheTTL = time.Hour
+	// copilotAssigneeMissTTL is how long the absence of the copilot bot is cached for a repository.
+	// It is kept short so that enabling copilot on a repository is picked up quickly.
+	copilotAssigneeMissTTL = time.Minute
+)
+
+// copilotAssigneeCache caches the node ID of the copilot bot per repository, so that assigning
+// copilot to several issues in a row doesn't page through suggestedActors every time.
+// It is safe for concurrent use.
+type copilotAssigneeCache struct {
+	mu      sync.Mutex
+	entries map[string]copilotAssigneeCacheEntry
+}
+
+type copilotAssigneeCacheEntry struct {
+	id        githubv4.ID // nil if copilot isn't available in the repository
+	expiresAt time.Time
+}
+
+func newCopilotAssigneeCache() *copilotAssigneeCache {
+	return &copilotAssigneeCache{entries: make(map[string]copilotAssigneeCacheEntry)}
+}
+
+func copilotAssigneeCacheKey(owner, repo string) string {
+	return strings.ToLower(owner + "/" + repo)
+}
+
+// get returns the cached copilot bot ID for the repository, which is nil if copilot was
+// recently found not to be available. The boolean is false if there is no live entry.
+func (c *copilotAssigneeCache) get(owner, repo string) (githubv4.ID, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := copilotAssigneeCacheKey(owner, repo)
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !timeNow().Before(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.id, true
+}
+
+// set caches the copilot bot ID for the repository. A nil ID records that copilot isn't
+// available, which is only cached for copilotAssigneeMissTTL.
+func (c *copilotAssigneeCache) set(owner, repo string, id githubv4.ID) {
+	ttl := copilotAssigneeCacheTTL
+	if id == nil {
+		ttl = copilotAssigneeMissTTL
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[copilotAssigneeCacheKey(owner, repo)] = copilotAssigneeCacheEntry{
+		id:        id,
+		expiresAt: timeNow().Add(ttl),
+	}
+}
+
+// findCopilotAssigneeID looks up the node ID of the copilot bot in the suggested actors for the
+// repository, returning nil if copilot can't be assigned there.
+func findCopilotAssigneeID(ctx context.Context, client *githubv4.Client, owner, repo string) (githubv4.ID, error) {
+	// Although as I write this, we would expect copilot to be at the top of the list, in future, maybe
+	// it will not be on the first page of responses, thus we will keep paginating until we find it.
+	type botAssignee struct {
+		ID       githubv4.ID
+		Login    string
+		TypeName string `graphql:"__typename"`
+	}
+
+	type suggestedActorsQuery struct {
+		Repository struct {
+			SuggestedActors struct {
+				Nodes []struct {
+					Bot botAssignee `graphql:"... on Bot"`
+				}
+				PageInfo struct {
+					HasNextPage bool
+					EndCursor   string
+				}
+			} `graphql:"suggestedActors(first: 100, after: $endCursor, capabilities: CAN_BE_ASSIGNED)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	variables := map[string]any{
+		"owner":     githubv4.String(owner),
+		"name":      githubv4.String(repo),
+		"endCursor": (*githubv4.String)(nil),
+	}
+
+	for {
+		var query suggestedActorsQuery
+		err := client.Query(ctx, &query, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		// Iterate all the returned nodes looking for the copilot bot, which is supposed to have the
+		// same name on each host. We need this in order to get the ID for later assignment.
+		for _, node := range query.Repository.SuggestedActors.Nodes {
+			if node.Bot.Login == copilotBotLogin {
+				return node.Bot.ID, nil
+			}
+		}
+
+		if !query.Repository.SuggestedActors.PageInfo.HasNextPage {
+			return nil, nil
+		}
+		variables["endCursor"] = githubv4.String(query.Repository.SuggestedActors.PageInfo.EndCursor)
+	}
+}
+
 func AssignCopilotToIssue(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
 	description := mvpDescription{
 		summary: "Assign Copilot to a specific issue in a GitHub repository.",
@@ -1106,6 +1953,8 @@ func AssignCopilotToIssue(getGQLClient GetGQLClientFn, t translations.Translatio
 		},
 	}
 
+	copilotIDs := newCopilotAssigneeCache()
+
 	return mcp.NewTool("assign_copilot_to_issue",
 			mcp.WithDescription(t("TOOL_ASSIGN_COPILOT_TO_ISSUE_DESCRIPTION", description.String())),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
@@ -1122,99 +1971,200 @@ func AssignCopilotToIssue(getGQLClient GetGQLClientFn, t translations.Translatio
 				mcp.Description("Repository name"),
 			),
 			mcp.WithNumber("issueNumber",
-				mcp.Required(),
-				mcp.Description("Issue number"),
+				mcp.Description("Issue number. Either issueNumber or issueNumbers is required"),
+			),
+			mcp.WithArray("issueNumbers",
+				mcp.Description("Issue numbers to assign Copilot to in a single call. Returns the outcome for each issue, and a failure on one issue doesn't stop the others"),
+				mcp.Items(
+					map[string]any{
+						"type": "number",
+					},
+				),
 			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			var params struct {
-				Owner       string
-				Repo        string
-				IssueNumber int32
+				Owner        string
+				Repo         string
+				IssueNumber  int32
+				IssueNumbers []int32
 			}
 			if err := mapstructure.Decode(request.Params.Arguments, &params); err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			if params.IssueNumber != 0 && len(params.IssueNumbers) > 0 {
+				return mcp.NewToolResultError("only one of issueNumber or issueNumbers may be provided"), nil
+			}
+			if params.IssueNumber == 0 && len(params.IssueNumbers) == 0 {
+				return mcp.NewToolResultError("either issueNumber or issueNumbers must be provided"), nil
+			}
 
 			client, err := getGQLClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
 
-			// Firstly, we try to find the copilot bot in the suggested actors for the repository.
-			// Although as I write this, we would expect copilot to be at the top of the list, in future, maybe
-			// it will not be on the first page of responses, thus we will keep paginating until we find it.
-			type botAssignee struct {
-				ID       githubv4.ID
-				Login    string
-				TypeName string `graphql:"__typename"`
+			// Firstly, we need the ID of the copilot bot, which we look up in the suggested actors for the
+			// repository unless we've recently done so already.
+			copilotID, cached := copilotIDs.get(params.Owner, params.Repo)
+			if !cached {
+				copilotID, err = findCopilotAssigneeID(ctx, client, params.Owner, params.Repo)
+				if err != nil {
+					return nil, err
+				}
+				copilotIDs.set(params.Owner, params.Repo, copilotID)
 			}
 
-			type suggestedActorsQuery struct {
-				Repository struct {
-					SuggestedActors struct {
-						Nodes []struct {
-							Bot botAssignee `graphql:"... on Bot"`
-						}
-						PageInfo struct {
-							HasNextPage bool
-							EndCursor   string
-						}
-					} `graphql:"suggestedActors(first: 100, after: $endCursor, capabilities: CAN_BE_ASSIGNED)"`
-				} `graphql:"repository(owner: $owner, name: $name)"`
+			// If we didn't find the copilot bot, we can't proceed any further.
+			if copilotID == nil {
+				// The e2e tests depend upon this specific message to skip the test.
+				return mcp.NewToolResultError("copilot isn't available as an assignee for this issue. Please inform the user to visit https://docs.github.com/en/copilot/using-github-copilot/using-copilot-coding-agent-to-work-on-tasks/about-assigning-tasks-to-copilot for more information."), nil
 			}
 
-			variables := map[string]any{
-				"owner":     githubv4.String(params.Owner),
-				"name":      githubv4.String(params.Repo),
-				"endCursor": (*githubv4.String)(nil),
+			if len(params.IssueNumbers) == 0 {
+				if err := assignCopilotToIssue(ctx, client, params.Owner, params.Repo, params.IssueNumber, copilotID); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				return mcp.NewToolResultText("successfully assigned copilot to issue"), nil
 			}
 
-			var copilotAssignee *botAssignee
-			for {
-				var query suggestedActorsQuery
-				err := client.Query(ctx, &query, variables)
-				if err != nil {
-					return nil, err
+			// With several issues, each one is assigned independently so that a failure on one of them
+			// (e.g. because it was deleted) doesn't prevent the rest from being assigned.
+			type assignmentResult struct {
+				IssueNumber int32  `json:"issueNumber"`
+				Assigned    bool   `json:"assigned"`
+				Error       string `json:"error,omitempty"`
+			}
+
+			results := make([]assignmentResult, 0, len(params.IssueNumbers))
+			for _, issueNumber := range params.IssueNumbers {
+				result := assignmentResult{IssueNumber: issueNumber, Assigned: true}
+				if err := assignCopilotToIssue(ctx, client, params.Owner, params.Repo, issueNumber, copilotID); err != nil {
+					result.Assigned = false
+					result.Error = err.Error()
 				}
+				results = append(results, result)
+			}
+
+			return MarshalledTextResult(results), nil
+		}
+}
+
+type ReplaceActorsForAssignableInput struct {
+	AssignableID githubv4.ID   `json:"assignableId"`
+	ActorIDs     []githubv4.ID `json:"actorIds"`
+}
 
-				// Iterate all the returned nodes looking for the copilot bot, which is supposed to have the
-				// same name on each host. We need this in order to get the ID for later assignment.
-				for _, node := range query.Repository.SuggestedActors.Nodes {
-					if node.Bot.Login == "copilot-swe-agent" {
-						copilotAssignee = &node.Bot
-						break
+// assignCopilotToIssue adds the copilot bot to the assignees of an issue, keeping any existing assignees.
+func assignCopilotToIssue(ctx context.Context, client *githubv4.Client, owner, repo string, issueNumber int32, copilotID githubv4.ID) error {
+	// We need the GQL Node ID and current assignees for this issue because the only way to
+	// assign copilot is to use replaceActorsForAssignable which requires the full list.
+	var getIssueQuery struct {
+		Repository struct {
+			Issue struct {
+				ID        githubv4.ID
+				Assignees struct {
+					Nodes []struct {
+						ID githubv4.ID
 					}
-				}
+				} `graphql:"assignees(first: 100)"`
+			} `graphql:"issue(number: $number)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
 
-				if !query.Repository.SuggestedActors.PageInfo.HasNextPage {
-					break
-				}
-				variables["endCursor"] = githubv4.String(query.Repository.SuggestedActors.PageInfo.EndCursor)
+	variables := map[string]any{
+		"owner":  githubv4.String(owner),
+		"name":   githubv4.String(repo),
+		"number": githubv4.Int(issueNumber),
+	}
+
+	if err := client.Query(ctx, &getIssueQuery, variables); err != nil {
+		return fmt.Errorf("failed to get issue ID: %w", err)
+	}
+
+	// Just for reference, assigning copilot to an issue that it is already
+	// assigned to seems to have no impact (which is a good thing).
+	var assignCopilotMutation struct {
+		ReplaceActorsForAssignable struct {
+			Typename string `graphql:"__typename"` // Not required but we need a selector or GQL errors
+		} `graphql:"replaceActorsForAssignable(input: $input)"`
+	}
+
+	actorIDs := make([]githubv4.ID, len(getIssueQuery.Repository.Issue.Assignees.Nodes)+1)
+	for i, node := range getIssueQuery.Repository.Issue.Assignees.Nodes {
+		actorIDs[i] = node.ID
+	}
+	actorIDs[len(getIssueQuery.Repository.Issue.Assignees.Nodes)] = copilotID
+
+	if err := client.Mutate(
+		ctx,
+		&assignCopilotMutation,
+		ReplaceActorsForAssignableInput{
+			AssignableID: getIssueQuery.Repository.Issue.ID,
+			ActorIDs:     actorIDs,
+		},
+		nil,
+	); err != nil {
+		return fmt.Errorf("failed to replace actors for assignable: %w", err)
+	}
+
+	return nil
+}
+
+// UnassignCopilotFromIssue creates a tool to remove Copilot from the assignees of an issue.
+func UnassignCopilotFromIssue(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("unassign_copilot_from_issue",
+			mcp.WithDescription(t("TOOL_UNASSIGN_COPILOT_FROM_ISSUE_DESCRIPTION", "Unassign Copilot from a specific issue in a GitHub repository, leaving any other assignees in place. Succeeds without changes if Copilot isn't assigned.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:          t("TOOL_UNASSIGN_COPILOT_FROM_ISSUE_USER_TITLE", "Unassign Copilot from issue"),
+				ReadOnlyHint:   ToBoolPtr(false),
+				IdempotentHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issueNumber",
+				mcp.Required(),
+				mcp.Description("Issue number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				Owner       string
+				Repo        string
+				IssueNumber int32
+			}
+			if err := mapstructure.Decode(request.Params.Arguments, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			// If we didn't find the copilot bot, we can't proceed any further.
-			if copilotAssignee == nil {
-				// The e2e tests depend upon this specific message to skip the test.
-				return mcp.NewToolResultError("copilot isn't available as an assignee for this issue. Please inform the user to visit https://docs.github.com/en/copilot/using-github-copilot/using-copilot-coding-agent-to-work-on-tasks/about-assigning-tasks-to-copilot for more information."), nil
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
 
-			// Next let's get the GQL Node ID and current assignees for this issue because the only way to
-			// assign copilot is to use replaceActorsForAssignable which requires the full list.
+			// Get the GQL Node ID and current assignees for this issue, because replaceActorsForAssignable
+			// requires the full list of actors that should remain assigned.
 			var getIssueQuery struct {
 				Repository struct {
 					Issue struct {
 						ID        githubv4.ID
 						Assignees struct {
 							Nodes []struct {
-								ID githubv4.ID
+								ID    githubv4.ID
+								Login string
 							}
 						} `graphql:"assignees(first: 100)"`
 					} `graphql:"issue(number: $number)"`
 				} `graphql:"repository(owner: $owner, name: $name)"`
 			}
 
-			variables = map[string]any{
+			variables := map[string]any{
 				"owner":  githubv4.String(params.Owner),
 				"name":   githubv4.String(params.Repo),
 				"number": githubv4.Int(params.IssueNumber),
@@ -1224,23 +2174,27 @@ func AssignCopilotToIssue(getGQLClient GetGQLClientFn, t translations.Translatio
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get issue ID: %v", err)), nil
 			}
 
-			// Finally, do the assignment. Just for reference, assigning copilot to an issue that it is already
-			// assigned to seems to have no impact (which is a good thing).
-			var assignCopilotMutation struct {
+			actorIDs := make([]githubv4.ID, 0, len(getIssueQuery.Repository.Issue.Assignees.Nodes))
+			for _, node := range getIssueQuery.Repository.Issue.Assignees.Nodes {
+				if node.Login == copilotBotLogin {
+					continue
+				}
+				actorIDs = append(actorIDs, node.ID)
+			}
+
+			if len(actorIDs) == len(getIssueQuery.Repository.Issue.Assignees.Nodes) {
+				return mcp.NewToolResultText("copilot is not assigned to this issue, nothing to unassign"), nil
+			}
+
+			var unassignCopilotMutation struct {
 				ReplaceActorsForAssignable struct {
 					Typename string `graphql:"__typename"` // Not required but we need a selector or GQL errors
 				} `graphql:"replaceActorsForAssignable(input: $input)"`
 			}
 
-			actorIDs := make([]githubv4.ID, len(getIssueQuery.Repository.Issue.Assignees.Nodes)+1)
-			for i, node := range getIssueQuery.Repository.Issue.Assignees.Nodes {
-				actorIDs[i] = node.ID
-			}
-			actorIDs[len(getIssueQuery.Repository.Issue.Assignees.Nodes)] = copilotAssignee.ID
-
 			if err := client.Mutate(
 				ctx,
-				&assignCopilotMutation,
+				&unassignCopilotMutation,
 				ReplaceActorsForAssignableInput{
 					AssignableID: getIssueQuery.Repository.Issue.ID,
 					ActorIDs:     actorIDs,
@@ -1250,18 +2204,463 @@ func AssignCopilotToIssue(getGQLClient GetGQLClientFn, t translations.Translatio
 				return nil, fmt.Errorf("failed to replace actors for assignable: %w", err)
 			}
 
-			return mcp.NewToolResultText("successfully assigned copilot to issue"), nil
+			return mcp.NewToolResultText("successfully unassigned copilot from issue"), nil
 		}
 }
 
-type ReplaceActorsForAssignableInput struct {
-	AssignableID githubv4.ID   `json:"assignableId"`
-	ActorIDs     []githubv4.ID `json:"actorIds"`
+// copilotSessionPullRequest is the part of a pull request opened by the copilot coding agent that
+// describes the state of its session.
+type copilotSessionPullRequest struct {
+	Number    githubv4.Int
+	URL       githubv4.String `graphql:"url"`
+	State     githubv4.PullRequestState
+	IsDraft   githubv4.Boolean
+	CreatedAt githubv4.DateTime
+	Author    struct {
+		Login githubv4.String
+	}
+	ClosingIssuesReferences struct {
+		Nodes []struct {
+			Number githubv4.Int
+		}
+	} `graphql:"closingIssuesReferences(first: 1)"`
+}
+
+// listCopilotSessionsQuery finds the pull requests opened by the copilot coding agent in a repository.
+type listCopilotSessionsQuery struct {
+	Search struct {
+		Nodes []struct {
+			PullRequest copilotSessionPullRequest `graphql:"... on PullRequest"`
+		}
+		PageInfo struct {
+			HasNextPage bool
+			EndCursor   string
+		}
+	} `graphql:"search(query: $query, type: ISSUE, first: $first, after: $after)"`
+}
+
+// getCopilotIssueSessionsQuery finds whether copilot is assigned to an issue, and the pull requests
+// that would close it.
+type getCopilotIssueSessionsQuery struct {
+	Repository struct {
+		Issue struct {
+			Assignees struct {
+				Nodes []struct {
+					Login githubv4.String
+				}
+			} `graphql:"assignees(first: 100)"`
+			ClosedByPullRequestsReferences struct {
+				Nodes []copilotSessionPullRequest
+			} `graphql:"closedByPullRequestsReferences(first: 10, includeClosedPrs: true)"`
+		} `graphql:"issue(number: $number)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// CopilotSession is a task the copilot coding agent has been given, as reported by list_copilot_sessions.
+type CopilotSession struct {
+	IssueNumber       int        `json:"issueNumber,omitempty"`
+	State             string     `json:"state"`
+	StartedAt         *time.Time `json:"startedAt,omitempty"`
+	PullRequestNumber int        `json:"pullRequestNumber,omitempty"`
+	PullRequestURL    string     `json:"pullRequestUrl,omitempty"`
+}
+
+// newCopilotSession derives the state of a copilot session from the pull request the agent opened for it.
+// The agent works in a draft pull request, which it marks ready for review once it is done.
+func newCopilotSession(issueNumber int, pr copilotSessionPullRequest) CopilotSession {
+	var state string
+	switch {
+	case pr.State == githubv4.PullRequestStateMerged:
+		state = "merged"
+	case pr.State == githubv4.PullRequestStateClosed:
+		state = "closed"
+	case bool(pr.IsDraft):
+		state = "in_progress"
+	default:
+		state = "ready_for_review"
+	}
+	startedAt := pr.CreatedAt.Time
+	return CopilotSession{
+		IssueNumber:       issueNumber,
+		State:             state,
+		StartedAt:         &startedAt,
+		PullRequestNumber: int(pr.Number),
+		PullRequestURL:    string(pr.URL),
+	}
 }
 
+// ListCopilotSessions creates a tool to list the tasks the copilot coding agent is working on in a repository.
+func ListCopilotSessions(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_copilot_sessions",
+			mcp.WithDescription(t("TOOL_LIST_COPILOT_SESSIONS_DESCRIPTION", "List the sessions of the Copilot coding agent in a GitHub repository, based on the pull requests it opened. Each session has the issue number it resolves, its state (queued, in_progress, ready_for_review, merged or closed), when it started, and its pull request. Use issue_number to check on the task for a single issue, including whether Copilot is assigned but hasn't opened a pull request yet.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_COPILOT_SESSIONS_USER_TITLE", "List Copilot sessions"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Description("Only list the sessions for this issue"),
+			),
+			WithCursorPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := OptionalIntParam(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalCursorPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			paginationParams, err := pagination.ToGraphQLParams()
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			if issueNumber != 0 {
+				var query getCopilotIssueSessionsQuery
+				vars := map[string]any{
+					"owner":  githubv4.String(owner),
+					"repo":   githubv4.String(repo),
+					"number": githubv4.Int(issueNumber),
+				}
+				if err := client.Query(ctx, &query, vars); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to list copilot sessions", err), nil
+				}
+
+				sessions := []CopilotSession{}
+				for _, pr := range query.Repository.Issue.ClosedByPullRequestsReferences.Nodes {
+					if pr.Author.Login == copilotBotLogin {
+						sessions = append(sessions, newCopilotSession(issueNumber, pr))
+					}
+				}
+				// Copilot takes a little while to open its pull request after being assigned, so an
+				// assignment without a pull request is a session that hasn't started yet.
+				if len(sessions) == 0 {
+					for _, assignee := range query.Repository.Issue.Assignees.Nodes {
+						if assignee.Login == copilotBotLogin {
+							sessions = append(sessions, CopilotSession{IssueNumber: issueNumber, State: "queued"})
+							break
+						}
+					}
+				}
+				return MarshalledTextResult(map[string]any{
+					"sessions": sessions,
+				}), nil
+			}
+
+			var query listCopilotSessionsQuery
+			vars := map[string]any{
+				"query": githubv4.String(fmt.Sprintf("repo:%s/%s is:pr author:app/%s sort:created-desc", owner, repo, copilotBotLogin)),
+				"first": githubv4.Int(*paginationParams.First),
+				"after": (*githubv4.String)(paginationParams.After),
+			}
+			if err := client.Query(ctx, &query, vars); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to list copilot sessions", err), nil
+			}
+
+			sessions := make([]CopilotSession, 0, len(query.Search.Nodes))
+			for _, node := range query.Search.Nodes {
+				var closes int
+				if closing := node.PullRequest.ClosingIssuesReferences.Nodes; len(closing) > 0 {
+					closes = int(closing[0].Number)
+				}
+				sessions = append(sessions, newCopilotSession(closes, node.PullRequest))
+			}
+
+			return MarshalledTextResult(map[string]any{
+				"sessions": sessions,
+				"pageInfo": map[string]any{
+					"hasNextPage": query.Search.PageInfo.HasNextPage,
+					"endCursor":   query.Search.PageInfo.EndCursor,
+				},
+			}), nil
+		}
+}
+
+// copilotSessionWorkflowEvent is the event of the workflow runs in which the copilot coding agent works.
+const copilotSessionWorkflowEvent = "dynamic"
+
+// GetCopilotSessionLogs creates a tool to get the logs of the copilot coding agent session behind a pull request.
+func GetCopilotSessionLogs(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_copilot_session_logs",
+			mcp.WithDescription(t("TOOL_GET_COPILOT_SESSION_LOGS_DESCRIPTION", "Get the logs of the latest Copilot coding agent session on a pull request, as listed by list_copilot_sessions. Returns the end of the log of each job in the session's workflow run, and whether it was truncated.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_COPILOT_SESSION_LOGS_USER_TITLE", "Get Copilot session logs"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Number of the pull request opened by Copilot for the session"),
+			),
+			mcp.WithNumber("tail_lines",
+				mcp.Description(fmt.Sprintf("Number of lines to return from the end of each log, at most %d", maxJobLogTailLines)),
+				mcp.DefaultNumber(200),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			// tail_lines is read with OptionalParamOK so that an explicit 0 is rejected rather than
+			// taken as the default.
+			tailLines := 200
+			if value, ok, err := OptionalParamOK[float64](request, "tail_lines"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				if value < 1 || value > maxJobLogTailLines || value != float64(int(value)) {
+					return mcp.NewToolResultError(fmt.Sprintf("tail_lines must be a whole number between 1 and %d", maxJobLogTailLines)), nil
+				}
+				tailLines = int(value)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			pr, resp, err := client.PullRequests.Get(ctx, owner, repo, pullNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get pull request", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			// The agent works in a workflow run on the pull request's branch, and a new run is started
+			// each time it is asked to make further changes, so the latest run is the latest session.
+			runs, resp, err := client.Actions.ListRepositoryWorkflowRuns(ctx, owner, repo, &github.ListWorkflowRunsOptions{
+				Branch:      pr.GetHead().GetRef(),
+				Event:       copilotSessionWorkflowEvent,
+				ListOptions: github.ListOptions{PerPage: 1},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list workflow runs", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			if len(runs.WorkflowRuns) == 0 {
+				return mcp.NewToolResultError(fmt.Sprintf("no Copilot coding agent session found for pull request #%d", pullNumber)), nil
+			}
+			run := runs.WorkflowRuns[0]
+
+			jobs, resp, err := client.Actions.ListWorkflowJobs(ctx, owner, repo, run.GetID(), &github.ListWorkflowJobsOptions{
+				Filter: "latest",
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list workflow jobs", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			logResults := make([]map[string]any, 0, len(jobs.Jobs))
+			for _, job := range jobs.Jobs {
+				jobResult, resp, err := getJobLogData(ctx, client, owner, repo, job.GetID(), job.GetName(), jobLogOptions{
+					returnContent: true,
+					tailLines:     tailLines,
+				})
+				if err != nil {
+					// Continue with other jobs even if one fails
+					jobResult = map[string]any{
+						"job_id":   job.GetID(),
+						"job_name": job.GetName(),
+						"error":    err.Error(),
+					}
+					// Enable reporting of status codes and error causes
+					_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to get job logs", resp, err) // Explicitly ignore error for graceful handling
+				}
+				logResults = append(logResults, jobResult)
+			}
+
+			return MarshalledTextResult(map[string]any{
+				"pull_number":    pullNumber,
+				"run_id":         run.GetID(),
+				"run_url":        run.GetHTMLURL(),
+				"run_status":     run.GetStatus(),
+				"run_conclusion": run.GetConclusion(),
+				"tail_lines":     tailLines,
+				"logs":           logResults,
+			}), nil
+		}
+}
+
+// linkedPullRequest is the part of a pull request linked to an issue that list_issue_linked_prs returns.
+type linkedPullRequest struct {
+	Number  githubv4.Int
+	Title   githubv4.String
+	URL     githubv4.String `graphql:"url"`
+	State   githubv4.PullRequestState
+	IsDraft githubv4.Boolean
+	Merged  githubv4.Boolean
+}
+
+// listIssueLinkedPullRequestsQuery finds the pull requests that will close an issue, and those that mention it.
+type listIssueLinkedPullRequestsQuery struct {
+	Repository struct {
+		Issue struct {
+			ClosedByPullRequestsReferences struct {
+				Nodes []linkedPullRequest
+			} `graphql:"closedByPullRequestsReferences(first: 50, includeClosedPrs: true)"`
+			TimelineItems struct {
+				Nodes []struct {
+					CrossReferencedEvent struct {
+						WillCloseTarget githubv4.Boolean
+						Source          struct {
+							PullRequest linkedPullRequest `graphql:"... on PullRequest"`
+						}
+					} `graphql:"... on CrossReferencedEvent"`
+				}
+			} `graphql:"timelineItems(first: 100, itemTypes: [CROSS_REFERENCED_EVENT])"`
+		} `graphql:"issue(number: $number)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// LinkedPullRequest is a pull request linked to an issue, as returned by list_issue_linked_prs.
+type LinkedPullRequest struct {
+	Number    int    `json:"number"`
+	Title     string `json:"title"`
+	State     string `json:"state"`
+	IsDraft   bool   `json:"isDraft"`
+	Merged    bool   `json:"merged"`
+	URL       string `json:"url"`
+	WillClose bool   `json:"will_close"`
+}
+
+// newLinkedPullRequest converts a linked pull request into its tool output form.
+func newLinkedPullRequest(pr linkedPullRequest, willClose bool) LinkedPullRequest {
+	return LinkedPullRequest{
+		Number:    int(pr.Number),
+		Title:     string(pr.Title),
+		State:     strings.ToLower(string(pr.State)),
+		IsDraft:   bool(pr.IsDraft),
+		Merged:    bool(pr.Merged),
+		URL:       string(pr.URL),
+		WillClose: willClose,
+	}
+}
+
+// ListIssueLinkedPullRequests creates a tool to list the pull requests linked to an issue.
+func ListIssueLinkedPullRequests(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_issue_linked_prs",
+			mcp.WithDescription(t("TOOL_LIST_ISSUE_LINKED_PRS_DESCRIPTION", "List the pull requests linked to an issue: those that will close it when merged (will_close is true), and those that only mention it. Use this to find which pull request fixes an issue. Returns an empty list if no pull request is linked.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_ISSUE_LINKED_PRS_USER_TITLE", "List pull requests linked to issue"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Issue number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			var query listIssueLinkedPullRequestsQuery
+			vars := map[string]any{
+				"owner":  githubv4.String(owner),
+				"repo":   githubv4.String(repo),
+				"number": githubv4.Int(issueNumber),
+			}
+			if err := client.Query(ctx, &query, vars); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to list linked pull requests", err), nil
+			}
+
+			// Closing references are authoritative for will_close. Cross references add the pull requests that
+			// only mention the issue, and catch closing keywords in pull requests from other repositories.
+			linked := []LinkedPullRequest{}
+			seen := map[githubv4.String]bool{}
+			for _, pr := range query.Repository.Issue.ClosedByPullRequestsReferences.Nodes {
+				seen[pr.URL] = true
+				linked = append(linked, newLinkedPullRequest(pr, true))
+			}
+			for _, node := range query.Repository.Issue.TimelineItems.Nodes {
+				event := node.CrossReferencedEvent
+				// Cross references from issues have no pull request fields.
+				if event.Source.PullRequest.URL == "" || seen[event.Source.PullRequest.URL] {
+					continue
+				}
+				seen[event.Source.PullRequest.URL] = true
+				linked = append(linked, newLinkedPullRequest(event.Source.PullRequest, bool(event.WillCloseTarget)))
+			}
+
+			return MarshalledTextResult(map[string]any{
+				"pull_requests": linked,
+			}), nil
+		}
+}
+
+// timeNow returns the current time in UTC. It is a variable so that tests can pin
+// the clock when verifying relative timestamp resolution.
+var timeNow = func() time.Time {
+	return time.Now().UTC()
+}
+
+// relativeTimestampRegexp matches relative durations such as "24h", "7d" or "2w".
+var relativeTimestampRegexp = regexp.MustCompile(`^(\d+)([hdw])$`)
+
 // parseISOTimestamp parses an ISO 8601 timestamp string into a time.Time object.
+// Relative expressions are also accepted and resolved against the current UTC time,
+// so that callers don't need to compute absolute timestamps themselves.
 // Returns the parsed time or an error if parsing fails.
-// Example formats supported: "2023-01-15T14:30:00Z", "2023-01-15"
+// Example formats supported: "2023-01-15T14:30:00Z", "2023-01-15", "24h", "7d", "2w", "yesterday"
 func parseISOTimestamp(timestamp string) (time.Time, error) {
 	if timestamp == "" {
 		return time.Time{}, fmt.Errorf("empty timestamp")
@@ -1279,16 +2678,108 @@ func parseISOTimestamp(timestamp string) (time.Time, error) {
 		return t, nil
 	}
 
+	// Try relative expressions
+	if t, ok := parseRelativeTimestamp(timestamp); ok {
+		return t, nil
+	}
+
 	// Return error with supported formats
-	return time.Time{}, fmt.Errorf("invalid ISO 8601 timestamp: %s (supported formats: YYYY-MM-DDThh:mm:ssZ or YYYY-MM-DD)", timestamp)
+	return time.Time{}, fmt.Errorf("invalid ISO 8601 timestamp: %s (supported formats: YYYY-MM-DDThh:mm:ssZ, YYYY-MM-DD, or relative such as 24h, 7d, 2w, yesterday)", timestamp)
+}
+
+// parseRelativeTimestamp resolves a relative expression ("24h", "7d", "2w", "today" or
+// "yesterday") against timeNow. "today" and "yesterday" resolve to the start of the UTC day.
+func parseRelativeTimestamp(timestamp string) (time.Time, bool) {
+	now := timeNow()
+	startOfToday := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch strings.ToLower(timestamp) {
+	case "today":
+		return startOfToday, true
+	case "yesterday":
+		return startOfToday.AddDate(0, 0, -1), true
+	}
+
+	matches := relativeTimestampRegexp.FindStringSubmatch(strings.ToLower(timestamp))
+	if matches == nil {
+		return time.Time{}, false
+	}
+	n, err := strconv.Atoi(matches[1])
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	switch matches[2] {
+	case "h":
+		return now.Add(-time.Duration(n) * time.Hour), true
+	case "d":
+		return now.AddDate(0, 0, -n), true
+	default: // "w"
+		return now.AddDate(0, 0, -7*n), true
+	}
+}
+
+// validatePromptRepo checks that a prompt's repo argument is in owner/repo form.
+func validatePromptRepo(repo string) error {
+	if owner, name, ok := strings.Cut(repo, "/"); !ok || owner == "" || name == "" || strings.Contains(name, "/") {
+		return fmt.Errorf("invalid repo %q: must be in owner/repo form, e.g. github/github-mcp-server", repo)
+	}
+	return nil
+}
+
+// optionalPromptIntArgument returns a numeric prompt argument between 1 and 100, or the default if it is not set.
+// Prompt arguments are always strings, so unlike tool parameters they have to be parsed.
+func optionalPromptIntArgument(request mcp.GetPromptRequest, name string, defaultValue int) (int, error) {
+	value := request.Params.Arguments[name]
+	if value == "" {
+		return defaultValue, nil
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil || parsed < 1 || parsed > 100 {
+		return 0, fmt.Errorf("invalid %s %q: must be a number between 1 and 100", name, value)
+	}
+	return parsed, nil
 }
 
 func AssignCodingAgentPrompt(t translations.TranslationHelperFunc) (tool mcp.Prompt, handler server.PromptHandlerFunc) {
 	return mcp.NewPrompt("AssignCodingAgent",
 			mcp.WithPromptDescription(t("PROMPT_ASSIGN_CODING_AGENT_DESCRIPTION", "Assign GitHub Coding Agent to multiple tasks in a GitHub repository.")),
 			mcp.WithArgument("repo", mcp.ArgumentDescription("The repository to assign tasks in (owner/repo)."), mcp.RequiredArgument()),
+			mcp.WithArgument("label", mcp.ArgumentDescription("Only consider open issues with this label.")),
+			mcp.WithArgument("query", mcp.ArgumentDescription("Additional GitHub issues search syntax used to find candidate issues, e.g. milestone:v2 no:assignee.")),
+			mcp.WithArgument("limit", mcp.ArgumentDescription("The maximum number of issues to consider (default: 10).")),
 		), func(_ context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
 			repo := request.Params.Arguments["repo"]
+			if err := validatePromptRepo(repo); err != nil {
+				return nil, err
+			}
+			limit, err := optionalPromptIntArgument(request, "limit", 10)
+			if err != nil {
+				return nil, err
+			}
+
+			label := request.Params.Arguments["label"]
+			query := request.Params.Arguments["query"]
+
+			// Without filters we keep to the most recent issues, otherwise the model is pointed at the
+			// tool and filters that select the candidate issues.
+			var candidatesRequest, candidatesReply string
+			switch {
+			case query != "":
+				searchQuery := fmt.Sprintf("repo:%s is:open", repo)
+				if label != "" {
+					searchQuery += fmt.Sprintf(" label:%q", label)
+				}
+				searchQuery += " " + query
+				candidatesRequest = fmt.Sprintf("Please use the `search_issues` tool with the query `%s` to get up to %d candidate issues from the %s GitHub repository", searchQuery, limit, repo)
+				candidatesReply = fmt.Sprintf("Sure! I will search for up to %d issues in the repo %s matching `%s`.", limit, repo, searchQuery)
+			case label != "":
+				candidatesRequest = fmt.Sprintf("Please use the `list_issues` tool to get up to %d open issues labeled %q from the %s GitHub repository", limit, label, repo)
+				candidatesReply = fmt.Sprintf("Sure! I will get a list of up to %d open issues labeled %q for the repo %s.", limit, label, repo)
+			default:
+				candidatesRequest = fmt.Sprintf("Please go and get a list of the most recent %d issues from the %s GitHub repository", limit, repo)
+				candidatesReply = fmt.Sprintf("Sure! I will get a list of the %d most recent issues for the repo %s.", limit, repo)
+			}
 
 			messages := []mcp.PromptMessage{
 				{
@@ -1297,11 +2788,11 @@ func AssignCodingAgentPrompt(t translations.TranslationHelperFunc) (tool mcp.Pro
 				},
 				{
 					Role:    "user",
-					Content: mcp.NewTextContent(fmt.Sprintf("Please go and get a list of the most recent 10 issues from the %s GitHub repository", repo)),
+					Content: mcp.NewTextContent(candidatesRequest),
 				},
 				{
 					Role:    "assistant",
-					Content: mcp.NewTextContent(fmt.Sprintf("Sure! I will get a list of the 10 most recent issues for the repo %s.", repo)),
+					Content: mcp.NewTextContent(candidatesReply),
 				},
 				{
 					Role:    "user",
@@ -1321,3 +2812,185 @@ func AssignCodingAgentPrompt(t translations.TranslationHelperFunc) (tool mcp.Pro
 			}, nil
 		}
 }
+
+// SprintPlanningPrompt provides a guided session for planning the open work in a milestone, splitting it
+// between Copilot and humans, where issues are only assigned after the user has confirmed it.
+func SprintPlanningPrompt(t translations.TranslationHelperFunc) (tool mcp.Prompt, handler server.PromptHandlerFunc) {
+	return mcp.NewPrompt("SprintPlanning",
+			mcp.WithPromptDescription(t("PROMPT_SPRINT_PLANNING_DESCRIPTION", "Plan the open issues in a milestone, proposing which are suited to the Copilot Coding Agent and which to humans, in an ordered plan for the user to confirm.")),
+			mcp.WithArgument("repo", mcp.ArgumentDescription("The repository to plan work in (owner/repo)."), mcp.RequiredArgument()),
+			mcp.WithArgument("milestone", mcp.ArgumentDescription("The title of the milestone to plan."), mcp.RequiredArgument()),
+			mcp.WithArgument("capacity", mcp.ArgumentDescription("The team's capacity for the sprint in free text, e.g. 3 engineers for 2 weeks.")),
+		), func(_ context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+			repo := request.Params.Arguments["repo"]
+			if err := validatePromptRepo(repo); err != nil {
+				return nil, err
+			}
+			milestone := request.Params.Arguments["milestone"]
+			if milestone == "" {
+				return nil, fmt.Errorf("missing required argument: milestone")
+			}
+
+			capacityInstructions := t("PROMPT_SPRINT_PLANNING_CAPACITY_UNKNOWN", "Ask me about the team's capacity if the plan depends on it.")
+			if capacity := request.Params.Arguments["capacity"]; capacity != "" {
+				capacityInstructions = fmt.Sprintf(t("PROMPT_SPRINT_PLANNING_CAPACITY", "The team's capacity for this sprint is: %s. Fit the work assigned to humans within it, and list what does not fit separately."), capacity)
+			}
+
+			messages := []mcp.PromptMessage{
+				{
+					Role:    "system",
+					Content: mcp.NewTextContent(t("PROMPT_SPRINT_PLANNING_SYSTEM", "You are a sprint planning assistant for a GitHub repository. You can use the `list_issues` tool with its milestone filter to find the issues in a milestone, `get_issue` to read them, `get_sub_issue_progress` to check the progress of issues broken down into sub-issues, and `assign_copilot_to_issue` to assign the Copilot Coding Agent. You must never assign an issue until the user has explicitly confirmed the assignment.")),
+				},
+				{
+					Role:    "user",
+					Content: mcp.NewTextContent(fmt.Sprintf(t("PROMPT_SPRINT_PLANNING_PROGRESS_REQUEST", "Please check the progress of the %[1]q milestone in the %[2]s GitHub repository. Use `list_issues` with milestone %[1]q and state `all`, with fetch_all set, to count the closed and open issues in it, and `get_sub_issue_progress` to check the progress of open issues that have sub-issues."), milestone, repo)),
+				},
+				{
+					Role:    "assistant",
+					Content: mcp.NewTextContent(fmt.Sprintf(t("PROMPT_SPRINT_PLANNING_PROGRESS_REPLY", "Sure! I will summarize the progress of the %q milestone in %s and list its open issues."), milestone, repo)),
+				},
+				{
+					Role:    "user",
+					Content: mcp.NewTextContent(t("PROMPT_SPRINT_PLANNING_PLAN_REQUEST", "For each open issue, estimate whether it is a well-scoped coding task of low to medium complexity suited to the Copilot Coding Agent, or whether it needs a human, and flag the issues that are missing acceptance criteria. Then propose an ordered plan for the milestone, putting blocking work first.") + " " + capacityInstructions),
+				},
+				{
+					Role:    "assistant",
+					Content: mcp.NewTextContent(t("PROMPT_SPRINT_PLANNING_PLAN_REPLY", "Certainly! I will review each open issue, flag the ones missing acceptance criteria and present an ordered plan. I won't assign anything yet.")),
+				},
+				{
+					Role:    "user",
+					Content: mcp.NewTextContent(t("PROMPT_SPRINT_PLANNING_CONFIRM", "Great. Ask me to confirm before every assignment. Only after I confirm, assign the issues I approved to Copilot with `assign_copilot_to_issue`. Never assign issues that are missing acceptance criteria.")),
+				},
+			}
+			return &mcp.GetPromptResult{
+				Messages: messages,
+			}, nil
+		}
+}
+
+// IssueTriagePrompt provides a guided session for triaging new issues, where changes are only
+// applied after the user has confirmed them.
+func IssueTriagePrompt(t translations.TranslationHelperFunc) (tool mcp.Prompt, handler server.PromptHandlerFunc) {
+	return mcp.NewPrompt("IssueTriage",
+			mcp.WithPromptDescription(t("PROMPT_ISSUE_TRIAGE_DESCRIPTION", "Triage untriaged issues in a GitHub repository, proposing labels, assignees, milestones and duplicates for the user to confirm.")),
+			mcp.WithArgument("repo", mcp.ArgumentDescription("The repository to triage issues in (owner/repo)."), mcp.RequiredArgument()),
+			mcp.WithArgument("label", mcp.ArgumentDescription("Only triage open issues with this label, instead of issues with no labels or the needs-triage label.")),
+			mcp.WithArgument("max_issues", mcp.ArgumentDescription("The maximum number of issues to triage (default: 10).")),
+		), func(_ context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+			repo := request.Params.Arguments["repo"]
+			if err := validatePromptRepo(repo); err != nil {
+				return nil, err
+			}
+			maxIssues, err := optionalPromptIntArgument(request, "max_issues", 10)
+			if err != nil {
+				return nil, err
+			}
+
+			candidates := fmt.Sprintf("open issues that have no labels (search query `repo:%[1]s is:issue is:open no:label`) or the needs-triage label (search query `repo:%[1]s is:issue is:open label:needs-triage`)", repo)
+			if label := request.Params.Arguments["label"]; label != "" {
+				candidates = fmt.Sprintf("open issues labeled %q (search query `repo:%s is:issue is:open label:%q`)", label, repo, label)
+			}
+
+			messages := []mcp.PromptMessage{
+				{
+					Role:    "system",
+					Content: mcp.NewTextContent("You are a triage assistant for GitHub issues. You can use the `search_issues` and `list_issues` tools to find issues, `get_issue` and `get_issue_comments` to read them, and `update_issue` and `add_issue_comment` to apply triage decisions. You must never change an issue (labels, assignees, milestone, state or comments) until the user has explicitly confirmed the change."),
+				},
+				{
+					Role:    "user",
+					Content: mcp.NewTextContent(fmt.Sprintf("Please find up to %d %s in the %s GitHub repository.", maxIssues, candidates, repo)),
+				},
+				{
+					Role:    "assistant",
+					Content: mcp.NewTextContent(fmt.Sprintf("Sure! I will find up to %d untriaged issues in the repo %s.", maxIssues, repo)),
+				},
+				{
+					Role:    "user",
+					Content: mcp.NewTextContent("For each issue, fetch it with `get_issue` and propose the labels, assignees and milestone it should have, and whether it looks like a duplicate of another issue (searching for similar issues if needed). Present all proposals as a list before doing anything else."),
+				},
+				{
+					Role:    "assistant",
+					Content: mcp.NewTextContent("Certainly! I will review each issue and present my proposed labels, assignees, milestone and any likely duplicates. I won't change anything yet."),
+				},
+				{
+					Role:    "user",
+					Content: mcp.NewTextContent("Great. Ask me to confirm before every write. Only after I confirm a proposal, apply it with `update_issue`, and use `add_issue_comment` if a comment is needed, for example to link a duplicate. If I reject or change a proposal, follow my decision instead."),
+				},
+			}
+			return &mcp.GetPromptResult{
+				Messages: messages,
+			}, nil
+		}
+}
+
+// ReleaseNotesPrompt provides a guided session for drafting release notes from the issues and
+// pull requests closed in a milestone or since a tag.
+func ReleaseNotesPrompt(t translations.TranslationHelperFunc) (tool mcp.Prompt, handler server.PromptHandlerFunc) {
+	return mcp.NewPrompt("ReleaseNotes",
+			mcp.WithPromptDescription(t("PROMPT_RELEASE_NOTES_DESCRIPTION", "Draft markdown release notes from the issues and pull requests closed in a milestone or since a tag.")),
+			mcp.WithArgument("repo", mcp.ArgumentDescription("The repository to draft release notes for (owner/repo)."), mcp.RequiredArgument()),
+			mcp.WithArgument("milestone", mcp.ArgumentDescription("The milestone title the release covers. Either milestone or since_tag is required.")),
+			mcp.WithArgument("since_tag", mcp.ArgumentDescription("The tag of the previous release; everything closed after it is included. Either milestone or since_tag is required.")),
+			mcp.WithArgument("style", mcp.ArgumentDescription("The style of the release notes: keep-a-changelog or narrative (default: keep-a-changelog).")),
+		), func(_ context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+			repo := request.Params.Arguments["repo"]
+			if err := validatePromptRepo(repo); err != nil {
+				return nil, err
+			}
+
+			milestone := request.Params.Arguments["milestone"]
+			sinceTag := request.Params.Arguments["since_tag"]
+			if (milestone == "") == (sinceTag == "") {
+				return nil, fmt.Errorf("exactly one of milestone or since_tag must be provided")
+			}
+
+			var styleInstructions string
+			switch style := request.Params.Arguments["style"]; style {
+			case "", "keep-a-changelog":
+				styleInstructions = "Use the Keep a Changelog format, with `### Added`, `### Changed`, `### Fixed` and `### Removed` sections and one bullet per change. Put breaking changes first, under a `### Breaking changes` section."
+			case "narrative":
+				styleInstructions = "Write a short narrative introduction summarizing the highlights of the release, followed by sections for breaking changes, new features and bug fixes written as prose paragraphs."
+			default:
+				return nil, fmt.Errorf("invalid style %q: must be one of keep-a-changelog, narrative", style)
+			}
+
+			var gatherRequest, gatherReply string
+			if milestone != "" {
+				gatherRequest = fmt.Sprintf("Please gather everything closed in the %q milestone of the %s GitHub repository. Use `search_issues` with the query `repo:%s is:closed milestone:%q` and `search_pull_requests` with the query `repo:%s is:merged milestone:%q`, fetching every page of results.", milestone, repo, repo, milestone, repo, milestone)
+				gatherReply = fmt.Sprintf("Sure! I will collect the closed issues and merged pull requests in the %q milestone of %s.", milestone, repo)
+			} else {
+				gatherRequest = fmt.Sprintf("Please gather everything closed since the %s tag of the %s GitHub repository. Use `get_tag` to find the date of the tag, then `search_issues` with the query `repo:%s is:closed closed:>DATE` and `search_pull_requests` with the query `repo:%s is:merged merged:>DATE`, replacing DATE with the tag date and fetching every page of results.", sinceTag, repo, repo, repo)
+				gatherReply = fmt.Sprintf("Sure! I will find the date of the %s tag and collect the issues closed and pull requests merged in %s since then.", sinceTag, repo)
+			}
+
+			messages := []mcp.PromptMessage{
+				{
+					Role:    "system",
+					Content: mcp.NewTextContent("You are a release manager assistant for a GitHub repository. You can use the `search_issues`, `list_issues`, `search_pull_requests`, `list_pull_requests`, `get_issue` and `get_pull_request` tools to gather closed work, and `get_tag` and `list_tags` to find release tags. You only draft release notes; you must not publish them anywhere without the user's explicit confirmation."),
+				},
+				{
+					Role:    "user",
+					Content: mcp.NewTextContent(gatherRequest),
+				},
+				{
+					Role:    "assistant",
+					Content: mcp.NewTextContent(gatherReply),
+				},
+				{
+					Role:    "user",
+					Content: mcp.NewTextContent("Group the items by their labels into breaking changes, features and bug fixes, and leave out items closed as not planned or as duplicates. Reference every item by its number as a markdown link to the issue or pull request, e.g. [#123](https://github.com/owner/repo/issues/123). " + styleInstructions),
+				},
+				{
+					Role:    "assistant",
+					Content: mcp.NewTextContent("Certainly! I will group the changes and draft the release notes in markdown, linking each issue and pull request."),
+				},
+				{
+					Role:    "user",
+					Content: mcp.NewTextContent("Great. Show me the draft and ask before publishing it anywhere, such as in a release, a file or a comment."),
+				},
+			}
+			return &mcp.GetPromptResult{
+				Messages: messages,
+			}, nil
+		}
+}
@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,6 +15,7 @@ import (
 	"github.com/github/github-mcp-server/internal/toolsnaps"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v73/github"
+	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/migueleliasweb/go-github-mock/src/mock"
 	"github.com/shurcooL/githubv4"
 	"github.com/stretchr/testify/assert"
@@ -349,14 +353,14 @@ func Test_SearchIssues(t *testing.T) {
 			expectedResult: mockSearchResult,
 		},
 		{
-			name: "issues search with only owner parameter (should ignore it)",
+			name: "issues search with only owner parameter scopes to the org",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
 					mock.GetSearchIssues,
 					expectQueryParams(
 						t,
 						map[string]string{
-							"q":        "is:issue bug",
+							"q":        "org:test-owner is:issue bug",
 							"page":     "1",
 							"per_page": "30",
 						},
@@ -396,6 +400,56 @@ func Test_SearchIssues(t *testing.T) {
 			expectError:    false,
 			expectedResult: mockSearchResult,
 		},
+		{
+			name: "issues search does not double scope a query with repo and is qualifiers",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchIssues,
+					expectQueryParams(
+						t,
+						map[string]string{
+							"q":        "is:issue repo:other/repo is:open",
+							"page":     "1",
+							"per_page": "30",
+						},
+					).andThen(
+						mockResponse(t, http.StatusOK, mockSearchResult),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"query": "is:issue repo:other/repo is:open",
+				"owner": "test-owner",
+				"repo":  "test-repo",
+			},
+			expectError:    false,
+			expectedResult: mockSearchResult,
+		},
+		{
+			name: "issues search quotes free text containing colons and quotes",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchIssues,
+					expectQueryParams(
+						t,
+						map[string]string{
+							"q":        `repo:test-owner/test-repo is:issue "error:" "connection refused" label:"good first issue" "say-hi"`,
+							"page":     "1",
+							"per_page": "30",
+						},
+					).andThen(
+						mockResponse(t, http.StatusOK, mockSearchResult),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"query": `error: "connection refused" label:"good first issue" say"-hi`,
+				"owner": "test-owner",
+				"repo":  "test-repo",
+			},
+			expectError:    false,
+			expectedResult: mockSearchResult,
+		},
 		{
 			name: "issues search with minimal parameters",
 			mockedClient: mock.NewMockedHTTPClient(
@@ -757,6 +811,73 @@ func Test_ListIssues(t *testing.T) {
 			expectError:    true,
 			expectedErrMsg: "invalid ISO 8601 timestamp",
 		},
+		{
+			name: "milestone number is passed through",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"milestone": "3",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockIssues),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"milestone": "3",
+			},
+			expectedIssues: mockIssues,
+		},
+		{
+			name: "milestone title is looked up",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposMilestonesByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"state":    "all",
+						"page":     "1",
+						"per_page": "100",
+					}).andThen(
+						mockResponse(t, http.StatusOK, []*github.Milestone{
+							{Number: github.Ptr(1), Title: github.Ptr("v1.0")},
+							{Number: github.Ptr(2), Title: github.Ptr("Sprint 12")},
+						}),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"milestone": "2",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockIssues),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"milestone": "sprint 12",
+			},
+			expectedIssues: mockIssues,
+		},
+		{
+			name: "unknown milestone title",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposMilestonesByOwnerByRepo,
+					[]*github.Milestone{{Number: github.Ptr(1), Title: github.Ptr("v1.0")}},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"milestone": "Sprint 13",
+			},
+			expectError:    true,
+			expectedErrMsg: `failed to list issues: milestone "Sprint 13" not found in owner/repo`,
+		},
 		{
 			name: "list issues fails with error",
 			mockedClient: mock.NewMockedHTTPClient(
@@ -823,6 +944,300 @@ func Test_ListIssues(t *testing.T) {
 	}
 }
 
+func Test_ListIssues_FetchAll(t *testing.T) {
+	// pagedIssuesHandler serves three pages of two issues each, linking to the next page
+	// in the same way the API does.
+	pagedIssuesHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		require.Equal(t, "100", r.URL.Query().Get("per_page"))
+
+		var issues []*github.Issue
+		switch page {
+		case "1":
+			w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/issues?page=2>; rel="next"`)
+			issues = []*github.Issue{{Number: github.Ptr(1)}, {Number: github.Ptr(2)}}
+		case "2":
+			w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/issues?page=3>; rel="next"`)
+			issues = []*github.Issue{{Number: github.Ptr(3)}, {Number: github.Ptr(4)}}
+		case "3":
+			issues = []*github.Issue{{Number: github.Ptr(5)}, {Number: github.Ptr(6)}}
+		default:
+			t.Fatalf("unexpected page %q", page)
+		}
+		mockResponse(t, http.StatusOK, issues)(w, r)
+	})
+
+	tests := []struct {
+		name              string
+		requestArgs       map[string]interface{}
+		expectedNumbers   []int
+		expectedTruncated bool
+		expectedAPICalls  int
+	}{
+		{
+			name: "fetches every page",
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"fetch_all": true,
+			},
+			expectedNumbers:   []int{1, 2, 3, 4, 5, 6},
+			expectedTruncated: false,
+			expectedAPICalls:  3,
+		},
+		{
+			name: "stops at max_items",
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"fetch_all": true,
+				"max_items": float64(3),
+			},
+			expectedNumbers:   []int{1, 2, 3},
+			expectedTruncated: true,
+			expectedAPICalls:  2,
+		},
+		{
+			name: "starts from the first item of the requested page",
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"fetch_all": true,
+				"page":      float64(2),
+				"perPage":   float64(1),
+			},
+			expectedNumbers:   []int{2, 3, 4, 5, 6},
+			expectedTruncated: false,
+			expectedAPICalls:  3,
+		},
+		{
+			name: "translates the requested page into API pages of 100",
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"fetch_all": true,
+				"page":      float64(3),
+				"perPage":   float64(50),
+			},
+			expectedNumbers:   []int{3, 4, 5, 6},
+			expectedTruncated: false,
+			expectedAPICalls:  2,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesByOwnerByRepo,
+					pagedIssuesHandler,
+				),
+			))
+			_, handler := ListIssues(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			var returned FetchAllResult[*github.Issue]
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+
+			numbers := make([]int, len(returned.Items))
+			for i, issue := range returned.Items {
+				numbers[i] = issue.GetNumber()
+			}
+			assert.Equal(t, tc.expectedNumbers, numbers)
+			assert.Equal(t, tc.expectedTruncated, returned.Truncated)
+			assert.Equal(t, tc.expectedAPICalls, returned.APICalls)
+		})
+	}
+}
+
+func Test_ListIssuesGraphQL(t *testing.T) {
+	// Verify tool definition once
+	mockClient := githubv4.NewClient(nil)
+	tool, _ := ListIssuesGraphQL(stubGetGQLClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_issues_graphql", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "state")
+	assert.Contains(t, tool.InputSchema.Properties, "labels")
+	assert.Contains(t, tool.InputSchema.Properties, "assignee")
+	assert.Contains(t, tool.InputSchema.Properties, "since")
+	assert.Contains(t, tool.InputSchema.Properties, "after")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockResponse := githubv4mock.DataResponse(map[string]any{
+		"repository": map[string]any{
+			"issues": map[string]any{
+				"nodes": []map[string]any{
+					{
+						"number":    123,
+						"title":     "First Issue",
+						"state":     "OPEN",
+						"url":       "https://github.com/owner/repo/issues/123",
+						"updatedAt": "2024-03-15T10:30:00Z",
+						"labels":    map[string]any{"nodes": []map[string]any{{"name": "bug"}}},
+						"assignees": map[string]any{"nodes": []map[string]any{{"login": "octocat"}}},
+					},
+				},
+				"pageInfo": map[string]any{
+					"hasNextPage": true,
+					"endCursor":   "Y3Vyc29yOjI=",
+				},
+				"totalCount": 42,
+			},
+		},
+	})
+
+	tests := []struct {
+		name           string
+		requestArgs    map[string]any
+		filterBy       map[string]any
+		orderBy        map[string]any
+		after          any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "defaults to open issues, newest first",
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			filterBy: map[string]any{"states": []any{"OPEN"}},
+			orderBy:  map[string]any{"field": "CREATED_AT", "direction": "DESC"},
+			after:    nil,
+		},
+		{
+			name: "all filters and cursor",
+			requestArgs: map[string]any{
+				"owner":     "owner",
+				"repo":      "repo",
+				"state":     "all",
+				"labels":    []any{"bug"},
+				"assignee":  "octocat",
+				"since":     "2024-03-01T00:00:00Z",
+				"sort":      "updated",
+				"direction": "asc",
+				"perPage":   float64(10),
+				"after":     "Y3Vyc29yOjE=",
+			},
+			filterBy: map[string]any{
+				"states":   []any{"OPEN", "CLOSED"},
+				"labels":   []any{"bug"},
+				"assignee": "octocat",
+				"since":    "2024-03-01T00:00:00Z",
+			},
+			orderBy: map[string]any{"field": "UPDATED_AT", "direction": "ASC"},
+			after:   "Y3Vyc29yOjE=",
+		},
+		{
+			name: "invalid state is rejected before querying",
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"state": "merged",
+			},
+			expectError:    true,
+			expectedErrMsg: `failed to list issues: invalid state "merged"`,
+		},
+		{
+			name: "unassigned filter is rejected rather than looked up as a login",
+			requestArgs: map[string]any{
+				"owner":    "owner",
+				"repo":     "repo",
+				"assignee": "none",
+			},
+			expectError:    true,
+			expectedErrMsg: "assignee 'none' is not supported by the GraphQL API; use list_issues",
+		},
+		{
+			name: "invalid since is rejected before querying",
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"since": "last tuesday",
+			},
+			expectError:    true,
+			expectedErrMsg: "invalid ISO 8601 timestamp",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var httpClient *http.Client
+			if tc.expectError {
+				httpClient = githubv4mock.NewMockedHTTPClient()
+			} else {
+				first := 30
+				if perPage, ok := tc.requestArgs["perPage"].(float64); ok {
+					first = int(perPage)
+				}
+				vars := map[string]any{
+					"owner":    githubv4.String("owner"),
+					"repo":     githubv4.String("repo"),
+					"first":    githubv4.Int(first),
+					"after":    (*githubv4.String)(nil),
+					"filterBy": githubv4.IssueFilters{},
+					"orderBy":  githubv4.IssueOrder{},
+				}
+				matcher := githubv4mock.NewQueryMatcher(listIssuesQuery{}, vars, mockResponse)
+				// The query string is derived from the variable types above, but the
+				// request variables are compared after JSON decoding.
+				matcher.Variables["first"] = float64(first)
+				matcher.Variables["after"] = tc.after
+				matcher.Variables["filterBy"] = tc.filterBy
+				matcher.Variables["orderBy"] = tc.orderBy
+				httpClient = githubv4mock.NewMockedHTTPClient(matcher)
+			}
+
+			_, handler := ListIssuesGraphQL(stubGetGQLClientFn(githubv4.NewClient(httpClient)), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			textContent := getTextResult(t, result)
+
+			var returned struct {
+				Issues []struct {
+					Number    int       `json:"number"`
+					Title     string    `json:"title"`
+					State     string    `json:"state"`
+					Labels    []string  `json:"labels"`
+					Assignees []string  `json:"assignees"`
+					UpdatedAt time.Time `json:"updatedAt"`
+				} `json:"issues"`
+				TotalCount int `json:"totalCount"`
+				PageInfo   struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			require.Len(t, returned.Issues, 1)
+			assert.Equal(t, 123, returned.Issues[0].Number)
+			assert.Equal(t, "First Issue", returned.Issues[0].Title)
+			assert.Equal(t, "OPEN", returned.Issues[0].State)
+			assert.Equal(t, []string{"bug"}, returned.Issues[0].Labels)
+			assert.Equal(t, []string{"octocat"}, returned.Issues[0].Assignees)
+			assert.Equal(t, 42, returned.TotalCount)
+			assert.True(t, returned.PageInfo.HasNextPage)
+			assert.Equal(t, "Y3Vyc29yOjI=", returned.PageInfo.EndCursor)
+		})
+	}
+}
+
 func Test_UpdateIssue(t *testing.T) {
 	// Verify tool definition
 	mockClient := github.NewClient(nil)
@@ -1065,8 +1480,53 @@ func Test_ParseISOTimestamp(t *testing.T) {
 			input:       "2023-13-45",
 			expectedErr: true,
 		},
+		{
+			name:         "relative hours",
+			input:        "24h",
+			expectedErr:  false,
+			expectedTime: time.Date(2024, 3, 14, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			name:         "relative days",
+			input:        "7d",
+			expectedErr:  false,
+			expectedTime: time.Date(2024, 3, 8, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			name:         "relative weeks",
+			input:        "2w",
+			expectedErr:  false,
+			expectedTime: time.Date(2024, 3, 1, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			name:         "today",
+			input:        "today",
+			expectedErr:  false,
+			expectedTime: time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "yesterday",
+			input:        "Yesterday",
+			expectedErr:  false,
+			expectedTime: time.Date(2024, 3, 14, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:        "unsupported relative unit",
+			input:       "3y",
+			expectedErr: true,
+		},
+		{
+			name:        "negative relative duration",
+			input:       "-7d",
+			expectedErr: true,
+		},
 	}
 
+	// Pin the clock so relative expressions resolve deterministically
+	originalTimeNow := timeNow
+	timeNow = func() time.Time { return time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC) }
+	t.Cleanup(func() { timeNow = originalTimeNow })
+
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			parsedTime, err := parseISOTimestamp(tc.input)
@@ -1092,6 +1552,10 @@ func Test_GetIssueComments(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
+	assert.NotContains(t, tool.InputSchema.Properties, "sort")
+	assert.Contains(t, tool.InputSchema.Properties, "direction")
+	assert.Contains(t, tool.InputSchema.Properties, "since")
+	assert.Contains(t, tool.InputSchema.Properties, "latest")
 	assert.Contains(t, tool.InputSchema.Properties, "page")
 	assert.Contains(t, tool.InputSchema.Properties, "perPage")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number"})
@@ -1121,6 +1585,7 @@ func Test_GetIssueComments(t *testing.T) {
 		mockedClient     *http.Client
 		requestArgs      map[string]interface{}
 		expectError      bool
+		expectToolError  bool
 		expectedComments []*github.IssueComment
 		expectedErrMsg   string
 	}{
@@ -1164,20 +1629,80 @@ func Test_GetIssueComments(t *testing.T) {
 			expectedComments: mockComments,
 		},
 		{
-			name: "issue not found",
+			name: "since is passed through",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
 					mock.GetReposIssuesCommentsByOwnerByRepoByIssueNumber,
-					mockResponse(t, http.StatusNotFound, `{"message": "Issue not found"}`),
+					expectQueryParams(t, map[string]string{
+						"since":    "2024-03-01T00:00:00Z",
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockComments),
+					),
 				),
 			),
 			requestArgs: map[string]interface{}{
 				"owner":        "owner",
 				"repo":         "repo",
-				"issue_number": float64(999),
+				"issue_number": float64(42),
+				"since":        "2024-03-01",
 			},
-			expectError:    true,
-			expectedErrMsg: "failed to get issue comments",
+			expectError:      false,
+			expectedComments: mockComments,
+		},
+		{
+			name:         "invalid direction is rejected before any API call",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"direction":    "up",
+			},
+			expectToolError: true,
+			expectedErrMsg:  `invalid direction "up"`,
+		},
+		{
+			name:         "latest conflicts with explicit ordering",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"latest":       true,
+				"direction":    "asc",
+			},
+			expectToolError: true,
+			expectedErrMsg:  "latest cannot be combined",
+		},
+		{
+			name:         "invalid since is rejected before any API call",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"since":        "last week",
+			},
+			expectToolError: true,
+			expectedErrMsg:  "invalid ISO 8601 timestamp",
+		},
+		{
+			name: "issue not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesCommentsByOwnerByRepoByIssueNumber,
+					mockResponse(t, http.StatusNotFound, `{"message": "Issue not found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(999),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get issue comments",
 		},
 	}
 
@@ -1201,6 +1726,11 @@ func Test_GetIssueComments(t *testing.T) {
 			}
 
 			require.NoError(t, err)
+			if tc.expectToolError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
@@ -1216,6 +1746,150 @@ func Test_GetIssueComments(t *testing.T) {
 	}
 }
 
+func Test_GetIssueComments_NewestFirst(t *testing.T) {
+	// The endpoint only lists comments oldest first, so serve comments 1 to 7 in pages
+	// the way the API does and check that they come back newest first.
+	pagedComments := func(n int64) http.HandlerFunc {
+		var comments []*github.IssueComment
+		for id := int64(1); id <= n; id++ {
+			comments = append(comments, &github.IssueComment{ID: github.Ptr(id), Body: github.Ptr(fmt.Sprintf("comment %d", id))})
+		}
+		requested := map[string]bool{}
+		return func(w http.ResponseWriter, r *http.Request) {
+			page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+			perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+			assert.Empty(t, r.URL.Query().Get("direction"))
+			assert.False(t, requested[r.URL.RawQuery], "page requested twice: %s", r.URL.RawQuery)
+			requested[r.URL.RawQuery] = true
+			lastPage := (len(comments) + perPage - 1) / perPage
+			if page < lastPage {
+				w.Header().Set("Link", fmt.Sprintf(`<https://api.github.com/repos/owner/repo/issues/42/comments?page=%d&per_page=%d>; rel="next", `+
+					`<https://api.github.com/repos/owner/repo/issues/42/comments?page=%d&per_page=%d>; rel="last"`, page+1, perPage, lastPage, perPage))
+			}
+			start := min((page-1)*perPage, len(comments))
+			end := min(start+perPage, len(comments))
+			mockResponse(t, http.StatusOK, comments[start:end])(w, r)
+		}
+	}
+
+	tests := []struct {
+		name        string
+		requestArgs map[string]interface{}
+		expectedIDs []int64
+	}{
+		{
+			name:        "latest returns the newest comments",
+			requestArgs: map[string]interface{}{"latest": true, "perPage": float64(3)},
+			expectedIDs: []int64{7, 6, 5},
+		},
+		{
+			name:        "later pages go back in time",
+			requestArgs: map[string]interface{}{"direction": "desc", "page": float64(2), "perPage": float64(3)},
+			expectedIDs: []int64{4, 3, 2},
+		},
+		{
+			name:        "the last page holds the oldest comments",
+			requestArgs: map[string]interface{}{"direction": "desc", "page": float64(3), "perPage": float64(3)},
+			expectedIDs: []int64{1},
+		},
+		{
+			name:        "pages past the oldest comment are empty",
+			requestArgs: map[string]interface{}{"direction": "desc", "page": float64(4), "perPage": float64(3)},
+			expectedIDs: []int64{},
+		},
+		{
+			name:        "comments on a single page",
+			requestArgs: map[string]interface{}{"latest": true, "perPage": float64(10)},
+			expectedIDs: []int64{7, 6, 5, 4, 3, 2, 1},
+		},
+		{
+			name:        "ascending order is unchanged",
+			requestArgs: map[string]interface{}{"direction": "asc", "perPage": float64(3)},
+			expectedIDs: []int64{1, 2, 3},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposIssuesCommentsByOwnerByRepoByIssueNumber, pagedComments(7)),
+			))
+			_, handler := GetIssueComments(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			args := map[string]interface{}{"owner": "owner", "repo": "repo", "issue_number": float64(42)}
+			for k, v := range tc.requestArgs {
+				args[k] = v
+			}
+			result, err := handler(context.Background(), createMCPRequest(args))
+			require.NoError(t, err)
+
+			var returned []*github.IssueComment
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			ids := []int64{}
+			for _, c := range returned {
+				ids = append(ids, c.GetID())
+			}
+			assert.Equal(t, tc.expectedIDs, ids)
+		})
+	}
+
+	t.Run("fetch_all keeps the newest comments", func(t *testing.T) {
+		// fetch_all reads 100 comments per page, so 250 comments span three pages.
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(mock.GetReposIssuesCommentsByOwnerByRepoByIssueNumber, pagedComments(250)),
+		))
+		_, handler := GetIssueComments(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner":        "owner",
+			"repo":         "repo",
+			"issue_number": float64(42),
+			"latest":       true,
+			"fetch_all":    true,
+			"max_items":    float64(120),
+		}))
+		require.NoError(t, err)
+
+		var returned FetchAllResult[*github.IssueComment]
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+		require.Len(t, returned.Items, 120)
+		for i, c := range returned.Items {
+			assert.Equal(t, int64(250-i), c.GetID())
+		}
+		assert.True(t, returned.Truncated)
+		// The first and last pages are read once to find the page count; only the middle page is left.
+		assert.Equal(t, 3, returned.APICalls)
+	})
+
+	t.Run("fetch_all starts from the requested page of newest comments", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(mock.GetReposIssuesCommentsByOwnerByRepoByIssueNumber, pagedComments(250)),
+		))
+		_, handler := GetIssueComments(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner":        "owner",
+			"repo":         "repo",
+			"issue_number": float64(42),
+			"latest":       true,
+			"fetch_all":    true,
+			"page":         float64(3),
+			"perPage":      float64(30),
+			"max_items":    float64(40),
+		}))
+		require.NoError(t, err)
+
+		// The 60 newest comments are skipped, which crosses from the last API page of 50 into the one before it.
+		var returned FetchAllResult[*github.IssueComment]
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+		require.Len(t, returned.Items, 40)
+		for i, c := range returned.Items {
+			assert.Equal(t, int64(190-i), c.GetID())
+		}
+		assert.True(t, returned.Truncated)
+	})
+}
+
 func TestAssignCopilotToIssue(t *testing.T) {
 	t.Parallel()
 
@@ -1229,7 +1903,8 @@ func TestAssignCopilotToIssue(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "issueNumber")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issueNumber"})
+	assert.Contains(t, tool.InputSchema.Properties, "issueNumbers")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
 
 	var pageOfFakeBots = func(n int) []struct{} {
 		// We don't _really_ need real bots here, just objects that count as entries for the page
@@ -1536,102 +2211,977 @@ func TestAssignCopilotToIssue(t *testing.T) {
 						"name":   githubv4.String("repo"),
 						"number": githubv4.Int(123),
 					},
-					githubv4mock.DataResponse(map[string]any{
-						"repository": map[string]any{
-							"issue": map[string]any{
-								"id": githubv4.ID("test-issue-id"),
-								"assignees": map[string]any{
-									"nodes": []any{},
-								},
-							},
-						},
-					}),
-				),
-				githubv4mock.NewMutationMatcher(
-					struct {
-						ReplaceActorsForAssignable struct {
-							Typename string `graphql:"__typename"`
-						} `graphql:"replaceActorsForAssignable(input: $input)"`
-					}{},
-					ReplaceActorsForAssignableInput{
-						AssignableID: githubv4.ID("test-issue-id"),
-						ActorIDs:     []githubv4.ID{githubv4.ID("copilot-swe-agent-id")},
+					githubv4mock.DataResponse(map[string]any{
+						"repository": map[string]any{
+							"issue": map[string]any{
+								"id": githubv4.ID("test-issue-id"),
+								"assignees": map[string]any{
+									"nodes": []any{},
+								},
+							},
+						},
+					}),
+				),
+				githubv4mock.NewMutationMatcher(
+					struct {
+						ReplaceActorsForAssignable struct {
+							Typename string `graphql:"__typename"`
+						} `graphql:"replaceActorsForAssignable(input: $input)"`
+					}{},
+					ReplaceActorsForAssignableInput{
+						AssignableID: githubv4.ID("test-issue-id"),
+						ActorIDs:     []githubv4.ID{githubv4.ID("copilot-swe-agent-id")},
+					},
+					nil,
+					githubv4mock.DataResponse(map[string]any{}),
+				),
+			),
+		},
+		{
+			name: "copilot not a suggested actor",
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"issueNumber": float64(123),
+			},
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(
+					struct {
+						Repository struct {
+							SuggestedActors struct {
+								Nodes []struct {
+									Bot struct {
+										ID       githubv4.ID
+										Login    githubv4.String
+										TypeName string `graphql:"__typename"`
+									} `graphql:"... on Bot"`
+								}
+								PageInfo struct {
+									HasNextPage bool
+									EndCursor   string
+								}
+							} `graphql:"suggestedActors(first: 100, after: $endCursor, capabilities: CAN_BE_ASSIGNED)"`
+						} `graphql:"repository(owner: $owner, name: $name)"`
+					}{},
+					map[string]any{
+						"owner":     githubv4.String("owner"),
+						"name":      githubv4.String("repo"),
+						"endCursor": (*githubv4.String)(nil),
+					},
+					githubv4mock.DataResponse(map[string]any{
+						"repository": map[string]any{
+							"suggestedActors": map[string]any{
+								"nodes": []any{},
+							},
+						},
+					}),
+				),
+			),
+			expectToolError:    true,
+			expectedToolErrMsg: "copilot isn't available as an assignee for this issue. Please inform the user to visit https://docs.github.com/en/copilot/using-github-copilot/using-copilot-coding-agent-to-work-on-tasks/about-assigning-tasks-to-copilot for more information.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+
+			t.Parallel()
+			// Setup client with mock
+			client := githubv4.NewClient(tc.mockedClient)
+			_, handler := AssignCopilotToIssue(stubGetGQLClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+
+			if tc.expectToolError {
+				require.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedToolErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, fmt.Sprintf("expected there to be no tool error, text was %s", textContent.Text))
+			require.Equal(t, textContent.Text, "successfully assigned copilot to issue")
+		})
+	}
+}
+
+func Test_CopilotAssigneeCache(t *testing.T) {
+	now := time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC)
+	originalTimeNow := timeNow
+	timeNow = func() time.Time { return now }
+	t.Cleanup(func() { timeNow = originalTimeNow })
+
+	cache := newCopilotAssigneeCache()
+
+	_, ok := cache.get("owner", "repo")
+	assert.False(t, ok, "empty cache should miss")
+
+	cache.set("owner", "repo", githubv4.ID("copilot-swe-agent-id"))
+	cache.set("owner", "no-copilot", nil)
+
+	id, ok := cache.get("Owner", "Repo")
+	assert.True(t, ok, "keys should be case insensitive")
+	assert.Equal(t, githubv4.ID("copilot-swe-agent-id"), id)
+
+	id, ok = cache.get("owner", "no-copilot")
+	assert.True(t, ok)
+	assert.Nil(t, id, "copilot being unavailable should be cached")
+
+	// Misses expire quickly so that enabling copilot is picked up.
+	now = now.Add(copilotAssigneeMissTTL)
+	_, ok = cache.get("owner", "no-copilot")
+	assert.False(t, ok)
+	_, ok = cache.get("owner", "repo")
+	assert.True(t, ok)
+
+	now = now.Add(copilotAssigneeCacheTTL)
+	_, ok = cache.get("owner", "repo")
+	assert.False(t, ok)
+}
+
+func TestAssignCopilotToIssue_CachesCopilotID(t *testing.T) {
+	t.Parallel()
+
+	suggestedActorsMatcher := githubv4mock.NewQueryMatcher(
+		struct {
+			Repository struct {
+				SuggestedActors struct {
+					Nodes []struct {
+						Bot struct {
+							ID       githubv4.ID
+							Login    githubv4.String
+							TypeName string `graphql:"__typename"`
+						} `graphql:"... on Bot"`
+					}
+					PageInfo struct {
+						HasNextPage bool
+						EndCursor   string
+					}
+				} `graphql:"suggestedActors(first: 100, after: $endCursor, capabilities: CAN_BE_ASSIGNED)"`
+			} `graphql:"repository(owner: $owner, name: $name)"`
+		}{},
+		map[string]any{
+			"owner":     githubv4.String("owner"),
+			"name":      githubv4.String("repo"),
+			"endCursor": (*githubv4.String)(nil),
+		},
+		githubv4mock.DataResponse(map[string]any{
+			"repository": map[string]any{
+				"suggestedActors": map[string]any{
+					"nodes": []any{
+						map[string]any{
+							"id":         githubv4.ID("copilot-swe-agent-id"),
+							"login":      githubv4.String("copilot-swe-agent"),
+							"__typename": "Bot",
+						},
+					},
+				},
+			},
+		}),
+	)
+	issueMatcher := func(number int) githubv4mock.Matcher {
+		return githubv4mock.NewQueryMatcher(
+			struct {
+				Repository struct {
+					Issue struct {
+						ID        githubv4.ID
+						Assignees struct {
+							Nodes []struct {
+								ID githubv4.ID
+							}
+						} `graphql:"assignees(first: 100)"`
+					} `graphql:"issue(number: $number)"`
+				} `graphql:"repository(owner: $owner, name: $name)"`
+			}{},
+			map[string]any{
+				"owner":  githubv4.String("owner"),
+				"name":   githubv4.String("repo"),
+				"number": githubv4.Int(number),
+			},
+			githubv4mock.DataResponse(map[string]any{
+				"repository": map[string]any{
+					"issue": map[string]any{
+						"id": githubv4.ID(fmt.Sprintf("issue-%d", number)),
+						"assignees": map[string]any{
+							"nodes": []any{},
+						},
+					},
+				},
+			}),
+		)
+	}
+	mutationMatcher := func(number int) githubv4mock.Matcher {
+		return githubv4mock.NewMutationMatcher(
+			struct {
+				ReplaceActorsForAssignable struct {
+					Typename string `graphql:"__typename"`
+				} `graphql:"replaceActorsForAssignable(input: $input)"`
+			}{},
+			ReplaceActorsForAssignableInput{
+				AssignableID: githubv4.ID(fmt.Sprintf("issue-%d", number)),
+				ActorIDs:     []githubv4.ID{githubv4.ID("copilot-swe-agent-id")},
+			},
+			nil,
+			githubv4mock.DataResponse(map[string]any{}),
+		)
+	}
+
+	// The second client has no suggestedActors matcher, so the second call fails
+	// if it looks up the copilot bot again instead of using the cached ID.
+	clients := []*githubv4.Client{
+		githubv4.NewClient(githubv4mock.NewMockedHTTPClient(suggestedActorsMatcher, issueMatcher(1), mutationMatcher(1))),
+		githubv4.NewClient(githubv4mock.NewMockedHTTPClient(issueMatcher(2), mutationMatcher(2))),
+	}
+	calls := 0
+	getGQLClient := func(_ context.Context) (*githubv4.Client, error) {
+		client := clients[calls]
+		calls++
+		return client, nil
+	}
+
+	_, handler := AssignCopilotToIssue(getGQLClient, translations.NullTranslationHelper)
+
+	for _, number := range []int{1, 2} {
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":       "owner",
+			"repo":        "repo",
+			"issueNumber": float64(number),
+		}))
+		require.NoError(t, err)
+		textContent := getTextResult(t, result)
+		require.False(t, result.IsError, textContent.Text)
+		assert.Equal(t, "successfully assigned copilot to issue", textContent.Text)
+	}
+}
+
+func TestAssignCopilotToIssue_Batch(t *testing.T) {
+	t.Parallel()
+
+	suggestedActorsMatcher := githubv4mock.NewQueryMatcher(
+		struct {
+			Repository struct {
+				SuggestedActors struct {
+					Nodes []struct {
+						Bot struct {
+							ID       githubv4.ID
+							Login    githubv4.String
+							TypeName string `graphql:"__typename"`
+						} `graphql:"... on Bot"`
+					}
+					PageInfo struct {
+						HasNextPage bool
+						EndCursor   string
+					}
+				} `graphql:"suggestedActors(first: 100, after: $endCursor, capabilities: CAN_BE_ASSIGNED)"`
+			} `graphql:"repository(owner: $owner, name: $name)"`
+		}{},
+		map[string]any{
+			"owner":     githubv4.String("owner"),
+			"name":      githubv4.String("repo"),
+			"endCursor": (*githubv4.String)(nil),
+		},
+		githubv4mock.DataResponse(map[string]any{
+			"repository": map[string]any{
+				"suggestedActors": map[string]any{
+					"nodes": []any{
+						map[string]any{
+							"id":         githubv4.ID("copilot-swe-agent-id"),
+							"login":      githubv4.String("copilot-swe-agent"),
+							"__typename": "Bot",
+						},
+					},
+				},
+			},
+		}),
+	)
+	// Only issue 1 exists, so looking up issue 404 fails.
+	issueMatcher := githubv4mock.NewQueryMatcher(
+		struct {
+			Repository struct {
+				Issue struct {
+					ID        githubv4.ID
+					Assignees struct {
+						Nodes []struct {
+							ID githubv4.ID
+						}
+					} `graphql:"assignees(first: 100)"`
+				} `graphql:"issue(number: $number)"`
+			} `graphql:"repository(owner: $owner, name: $name)"`
+		}{},
+		map[string]any{
+			"owner":  githubv4.String("owner"),
+			"name":   githubv4.String("repo"),
+			"number": githubv4.Int(1),
+		},
+		githubv4mock.DataResponse(map[string]any{
+			"repository": map[string]any{
+				"issue": map[string]any{
+					"id": githubv4.ID("issue-1"),
+					"assignees": map[string]any{
+						"nodes": []any{},
+					},
+				},
+			},
+		}),
+	)
+	mutationMatcher := githubv4mock.NewMutationMatcher(
+		struct {
+			ReplaceActorsForAssignable struct {
+				Typename string `graphql:"__typename"`
+			} `graphql:"replaceActorsForAssignable(input: $input)"`
+		}{},
+		ReplaceActorsForAssignableInput{
+			AssignableID: githubv4.ID("issue-1"),
+			ActorIDs:     []githubv4.ID{githubv4.ID("copilot-swe-agent-id")},
+		},
+		nil,
+		githubv4mock.DataResponse(map[string]any{}),
+	)
+
+	client := githubv4.NewClient(githubv4mock.NewMockedHTTPClient(suggestedActorsMatcher, issueMatcher, mutationMatcher))
+	_, handler := AssignCopilotToIssue(stubGetGQLClientFn(client), translations.NullTranslationHelper)
+
+	// The failing issue comes first to show that it doesn't abort the rest of the batch.
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":        "owner",
+		"repo":         "repo",
+		"issueNumbers": []any{float64(404), float64(1)},
+	}))
+	require.NoError(t, err)
+	textContent := getTextResult(t, result)
+	require.False(t, result.IsError, textContent.Text)
+
+	var results []struct {
+		IssueNumber int    `json:"issueNumber"`
+		Assigned    bool   `json:"assigned"`
+		Error       string `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &results))
+	require.Len(t, results, 2)
+	assert.Equal(t, 404, results[0].IssueNumber)
+	assert.False(t, results[0].Assigned)
+	assert.Contains(t, results[0].Error, "failed to get issue ID")
+	assert.Equal(t, 1, results[1].IssueNumber)
+	assert.True(t, results[1].Assigned)
+	assert.Empty(t, results[1].Error)
+
+	t.Run("issueNumber and issueNumbers are mutually exclusive", func(t *testing.T) {
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":        "owner",
+			"repo":         "repo",
+			"issueNumber":  float64(1),
+			"issueNumbers": []any{float64(2)},
+		}))
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, result).Text, "only one of issueNumber or issueNumbers")
+	})
+
+	t.Run("an issue number is required", func(t *testing.T) {
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner": "owner",
+			"repo":  "repo",
+		}))
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, result).Text, "either issueNumber or issueNumbers must be provided")
+	})
+}
+
+func TestUnassignCopilotFromIssue(t *testing.T) {
+	t.Parallel()
+
+	// Verify tool definition
+	mockClient := githubv4.NewClient(nil)
+	tool, _ := UnassignCopilotFromIssue(stubGetGQLClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "unassign_copilot_from_issue", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "issueNumber")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issueNumber"})
+
+	issueMatcher := func(assignees ...map[string]any) githubv4mock.Matcher {
+		nodes := make([]any, len(assignees))
+		for i, assignee := range assignees {
+			nodes[i] = assignee
+		}
+		return githubv4mock.NewQueryMatcher(
+			struct {
+				Repository struct {
+					Issue struct {
+						ID        githubv4.ID
+						Assignees struct {
+							Nodes []struct {
+								ID    githubv4.ID
+								Login string
+							}
+						} `graphql:"assignees(first: 100)"`
+					} `graphql:"issue(number: $number)"`
+				} `graphql:"repository(owner: $owner, name: $name)"`
+			}{},
+			map[string]any{
+				"owner":  githubv4.String("owner"),
+				"name":   githubv4.String("repo"),
+				"number": githubv4.Int(123),
+			},
+			githubv4mock.DataResponse(map[string]any{
+				"repository": map[string]any{
+					"issue": map[string]any{
+						"id": githubv4.ID("test-issue-id"),
+						"assignees": map[string]any{
+							"nodes": nodes,
+						},
+					},
+				},
+			}),
+		)
+	}
+	mutationMatcher := func(actorIDs ...githubv4.ID) githubv4mock.Matcher {
+		if actorIDs == nil {
+			// Removing the only assignee sends an empty list rather than null.
+			actorIDs = []githubv4.ID{}
+		}
+		return githubv4mock.NewMutationMatcher(
+			struct {
+				ReplaceActorsForAssignable struct {
+					Typename string `graphql:"__typename"`
+				} `graphql:"replaceActorsForAssignable(input: $input)"`
+			}{},
+			ReplaceActorsForAssignableInput{
+				AssignableID: githubv4.ID("test-issue-id"),
+				ActorIDs:     actorIDs,
+			},
+			nil,
+			githubv4mock.DataResponse(map[string]any{}),
+		)
+	}
+	copilot := map[string]any{"id": githubv4.ID("copilot-swe-agent-id"), "login": "copilot-swe-agent"}
+	octocat := map[string]any{"id": githubv4.ID("octocat-id"), "login": "octocat"}
+	hubot := map[string]any{"id": githubv4.ID("hubot-id"), "login": "hubot"}
+
+	tests := []struct {
+		name         string
+		mockedClient *http.Client
+		expectedText string
+	}{
+		{
+			name: "copilot is the only assignee",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				issueMatcher(copilot),
+				mutationMatcher(),
+			),
+			expectedText: "successfully unassigned copilot from issue",
+		},
+		{
+			name: "copilot is one of several assignees",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				issueMatcher(octocat, copilot, hubot),
+				mutationMatcher("octocat-id", "hubot-id"),
+			),
+			expectedText: "successfully unassigned copilot from issue",
+		},
+		{
+			name: "copilot is not assigned",
+			// No mutation matcher, so any attempt to change the assignees fails the test.
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				issueMatcher(octocat),
+			),
+			expectedText: "copilot is not assigned to this issue, nothing to unassign",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := githubv4.NewClient(tc.mockedClient)
+			_, handler := UnassignCopilotFromIssue(stubGetGQLClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"issueNumber": float64(123),
+			}))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			require.False(t, result.IsError, textContent.Text)
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
+
+func TestListCopilotSessions(t *testing.T) {
+	t.Parallel()
+
+	// Verify tool definition
+	mockClient := githubv4.NewClient(nil)
+	tool, _ := ListCopilotSessions(stubGetGQLClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_copilot_sessions", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
+	assert.Contains(t, tool.InputSchema.Properties, "after")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	pullRequest := func(number int, state string, isDraft bool, closes ...int) map[string]any {
+		closing := make([]any, len(closes))
+		for i, issueNumber := range closes {
+			closing[i] = map[string]any{"number": issueNumber}
+		}
+		return map[string]any{
+			"number":                  number,
+			"url":                     fmt.Sprintf("https://github.com/owner/repo/pull/%d", number),
+			"state":                   state,
+			"isDraft":                 isDraft,
+			"createdAt":               "2024-03-15T10:30:00Z",
+			"author":                  map[string]any{"login": "copilot-swe-agent"},
+			"closingIssuesReferences": map[string]any{"nodes": closing},
+		}
+	}
+
+	searchMatcher := func() githubv4mock.Matcher {
+		matcher := githubv4mock.NewQueryMatcher(
+			listCopilotSessionsQuery{},
+			map[string]any{
+				"query": githubv4.String("repo:owner/repo is:pr author:app/copilot-swe-agent sort:created-desc"),
+				"first": githubv4.Int(30),
+				"after": (*githubv4.String)(nil),
+			},
+			githubv4mock.DataResponse(map[string]any{
+				"search": map[string]any{
+					"nodes": []any{
+						pullRequest(7, "OPEN", true, 123),
+						pullRequest(5, "MERGED", false),
+					},
+					"pageInfo": map[string]any{
+						"hasNextPage": false,
+						"endCursor":   "Y3Vyc29yOjI=",
+					},
+				},
+			}),
+		)
+		// The query string is derived from the variable types above, but the
+		// request variables are compared after JSON decoding.
+		matcher.Variables["first"] = float64(30)
+		matcher.Variables["after"] = nil
+		return matcher
+	}
+	issueMatcher := func(assignees []any, pullRequests []any) githubv4mock.Matcher {
+		return githubv4mock.NewQueryMatcher(
+			getCopilotIssueSessionsQuery{},
+			map[string]any{
+				"owner":  githubv4.String("owner"),
+				"repo":   githubv4.String("repo"),
+				"number": githubv4.Int(123),
+			},
+			githubv4mock.DataResponse(map[string]any{
+				"repository": map[string]any{
+					"issue": map[string]any{
+						"assignees":                      map[string]any{"nodes": assignees},
+						"closedByPullRequestsReferences": map[string]any{"nodes": pullRequests},
+					},
+				},
+			}),
+		)
+	}
+	copilot := map[string]any{"login": "copilot-swe-agent"}
+	humanPullRequest := pullRequest(9, "OPEN", false)
+	humanPullRequest["author"] = map[string]any{"login": "octocat"}
+
+	startedAt := time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]any
+		expectedSessions []CopilotSession
+	}{
+		{
+			name:         "lists the sessions in the repository",
+			mockedClient: githubv4mock.NewMockedHTTPClient(searchMatcher()),
+			requestArgs:  map[string]any{"owner": "owner", "repo": "repo"},
+			expectedSessions: []CopilotSession{
+				{IssueNumber: 123, State: "in_progress", StartedAt: &startedAt, PullRequestNumber: 7, PullRequestURL: "https://github.com/owner/repo/pull/7"},
+				{State: "merged", StartedAt: &startedAt, PullRequestNumber: 5, PullRequestURL: "https://github.com/owner/repo/pull/5"},
+			},
+		},
+		{
+			name: "issue with a pull request opened by copilot",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				issueMatcher([]any{copilot}, []any{humanPullRequest, pullRequest(7, "OPEN", false, 123)}),
+			),
+			requestArgs: map[string]any{"owner": "owner", "repo": "repo", "issue_number": float64(123)},
+			expectedSessions: []CopilotSession{
+				{IssueNumber: 123, State: "ready_for_review", StartedAt: &startedAt, PullRequestNumber: 7, PullRequestURL: "https://github.com/owner/repo/pull/7"},
+			},
+		},
+		{
+			name: "issue assigned to copilot without a pull request yet",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				issueMatcher([]any{copilot}, []any{}),
+			),
+			requestArgs: map[string]any{"owner": "owner", "repo": "repo", "issue_number": float64(123)},
+			expectedSessions: []CopilotSession{
+				{IssueNumber: 123, State: "queued"},
+			},
+		},
+		{
+			name: "issue not assigned to copilot",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				issueMatcher([]any{}, []any{humanPullRequest}),
+			),
+			requestArgs:      map[string]any{"owner": "owner", "repo": "repo", "issue_number": float64(123)},
+			expectedSessions: []CopilotSession{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := githubv4.NewClient(tc.mockedClient)
+			_, handler := ListCopilotSessions(stubGetGQLClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			require.False(t, result.IsError, textContent.Text)
+
+			var response struct {
+				Sessions []CopilotSession `json:"sessions"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+			assert.Equal(t, tc.expectedSessions, response.Sessions)
+		})
+	}
+}
+
+func TestGetCopilotSessionLogs(t *testing.T) {
+	// Verify tool definition
+	mockClient := github.NewClient(nil)
+	tool, _ := GetCopilotSessionLogs(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_copilot_session_logs", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "tail_lines")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	logContent := "Cloning the repository...\nRunning tests...\nTests failed"
+	logServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(logContent))
+	}))
+	defer logServer.Close()
+
+	mockPR := &github.PullRequest{
+		Number: github.Ptr(7),
+		Head:   &github.PullRequestBranch{Ref: github.Ptr("copilot/fix-123")},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedLogs   string
+		expectedTrunc  bool
+	}{
+		{
+			name: "truncates the log of the latest session",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposPullsByOwnerByRepoByPullNumber, mockPR),
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"branch":   "copilot/fix-123",
+						"event":    "dynamic",
+						"per_page": "1",
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.WorkflowRuns{
+							TotalCount: github.Ptr(1),
+							WorkflowRuns: []*github.WorkflowRun{
+								{ID: github.Ptr(int64(42)), Status: github.Ptr("completed"), Conclusion: github.Ptr("failure")},
+							},
+						}),
+					),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposActionsRunsJobsByOwnerByRepoByRunId,
+					&github.Jobs{
+						TotalCount: github.Ptr(1),
+						Jobs:       []*github.WorkflowJob{{ID: github.Ptr(int64(99)), Name: github.Ptr("copilot")}},
+					},
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsJobsLogsByOwnerByRepoByJobId,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.Header().Set("Location", logServer.URL)
+						w.WriteHeader(http.StatusFound)
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(7),
+				"tail_lines": float64(2),
+			},
+			expectedLogs:  "Running tests...\nTests failed",
+			expectedTrunc: true,
+		},
+		{
+			name: "pull request without a session",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposPullsByOwnerByRepoByPullNumber, mockPR),
+				mock.WithRequestMatch(mock.GetReposActionsRunsByOwnerByRepo, &github.WorkflowRuns{TotalCount: github.Ptr(0)}),
+			),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(7),
+			},
+			expectError:    true,
+			expectedErrMsg: "no Copilot coding agent session found for pull request #7",
+		},
+		{
+			name:         "zero tail_lines is rejected",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(7),
+				"tail_lines": float64(0),
+			},
+			expectError:    true,
+			expectedErrMsg: "tail_lines must be a whole number between 1 and 5000",
+		},
+		{
+			name:         "negative tail_lines is rejected",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(7),
+				"tail_lines": float64(-5),
+			},
+			expectError:    true,
+			expectedErrMsg: "tail_lines must be a whole number between 1 and 5000",
+		},
+		{
+			name:         "tail_lines above the limit is rejected",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(7),
+				"tail_lines": float64(5001),
+			},
+			expectError:    true,
+			expectedErrMsg: "tail_lines must be a whole number between 1 and 5000",
+		},
+		{
+			name: "pull request not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(7),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get pull request",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetCopilotSessionLogs(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			require.False(t, result.IsError, textContent.Text)
+
+			var response struct {
+				RunID         int64  `json:"run_id"`
+				RunConclusion string `json:"run_conclusion"`
+				Logs          []struct {
+					JobName     string `json:"job_name"`
+					LogsContent string `json:"logs_content"`
+					Truncated   bool   `json:"truncated"`
+				} `json:"logs"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+			assert.Equal(t, int64(42), response.RunID)
+			assert.Equal(t, "failure", response.RunConclusion)
+			require.Len(t, response.Logs, 1)
+			assert.Equal(t, "copilot", response.Logs[0].JobName)
+			assert.Equal(t, tc.expectedLogs, response.Logs[0].LogsContent)
+			assert.Equal(t, tc.expectedTrunc, response.Logs[0].Truncated)
+		})
+	}
+}
+
+func TestListIssueLinkedPullRequests(t *testing.T) {
+	t.Parallel()
+
+	// Verify tool definition
+	mockClient := githubv4.NewClient(nil)
+	tool, _ := ListIssueLinkedPullRequests(stubGetGQLClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_issue_linked_prs", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number"})
+
+	pullRequest := func(number int, state string, isDraft bool) map[string]any {
+		return map[string]any{
+			"number":  number,
+			"title":   fmt.Sprintf("Pull request %d", number),
+			"url":     fmt.Sprintf("https://github.com/owner/repo/pull/%d", number),
+			"state":   state,
+			"isDraft": isDraft,
+			"merged":  state == "MERGED",
+		}
+	}
+	crossReference := func(willClose bool, source map[string]any) map[string]any {
+		return map[string]any{
+			"willCloseTarget": willClose,
+			"source":          source,
+		}
+	}
+	issueMatcher := func(closedBy []any, timeline []any) githubv4mock.Matcher {
+		return githubv4mock.NewQueryMatcher(
+			listIssueLinkedPullRequestsQuery{},
+			map[string]any{
+				"owner":  githubv4.String("owner"),
+				"repo":   githubv4.String("repo"),
+				"number": githubv4.Int(123),
+			},
+			githubv4mock.DataResponse(map[string]any{
+				"repository": map[string]any{
+					"issue": map[string]any{
+						"closedByPullRequestsReferences": map[string]any{"nodes": closedBy},
+						"timelineItems":                  map[string]any{"nodes": timeline},
+					},
+				},
+			}),
+		)
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+		expectedPRs    []LinkedPullRequest
+	}{
+		{
+			name: "closing and mentioning pull requests",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				issueMatcher(
+					[]any{pullRequest(7, "MERGED", false)},
+					[]any{
+						// Already listed as a closing reference.
+						crossReference(true, pullRequest(7, "MERGED", false)),
+						crossReference(false, pullRequest(9, "OPEN", true)),
+						// Cross references from issues have no pull request fields.
+						crossReference(false, map[string]any{}),
 					},
-					nil,
-					githubv4mock.DataResponse(map[string]any{}),
 				),
 			),
+			expectedPRs: []LinkedPullRequest{
+				{Number: 7, Title: "Pull request 7", State: "merged", Merged: true, URL: "https://github.com/owner/repo/pull/7", WillClose: true},
+				{Number: 9, Title: "Pull request 9", State: "open", IsDraft: true, URL: "https://github.com/owner/repo/pull/9"},
+			},
 		},
 		{
-			name: "copilot not a suggested actor",
-			requestArgs: map[string]any{
-				"owner":       "owner",
-				"repo":        "repo",
-				"issueNumber": float64(123),
-			},
+			name: "issue without linked pull requests",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				issueMatcher([]any{}, []any{}),
+			),
+			expectedPRs: []LinkedPullRequest{},
+		},
+		{
+			name: "query fails",
 			mockedClient: githubv4mock.NewMockedHTTPClient(
 				githubv4mock.NewQueryMatcher(
-					struct {
-						Repository struct {
-							SuggestedActors struct {
-								Nodes []struct {
-									Bot struct {
-										ID       githubv4.ID
-										Login    githubv4.String
-										TypeName string `graphql:"__typename"`
-									} `graphql:"... on Bot"`
-								}
-								PageInfo struct {
-									HasNextPage bool
-									EndCursor   string
-								}
-							} `graphql:"suggestedActors(first: 100, after: $endCursor, capabilities: CAN_BE_ASSIGNED)"`
-						} `graphql:"repository(owner: $owner, name: $name)"`
-					}{},
+					listIssueLinkedPullRequestsQuery{},
 					map[string]any{
-						"owner":     githubv4.String("owner"),
-						"name":      githubv4.String("repo"),
-						"endCursor": (*githubv4.String)(nil),
+						"owner":  githubv4.String("owner"),
+						"repo":   githubv4.String("repo"),
+						"number": githubv4.Int(123),
 					},
-					githubv4mock.DataResponse(map[string]any{
-						"repository": map[string]any{
-							"suggestedActors": map[string]any{
-								"nodes": []any{},
-							},
-						},
-					}),
+					githubv4mock.ErrorResponse("Could not resolve to an Issue with the number of 123."),
 				),
 			),
-			expectToolError:    true,
-			expectedToolErrMsg: "copilot isn't available as an assignee for this issue. Please inform the user to visit https://docs.github.com/en/copilot/using-github-copilot/using-copilot-coding-agent-to-work-on-tasks/about-assigning-tasks-to-copilot for more information.",
+			expectError:    true,
+			expectedErrMsg: "failed to list linked pull requests",
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-
 			t.Parallel()
-			// Setup client with mock
-			client := githubv4.NewClient(tc.mockedClient)
-			_, handler := AssignCopilotToIssue(stubGetGQLClientFn(client), translations.NullTranslationHelper)
 
-			// Create call request
-			request := createMCPRequest(tc.requestArgs)
+			client := githubv4.NewClient(tc.mockedClient)
+			_, handler := ListIssueLinkedPullRequests(stubGetGQLClientFn(client), translations.NullTranslationHelper)
 
-			// Call handler
-			result, err := handler(context.Background(), request)
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(123),
+			}))
 			require.NoError(t, err)
 
-			textContent := getTextResult(t, result)
-
-			if tc.expectToolError {
+			if tc.expectError {
 				require.True(t, result.IsError)
-				assert.Contains(t, textContent.Text, tc.expectedToolErrMsg)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
 				return
 			}
 
-			require.False(t, result.IsError, fmt.Sprintf("expected there to be no tool error, text was %s", textContent.Text))
-			require.Equal(t, textContent.Text, "successfully assigned copilot to issue")
+			require.False(t, result.IsError)
+			var returned struct {
+				PullRequests []LinkedPullRequest `json:"pull_requests"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, tc.expectedPRs, returned.PullRequests)
 		})
 	}
 }
@@ -2112,6 +3662,100 @@ func Test_ListSubIssues(t *testing.T) {
 	}
 }
 
+func Test_GetSubIssueProgress(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetSubIssueProgress(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_sub_issue_progress", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number"})
+
+	subIssues := []*github.Issue{
+		{Number: github.Ptr(1), Title: github.Ptr("Done"), State: github.Ptr("closed")},
+		{Number: github.Ptr(2), Title: github.Ptr("Also done"), State: github.Ptr("closed")},
+		{Number: github.Ptr(3), Title: github.Ptr("To do"), State: github.Ptr("open")},
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		expectError      bool
+		expectedErrMsg   string
+		expectedProgress SubIssueProgress
+	}{
+		{
+			name: "counts closed sub-issues as completed",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesSubIssuesByOwnerByRepoByIssueNumber,
+					expectQueryParams(t, map[string]string{
+						"page":     "1",
+						"per_page": "100",
+					}).andThen(
+						mockResponse(t, http.StatusOK, subIssues),
+					),
+				),
+			),
+			expectedProgress: SubIssueProgress{
+				Total:            3,
+				Completed:        2,
+				PercentCompleted: 66,
+				OpenSubIssues: []IssueSummary{
+					{Number: 3, Title: "To do", State: "open", Labels: []string{}, Assignees: []string{}},
+				},
+			},
+		},
+		{
+			name: "issue without sub-issues",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposIssuesSubIssuesByOwnerByRepoByIssueNumber,
+					[]*github.Issue{},
+				),
+			),
+			expectedProgress: SubIssueProgress{OpenSubIssues: []IssueSummary{}},
+		},
+		{
+			name: "issue not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesSubIssuesByOwnerByRepoByIssueNumber,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to list sub-issues",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetSubIssueProgress(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			var progress SubIssueProgress
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &progress))
+			assert.Equal(t, tc.expectedProgress, progress)
+		})
+	}
+}
+
 func Test_RemoveSubIssue(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
@@ -2601,3 +4245,192 @@ func Test_ReprioritizeSubIssue(t *testing.T) {
 		})
 	}
 }
+
+func Test_AssignCodingAgentPrompt(t *testing.T) {
+	prompt, handler := AssignCodingAgentPrompt(translations.NullTranslationHelper)
+	assert.Equal(t, "AssignCodingAgent", prompt.Name)
+
+	argumentNames := make([]string, 0, len(prompt.Arguments))
+	for _, arg := range prompt.Arguments {
+		argumentNames = append(argumentNames, arg.Name)
+	}
+	assert.ElementsMatch(t, []string{"repo", "label", "query", "limit"}, argumentNames)
+
+	tests := []struct {
+		name              string
+		arguments         map[string]string
+		expectedRequest   string
+		expectedReply     string
+		expectedErrSubstr string
+	}{
+		{
+			name:            "defaults to the most recent 10 issues",
+			arguments:       map[string]string{"repo": "owner/repo"},
+			expectedRequest: "Please go and get a list of the most recent 10 issues from the owner/repo GitHub repository",
+			expectedReply:   "Sure! I will get a list of the 10 most recent issues for the repo owner/repo.",
+		},
+		{
+			name:            "label uses list_issues",
+			arguments:       map[string]string{"repo": "owner/repo", "label": "good-for-copilot", "limit": "5"},
+			expectedRequest: "Please use the `list_issues` tool to get up to 5 open issues labeled \"good-for-copilot\" from the owner/repo GitHub repository",
+			expectedReply:   "Sure! I will get a list of up to 5 open issues labeled \"good-for-copilot\" for the repo owner/repo.",
+		},
+		{
+			name:            "query uses search_issues and includes the label",
+			arguments:       map[string]string{"repo": "owner/repo", "label": "good-for-copilot", "query": "milestone:v2"},
+			expectedRequest: "Please use the `search_issues` tool with the query `repo:owner/repo is:open label:\"good-for-copilot\" milestone:v2` to get up to 10 candidate issues from the owner/repo GitHub repository",
+			expectedReply:   "Sure! I will search for up to 10 issues in the repo owner/repo matching `repo:owner/repo is:open label:\"good-for-copilot\" milestone:v2`.",
+		},
+		{
+			name:              "repo must be owner/repo",
+			arguments:         map[string]string{"repo": "just-a-repo"},
+			expectedErrSubstr: "must be in owner/repo form",
+		},
+		{
+			name:              "limit must be a positive number",
+			arguments:         map[string]string{"repo": "owner/repo", "limit": "lots"},
+			expectedErrSubstr: `invalid limit "lots"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			request := mcp.GetPromptRequest{}
+			request.Params.Arguments = tc.arguments
+
+			result, err := handler(context.Background(), request)
+			if tc.expectedErrSubstr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrSubstr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, result.Messages, 6)
+			assert.Equal(t, tc.expectedRequest, result.Messages[1].Content.(mcp.TextContent).Text)
+			assert.Equal(t, tc.expectedReply, result.Messages[2].Content.(mcp.TextContent).Text)
+		})
+	}
+}
+
+func Test_IssueTriagePrompt(t *testing.T) {
+	prompt, handler := IssueTriagePrompt(translations.NullTranslationHelper)
+	assert.Equal(t, "IssueTriage", prompt.Name)
+
+	request := mcp.GetPromptRequest{}
+	request.Params.Arguments = map[string]string{"repo": "owner/repo", "max_issues": "3"}
+	result, err := handler(context.Background(), request)
+	require.NoError(t, err)
+	require.NotEmpty(t, result.Messages)
+	assert.Contains(t, result.Messages[1].Content.(mcp.TextContent).Text, "up to 3 open issues that have no labels")
+	assert.Contains(t, result.Messages[1].Content.(mcp.TextContent).Text, "repo:owner/repo is:issue is:open label:needs-triage")
+	// The final instruction must require confirmation before any write.
+	assert.Contains(t, result.Messages[len(result.Messages)-1].Content.(mcp.TextContent).Text, "Ask me to confirm before every write")
+
+	request.Params.Arguments = map[string]string{"repo": "owner/repo", "label": "bug"}
+	result, err = handler(context.Background(), request)
+	require.NoError(t, err)
+	assert.Contains(t, result.Messages[1].Content.(mcp.TextContent).Text, `up to 10 open issues labeled "bug"`)
+
+	request.Params.Arguments = map[string]string{"repo": "owner"}
+	_, err = handler(context.Background(), request)
+	assert.ErrorContains(t, err, "must be in owner/repo form")
+}
+
+func Test_ReleaseNotesPrompt(t *testing.T) {
+	prompt, handler := ReleaseNotesPrompt(translations.NullTranslationHelper)
+	assert.Equal(t, "ReleaseNotes", prompt.Name)
+
+	tests := []struct {
+		name              string
+		arguments         map[string]string
+		expectedContains  []string
+		expectedErrSubstr string
+	}{
+		{
+			name:      "milestone with the default style",
+			arguments: map[string]string{"repo": "owner/repo", "milestone": "v2.0"},
+			expectedContains: []string{
+				`repo:owner/repo is:closed milestone:"v2.0"`,
+				"Keep a Changelog",
+			},
+		},
+		{
+			name:      "since tag with the narrative style",
+			arguments: map[string]string{"repo": "owner/repo", "since_tag": "v1.9.0", "style": "narrative"},
+			expectedContains: []string{
+				"Use `get_tag` to find the date of the tag",
+				"narrative introduction",
+			},
+		},
+		{
+			name:              "milestone or since_tag is required",
+			arguments:         map[string]string{"repo": "owner/repo"},
+			expectedErrSubstr: "exactly one of milestone or since_tag",
+		},
+		{
+			name:              "milestone and since_tag are mutually exclusive",
+			arguments:         map[string]string{"repo": "owner/repo", "milestone": "v2.0", "since_tag": "v1.9.0"},
+			expectedErrSubstr: "exactly one of milestone or since_tag",
+		},
+		{
+			name:              "unknown style",
+			arguments:         map[string]string{"repo": "owner/repo", "milestone": "v2.0", "style": "haiku"},
+			expectedErrSubstr: `invalid style "haiku"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			request := mcp.GetPromptRequest{}
+			request.Params.Arguments = tc.arguments
+
+			result, err := handler(context.Background(), request)
+			if tc.expectedErrSubstr != "" {
+				assert.ErrorContains(t, err, tc.expectedErrSubstr)
+				return
+			}
+			require.NoError(t, err)
+
+			var text strings.Builder
+			for _, message := range result.Messages {
+				text.WriteString(message.Content.(mcp.TextContent).Text)
+			}
+			for _, expected := range tc.expectedContains {
+				assert.Contains(t, text.String(), expected)
+			}
+			assert.Contains(t, result.Messages[len(result.Messages)-1].Content.(mcp.TextContent).Text, "ask before publishing")
+		})
+	}
+}
+
+func Test_SprintPlanningPrompt(t *testing.T) {
+	prompt, handler := SprintPlanningPrompt(translations.NullTranslationHelper)
+	assert.Equal(t, "SprintPlanning", prompt.Name)
+
+	request := mcp.GetPromptRequest{}
+	request.Params.Arguments = map[string]string{"repo": "owner/repo", "milestone": "Sprint 12", "capacity": "2 engineers for 1 week"}
+	result, err := handler(context.Background(), request)
+	require.NoError(t, err)
+	require.NotEmpty(t, result.Messages)
+	assert.Contains(t, result.Messages[0].Content.(mcp.TextContent).Text, "`get_sub_issue_progress`")
+	assert.Contains(t, result.Messages[1].Content.(mcp.TextContent).Text, "Use `list_issues` with milestone \"Sprint 12\"")
+	assert.Contains(t, result.Messages[3].Content.(mcp.TextContent).Text, "2 engineers for 1 week")
+	// The final instruction must require confirmation before any assignment.
+	assert.Contains(t, result.Messages[len(result.Messages)-1].Content.(mcp.TextContent).Text, "Ask me to confirm before every assignment")
+
+	request.Params.Arguments = map[string]string{"repo": "owner/repo"}
+	_, err = handler(context.Background(), request)
+	assert.ErrorContains(t, err, "missing required argument: milestone")
+
+	// The messages can be overridden like the rest of the prompt.
+	_, handler = SprintPlanningPrompt(func(key, defaultValue string) string {
+		if key == "PROMPT_SPRINT_PLANNING_CONFIRM" {
+			return "Confirm each assignment with me."
+		}
+		return defaultValue
+	})
+	request.Params.Arguments = map[string]string{"repo": "owner/repo", "milestone": "Sprint 12"}
+	result, err = handler(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, "Confirm each assignment with me.", result.Messages[len(result.Messages)-1].Content.(mcp.TextContent).Text)
+}
@@ -0,0 +1,178 @@
+package github
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v73/github"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const (
+	OutputFormatJSON     = "json"
+	OutputFormatMarkdown = "markdown"
+)
+
+// WithOutputFormat adds a "format" parameter to a tool, allowing callers to request a
+// compact markdown rendering instead of the default JSON.
+func WithOutputFormat() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("format",
+			mcp.Description("Output format. 'markdown' returns a compact, human readable rendering and ignores the fields parameter (default: json)"),
+			mcp.Enum(OutputFormatJSON, OutputFormatMarkdown),
+		)(tool)
+	}
+}
+
+// OptionalOutputFormatParam returns the "format" parameter from the request, defaulting to JSON.
+func OptionalOutputFormatParam(r mcp.CallToolRequest) (string, error) {
+	format, err := OptionalParam[string](r, "format")
+	if err != nil {
+		return "", err
+	}
+	switch format {
+	case "":
+		return OutputFormatJSON, nil
+	case OutputFormatJSON, OutputFormatMarkdown:
+		return format, nil
+	default:
+		return "", fmt.Errorf("invalid format %q: must be one of %s, %s", format, OutputFormatJSON, OutputFormatMarkdown)
+	}
+}
+
+// markdownTimestamp renders a timestamp compactly, or an empty string if it is unset.
+func markdownTimestamp(ts github.Timestamp) string {
+	if ts.IsZero() {
+		return ""
+	}
+	return ts.UTC().Format("2006-01-02 15:04 UTC")
+}
+
+// markdownTableCell escapes a value so that it can't break out of a markdown table cell.
+func markdownTableCell(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", " ")
+	s = strings.ReplaceAll(s, "\n", " ")
+	return strings.ReplaceAll(s, "|", "\\|")
+}
+
+// markdownFence wraps text in a code fence that is longer than any run of backticks
+// in the text itself, so the content can't terminate the fence early.
+func markdownFence(text string) string {
+	longest, current := 0, 0
+	for _, r := range text {
+		if r == '`' {
+			current++
+			if current > longest {
+				longest = current
+			}
+			continue
+		}
+		current = 0
+	}
+	fence := strings.Repeat("`", max(3, longest+1))
+	return fmt.Sprintf("%s\n%s\n%s", fence, strings.TrimRight(text, "\n"), fence)
+}
+
+func issueLabelNames(issue *github.Issue) []string {
+	names := make([]string, 0, len(issue.Labels))
+	for _, label := range issue.Labels {
+		names = append(names, label.GetName())
+	}
+	return names
+}
+
+func issueAssigneeLogins(issue *github.Issue) []string {
+	logins := make([]string, 0, len(issue.Assignees))
+	for _, assignee := range issue.Assignees {
+		logins = append(logins, assignee.GetLogin())
+	}
+	if len(logins) == 0 && issue.Assignee != nil {
+		logins = append(logins, issue.Assignee.GetLogin())
+	}
+	return logins
+}
+
+// formatIssueListMarkdown renders issues as a markdown table.
+func formatIssueListMarkdown(issues []*github.Issue) string {
+	if len(issues) == 0 {
+		return "No issues found."
+	}
+
+	var sb strings.Builder
+	sb.WriteString("| # | Title | State | Labels | Assignee | Updated |\n")
+	sb.WriteString("|---|---|---|---|---|---|\n")
+	for _, issue := range issues {
+		sb.WriteString(fmt.Sprintf("| %d | %s | %s | %s | %s | %s |\n",
+			issue.GetNumber(),
+			markdownTableCell(issue.GetTitle()),
+			issue.GetState(),
+			markdownTableCell(strings.Join(issueLabelNames(issue), ", ")),
+			markdownTableCell(strings.Join(issueAssigneeLogins(issue), ", ")),
+			markdownTimestamp(issue.GetUpdatedAt()),
+		))
+	}
+	return strings.TrimRight(sb.String(), "\n")
+}
+
+// formatIssueMarkdown renders a single issue as a header, a metadata list and its fenced body.
+func formatIssueMarkdown(issue *github.Issue) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# #%d: %s\n\n", issue.GetNumber(), issue.GetTitle()))
+
+	sb.WriteString(fmt.Sprintf("- **State:** %s\n", issue.GetState()))
+	if issue.StateReason != nil {
+		sb.WriteString(fmt.Sprintf("- **State reason:** %s\n", issue.GetStateReason()))
+	}
+	sb.WriteString(fmt.Sprintf("- **Author:** %s\n", issue.GetUser().GetLogin()))
+	if labels := issueLabelNames(issue); len(labels) > 0 {
+		sb.WriteString(fmt.Sprintf("- **Labels:** %s\n", strings.Join(labels, ", ")))
+	}
+	if assignees := issueAssigneeLogins(issue); len(assignees) > 0 {
+		sb.WriteString(fmt.Sprintf("- **Assignees:** %s\n", strings.Join(assignees, ", ")))
+	}
+	if issue.Milestone != nil {
+		sb.WriteString(fmt.Sprintf("- **Milestone:** %s\n", issue.Milestone.GetTitle()))
+	}
+	sb.WriteString(fmt.Sprintf("- **Comments:** %d\n", issue.GetComments()))
+	if created := markdownTimestamp(issue.GetCreatedAt()); created != "" {
+		sb.WriteString(fmt.Sprintf("- **Created:** %s\n", created))
+	}
+	if updated := markdownTimestamp(issue.GetUpdatedAt()); updated != "" {
+		sb.WriteString(fmt.Sprintf("- **Updated:** %s\n", updated))
+	}
+	if issue.HTMLURL != nil {
+		sb.WriteString(fmt.Sprintf("- **URL:** %s\n", issue.GetHTMLURL()))
+	}
+
+	if body := issue.GetBody(); body != "" {
+		sb.WriteString("\n")
+		sb.WriteString(markdownFence(body))
+	}
+	return strings.TrimRight(sb.String(), "\n")
+}
+
+// formatIssueCommentsMarkdown renders comments as a sequence of author/date headed sections.
+func formatIssueCommentsMarkdown(comments []*github.IssueComment) string {
+	if len(comments) == 0 {
+		return "No comments found."
+	}
+
+	sections := make([]string, 0, len(comments))
+	for _, comment := range comments {
+		header := fmt.Sprintf("### @%s", comment.GetUser().GetLogin())
+		if created := markdownTimestamp(comment.GetCreatedAt()); created != "" {
+			header = fmt.Sprintf("%s (%s)", header, created)
+		}
+		sections = append(sections, fmt.Sprintf("%s\n\n%s", header, strings.TrimRight(comment.GetBody(), "\n")))
+	}
+	return strings.Join(sections, "\n\n")
+}
+
+// formatFetchAllMarkdown appends a summary of an auto-paginated fetch to the rendered items.
+func formatFetchAllMarkdown[T any](rendered string, r *FetchAllResult[T]) string {
+	summary := fmt.Sprintf("_%d items fetched with %d API calls", len(r.Items), r.APICalls)
+	if r.Truncated {
+		summary += "; results were truncated at the item limit"
+	}
+	return fmt.Sprintf("%s\n\n%s._", rendered, summary)
+}
@@ -0,0 +1,168 @@
+package github
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v73/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_OptionalOutputFormatParam(t *testing.T) {
+	tests := []struct {
+		name        string
+		params      map[string]any
+		expected    string
+		expectError bool
+	}{
+		{
+			name:     "defaults to json",
+			params:   map[string]any{},
+			expected: OutputFormatJSON,
+		},
+		{
+			name:     "markdown",
+			params:   map[string]any{"format": "markdown"},
+			expected: OutputFormatMarkdown,
+		},
+		{
+			name:        "unknown format",
+			params:      map[string]any{"format": "yaml"},
+			expectError: true,
+		},
+		{
+			name:        "wrong type",
+			params:      map[string]any{"format": float64(1)},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			format, err := OptionalOutputFormatParam(createMCPRequest(tc.params))
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, format)
+		})
+	}
+}
+
+func Test_FormatIssueListMarkdown(t *testing.T) {
+	updated := github.Timestamp{Time: time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC)}
+	issues := []*github.Issue{
+		{
+			Number:    github.Ptr(1),
+			Title:     github.Ptr("Crash | on start"),
+			State:     github.Ptr("open"),
+			Labels:    []*github.Label{{Name: github.Ptr("bug")}, {Name: github.Ptr("p1")}},
+			Assignees: []*github.User{{Login: github.Ptr("octocat")}},
+			UpdatedAt: &updated,
+		},
+		{
+			Number: github.Ptr(2),
+			Title:  github.Ptr("Multi\nline"),
+			State:  github.Ptr("closed"),
+		},
+	}
+
+	expected := "| # | Title | State | Labels | Assignee | Updated |\n" +
+		"|---|---|---|---|---|---|\n" +
+		"| 1 | Crash \\| on start | open | bug, p1 | octocat | 2024-03-15 10:30 UTC |\n" +
+		"| 2 | Multi line | closed |  |  |  |"
+	assert.Equal(t, expected, formatIssueListMarkdown(issues))
+	assert.Equal(t, "No issues found.", formatIssueListMarkdown(nil))
+}
+
+func Test_FormatIssueMarkdown(t *testing.T) {
+	created := github.Timestamp{Time: time.Date(2024, 3, 14, 9, 0, 0, 0, time.UTC)}
+	issue := &github.Issue{
+		Number:    github.Ptr(42),
+		Title:     github.Ptr("Found a bug"),
+		State:     github.Ptr("open"),
+		User:      &github.User{Login: github.Ptr("reporter")},
+		Labels:    []*github.Label{{Name: github.Ptr("bug")}},
+		Assignee:  &github.User{Login: github.Ptr("fixer")},
+		Milestone: &github.Milestone{Title: github.Ptr("v1.0")},
+		Comments:  github.Ptr(3),
+		CreatedAt: &created,
+		HTMLURL:   github.Ptr("https://github.com/owner/repo/issues/42"),
+		Body:      github.Ptr("Steps:\n```sh\nrun it\n```\n"),
+	}
+
+	expected := "# #42: Found a bug\n\n" +
+		"- **State:** open\n" +
+		"- **Author:** reporter\n" +
+		"- **Labels:** bug\n" +
+		"- **Assignees:** fixer\n" +
+		"- **Milestone:** v1.0\n" +
+		"- **Comments:** 3\n" +
+		"- **Created:** 2024-03-14 09:00 UTC\n" +
+		"- **URL:** https://github.com/owner/repo/issues/42\n" +
+		"\n" +
+		"````\nSteps:\n```sh\nrun it\n```\n````"
+	assert.Equal(t, expected, formatIssueMarkdown(issue))
+}
+
+func Test_FormatIssueCommentsMarkdown(t *testing.T) {
+	created := github.Timestamp{Time: time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC)}
+	comments := []*github.IssueComment{
+		{
+			User:      &github.User{Login: github.Ptr("alice")},
+			CreatedAt: &created,
+			Body:      github.Ptr("First!\n"),
+		},
+		{
+			User: &github.User{Login: github.Ptr("bob")},
+			Body: github.Ptr("Second"),
+		},
+	}
+
+	expected := "### @alice (2024-03-15 10:30 UTC)\n\nFirst!\n\n### @bob\n\nSecond"
+	assert.Equal(t, expected, formatIssueCommentsMarkdown(comments))
+	assert.Equal(t, "No comments found.", formatIssueCommentsMarkdown(nil))
+}
+
+func Test_ListIssues_MarkdownFormat(t *testing.T) {
+	mockIssues := []*github.Issue{
+		{
+			Number: github.Ptr(123),
+			Title:  github.Ptr("First Issue"),
+			State:  github.Ptr("open"),
+		},
+	}
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetReposIssuesByOwnerByRepo,
+			mockIssues,
+		),
+	))
+	_, handler := ListIssues(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":  "owner",
+		"repo":   "repo",
+		"format": "markdown",
+	}))
+	require.NoError(t, err)
+	textContent := getTextResult(t, result)
+	assert.Equal(t, formatIssueListMarkdown(mockIssues), textContent.Text)
+
+	// Invalid formats are rejected before any API call is made
+	_, handler = ListIssues(stubGetClientFn(github.NewClient(mock.NewMockedHTTPClient())), translations.NullTranslationHelper)
+	result, err = handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":  "owner",
+		"repo":   "repo",
+		"format": "html",
+	}))
+	require.NoError(t, err)
+	errorContent := getErrorResult(t, result)
+	assert.Contains(t, errorContent.Text, `invalid format "html"`)
+}
@@ -3,10 +3,14 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"path"
 	"strconv"
+	"strings"
 	"time"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
@@ -14,6 +18,7 @@ import (
 	"github.com/google/go-github/v73/github"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
 )
 
 const (
@@ -22,10 +27,70 @@ const (
 	FilterOnlyParticipating = "only_participating"
 )
 
+// NotificationSummary is the compact form of a notification thread, as returned by list_notifications.
+type NotificationSummary struct {
+	ID               string `json:"id"`
+	Reason           string `json:"reason"`
+	Unread           bool   `json:"unread"`
+	UpdatedAt        string `json:"updated_at,omitempty"`
+	Repository       string `json:"repository"`
+	SubjectTitle     string `json:"subject_title"`
+	SubjectType      string `json:"subject_type"`
+	SubjectURL       string `json:"subject_url,omitempty"`
+	LatestCommentURL string `json:"latest_comment_url,omitempty"`
+}
+
+// summarizeNotification converts a notification thread into its compact summary form.
+func summarizeNotification(notification *github.Notification) NotificationSummary {
+	return NotificationSummary{
+		ID:               notification.GetID(),
+		Reason:           notification.GetReason(),
+		Unread:           notification.GetUnread(),
+		UpdatedAt:        summaryTimestamp(notification.GetUpdatedAt()),
+		Repository:       notification.GetRepository().GetFullName(),
+		SubjectTitle:     notification.GetSubject().GetTitle(),
+		SubjectType:      notification.GetSubject().GetType(),
+		SubjectURL:       notification.GetSubject().GetURL(),
+		LatestCommentURL: notification.GetSubject().GetLatestCommentURL(),
+	}
+}
+
+// NotificationCounts is the result of list_notifications in counts_only mode.
+type NotificationCounts struct {
+	Total        int            `json:"total"`
+	Unread       int            `json:"unread"`
+	ByReason     map[string]int `json:"by_reason"`
+	ByRepository map[string]int `json:"by_repository"`
+	// Truncated is set when more notifications matched than could be counted, in which case
+	// only the most recently updated ones are included.
+	Truncated bool `json:"truncated"`
+}
+
+// notificationCountMaxItems is the largest number of notifications counted in counts_only mode.
+const notificationCountMaxItems = DefaultFetchAllMaxItems
+
+// countNotifications groups the notifications by reason and by repository.
+func countNotifications(notifications []*github.Notification, truncated bool) NotificationCounts {
+	counts := NotificationCounts{
+		ByReason:     map[string]int{},
+		ByRepository: map[string]int{},
+		Truncated:    truncated,
+	}
+	for _, notification := range notifications {
+		counts.Total++
+		if notification.GetUnread() {
+			counts.Unread++
+		}
+		counts.ByReason[notification.GetReason()]++
+		counts.ByRepository[notification.GetRepository().GetFullName()]++
+	}
+	return counts
+}
+
 // ListNotifications creates a tool to list notifications for the current user.
-func ListNotifications(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+func ListNotifications(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("list_notifications",
-			mcp.WithDescription(t("TOOL_LIST_NOTIFICATIONS_DESCRIPTION", "Lists all GitHub notifications for the authenticated user, including unread notifications, mentions, review requests, assignments, and updates on issues or pull requests. Use this tool whenever the user asks what to work on next, requests a summary of their GitHub activity, wants to see pending reviews, or needs to check for new updates or tasks. This tool is the primary way to discover actionable items, reminders, and outstanding work on GitHub. Always call this tool when asked what to work on next, what is pending, or what needs attention in GitHub.")),
+			mcp.WithDescription(t("TOOL_LIST_NOTIFICATIONS_DESCRIPTION", "Lists all GitHub notifications for the authenticated user, including unread notifications, mentions, review requests, assignments, and updates on issues or pull requests. Use this tool whenever the user asks what to work on next, requests a summary of their GitHub activity, wants to see pending reviews, or needs to check for new updates or tasks. This tool is the primary way to discover actionable items, reminders, and outstanding work on GitHub. Always call this tool when asked what to work on next, what is pending, or what needs attention in GitHub. Returns the id, reason, repository and subject of each thread; set include_subjects to also get the type, number, title, state, author and update time of the item each notification is about in the same call, or counts_only to get totals by reason and repository as a cheap overview.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_LIST_NOTIFICATIONS_USER_TITLE", "List notifications"),
 				ReadOnlyHint: ToBoolPtr(true),
@@ -35,14 +100,24 @@ func ListNotifications(getClient GetClientFn, t translations.TranslationHelperFu
 				mcp.Enum(FilterDefault, FilterIncludeRead, FilterOnlyParticipating),
 			),
 			mcp.WithString("since",
-				mcp.Description("Only show notifications updated after the given time (ISO 8601 format)"),
+				mcp.Description("Only show notifications updated after the given time (ISO 8601 format, or relative such as 24h, 7d, 2w, yesterday)"),
 			),
 			mcp.WithString("before",
-				mcp.Description("Only show notifications updated before the given time (ISO 8601 format)"),
+				mcp.Description("Only show notifications updated before the given time (ISO 8601 format, or relative such as 24h, 7d, 2w, yesterday)"),
+			),
+			mcp.WithString("reason",
+				mcp.Description("Only show notifications with this reason. The filter is applied to each page, so a page can hold fewer notifications than perPage."),
+				mcp.Enum("approval_requested", "assign", "author", "ci_activity", "comment", "invitation", "manual", "member_feature_requested", "mention", "review_requested", "security_advisory_credit", "security_alert", "state_change", "subscribed", "team_mention"),
 			),
 			mcp.WithString("owner",
 				mcp.Description("Optional repository owner. If provided with repo, only notifications for this repository are listed."),
 			),
+			mcp.WithBoolean("counts_only",
+				mcp.Description(fmt.Sprintf("Instead of listing notifications, count all those matching the filters, grouped by reason and by repository. Pages through up to %d notifications, ignoring page and perPage, and reports whether the count was truncated", notificationCountMaxItems)),
+			),
+			mcp.WithBoolean("include_subjects",
+				mcp.Description("Also resolve the issue, pull request, release or discussion each notification is about. Subjects that can't be resolved are skipped with a note on the notification."),
+			),
 			mcp.WithString("repo",
 				mcp.Description("Optional repository name. If provided with owner, only notifications for this repository are listed."),
 			),
@@ -69,6 +144,11 @@ func ListNotifications(getClient GetClientFn, t translations.TranslationHelperFu
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
+			reason, err := OptionalParam[string](request, "reason")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
 			owner, err := OptionalParam[string](request, "owner")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
@@ -77,6 +157,17 @@ func ListNotifications(getClient GetClientFn, t translations.TranslationHelperFu
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			includeSubjects, err := OptionalParam[bool](request, "include_subjects")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			countsOnly, err := OptionalParam[bool](request, "counts_only")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if countsOnly && includeSubjects {
+				return mcp.NewToolResultError("counts_only and include_subjects can't be combined"), nil
+			}
 
 			paginationParams, err := OptionalPaginationParams(request)
 			if err != nil {
@@ -95,29 +186,45 @@ func ListNotifications(getClient GetClientFn, t translations.TranslationHelperFu
 
 			// Parse time parameters if provided
 			if since != "" {
-				sinceTime, err := time.Parse(time.RFC3339, since)
+				sinceTime, err := parseISOTimestamp(since)
 				if err != nil {
-					return mcp.NewToolResultError(fmt.Sprintf("invalid since time format, should be RFC3339/ISO8601: %v", err)), nil
+					return mcp.NewToolResultError(fmt.Sprintf("invalid since time: %v", err)), nil
 				}
 				opts.Since = sinceTime
 			}
 
 			if before != "" {
-				beforeTime, err := time.Parse(time.RFC3339, before)
+				beforeTime, err := parseISOTimestamp(before)
 				if err != nil {
-					return mcp.NewToolResultError(fmt.Sprintf("invalid before time format, should be RFC3339/ISO8601: %v", err)), nil
+					return mcp.NewToolResultError(fmt.Sprintf("invalid before time: %v", err)), nil
 				}
 				opts.Before = beforeTime
 			}
 
-			var notifications []*github.Notification
-			var resp *github.Response
+			listNotifications := func(opts *github.NotificationListOptions) ([]*github.Notification, *github.Response, error) {
+				if owner != "" && repo != "" {
+					return client.Activity.ListRepositoryNotifications(ctx, owner, repo, opts)
+				}
+				return client.Activity.ListNotifications(ctx, opts)
+			}
 
-			if owner != "" && repo != "" {
-				notifications, resp, err = client.Activity.ListRepositoryNotifications(ctx, owner, repo, opts)
-			} else {
-				notifications, resp, err = client.Activity.ListNotifications(ctx, opts)
+			if countsOnly {
+				result, resp, err := fetchAllPages(ctx, 1, notificationCountMaxItems, func(page int) ([]*github.Notification, *github.Response, error) {
+					pageOpts := *opts
+					pageOpts.ListOptions = github.ListOptions{Page: page, PerPage: 50}
+					return listNotifications(&pageOpts)
+				})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to list notifications",
+						resp,
+						err,
+					), nil
+				}
+				return MarshalledTextResult(countNotifications(filterNotificationsByReason(result.Items, reason), result.Truncated)), nil
 			}
+
+			notifications, resp, err := listNotifications(opts)
 			if err != nil {
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
 					"failed to list notifications",
@@ -135,8 +242,19 @@ func ListNotifications(getClient GetClientFn, t translations.TranslationHelperFu
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get notifications: %s", string(body))), nil
 			}
 
+			notifications = filterNotificationsByReason(notifications, reason)
+
+			if includeSubjects {
+				return MarshalledTextResult(resolveNotificationThreads(ctx, client, getGQLClient, notifications)), nil
+			}
+
+			summaries := make([]NotificationSummary, 0, len(notifications))
+			for _, notification := range notifications {
+				summaries = append(summaries, summarizeNotification(notification))
+			}
+
 			// Marshal response to JSON
-			r, err := json.Marshal(notifications)
+			r, err := json.Marshal(summaries)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -145,6 +263,21 @@ func ListNotifications(getClient GetClientFn, t translations.TranslationHelperFu
 		}
 }
 
+// filterNotificationsByReason keeps the notifications with the given reason, or all of them if
+// reason is empty. The API has no reason filter, so it is applied to the listed notifications.
+func filterNotificationsByReason(notifications []*github.Notification, reason string) []*github.Notification {
+	if reason == "" {
+		return notifications
+	}
+	filtered := make([]*github.Notification, 0, len(notifications))
+	for _, notification := range notifications {
+		if notification.GetReason() == reason {
+			filtered = append(filtered, notification)
+		}
+	}
+	return filtered
+}
+
 // DismissNotification creates a tool to mark a notification as read/done.
 func DismissNotification(getclient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("dismiss_notification",
@@ -212,16 +345,209 @@ func DismissNotification(getclient GetClientFn, t translations.TranslationHelper
 		}
 }
 
+// NotificationMarkResult reports the outcome of marking a single notification thread.
+type NotificationMarkResult struct {
+	ThreadID string `json:"thread_id"`
+	Success  bool   `json:"success"`
+	Error    string `json:"error,omitempty"`
+}
+
+// NotificationMarkReport reports the outcome of marking one or more notification threads.
+type NotificationMarkReport struct {
+	Results   []NotificationMarkResult `json:"results"`
+	Succeeded int                      `json:"succeeded"`
+	Failed    int                      `json:"failed"`
+}
+
+// withThreadIDs adds the threadID and threadIDs parameters used by tools that act on one or more
+// notification threads.
+func withThreadIDs() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("threadID",
+			mcp.Description("The ID of a single notification thread. Provide this or threadIDs."),
+		)(tool)
+		mcp.WithArray("threadIDs",
+			mcp.Description("The IDs of several notification threads. Provide this or threadID."),
+			mcp.Items(map[string]any{
+				"type": "string",
+			}),
+		)(tool)
+	}
+}
+
+// requiredThreadIDs gets the notification thread IDs from the threadID and threadIDs parameters,
+// dropping duplicates. At least one ID must be given.
+func requiredThreadIDs(r mcp.CallToolRequest) ([]string, error) {
+	var ids []string
+	if _, ok := r.GetArguments()["threadID"]; ok {
+		id, err := requiredThreadID(r, "threadID")
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	switch v := r.GetArguments()["threadIDs"].(type) {
+	case nil:
+	case []any:
+		for i, item := range v {
+			id, err := threadIDValue("threadIDs", item)
+			if err != nil {
+				return nil, fmt.Errorf("invalid thread ID at index %d: %w", i, err)
+			}
+			ids = append(ids, id)
+		}
+	case []string:
+		for i, id := range v {
+			if id == "" {
+				return nil, fmt.Errorf("invalid thread ID at index %d: missing required parameter: threadIDs", i)
+			}
+			ids = append(ids, id)
+		}
+	default:
+		return nil, fmt.Errorf("parameter threadIDs must be an array, is %T", v)
+	}
+
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("missing required parameter: provide threadID or threadIDs")
+	}
+
+	seen := make(map[string]bool, len(ids))
+	unique := ids[:0]
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique, nil
+}
+
+// markNotificationThreads applies mark to each thread, carrying on past individual failures.
+// A 304 Not Modified response means the thread was already in the requested state and counts
+// as success.
+func markNotificationThreads(ctx context.Context, threadIDs []string, state string, mark func(threadID string) (*github.Response, error)) NotificationMarkReport {
+	report := NotificationMarkReport{Results: make([]NotificationMarkResult, 0, len(threadIDs))}
+	for _, threadID := range threadIDs {
+		resp, err := mark(threadID)
+		if resp != nil {
+			_ = resp.Body.Close()
+		}
+		if err != nil && (resp == nil || resp.StatusCode != http.StatusNotModified) {
+			if resp != nil {
+				_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, fmt.Sprintf("failed to mark notification thread '%s' as %s", threadID, state), resp, err)
+			}
+			report.Results = append(report.Results, NotificationMarkResult{ThreadID: threadID, Error: err.Error()})
+			report.Failed++
+			continue
+		}
+		report.Results = append(report.Results, NotificationMarkResult{ThreadID: threadID, Success: true})
+		report.Succeeded++
+	}
+	return report
+}
+
+// MarkNotificationRead creates a tool to mark one or more notification threads as read.
+func MarkNotificationRead(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("mark_notification_read",
+			mcp.WithDescription(t("TOOL_MARK_NOTIFICATION_READ_DESCRIPTION", "Mark one or more notification threads as read. Threads that are already read count as success, and a failure on one thread does not stop the others. Returns a result for each thread.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_MARK_NOTIFICATION_READ_USER_TITLE", "Mark notifications as read"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			withThreadIDs(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			threadIDs, err := requiredThreadIDs(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			report := markNotificationThreads(ctx, threadIDs, "read", func(threadID string) (*github.Response, error) {
+				return client.Activity.MarkThreadRead(ctx, threadID)
+			})
+			return MarshalledTextResult(report), nil
+		}
+}
+
+// MarkNotificationDone creates a tool to mark one or more notification threads as done.
+func MarkNotificationDone(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("mark_notification_done",
+			mcp.WithDescription(t("TOOL_MARK_NOTIFICATION_DONE_DESCRIPTION", "Mark one or more notification threads as done, removing them from the inbox. A failure on one thread does not stop the others. Returns a result for each thread.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_MARK_NOTIFICATION_DONE_USER_TITLE", "Mark notifications as done"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			withThreadIDs(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			threadIDs, err := requiredThreadIDs(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			report := markNotificationThreads(ctx, threadIDs, "done", func(threadID string) (*github.Response, error) {
+				// MarkThreadDone takes the thread ID as an int64, unlike the other thread endpoints
+				id, err := strconv.ParseInt(threadID, 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("invalid threadID format: %w", err)
+				}
+				return client.Activity.MarkThreadDone(ctx, id)
+			})
+			return MarshalledTextResult(report), nil
+		}
+}
+
+// countUnreadNotifications counts the unread notification threads, across all repositories or in
+// owner/repo when both are set. It stops counting at DefaultFetchAllMaxItems and reports whether
+// it did. The returned response, if any, has its body closed already.
+func countUnreadNotifications(ctx context.Context, client *github.Client, owner, repo string) (int, bool, *github.Response, error) {
+	result, resp, err := fetchAllPages(ctx, 1, DefaultFetchAllMaxItems, func(page int) ([]*github.Notification, *github.Response, error) {
+		opts := &github.NotificationListOptions{
+			ListOptions: github.ListOptions{Page: page, PerPage: 50},
+		}
+		if owner != "" && repo != "" {
+			return client.Activity.ListRepositoryNotifications(ctx, owner, repo, opts)
+		}
+		return client.Activity.ListNotifications(ctx, opts)
+	})
+	if resp != nil {
+		_ = resp.Body.Close()
+	}
+	if err != nil {
+		return 0, false, resp, err
+	}
+	return len(result.Items), result.Truncated, resp, nil
+}
+
+// formatUnreadCount formats a count from countUnreadNotifications.
+func formatUnreadCount(count int, truncated bool) string {
+	if truncated {
+		return fmt.Sprintf("%d+", count)
+	}
+	return strconv.Itoa(count)
+}
+
 // MarkAllNotificationsRead creates a tool to mark all notifications as read.
 func MarkAllNotificationsRead(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("mark_all_notifications_read",
-			mcp.WithDescription(t("TOOL_MARK_ALL_NOTIFICATIONS_READ_DESCRIPTION", "Mark all notifications as read")),
+			mcp.WithDescription(t("TOOL_MARK_ALL_NOTIFICATIONS_READ_DESCRIPTION", "Mark all notifications as read, optionally only those in one repository. Requires confirm to be true, and reports the number of unread notifications before and after.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_MARK_ALL_NOTIFICATIONS_READ_USER_TITLE", "Mark all notifications as read"),
 				ReadOnlyHint: ToBoolPtr(false),
 			}),
 			mcp.WithString("lastReadAt",
-				mcp.Description("Describes the last point that notifications were checked (optional). Default: Now"),
+				mcp.Description("Describes the last point that notifications were checked (optional, ISO 8601 format or relative such as 24h, 7d, yesterday). Default: Now"),
 			),
 			mcp.WithString("owner",
 				mcp.Description("Optional repository owner. If provided with repo, only notifications for this repository are marked as read."),
@@ -229,13 +555,12 @@ func MarkAllNotificationsRead(getClient GetClientFn, t translations.TranslationH
 			mcp.WithString("repo",
 				mcp.Description("Optional repository name. If provided with owner, only notifications for this repository are marked as read."),
 			),
+			mcp.WithBoolean("confirm",
+				mcp.Required(),
+				mcp.Description("Must be true to confirm that the notifications should be marked as read"),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			client, err := getClient(ctx)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
-			}
-
 			lastReadAt, err := OptionalParam[string](request, "lastReadAt")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
@@ -249,45 +574,88 @@ func MarkAllNotificationsRead(getClient GetClientFn, t translations.TranslationH
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			// RequiredParam rejects false, which deserves its own explanation.
+			confirm, err := OptionalParam[bool](request, "confirm")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if !confirm {
+				return mcp.NewToolResultError("confirm must be true to mark all notifications as read"), nil
+			}
 
 			var lastReadTime time.Time
 			if lastReadAt != "" {
-				lastReadTime, err = time.Parse(time.RFC3339, lastReadAt)
+				lastReadTime, err = parseISOTimestamp(lastReadAt)
 				if err != nil {
-					return mcp.NewToolResultError(fmt.Sprintf("invalid lastReadAt time format, should be RFC3339/ISO8601: %v", err)), nil
+					return mcp.NewToolResultError(fmt.Sprintf("invalid lastReadAt time: %v", err)), nil
 				}
 			} else {
-				lastReadTime = time.Now()
+				lastReadTime = timeNow()
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			before, beforeTruncated, resp, err := countUnreadNotifications(ctx, client, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to count unread notifications",
+					resp,
+					err,
+				), nil
 			}
 
 			markReadOptions := github.Timestamp{
 				Time: lastReadTime,
 			}
 
-			var resp *github.Response
+			var markResp *github.Response
 			if owner != "" && repo != "" {
-				resp, err = client.Activity.MarkRepositoryNotificationsRead(ctx, owner, repo, markReadOptions)
+				markResp, err = client.Activity.MarkRepositoryNotificationsRead(ctx, owner, repo, markReadOptions)
 			} else {
-				resp, err = client.Activity.MarkNotificationsRead(ctx, markReadOptions)
+				markResp, err = client.Activity.MarkNotificationsRead(ctx, markReadOptions)
 			}
-			if err != nil {
+			// GitHub answers with a 202 when there are too many notifications to mark synchronously,
+			// which go-github reports as an AcceptedError.
+			accepted := err != nil && markResp != nil && markResp.StatusCode == http.StatusAccepted && isAcceptedError(err)
+			if err != nil && !accepted {
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
 					"failed to mark all notifications as read",
-					resp,
+					markResp,
 					err,
 				), nil
 			}
-			defer func() { _ = resp.Body.Close() }()
 
-			if resp.StatusCode != http.StatusResetContent && resp.StatusCode != http.StatusOK {
-				body, err := io.ReadAll(resp.Body)
+			if !accepted && markResp.StatusCode != http.StatusResetContent && markResp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(markResp.Body)
+				_ = markResp.Body.Close()
 				if err != nil {
 					return nil, fmt.Errorf("failed to read response body: %w", err)
 				}
 				return mcp.NewToolResultError(fmt.Sprintf("failed to mark all notifications as read: %s", string(body))), nil
 			}
+			_ = markResp.Body.Close()
 
-			return mcp.NewToolResultText("All notifications marked as read"), nil
+			scope := "All notifications"
+			if owner != "" && repo != "" {
+				scope = fmt.Sprintf("All notifications in %s/%s", owner, repo)
+			}
+			message := fmt.Sprintf("%s marked as read", scope)
+			if accepted {
+				message = fmt.Sprintf("%s are being marked as read; GitHub is processing the request asynchronously, so some may still show as unread for a while", scope)
+			}
+
+			after, afterTruncated, afterResp, err := countUnreadNotifications(ctx, client, owner, repo)
+			if err != nil {
+				_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to count unread notifications", afterResp, err)
+				return mcp.NewToolResultText(fmt.Sprintf("%s. Unread before: %s; failed to count unread notifications afterwards: %v",
+					message, formatUnreadCount(before, beforeTruncated), err)), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("%s. Unread before: %s, unread after: %s",
+				message, formatUnreadCount(before, beforeTruncated), formatUnreadCount(after, afterTruncated))), nil
 		}
 }
 
@@ -342,6 +710,381 @@ func GetNotificationDetails(getClient GetClientFn, t translations.TranslationHel
 		}
 }
 
+// notificationCommentMaxBytes is the longest latest comment body get_notification_thread returns.
+const notificationCommentMaxBytes = 2000
+
+// NotificationComment is the latest comment on the subject of a notification thread.
+type NotificationComment struct {
+	Author    string `json:"author"`
+	Body      string `json:"body"`
+	Truncated bool   `json:"truncated,omitempty"`
+	HTMLURL   string `json:"html_url,omitempty"`
+}
+
+// NotificationSubjectDetails is the issue, pull request, release or discussion a notification thread is about.
+type NotificationSubjectDetails struct {
+	Type          string               `json:"type"`
+	Number        int                  `json:"number,omitempty"`
+	TagName       string               `json:"tag_name,omitempty"`
+	Title         string               `json:"title"`
+	State         string               `json:"state"`
+	Author        string               `json:"author,omitempty"`
+	UpdatedAt     string               `json:"updated_at,omitempty"`
+	HTMLURL       string               `json:"html_url,omitempty"`
+	LatestComment *NotificationComment `json:"latest_comment,omitempty"`
+}
+
+// NotificationThread is the result of get_notification_thread. Note explains why the subject is
+// missing when it could not be resolved.
+type NotificationThread struct {
+	NotificationSummary
+	Subject *NotificationSubjectDetails `json:"subject,omitempty"`
+	Note    string                      `json:"note,omitempty"`
+}
+
+// requiredThreadID gets a notification thread ID from the request. The API returns thread IDs as
+// strings, but they are accepted as whole numbers too.
+func requiredThreadID(r mcp.CallToolRequest, p string) (string, error) {
+	return threadIDValue(p, r.GetArguments()[p])
+}
+
+// threadIDValue converts a thread ID given as a string or whole number to a string.
+func threadIDValue(p string, value any) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", fmt.Errorf("missing required parameter: %s", p)
+	case string:
+		if v == "" {
+			return "", fmt.Errorf("missing required parameter: %s", p)
+		}
+		return v, nil
+	case float64:
+		if v < 1 || float64(int64(v)) != v {
+			return "", fmt.Errorf("parameter %s must be a positive whole number, got %v", p, v)
+		}
+		return strconv.FormatInt(int64(v), 10), nil
+	default:
+		return "", fmt.Errorf("parameter %s must be a string or a number", p)
+	}
+}
+
+// subjectNumber gets the number or ID of the item a notification subject API URL points to, such as
+// 7 for https://api.github.com/repos/octocat/hello-world/pulls/7.
+func subjectNumber(subjectURL string) (int64, error) {
+	u, err := url.Parse(subjectURL)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(path.Base(u.Path), 10, 64)
+}
+
+// getLatestComment gets the latest comment on the subject of a notification thread from its API URL.
+// Issue, pull request and review comments all carry the fields read here.
+func getLatestComment(ctx context.Context, client *github.Client, commentURL string) (*NotificationComment, *github.Response, error) {
+	req, err := client.NewRequest(http.MethodGet, commentURL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var comment struct {
+		Body    string `json:"body"`
+		HTMLURL string `json:"html_url"`
+		User    struct {
+			Login string `json:"login"`
+		} `json:"user"`
+	}
+	resp, err := client.Do(ctx, req, &comment)
+	if err != nil {
+		return nil, resp, err
+	}
+	body, truncated := truncateContent([]byte(comment.Body), notificationCommentMaxBytes)
+	return &NotificationComment{
+		Author:    comment.User.Login,
+		Body:      string(body),
+		Truncated: truncated,
+		HTMLURL:   comment.HTMLURL,
+	}, resp, nil
+}
+
+// getNotificationDiscussion finds the discussion a notification thread is about. The REST API gives
+// discussion subjects no URL, so the discussion is searched for by its title.
+func getNotificationDiscussion(ctx context.Context, client *githubv4.Client, repository, title string) (*NotificationSubjectDetails, error) {
+	var q struct {
+		Search struct {
+			Nodes []struct {
+				Discussion struct {
+					Number    githubv4.Int
+					Title     githubv4.String
+					Closed    githubv4.Boolean
+					UpdatedAt githubv4.DateTime
+					URL       githubv4.String `graphql:"url"`
+					Author    struct {
+						Login githubv4.String
+					}
+					Comments struct {
+						Nodes []struct {
+							Body   githubv4.String
+							URL    githubv4.String `graphql:"url"`
+							Author struct {
+								Login githubv4.String
+							}
+						}
+					} `graphql:"comments(last: 1)"`
+				} `graphql:"... on Discussion"`
+			}
+		} `graphql:"search(query: $query, type: DISCUSSION, first: 5)"`
+	}
+	vars := map[string]interface{}{
+		"query": githubv4.String(fmt.Sprintf(`repo:%s in:title "%s"`, repository, strings.ReplaceAll(title, `"`, ""))),
+	}
+	if err := client.Query(ctx, &q, vars); err != nil {
+		return nil, err
+	}
+	for _, node := range q.Search.Nodes {
+		d := node.Discussion
+		if string(d.Title) != title {
+			continue
+		}
+		details := &NotificationSubjectDetails{
+			Type:      "Discussion",
+			Number:    int(d.Number),
+			Title:     string(d.Title),
+			State:     "open",
+			Author:    string(d.Author.Login),
+			UpdatedAt: summaryTimestamp(github.Timestamp{Time: d.UpdatedAt.Time}),
+			HTMLURL:   string(d.URL),
+		}
+		if d.Closed {
+			details.State = "closed"
+		}
+		if len(d.Comments.Nodes) > 0 {
+			comment := d.Comments.Nodes[0]
+			body, truncated := truncateContent([]byte(comment.Body), notificationCommentMaxBytes)
+			details.LatestComment = &NotificationComment{
+				Author:    string(comment.Author.Login),
+				Body:      string(body),
+				Truncated: truncated,
+				HTMLURL:   string(comment.URL),
+			}
+		}
+		return details, nil
+	}
+	return nil, fmt.Errorf("no discussion titled %q found in %s", title, repository)
+}
+
+// errSubjectNotResolved is returned by getNotificationSubject for subject types it doesn't resolve.
+var errSubjectNotResolved = errors.New("subject type is not resolved")
+
+// getNotificationSubject fetches the issue, pull request, release or discussion a notification is
+// about. The GraphQL client is only requested for discussions.
+func getNotificationSubject(ctx context.Context, client *github.Client, getGQLClient GetGQLClientFn, notification *github.Notification) (*NotificationSubjectDetails, *github.Response, error) {
+	owner := notification.GetRepository().GetOwner().GetLogin()
+	repo := notification.GetRepository().GetName()
+	subjectType := notification.GetSubject().GetType()
+	subjectURL := notification.GetSubject().GetURL()
+
+	switch subjectType {
+	case "Issue", "PullRequest", "Release":
+	case "Discussion":
+		gqlClient, err := getGQLClient(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+		}
+		subject, err := getNotificationDiscussion(ctx, gqlClient, notification.GetRepository().GetFullName(), notification.GetSubject().GetTitle())
+		return subject, nil, err
+	default:
+		return nil, nil, errSubjectNotResolved
+	}
+
+	number, err := subjectNumber(subjectURL)
+	if err != nil {
+		return nil, nil, fmt.Errorf("the subject URL %q could not be parsed", subjectURL)
+	}
+
+	switch subjectType {
+	case "Issue":
+		issue, resp, err := client.Issues.Get(ctx, owner, repo, int(number))
+		if err != nil {
+			return nil, resp, err
+		}
+		return &NotificationSubjectDetails{
+			Type:      subjectType,
+			Number:    issue.GetNumber(),
+			Title:     issue.GetTitle(),
+			State:     issue.GetState(),
+			Author:    issue.GetUser().GetLogin(),
+			UpdatedAt: summaryTimestamp(issue.GetUpdatedAt()),
+			HTMLURL:   issue.GetHTMLURL(),
+		}, resp, nil
+	case "PullRequest":
+		pr, resp, err := client.PullRequests.Get(ctx, owner, repo, int(number))
+		if err != nil {
+			return nil, resp, err
+		}
+		subject := &NotificationSubjectDetails{
+			Type:      subjectType,
+			Number:    pr.GetNumber(),
+			Title:     pr.GetTitle(),
+			State:     pr.GetState(),
+			Author:    pr.GetUser().GetLogin(),
+			UpdatedAt: summaryTimestamp(pr.GetUpdatedAt()),
+			HTMLURL:   pr.GetHTMLURL(),
+		}
+		if pr.GetMerged() {
+			subject.State = "merged"
+		}
+		return subject, resp, nil
+	default:
+		release, resp, err := client.Repositories.GetRelease(ctx, owner, repo, number)
+		if err != nil {
+			return nil, resp, err
+		}
+		subject := &NotificationSubjectDetails{
+			Type:    subjectType,
+			TagName: release.GetTagName(),
+			Title:   release.GetName(),
+			State:   "published",
+			Author:  release.GetAuthor().GetLogin(),
+			HTMLURL: release.GetHTMLURL(),
+		}
+		// Releases have no update time, so the publication time stands in for it.
+		if release.PublishedAt != nil {
+			subject.UpdatedAt = summaryTimestamp(*release.PublishedAt)
+		}
+		switch {
+		case release.GetDraft():
+			subject.State = "draft"
+		case release.GetPrerelease():
+			subject.State = "prerelease"
+		}
+		return subject, resp, nil
+	}
+}
+
+// deferredAPIError is an API error to be recorded in the context later. The context's error list
+// isn't safe for concurrent use, so workers hand their errors back to the calling goroutine.
+type deferredAPIError struct {
+	message string
+	resp    *github.Response
+	err     error
+}
+
+// record adds the error, if any, to the context.
+func (e *deferredAPIError) record(ctx context.Context) {
+	if e != nil {
+		_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, e.message, e.resp, e.err)
+	}
+}
+
+// resolveNotificationThread summarizes a notification together with the item it is about. Failing
+// to resolve the subject is reported in the note rather than as an error, so that the thread is
+// still returned. A failed API call is also returned, for the caller to record in the context.
+func resolveNotificationThread(ctx context.Context, client *github.Client, getGQLClient GetGQLClientFn, notification *github.Notification) (NotificationThread, *deferredAPIError) {
+	result := NotificationThread{NotificationSummary: summarizeNotification(notification)}
+	subjectType := notification.GetSubject().GetType()
+
+	subject, resp, err := getNotificationSubject(ctx, client, getGQLClient, notification)
+	if errors.Is(err, errSubjectNotResolved) {
+		result.Note = fmt.Sprintf("subjects of type %s are not resolved; use subject_url to look them up", subjectType)
+		return result, nil
+	}
+	if err != nil {
+		message := fmt.Sprintf("failed to get the %s the notification is about", subjectType)
+		result.Note = fmt.Sprintf("%s: %s", message, err)
+		if resp != nil {
+			return result, &deferredAPIError{message: message, resp: resp, err: err}
+		}
+		return result, nil
+	}
+	if resp != nil {
+		_ = resp.Body.Close()
+	}
+	result.Subject = subject
+	return result, nil
+}
+
+// notificationSubjectConcurrency is the largest number of notification subjects resolved at once.
+const notificationSubjectConcurrency = 5
+
+// resolveNotificationThreads resolves the subjects of the notifications concurrently, keeping
+// their order. Once the context is cancelled the remaining notifications are returned without
+// their subject.
+func resolveNotificationThreads(ctx context.Context, client *github.Client, getGQLClient GetGQLClientFn, notifications []*github.Notification) []NotificationThread {
+	threads := make([]NotificationThread, len(notifications))
+	apiErrors := make([]*deferredAPIError, len(notifications))
+	forEachConcurrently(ctx, len(notifications), notificationSubjectConcurrency,
+		func(i int) {
+			threads[i], apiErrors[i] = resolveNotificationThread(ctx, client, getGQLClient, notifications[i])
+		},
+		func(i int, err error) {
+			threads[i] = NotificationThread{
+				NotificationSummary: summarizeNotification(notifications[i]),
+				Note:                fmt.Sprintf("subject not resolved: %s", err),
+			}
+		},
+	)
+	for _, apiErr := range apiErrors {
+		apiErr.record(ctx)
+	}
+	return threads
+}
+
+// GetNotificationThread creates a tool to get a notification thread together with the item it is about.
+func GetNotificationThread(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_notification_thread",
+			mcp.WithDescription(t("TOOL_GET_NOTIFICATION_THREAD_DESCRIPTION", "Get a notification thread and what it is about in one call. Issue, pull request, release and discussion subjects are resolved to their title, state, author and latest comment, to tell whether the notification needs action.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_NOTIFICATION_THREAD_USER_TITLE", "Get notification thread"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("threadID",
+				mcp.Required(),
+				mcp.Description("The ID of the notification thread, as returned by list_notifications"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			threadID, err := requiredThreadID(request, "threadID")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			thread, resp, err := client.Activity.GetThread(ctx, threadID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get notification thread '%s'", threadID),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			result, apiErr := resolveNotificationThread(ctx, client, getGQLClient, thread)
+			apiErr.record(ctx)
+			if result.Subject == nil || result.Subject.Type == "Discussion" {
+				// The discussion query already includes its latest comment.
+				return MarshalledTextResult(result), nil
+			}
+
+			// The latest comment URL points at the subject itself when nothing has been commented yet.
+			if commentURL := thread.GetSubject().GetLatestCommentURL(); commentURL != "" && commentURL != thread.GetSubject().GetURL() {
+				comment, commentResp, err := getLatestComment(ctx, client, commentURL)
+				if err != nil {
+					_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to get the latest comment", commentResp, err)
+					result.Note = fmt.Sprintf("failed to get the latest comment: %s", err)
+				} else {
+					_ = commentResp.Body.Close()
+					result.Subject.LatestComment = comment
+				}
+			}
+
+			return MarshalledTextResult(result), nil
+		}
+}
+
 // Enum values for ManageNotificationSubscription action
 const (
 	NotificationActionIgnore = "ignore"
@@ -523,3 +1266,460 @@ func ManageRepositoryNotificationSubscription(getClient GetClientFn, t translati
 			return mcp.NewToolResultText(string(r)), nil
 		}
 }
+
+// RepositorySubscription is the notification subscription of the current user to a repository.
+type RepositorySubscription struct {
+	// Subscribed is null when the user has no explicit subscription, in which case they may still
+	// watch the repository through the defaults of its organization.
+	Subscribed *bool  `json:"subscribed"`
+	Ignored    *bool  `json:"ignored,omitempty"`
+	Reason     string `json:"reason,omitempty"`
+	CreatedAt  string `json:"created_at,omitempty"`
+}
+
+func newRepositorySubscription(sub *github.Subscription) RepositorySubscription {
+	result := RepositorySubscription{
+		Subscribed: sub.Subscribed,
+		Ignored:    sub.Ignored,
+		Reason:     sub.GetReason(),
+	}
+	if sub.CreatedAt != nil {
+		result.CreatedAt = summaryTimestamp(*sub.CreatedAt)
+	}
+	return result
+}
+
+// GetRepositorySubscription creates a tool to get the notification subscription of the current user to a repository.
+func GetRepositorySubscription(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_repository_subscription",
+			mcp.WithDescription(t("TOOL_GET_REPOSITORY_SUBSCRIPTION_DESCRIPTION", "Get whether the current user watches or ignores a repository. subscribed is null when there is no explicit subscription")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_REPOSITORY_SUBSCRIPTION_USER_TITLE", "Get repository subscription"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			sub, resp, err := client.Activity.GetRepositorySubscription(ctx, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get repository subscription", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			// go-github reports the 404 returned when there is no explicit subscription as a nil subscription.
+			if sub == nil {
+				return MarshalledTextResult(RepositorySubscription{Reason: "no explicit subscription"}), nil
+			}
+
+			return MarshalledTextResult(newRepositorySubscription(sub)), nil
+		}
+}
+
+// WatchedRepository is a repository watched by a user, as returned by list_watched_repositories.
+type WatchedRepository struct {
+	RepositorySummary
+	Description string `json:"description,omitempty"`
+	// Ignored and SubscriptionNote are only set when the subscription state is requested.
+	Ignored          *bool  `json:"ignored,omitempty"`
+	SubscriptionNote string `json:"subscription_note,omitempty"`
+}
+
+// watchedRepositorySubscriptionConcurrency is the largest number of repository subscriptions looked up at once.
+const watchedRepositorySubscriptionConcurrency = 5
+
+// ListWatchedRepositories creates a tool to list the repositories a user watches.
+func ListWatchedRepositories(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_watched_repositories",
+			mcp.WithDescription(t("TOOL_LIST_WATCHED_REPOSITORIES_DESCRIPTION", "List the repositories watched by the current user, or by another user. Set include_subscription_state to also see whether notifications from each repository are ignored; use set_repository_subscription or delete_repository_subscription to stop watching noisy ones")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_WATCHED_REPOSITORIES_USER_TITLE", "List watched repositories"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("username",
+				mcp.Description("The user whose watched repositories to list. Defaults to the current user"),
+			),
+			mcp.WithBoolean("include_subscription_state",
+				mcp.Description("Also look up whether notifications from each repository are ignored. Only available for the current user, and costs one request per repository"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			username, err := OptionalParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			includeSubscriptionState, err := OptionalParam[bool](request, "include_subscription_state")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			// Subscriptions can only be looked up for the current user.
+			if includeSubscriptionState && username != "" {
+				return mcp.NewToolResultError("include_subscription_state is only available for the current user, leave username empty"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			repos, resp, err := client.Activity.ListWatched(ctx, username, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to list watched repositories",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			watched := make([]WatchedRepository, len(repos))
+			for i, repo := range repos {
+				watched[i] = WatchedRepository{
+					RepositorySummary: summarizeRepository(repo),
+					Description:       repo.GetDescription(),
+				}
+			}
+
+			if includeSubscriptionState {
+				apiErrors := make([]*deferredAPIError, len(repos))
+				forEachConcurrently(ctx, len(repos), watchedRepositorySubscriptionConcurrency,
+					func(i int) {
+						sub, resp, err := client.Activity.GetRepositorySubscription(ctx, repos[i].GetOwner().GetLogin(), repos[i].GetName())
+						if err != nil {
+							apiErrors[i] = &deferredAPIError{message: fmt.Sprintf("failed to get subscription to %s", repos[i].GetFullName()), resp: resp, err: err}
+							watched[i].SubscriptionNote = fmt.Sprintf("failed to get subscription: %s", err)
+							return
+						}
+						_ = resp.Body.Close()
+						// go-github reports the 404 returned when there is no explicit subscription as a nil subscription.
+						if sub == nil {
+							watched[i].SubscriptionNote = "no explicit subscription"
+							return
+						}
+						watched[i].Ignored = github.Ptr(sub.GetIgnored())
+					},
+					func(i int, err error) {
+						watched[i].SubscriptionNote = fmt.Sprintf("subscription not looked up: %s", err)
+					},
+				)
+				for _, apiErr := range apiErrors {
+					apiErr.record(ctx)
+				}
+			}
+
+			return MarshalledTextResult(watched), nil
+		}
+}
+
+// SetRepositorySubscription creates a tool to watch or ignore a repository.
+func SetRepositorySubscription(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("set_repository_subscription",
+			mcp.WithDescription(t("TOOL_SET_REPOSITORY_SUBSCRIPTION_DESCRIPTION", "Watch a repository to get notified of all its activity, or ignore it to get no notifications from it. subscribed and ignored can't both be true")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SET_REPOSITORY_SUBSCRIPTION_USER_TITLE", "Set repository subscription"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithBoolean("subscribed",
+				mcp.Description("Watch the repository"),
+			),
+			mcp.WithBoolean("ignored",
+				mcp.Description("Ignore all notifications from the repository"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			subscribed, subscribedSet, err := OptionalParamOK[bool](request, "subscribed")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ignored, ignoredSet, err := OptionalParamOK[bool](request, "ignored")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if !subscribedSet && !ignoredSet {
+				return mcp.NewToolResultError("one of subscribed or ignored must be provided"), nil
+			}
+			if subscribed && ignored {
+				return mcp.NewToolResultError("subscribed and ignored can't both be true"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			sub, resp, err := client.Activity.SetRepositorySubscription(ctx, owner, repo, &github.Subscription{
+				Subscribed: github.Ptr(subscribed),
+				Ignored:    github.Ptr(ignored),
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to set repository subscription", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(newRepositorySubscription(sub)), nil
+		}
+}
+
+// DeleteRepositorySubscription creates a tool to remove the explicit subscription of the current user to a repository.
+func DeleteRepositorySubscription(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("delete_repository_subscription",
+			mcp.WithDescription(t("TOOL_DELETE_REPOSITORY_SUBSCRIPTION_DESCRIPTION", "Stop watching or ignoring a repository, so only notifications for threads the current user participates in or is mentioned in are received")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_DELETE_REPOSITORY_SUBSCRIPTION_USER_TITLE", "Delete repository subscription"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.Activity.DeleteRepositorySubscription(ctx, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to delete repository subscription", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf("subscription to %s/%s deleted", owner, repo)), nil
+		}
+}
+
+// ThreadSubscription is the notification subscription of the current user to a notification thread.
+type ThreadSubscription struct {
+	ThreadID   string `json:"thread_id"`
+	Subscribed bool   `json:"subscribed"`
+	Ignored    bool   `json:"ignored"`
+	Reason     string `json:"reason,omitempty"`
+	CreatedAt  string `json:"created_at,omitempty"`
+	// State spells out what the subscription means for future notifications, as the subscribed
+	// and ignored flags are easy to misread.
+	State string `json:"state"`
+}
+
+// Explanations of the states a thread subscription can be in.
+const (
+	threadStateIgnored    = "muted: no further notifications will be sent for this thread"
+	threadStateSubscribed = "subscribed: notifications will be sent for all activity on this thread"
+	threadStateDefault    = "default: notifications for this thread follow the repository watch settings, and are sent when the current user comments or is mentioned"
+)
+
+func newThreadSubscription(threadID string, sub *github.Subscription) ThreadSubscription {
+	result := ThreadSubscription{
+		ThreadID:   threadID,
+		Subscribed: sub.GetSubscribed(),
+		Ignored:    sub.GetIgnored(),
+		Reason:     sub.GetReason(),
+	}
+	if sub.CreatedAt != nil {
+		result.CreatedAt = summaryTimestamp(*sub.CreatedAt)
+	}
+	switch {
+	case result.Ignored:
+		result.State = threadStateIgnored
+	case result.Subscribed:
+		result.State = threadStateSubscribed
+	default:
+		result.State = threadStateDefault
+	}
+	return result
+}
+
+// GetThreadSubscription creates a tool to get the subscription of the current user to a notification thread.
+func GetThreadSubscription(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_thread_subscription",
+			mcp.WithDescription(t("TOOL_GET_THREAD_SUBSCRIPTION_DESCRIPTION", "Get whether the current user is subscribed to a notification thread, has muted it (ignored), or neither, in which case its notifications follow the repository watch settings. The state field spells out what this means for future notifications")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_THREAD_SUBSCRIPTION_USER_TITLE", "Get notification thread subscription"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("threadID",
+				mcp.Required(),
+				mcp.Description("The ID of the notification thread"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			threadID, err := requiredThreadID(request, "threadID")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			sub, resp, err := client.Activity.GetThreadSubscription(ctx, threadID)
+			if err != nil {
+				// Subscriptions are only created once the user participates in the thread or
+				// subscribes to it, so a 404 means the defaults apply.
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					_ = resp.Body.Close()
+					return MarshalledTextResult(ThreadSubscription{
+						ThreadID: threadID,
+						Reason:   "no explicit subscription",
+						State:    threadStateDefault,
+					}), nil
+				}
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get subscription to notification thread '%s'", threadID),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(newThreadSubscription(threadID, sub)), nil
+		}
+}
+
+// SetThreadSubscription creates a tool to subscribe to or mute a notification thread.
+func SetThreadSubscription(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("set_thread_subscription",
+			mcp.WithDescription(t("TOOL_SET_THREAD_SUBSCRIPTION_DESCRIPTION", "Subscribe to or mute a notification thread. ignored=true mutes the thread so no further notifications are sent for it, even when the current user is mentioned; ignored=false subscribes to all activity on it. Use delete_thread_subscription to go back to the repository watch settings")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SET_THREAD_SUBSCRIPTION_USER_TITLE", "Set notification thread subscription"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("threadID",
+				mcp.Required(),
+				mcp.Description("The ID of the notification thread"),
+			),
+			mcp.WithBoolean("ignored",
+				mcp.Required(),
+				mcp.Description("true to mute the thread, false to subscribe to it"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			threadID, err := requiredThreadID(request, "threadID")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			// RequiredParam rejects false, which is a valid value here.
+			ignored, ok, err := OptionalParamOK[bool](request, "ignored")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if !ok {
+				return mcp.NewToolResultError("missing required parameter: ignored"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			sub, resp, err := client.Activity.SetThreadSubscription(ctx, threadID, &github.Subscription{
+				Ignored: github.Ptr(ignored),
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to set subscription to notification thread '%s'", threadID),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(newThreadSubscription(threadID, sub)), nil
+		}
+}
+
+// DeleteThreadSubscription creates a tool to remove the subscription of the current user to a notification thread.
+func DeleteThreadSubscription(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("delete_thread_subscription",
+			mcp.WithDescription(t("TOOL_DELETE_THREAD_SUBSCRIPTION_DESCRIPTION", "Remove the subscription to a notification thread, undoing both subscribing and muting. Notifications for the thread then follow the repository watch settings, and are sent when the current user comments or is mentioned. Use set_thread_subscription with ignored=true to mute a thread instead")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_DELETE_THREAD_SUBSCRIPTION_USER_TITLE", "Delete notification thread subscription"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("threadID",
+				mcp.Required(),
+				mcp.Description("The ID of the notification thread"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			threadID, err := requiredThreadID(request, "threadID")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.Activity.DeleteThreadSubscription(ctx, threadID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to delete subscription to notification thread '%s'", threadID),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf("subscription to notification thread %s deleted; the thread is no longer muted or subscribed, so its notifications follow the repository watch settings and are sent when the current user comments or is mentioned", threadID)), nil
+		}
+}
@@ -3,13 +3,23 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/github/github-mcp-server/internal/githubv4mock"
 	"github.com/github/github-mcp-server/internal/toolsnaps"
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v73/github"
 	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/shurcooL/githubv4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -17,7 +27,7 @@ import (
 func Test_ListNotifications(t *testing.T) {
 	// Verify tool definition and schema
 	mockClient := github.NewClient(nil)
-	tool, _ := ListNotifications(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := ListNotifications(stubGetClientFn(mockClient), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
 	require.NoError(t, toolsnaps.Test(tool.Name, tool))
 
 	assert.Equal(t, "list_notifications", tool.Name)
@@ -25,8 +35,11 @@ func Test_ListNotifications(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "filter")
 	assert.Contains(t, tool.InputSchema.Properties, "since")
 	assert.Contains(t, tool.InputSchema.Properties, "before")
+	assert.Contains(t, tool.InputSchema.Properties, "reason")
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "include_subjects")
+	assert.Contains(t, tool.InputSchema.Properties, "counts_only")
 	assert.Contains(t, tool.InputSchema.Properties, "page")
 	assert.Contains(t, tool.InputSchema.Properties, "perPage")
 	// All fields are optional, so Required should be empty
@@ -35,6 +48,51 @@ func Test_ListNotifications(t *testing.T) {
 	mockNotification := &github.Notification{
 		ID:     github.Ptr("123"),
 		Reason: github.Ptr("mention"),
+		Unread: github.Ptr(true),
+		Repository: &github.Repository{
+			FullName: github.Ptr("octocat/hello-world"),
+			Owner:    &github.User{Login: github.Ptr("octocat"), AvatarURL: github.Ptr("https://avatars.githubusercontent.com/u/583231")},
+		},
+		Subject: &github.NotificationSubject{
+			Title:            github.Ptr("Fix the flaky test"),
+			Type:             github.Ptr("PullRequest"),
+			URL:              github.Ptr("https://api.github.com/repos/octocat/hello-world/pulls/7"),
+			LatestCommentURL: github.Ptr("https://api.github.com/repos/octocat/hello-world/issues/comments/99"),
+		},
+		UpdatedAt: &github.Timestamp{Time: time.Date(2024, 3, 14, 9, 0, 0, 0, time.UTC)},
+	}
+	reviewNotification := &github.Notification{
+		ID:     github.Ptr("456"),
+		Reason: github.Ptr("review_requested"),
+		Unread: github.Ptr(true),
+		Repository: &github.Repository{
+			FullName: github.Ptr("octocat/hello-world"),
+		},
+		Subject: &github.NotificationSubject{
+			Title: github.Ptr("Add a retry to the client"),
+			Type:  github.Ptr("PullRequest"),
+			URL:   github.Ptr("https://api.github.com/repos/octocat/hello-world/pulls/8"),
+		},
+	}
+	mockSummary := NotificationSummary{
+		ID:               "123",
+		Reason:           "mention",
+		Unread:           true,
+		UpdatedAt:        "2024-03-14T09:00:00Z",
+		Repository:       "octocat/hello-world",
+		SubjectTitle:     "Fix the flaky test",
+		SubjectType:      "PullRequest",
+		SubjectURL:       "https://api.github.com/repos/octocat/hello-world/pulls/7",
+		LatestCommentURL: "https://api.github.com/repos/octocat/hello-world/issues/comments/99",
+	}
+	reviewSummary := NotificationSummary{
+		ID:           "456",
+		Reason:       "review_requested",
+		Unread:       true,
+		Repository:   "octocat/hello-world",
+		SubjectTitle: "Add a retry to the client",
+		SubjectType:  "PullRequest",
+		SubjectURL:   "https://api.github.com/repos/octocat/hello-world/pulls/8",
 	}
 
 	tests := []struct {
@@ -42,7 +100,7 @@ func Test_ListNotifications(t *testing.T) {
 		mockedClient   *http.Client
 		requestArgs    map[string]interface{}
 		expectError    bool
-		expectedResult []*github.Notification
+		expectedResult []NotificationSummary
 		expectedErrMsg string
 	}{
 		{
@@ -55,7 +113,7 @@ func Test_ListNotifications(t *testing.T) {
 			),
 			requestArgs:    map[string]interface{}{},
 			expectError:    false,
-			expectedResult: []*github.Notification{mockNotification},
+			expectedResult: []NotificationSummary{mockSummary},
 		},
 		{
 			name: "success with filter=include_read_notifications",
@@ -69,7 +127,7 @@ func Test_ListNotifications(t *testing.T) {
 				"filter": "include_read_notifications",
 			},
 			expectError:    false,
-			expectedResult: []*github.Notification{mockNotification},
+			expectedResult: []NotificationSummary{mockSummary},
 		},
 		{
 			name: "success with filter=only_participating",
@@ -83,7 +141,7 @@ func Test_ListNotifications(t *testing.T) {
 				"filter": "only_participating",
 			},
 			expectError:    false,
-			expectedResult: []*github.Notification{mockNotification},
+			expectedResult: []NotificationSummary{mockSummary},
 		},
 		{
 			name: "success for repo notifications",
@@ -103,7 +161,7 @@ func Test_ListNotifications(t *testing.T) {
 				"perPage": float64(10),
 			},
 			expectError:    false,
-			expectedResult: []*github.Notification{mockNotification},
+			expectedResult: []NotificationSummary{mockSummary},
 		},
 		{
 			name: "error",
@@ -117,12 +175,94 @@ func Test_ListNotifications(t *testing.T) {
 			expectError:    true,
 			expectedErrMsg: "error",
 		},
+		{
+			name: "success with relative since",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetNotifications,
+					expectQueryParams(t, map[string]string{
+						"since":    "2024-03-08T10:30:00Z",
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, []*github.Notification{mockNotification}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"since": "7d",
+			},
+			expectError:    false,
+			expectedResult: []NotificationSummary{mockSummary},
+		},
+		{
+			name: "success with relative before",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetNotifications,
+					expectQueryParams(t, map[string]string{
+						"before":   "2024-03-14T00:00:00Z",
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, []*github.Notification{mockNotification}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"before": "yesterday",
+			},
+			expectError:    false,
+			expectedResult: []NotificationSummary{mockSummary},
+		},
+		{
+			name: "success with reason filter",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetNotifications,
+					[]*github.Notification{mockNotification, reviewNotification},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"reason": "review_requested",
+			},
+			expectError:    false,
+			expectedResult: []NotificationSummary{reviewSummary},
+		},
+		{
+			name: "reason filter matching nothing",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetNotifications,
+					[]*github.Notification{mockNotification},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"reason": "assign",
+			},
+			expectError:    false,
+			expectedResult: []NotificationSummary{},
+		},
+		{
+			name:         "invalid since",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"since": "last tuesday",
+			},
+			expectError:    true,
+			expectedErrMsg: "invalid since time",
+		},
 	}
 
+	// Pin the clock so relative expressions resolve deterministically
+	originalTimeNow := timeNow
+	timeNow = func() time.Time { return time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC) }
+	t.Cleanup(func() { timeNow = originalTimeNow })
+
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			client := github.NewClient(tc.mockedClient)
-			_, handler := ListNotifications(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := ListNotifications(stubGetClientFn(client), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
 			request := createMCPRequest(tc.requestArgs)
 			result, err := handler(context.Background(), request)
 
@@ -140,15 +280,331 @@ func Test_ListNotifications(t *testing.T) {
 			require.False(t, result.IsError)
 			textContent := getTextResult(t, result)
 			t.Logf("textContent: %s", textContent.Text)
-			var returned []*github.Notification
+			// The bulky repository payload is left out
+			assert.NotContains(t, textContent.Text, "avatar")
+			var returned []NotificationSummary
 			err = json.Unmarshal([]byte(textContent.Text), &returned)
 			require.NoError(t, err)
-			require.NotEmpty(t, returned)
-			assert.Equal(t, *tc.expectedResult[0].ID, *returned[0].ID)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_ListNotifications_CountsOnly(t *testing.T) {
+	notification := func(id, reason, repository string, unread bool) *github.Notification {
+		return &github.Notification{
+			ID:         github.Ptr(id),
+			Reason:     github.Ptr(reason),
+			Unread:     github.Ptr(unread),
+			Repository: &github.Repository{FullName: github.Ptr(repository)},
+			Subject:    &github.NotificationSubject{Title: github.Ptr("notification " + id)},
+		}
+	}
+	firstPage := []*github.Notification{
+		notification("1", "mention", "octocat/hello-world", true),
+		notification("2", "review_requested", "octocat/hello-world", true),
+	}
+	secondPage := []*github.Notification{
+		notification("3", "mention", "octocat/spoon-knife", false),
+	}
+
+	// More notifications than can be counted
+	manyPages := []interface{}{}
+	for page := 0; page < notificationCountMaxItems/50+1; page++ {
+		notifications := make([]*github.Notification, 50)
+		for i := range notifications {
+			notifications[i] = notification(strconv.Itoa(page*50+i), "subscribed", "octocat/busy", true)
+		}
+		manyPages = append(manyPages, notifications)
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedCounts NotificationCounts
+		expectedErrMsg string
+	}{
+		{
+			name: "counts across pages",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchPages(
+					mock.GetNotifications,
+					firstPage,
+					secondPage,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"counts_only": true,
+				"filter":      FilterIncludeRead,
+				"since":       "yesterday",
+				// Ignored when counting
+				"page": float64(3),
+			},
+			expectError: false,
+			expectedCounts: NotificationCounts{
+				Total:        3,
+				Unread:       2,
+				ByReason:     map[string]int{"mention": 2, "review_requested": 1},
+				ByRepository: map[string]int{"octocat/hello-world": 2, "octocat/spoon-knife": 1},
+			},
+		},
+		{
+			name: "counts with reason filter in a repository",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposNotificationsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"page":     "1",
+						"per_page": "50",
+					}).andThen(
+						mockResponse(t, http.StatusOK, firstPage),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"counts_only": true,
+				"owner":       "octocat",
+				"repo":        "hello-world",
+				"reason":      "mention",
+			},
+			expectError: false,
+			expectedCounts: NotificationCounts{
+				Total:        1,
+				Unread:       1,
+				ByReason:     map[string]int{"mention": 1},
+				ByRepository: map[string]int{"octocat/hello-world": 1},
+			},
+		},
+		{
+			name: "count truncated",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchPages(
+					mock.GetNotifications,
+					manyPages...,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"counts_only": true,
+			},
+			expectError: false,
+			expectedCounts: NotificationCounts{
+				Total:        notificationCountMaxItems,
+				Unread:       notificationCountMaxItems,
+				ByReason:     map[string]int{"subscribed": notificationCountMaxItems},
+				ByRepository: map[string]int{"octocat/busy": notificationCountMaxItems},
+				Truncated:    true,
+			},
+		},
+		{
+			name:         "counts_only with include_subjects",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"counts_only":      true,
+				"include_subjects": true,
+			},
+			expectError:    true,
+			expectedErrMsg: "counts_only and include_subjects can't be combined",
+		},
+		{
+			name: "list fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetNotifications,
+					mockResponse(t, http.StatusInternalServerError, `{"message": "error"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"counts_only": true,
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list notifications",
+		},
+	}
+
+	// Pin the clock so relative expressions resolve deterministically
+	originalTimeNow := timeNow
+	timeNow = func() time.Time { return time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC) }
+	t.Cleanup(func() { timeNow = originalTimeNow })
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListNotifications(stubGetClientFn(client), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var returned NotificationCounts
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, tc.expectedCounts, returned)
 		})
 	}
 }
 
+func Test_ListNotifications_IncludeSubjects(t *testing.T) {
+	repository := &github.Repository{
+		Name:     github.Ptr("hello-world"),
+		FullName: github.Ptr("octocat/hello-world"),
+		Owner:    &github.User{Login: github.Ptr("octocat")},
+	}
+	notification := func(id, subjectType, subjectURL string) *github.Notification {
+		return &github.Notification{
+			ID:         github.Ptr(id),
+			Reason:     github.Ptr("subscribed"),
+			Unread:     github.Ptr(true),
+			Repository: repository,
+			Subject: &github.NotificationSubject{
+				Title: github.Ptr("notification " + id),
+				Type:  github.Ptr(subjectType),
+				URL:   ToStringPtr(subjectURL),
+			},
+		}
+	}
+
+	// Issues 1 to 8, where issue 4 has been deleted, plus a pull request and a check suite
+	notifications := []*github.Notification{}
+	for i := 1; i <= 8; i++ {
+		notifications = append(notifications, notification(strconv.Itoa(i), "Issue", fmt.Sprintf("https://api.github.com/repos/octocat/hello-world/issues/%d", i)))
+	}
+	notifications = append(notifications,
+		notification("9", "PullRequest", "https://api.github.com/repos/octocat/hello-world/pulls/9"),
+		notification("10", "CheckSuite", ""),
+	)
+
+	var inFlight, maxInFlight atomic.Int32
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetNotifications,
+			notifications,
+		),
+		mock.WithRequestMatchHandler(
+			mock.GetReposIssuesByOwnerByRepoByIssueNumber,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				current := inFlight.Add(1)
+				defer inFlight.Add(-1)
+				for {
+					highest := maxInFlight.Load()
+					if current <= highest || maxInFlight.CompareAndSwap(highest, current) {
+						break
+					}
+				}
+				// Give the other workers a chance to pile up
+				time.Sleep(10 * time.Millisecond)
+
+				number, _ := strconv.Atoi(path.Base(r.URL.Path))
+				if number == 4 {
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&github.Issue{
+					Number:    github.Ptr(number),
+					Title:     github.Ptr(fmt.Sprintf("Issue %d", number)),
+					State:     github.Ptr("open"),
+					User:      &github.User{Login: github.Ptr("monalisa")},
+					UpdatedAt: &github.Timestamp{Time: time.Date(2025, 3, number, 0, 0, 0, 0, time.UTC)},
+				})
+			}),
+		),
+		mock.WithRequestMatch(
+			mock.GetReposPullsByOwnerByRepoByPullNumber,
+			&github.PullRequest{
+				Number: github.Ptr(9),
+				Title:  github.Ptr("Add a retry to the client"),
+				State:  github.Ptr("open"),
+				User:   &github.User{Login: github.Ptr("hubot")},
+			},
+		),
+	)
+
+	client := github.NewClient(mockedClient)
+	_, handler := ListNotifications(stubGetClientFn(client), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+
+	t.Run("resolves subjects", func(t *testing.T) {
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"include_subjects": true,
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var returned []NotificationThread
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+		require.Len(t, returned, 10)
+		assert.LessOrEqual(t, maxInFlight.Load(), int32(notificationSubjectConcurrency))
+
+		for i, thread := range returned[:8] {
+			number := i + 1
+			assert.Equal(t, strconv.Itoa(number), thread.ID)
+			if number == 4 {
+				assert.Nil(t, thread.Subject)
+				assert.Contains(t, thread.Note, "failed to get the Issue the notification is about")
+				continue
+			}
+			assert.Empty(t, thread.Note)
+			assert.Equal(t, &NotificationSubjectDetails{
+				Type:      "Issue",
+				Number:    number,
+				Title:     fmt.Sprintf("Issue %d", number),
+				State:     "open",
+				Author:    "monalisa",
+				UpdatedAt: fmt.Sprintf("2025-03-%02dT00:00:00Z", number),
+			}, thread.Subject)
+		}
+		assert.Equal(t, "9", returned[8].ID)
+		require.NotNil(t, returned[8].Subject)
+		assert.Equal(t, "Add a retry to the client", returned[8].Subject.Title)
+		assert.Equal(t, "10", returned[9].ID)
+		assert.Nil(t, returned[9].Subject)
+		assert.Contains(t, returned[9].Note, "subjects of type CheckSuite are not resolved")
+	})
+
+	t.Run("failed subjects are recorded in the context", func(t *testing.T) {
+		// Run with -race: every worker fails, and the context's error list must only be
+		// appended to from the calling goroutine.
+		failingClient := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetReposIssuesByOwnerByRepoByIssueNumber,
+				mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			),
+		))
+		ctx := ghErrors.ContextWithGitHubErrors(context.Background())
+
+		threads := resolveNotificationThreads(ctx, failingClient, stubGetGQLClientFn(githubv4.NewClient(nil)), notifications[:8])
+		require.Len(t, threads, 8)
+		for _, thread := range threads {
+			assert.Nil(t, thread.Subject)
+			assert.Contains(t, thread.Note, "failed to get the Issue the notification is about")
+		}
+
+		apiErrors, err := ghErrors.GetGitHubAPIErrors(ctx)
+		require.NoError(t, err)
+		assert.Len(t, apiErrors, 8)
+	})
+
+	t.Run("cancelled context returns partial results", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		threads := resolveNotificationThreads(ctx, client, stubGetGQLClientFn(githubv4.NewClient(nil)), notifications)
+		require.Len(t, threads, 10)
+		for _, thread := range threads {
+			assert.Nil(t, thread.Subject)
+			assert.Contains(t, thread.Note, "subject not resolved: context canceled")
+		}
+	})
+}
+
 func Test_ManageNotificationSubscription(t *testing.T) {
 	// Verify tool definition and schema
 	mockClient := github.NewClient(nil)
@@ -582,6 +1038,25 @@ func Test_DismissNotification(t *testing.T) {
 	}
 }
 
+// roundTripperFunc adapts a function to http.RoundTripper.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// failAfterRequests returns a client that sends the first n requests through client and fails
+// every later one with a transport error, for which go-github returns no response.
+func failAfterRequests(n int, client *http.Client) *http.Client {
+	var count atomic.Int32
+	return &http.Client{Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if int(count.Add(1)) > n {
+			return nil, errors.New("connection reset by peer")
+		}
+		return client.Transport.RoundTrip(req)
+	})}
+}
+
 func Test_MarkAllNotificationsRead(t *testing.T) {
 	// Verify tool definition and schema
 	mockClient := github.NewClient(nil)
@@ -593,68 +1068,162 @@ func Test_MarkAllNotificationsRead(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "lastReadAt")
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
-	assert.Empty(t, tool.InputSchema.Required)
+	assert.Contains(t, tool.InputSchema.Properties, "confirm")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"confirm"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	unread := func(ids ...string) []*github.Notification {
+		notifications := []*github.Notification{}
+		for _, id := range ids {
+			notifications = append(notifications, &github.Notification{ID: github.Ptr(id), Unread: github.Ptr(true)})
+		}
+		return notifications
+	}
 
 	tests := []struct {
 		name           string
 		mockedClient   *http.Client
 		requestArgs    map[string]interface{}
 		expectError    bool
-		expectMarked   bool
+		expectedText   string
 		expectedErrMsg string
 	}{
 		{
 			name: "success (no params)",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatch(
+					mock.GetNotifications,
+					unread("1", "2", "3"),
+					unread(),
+				),
+				mock.WithRequestMatchHandler(
 					mock.PutNotifications,
-					nil,
+					mockResponse(t, http.StatusResetContent, ""),
 				),
 			),
-			requestArgs:  map[string]interface{}{},
+			requestArgs: map[string]interface{}{
+				"confirm": true,
+			},
 			expectError:  false,
-			expectMarked: true,
+			expectedText: "All notifications marked as read. Unread before: 3, unread after: 0",
 		},
 		{
 			name: "success with lastReadAt param",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatch(
+					mock.GetNotifications,
+					unread("1", "2"),
+					unread("2"),
+				),
+				mock.WithRequestMatchHandler(
 					mock.PutNotifications,
-					nil,
+					expectRequestBody(t, map[string]any{
+						"last_read_at": "2024-01-01T00:00:00Z",
+					}).andThen(
+						mockResponse(t, http.StatusResetContent, ""),
+					),
 				),
 			),
 			requestArgs: map[string]interface{}{
 				"lastReadAt": "2024-01-01T00:00:00Z",
+				"confirm":    true,
 			},
 			expectError:  false,
-			expectMarked: true,
+			expectedText: "Unread before: 2, unread after: 1",
 		},
 		{
 			name: "success with owner and repo",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatch(
+					mock.GetReposNotificationsByOwnerByRepo,
+					unread("1"),
+					unread(),
+				),
+				mock.WithRequestMatchHandler(
 					mock.PutReposNotificationsByOwnerByRepo,
-					nil,
+					expectPath(t, "/repos/octocat/hello-world/notifications").andThen(
+						mockResponse(t, http.StatusResetContent, ""),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "octocat",
+				"repo":    "hello-world",
+				"confirm": true,
+			},
+			expectError:  false,
+			expectedText: "All notifications in octocat/hello-world marked as read. Unread before: 1, unread after: 0",
+		},
+		{
+			name: "processed asynchronously",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetNotifications,
+					unread("1", "2", "3"),
+					unread("3"),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PutNotifications,
+					mockResponse(t, http.StatusAccepted, map[string]string{"message": "Unread notifications couldn't be marked in a single request. Notifications are being marked as read in the background."}),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner": "octocat",
-				"repo":  "hello-world",
+				"confirm": true,
+			},
+			expectError:  false,
+			expectedText: "All notifications are being marked as read; GitHub is processing the request asynchronously, so some may still show as unread for a while. Unread before: 3, unread after: 1",
+		},
+		{
+			name: "count afterwards fails in transport",
+			mockedClient: failAfterRequests(2, mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetNotifications,
+					unread("1", "2"),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PutNotifications,
+					mockResponse(t, http.StatusResetContent, ""),
+				),
+			)),
+			requestArgs: map[string]interface{}{
+				"confirm": true,
 			},
 			expectError:  false,
-			expectMarked: true,
+			expectedText: "All notifications marked as read. Unread before: 2; failed to count unread notifications afterwards",
+		},
+		{
+			name:         "confirm false",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"confirm": false,
+			},
+			expectError:    true,
+			expectedErrMsg: "confirm must be true to mark all notifications as read",
+		},
+		{
+			name:           "confirm missing",
+			mockedClient:   mock.NewMockedHTTPClient(),
+			requestArgs:    map[string]interface{}{},
+			expectError:    true,
+			expectedErrMsg: "confirm must be true to mark all notifications as read",
 		},
 		{
 			name: "API error",
 			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetNotifications,
+					unread("1"),
+				),
 				mock.WithRequestMatchHandler(
 					mock.PutNotifications,
 					mockResponse(t, http.StatusInternalServerError, `{"message": "error"}`),
 				),
 			),
-			requestArgs:    map[string]interface{}{},
+			requestArgs: map[string]interface{}{
+				"confirm": true,
+			},
 			expectError:    true,
-			expectedErrMsg: "error",
+			expectedErrMsg: "failed to mark all notifications as read",
 		},
 	}
 
@@ -669,18 +1238,14 @@ func Test_MarkAllNotificationsRead(t *testing.T) {
 				require.NoError(t, err)
 				require.True(t, result.IsError)
 				errorContent := getErrorResult(t, result)
-				if tc.expectedErrMsg != "" {
-					assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
-				}
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
 				return
 			}
 
 			require.NoError(t, err)
 			require.False(t, result.IsError)
 			textContent := getTextResult(t, result)
-			if tc.expectMarked {
-				assert.Contains(t, textContent.Text, "All notifications marked as read")
-			}
+			assert.Contains(t, textContent.Text, tc.expectedText)
 		})
 	}
 }
@@ -763,3 +1328,1302 @@ func Test_GetNotificationDetails(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetRepositorySubscription(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRepositorySubscription(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_repository_subscription", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedJSON   string
+		expectedErrMsg string
+	}{
+		{
+			name: "explicit subscription",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposSubscriptionByOwnerByRepo,
+					&github.Subscription{
+						Subscribed: github.Ptr(false),
+						Ignored:    github.Ptr(true),
+						CreatedAt:  &github.Timestamp{Time: time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)},
+					},
+				),
+			),
+			expectError:  false,
+			expectedJSON: `{"subscribed":false,"ignored":true,"created_at":"2025-03-01T12:00:00Z"}`,
+		},
+		{
+			name: "no explicit subscription",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposSubscriptionByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			expectError:  false,
+			expectedJSON: `{"subscribed":null,"reason":"no explicit subscription"}`,
+		},
+		{
+			name: "get subscription fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposSubscriptionByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Forbidden"}`))
+					}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to get repository subscription",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetRepositorySubscription(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			})
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+			assert.JSONEq(t, tc.expectedJSON, textContent.Text)
+		})
+	}
+}
+
+func Test_ListWatchedRepositories(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListWatchedRepositories(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_watched_repositories", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "username")
+	assert.Contains(t, tool.InputSchema.Properties, "include_subscription_state")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.Empty(t, tool.InputSchema.Required)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	repository := func(name, description string) *github.Repository {
+		return &github.Repository{
+			Name:        github.Ptr(name),
+			FullName:    github.Ptr("octocat/" + name),
+			Owner:       &github.User{Login: github.Ptr("octocat")},
+			Description: github.Ptr(description),
+			HTMLURL:     github.Ptr("https://github.com/octocat/" + name),
+		}
+	}
+	repos := []*github.Repository{
+		repository("noisy", "Lots of bots"),
+		repository("quiet", "Rarely updated"),
+		repository("inherited", ""),
+		repository("broken", ""),
+	}
+	summary := func(name, description string) WatchedRepository {
+		return WatchedRepository{
+			RepositorySummary: RepositorySummary{
+				FullName: "octocat/" + name,
+				Owner:    "octocat",
+				HTMLURL:  "https://github.com/octocat/" + name,
+			},
+			Description: description,
+		}
+	}
+	withState := func(repo WatchedRepository, ignored *bool, note string) WatchedRepository {
+		repo.Ignored = ignored
+		repo.SubscriptionNote = note
+		return repo
+	}
+
+	subscriptionHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/octocat/noisy/subscription":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"subscribed": false, "ignored": true}`))
+		case "/repos/octocat/quiet/subscription":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"subscribed": true, "ignored": false}`))
+		case "/repos/octocat/inherited/subscription":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"message": "Internal Server Error"}`))
+		}
+	})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult []WatchedRepository
+		expectedErrMsg string
+	}{
+		{
+			name: "current user",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUserSubscriptions,
+					expectQueryParams(t, map[string]string{
+						"page":     "2",
+						"per_page": "4",
+					}).andThen(
+						mockResponse(t, http.StatusOK, repos),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"page":    float64(2),
+				"perPage": float64(4),
+			},
+			expectError: false,
+			expectedResult: []WatchedRepository{
+				summary("noisy", "Lots of bots"),
+				summary("quiet", "Rarely updated"),
+				summary("inherited", ""),
+				summary("broken", ""),
+			},
+		},
+		{
+			name: "another user",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUsersSubscriptionsByUsername,
+					expectPath(t, "/users/hubot/subscriptions").andThen(
+						mockResponse(t, http.StatusOK, repos[:1]),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"username": "hubot",
+			},
+			expectError:    false,
+			expectedResult: []WatchedRepository{summary("noisy", "Lots of bots")},
+		},
+		{
+			name: "with subscription state",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetUserSubscriptions,
+					repos,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposSubscriptionByOwnerByRepo,
+					subscriptionHandler,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"include_subscription_state": true,
+			},
+			expectError: false,
+			expectedResult: []WatchedRepository{
+				withState(summary("noisy", "Lots of bots"), github.Ptr(true), ""),
+				withState(summary("quiet", "Rarely updated"), github.Ptr(false), ""),
+				withState(summary("inherited", ""), nil, "no explicit subscription"),
+				withState(summary("broken", ""), nil, "failed to get subscription: GET "),
+			},
+		},
+		{
+			name:         "subscription state for another user",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"username":                   "hubot",
+				"include_subscription_state": true,
+			},
+			expectError:    true,
+			expectedErrMsg: "include_subscription_state is only available for the current user",
+		},
+		{
+			name: "list fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUsersSubscriptionsByUsername,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"username": "nobody",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list watched repositories",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListWatchedRepositories(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var returned []WatchedRepository
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			require.Len(t, returned, len(tc.expectedResult))
+			for i, expected := range tc.expectedResult {
+				// Error notes embed the request URL, so they are compared by prefix.
+				assert.True(t, strings.HasPrefix(returned[i].SubscriptionNote, expected.SubscriptionNote), returned[i].SubscriptionNote)
+				if expected.SubscriptionNote == "" {
+					assert.Empty(t, returned[i].SubscriptionNote)
+				}
+				returned[i].SubscriptionNote = expected.SubscriptionNote
+				assert.Equal(t, expected, returned[i])
+			}
+		})
+	}
+
+	t.Run("failed lookups are recorded in the context", func(t *testing.T) {
+		// Run with -race: every lookup fails, and the context's error list must only be
+		// appended to from the calling goroutine.
+		broken := make([]*github.Repository, 0, 8)
+		for i := 1; i <= 8; i++ {
+			broken = append(broken, repository(fmt.Sprintf("broken-%d", i), ""))
+		}
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(
+				mock.GetUserSubscriptions,
+				broken,
+			),
+			mock.WithRequestMatchHandler(
+				mock.GetReposSubscriptionByOwnerByRepo,
+				subscriptionHandler,
+			),
+		))
+		_, handler := ListWatchedRepositories(stubGetClientFn(client), translations.NullTranslationHelper)
+		ctx := ghErrors.ContextWithGitHubErrors(context.Background())
+
+		result, err := handler(ctx, createMCPRequest(map[string]interface{}{
+			"include_subscription_state": true,
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var returned []WatchedRepository
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+		require.Len(t, returned, 8)
+		for _, repo := range returned {
+			assert.Contains(t, repo.SubscriptionNote, "failed to get subscription")
+		}
+
+		apiErrors, err := ghErrors.GetGitHubAPIErrors(ctx)
+		require.NoError(t, err)
+		assert.Len(t, apiErrors, 8)
+	})
+}
+
+func Test_SetRepositorySubscription(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := SetRepositorySubscription(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "set_repository_subscription", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "subscribed")
+	assert.Contains(t, tool.InputSchema.Properties, "ignored")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult RepositorySubscription
+		expectedErrMsg string
+	}{
+		{
+			name: "watch repository",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposSubscriptionByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"subscribed": true,
+						"ignored":    false,
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Subscription{Subscribed: github.Ptr(true), Ignored: github.Ptr(false)}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"subscribed": true,
+			},
+			expectError:    false,
+			expectedResult: RepositorySubscription{Subscribed: github.Ptr(true), Ignored: github.Ptr(false)},
+		},
+		{
+			name: "ignore repository",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposSubscriptionByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"subscribed": false,
+						"ignored":    true,
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Subscription{Subscribed: github.Ptr(false), Ignored: github.Ptr(true)}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"ignored": true,
+			},
+			expectError:    false,
+			expectedResult: RepositorySubscription{Subscribed: github.Ptr(false), Ignored: github.Ptr(true)},
+		},
+		{
+			name:         "subscribed and ignored are exclusive",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"subscribed": true,
+				"ignored":    true,
+			},
+			expectError:    true,
+			expectedErrMsg: "subscribed and ignored can't both be true",
+		},
+		{
+			name:         "neither subscribed nor ignored",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "one of subscribed or ignored must be provided",
+		},
+		{
+			name: "set subscription fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposSubscriptionByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"subscribed": true,
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to set repository subscription",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := SetRepositorySubscription(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned RepositorySubscription
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_DeleteRepositorySubscription(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := DeleteRepositorySubscription(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "delete_repository_subscription", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "delete subscription",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposSubscriptionByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNoContent)
+					}),
+				),
+			),
+			expectError:  false,
+			expectedText: "subscription to owner/repo deleted",
+		},
+		{
+			name: "delete subscription fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposSubscriptionByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to delete repository subscription",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := DeleteRepositorySubscription(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			})
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
+
+func Test_GetNotificationThread(t *testing.T) {
+	// Verify tool definition and schema
+	mockClient := github.NewClient(nil)
+	tool, _ := GetNotificationThread(stubGetClientFn(mockClient), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_notification_thread", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "threadID")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"threadID"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	repository := &github.Repository{
+		Name:     github.Ptr("hello-world"),
+		FullName: github.Ptr("octocat/hello-world"),
+		Owner:    &github.User{Login: github.Ptr("octocat")},
+	}
+	thread := func(id, subjectType, title, subjectURL, latestCommentURL string) *github.Notification {
+		return &github.Notification{
+			ID:         github.Ptr(id),
+			Reason:     github.Ptr("review_requested"),
+			Unread:     github.Ptr(true),
+			Repository: repository,
+			Subject: &github.NotificationSubject{
+				Title:            github.Ptr(title),
+				Type:             github.Ptr(subjectType),
+				URL:              ToStringPtr(subjectURL),
+				LatestCommentURL: ToStringPtr(latestCommentURL),
+			},
+		}
+	}
+	prURL := "https://api.github.com/repos/octocat/hello-world/pulls/7"
+	commentURL := "https://api.github.com/repos/octocat/hello-world/issues/comments/99"
+	issueURL := "https://api.github.com/repos/octocat/hello-world/issues/5"
+	releaseURL := "https://api.github.com/repos/octocat/hello-world/releases/1234"
+
+	qDiscussion := "query($query:String!){search(query: $query, type: DISCUSSION, first: 5){nodes{... on Discussion{number,title,closed,updatedAt,url,author{login},comments(last: 1){nodes{body,url,author{login}}}}}}}"
+	discussionVars := map[string]interface{}{
+		"query": `repo:octocat/hello-world in:title "Roadmap for v2"`,
+	}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		gqlClient       *http.Client
+		requestArgs     map[string]interface{}
+		expectError     bool
+		expectedSubject *NotificationSubjectDetails
+		expectedNote    string
+		expectedErrMsg  string
+	}{
+		{
+			name: "pull request with latest comment by numeric id",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetNotificationsThreadsByThreadId,
+					expectPath(t, "/notifications/threads/42").andThen(
+						mockResponse(t, http.StatusOK, thread("42", "PullRequest", "Add a retry to the client", prURL, commentURL)),
+					),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					&github.PullRequest{
+						Number:    github.Ptr(7),
+						Title:     github.Ptr("Add a retry to the client"),
+						State:     github.Ptr("closed"),
+						Merged:    github.Ptr(true),
+						User:      &github.User{Login: github.Ptr("hubot")},
+						UpdatedAt: &github.Timestamp{Time: time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC)},
+						HTMLURL:   github.Ptr("https://github.com/octocat/hello-world/pull/7"),
+					},
+				),
+				mock.WithRequestMatch(
+					mock.GetReposIssuesCommentsByOwnerByRepoByCommentId,
+					&github.IssueComment{
+						Body:    github.Ptr("@octocat could you take another look?"),
+						User:    &github.User{Login: github.Ptr("hubot")},
+						HTMLURL: github.Ptr("https://github.com/octocat/hello-world/pull/7#issuecomment-99"),
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"threadID": float64(42),
+			},
+			expectError: false,
+			expectedSubject: &NotificationSubjectDetails{
+				Type:      "PullRequest",
+				Number:    7,
+				Title:     "Add a retry to the client",
+				State:     "merged",
+				Author:    "hubot",
+				UpdatedAt: "2025-03-03T09:00:00Z",
+				HTMLURL:   "https://github.com/octocat/hello-world/pull/7",
+				LatestComment: &NotificationComment{
+					Author:  "hubot",
+					Body:    "@octocat could you take another look?",
+					HTMLURL: "https://github.com/octocat/hello-world/pull/7#issuecomment-99",
+				},
+			},
+		},
+		{
+			name: "issue without comments",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetNotificationsThreadsByThreadId,
+					thread("43", "Issue", "Crash on start", issueURL, issueURL),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposIssuesByOwnerByRepoByIssueNumber,
+					&github.Issue{
+						Number:  github.Ptr(5),
+						Title:   github.Ptr("Crash on start"),
+						State:   github.Ptr("open"),
+						User:    &github.User{Login: github.Ptr("monalisa")},
+						HTMLURL: github.Ptr("https://github.com/octocat/hello-world/issues/5"),
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"threadID": "43",
+			},
+			expectError: false,
+			expectedSubject: &NotificationSubjectDetails{
+				Type:    "Issue",
+				Number:  5,
+				Title:   "Crash on start",
+				State:   "open",
+				Author:  "monalisa",
+				HTMLURL: "https://github.com/octocat/hello-world/issues/5",
+			},
+		},
+		{
+			name: "release",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetNotificationsThreadsByThreadId,
+					thread("44", "Release", "v1.2.0", releaseURL, releaseURL),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposReleasesByOwnerByRepoByReleaseId,
+					expectPath(t, "/repos/octocat/hello-world/releases/1234").andThen(
+						mockResponse(t, http.StatusOK, &github.RepositoryRelease{
+							TagName:    github.Ptr("v1.2.0"),
+							Name:       github.Ptr("v1.2.0"),
+							Prerelease: github.Ptr(true),
+							Author:     &github.User{Login: github.Ptr("octocat")},
+							HTMLURL:    github.Ptr("https://github.com/octocat/hello-world/releases/tag/v1.2.0"),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"threadID": "44",
+			},
+			expectError: false,
+			expectedSubject: &NotificationSubjectDetails{
+				Type:    "Release",
+				TagName: "v1.2.0",
+				Title:   "v1.2.0",
+				State:   "prerelease",
+				Author:  "octocat",
+				HTMLURL: "https://github.com/octocat/hello-world/releases/tag/v1.2.0",
+			},
+		},
+		{
+			name: "discussion",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetNotificationsThreadsByThreadId,
+					thread("45", "Discussion", "Roadmap for v2", "", ""),
+				),
+			),
+			gqlClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(qDiscussion, discussionVars, githubv4mock.DataResponse(map[string]any{
+					"search": map[string]any{
+						"nodes": []map[string]any{
+							{
+								"number": 3, "title": "Roadmap for v2 follow-up", "closed": false, "updatedAt": "2025-03-02T12:00:00Z",
+								"url": "https://github.com/octocat/hello-world/discussions/3", "author": map[string]any{"login": "hubot"},
+								"comments": map[string]any{"nodes": []map[string]any{}},
+							},
+							{
+								"number": 2, "title": "Roadmap for v2", "closed": true, "updatedAt": "2025-03-01T12:00:00Z",
+								"url": "https://github.com/octocat/hello-world/discussions/2", "author": map[string]any{"login": "octocat"},
+								"comments": map[string]any{"nodes": []map[string]any{
+									{"body": "Shipped, closing.", "url": "https://github.com/octocat/hello-world/discussions/2#discussioncomment-1", "author": map[string]any{"login": "octocat"}},
+								}},
+							},
+						},
+					},
+				})),
+			),
+			requestArgs: map[string]interface{}{
+				"threadID": "45",
+			},
+			expectError: false,
+			expectedSubject: &NotificationSubjectDetails{
+				Type:      "Discussion",
+				Number:    2,
+				Title:     "Roadmap for v2",
+				State:     "closed",
+				Author:    "octocat",
+				UpdatedAt: "2025-03-01T12:00:00Z",
+				HTMLURL:   "https://github.com/octocat/hello-world/discussions/2",
+				LatestComment: &NotificationComment{
+					Author:  "octocat",
+					Body:    "Shipped, closing.",
+					HTMLURL: "https://github.com/octocat/hello-world/discussions/2#discussioncomment-1",
+				},
+			},
+		},
+		{
+			name: "unsupported subject type",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetNotificationsThreadsByThreadId,
+					thread("46", "CheckSuite", "CI workflow run failed", "", ""),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"threadID": "46",
+			},
+			expectError:  false,
+			expectedNote: "subjects of type CheckSuite are not resolved",
+		},
+		{
+			name: "subject no longer accessible",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetNotificationsThreadsByThreadId,
+					thread("47", "Issue", "Crash on start", issueURL, issueURL),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesByOwnerByRepoByIssueNumber,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"threadID": "47",
+			},
+			expectError:  false,
+			expectedNote: "failed to get the Issue the notification is about",
+		},
+		{
+			name: "thread not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetNotificationsThreadsByThreadId,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"threadID": "48",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get notification thread '48'",
+		},
+		{
+			name:         "fractional thread id",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"threadID": float64(4.5),
+			},
+			expectError:    true,
+			expectedErrMsg: "parameter threadID must be a positive whole number",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			gqlClient := githubv4.NewClient(tc.gqlClient)
+			_, handler := GetNotificationThread(stubGetClientFn(client), stubGetGQLClientFn(gqlClient), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+
+			if tc.expectError {
+				require.NoError(t, err)
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			var returned NotificationThread
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, "octocat/hello-world", returned.Repository)
+			assert.Equal(t, "review_requested", returned.Reason)
+			assert.Equal(t, tc.expectedSubject, returned.Subject)
+			if tc.expectedNote == "" {
+				assert.Empty(t, returned.Note)
+			} else {
+				assert.Contains(t, returned.Note, tc.expectedNote)
+			}
+		})
+	}
+}
+
+func Test_MarkNotificationRead(t *testing.T) {
+	// Verify tool definition and schema
+	mockClient := github.NewClient(nil)
+	tool, _ := MarkNotificationRead(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "mark_notification_read", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "threadID")
+	assert.Contains(t, tool.InputSchema.Properties, "threadIDs")
+	assert.Empty(t, tool.InputSchema.Required)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	// Thread 1 is marked read, thread 2 was already read and thread 3 does not exist
+	markedPaths := []string{}
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PatchNotificationsThreadsByThreadId,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				markedPaths = append(markedPaths, r.URL.Path)
+				switch r.URL.Path {
+				case "/notifications/threads/1":
+					w.WriteHeader(http.StatusResetContent)
+				case "/notifications/threads/2":
+					w.WriteHeader(http.StatusNotModified)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+				}
+			}),
+		),
+	)
+
+	tests := []struct {
+		name           string
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedPaths  []string
+		expectedReport NotificationMarkReport
+		expectedErrMsg string
+	}{
+		{
+			name: "single thread",
+			requestArgs: map[string]interface{}{
+				"threadID": "1",
+			},
+			expectError:   false,
+			expectedPaths: []string{"/notifications/threads/1"},
+			expectedReport: NotificationMarkReport{
+				Results:   []NotificationMarkResult{{ThreadID: "1", Success: true}},
+				Succeeded: 1,
+			},
+		},
+		{
+			name: "already read thread is a success",
+			requestArgs: map[string]interface{}{
+				"threadID": float64(2),
+			},
+			expectError:   false,
+			expectedPaths: []string{"/notifications/threads/2"},
+			expectedReport: NotificationMarkReport{
+				Results:   []NotificationMarkResult{{ThreadID: "2", Success: true}},
+				Succeeded: 1,
+			},
+		},
+		{
+			name: "batch continues past failures",
+			requestArgs: map[string]interface{}{
+				"threadID":  "1",
+				"threadIDs": []interface{}{"3", float64(2), "1"},
+			},
+			expectError:   false,
+			expectedPaths: []string{"/notifications/threads/1", "/notifications/threads/3", "/notifications/threads/2"},
+			expectedReport: NotificationMarkReport{
+				Results: []NotificationMarkResult{
+					{ThreadID: "1", Success: true},
+					{ThreadID: "3"},
+					{ThreadID: "2", Success: true},
+				},
+				Succeeded: 2,
+				Failed:    1,
+			},
+		},
+		{
+			name:           "no thread ids",
+			requestArgs:    map[string]interface{}{},
+			expectError:    true,
+			expectedErrMsg: "provide threadID or threadIDs",
+		},
+		{
+			name: "invalid thread id in batch",
+			requestArgs: map[string]interface{}{
+				"threadIDs": []interface{}{"1", float64(-4)},
+			},
+			expectError:    true,
+			expectedErrMsg: "invalid thread ID at index 1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			markedPaths = []string{}
+			client := github.NewClient(mockedClient)
+			_, handler := MarkNotificationRead(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+
+			if tc.expectError {
+				require.NoError(t, err)
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				assert.Empty(t, markedPaths)
+				return
+			}
+
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+			assert.Equal(t, tc.expectedPaths, markedPaths)
+
+			var returned NotificationMarkReport
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			require.Len(t, returned.Results, len(tc.expectedReport.Results))
+			for i, expected := range tc.expectedReport.Results {
+				assert.Equal(t, expected.ThreadID, returned.Results[i].ThreadID)
+				assert.Equal(t, expected.Success, returned.Results[i].Success)
+				if expected.Success {
+					assert.Empty(t, returned.Results[i].Error)
+				} else {
+					assert.Contains(t, returned.Results[i].Error, "404")
+				}
+			}
+			assert.Equal(t, tc.expectedReport.Succeeded, returned.Succeeded)
+			assert.Equal(t, tc.expectedReport.Failed, returned.Failed)
+		})
+	}
+}
+
+func Test_MarkNotificationDone(t *testing.T) {
+	// Verify tool definition and schema
+	mockClient := github.NewClient(nil)
+	tool, _ := MarkNotificationDone(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "mark_notification_done", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "threadID")
+	assert.Contains(t, tool.InputSchema.Properties, "threadIDs")
+	assert.Empty(t, tool.InputSchema.Required)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	deletedPaths := []string{}
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.DeleteNotificationsThreadsByThreadId,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				deletedPaths = append(deletedPaths, r.URL.Path)
+				if r.URL.Path == "/notifications/threads/9" {
+					w.WriteHeader(http.StatusForbidden)
+					_, _ = w.Write([]byte(`{"message": "Forbidden"}`))
+					return
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		),
+	)
+
+	client := github.NewClient(mockedClient)
+	_, handler := MarkNotificationDone(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"threadIDs": []interface{}{"7", "not-a-number", "9", float64(8)},
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	assert.Equal(t, []string{"/notifications/threads/7", "/notifications/threads/9", "/notifications/threads/8"}, deletedPaths)
+
+	var returned NotificationMarkReport
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	require.Len(t, returned.Results, 4)
+	assert.Equal(t, NotificationMarkResult{ThreadID: "7", Success: true}, returned.Results[0])
+	assert.Equal(t, "not-a-number", returned.Results[1].ThreadID)
+	assert.Contains(t, returned.Results[1].Error, "invalid threadID format")
+	assert.Equal(t, "9", returned.Results[2].ThreadID)
+	assert.False(t, returned.Results[2].Success)
+	assert.Contains(t, returned.Results[2].Error, "403")
+	assert.Equal(t, NotificationMarkResult{ThreadID: "8", Success: true}, returned.Results[3])
+	assert.Equal(t, 2, returned.Succeeded)
+	assert.Equal(t, 2, returned.Failed)
+}
+
+func Test_GetThreadSubscription(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetThreadSubscription(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_thread_subscription", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "threadID")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"threadID"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedJSON   string
+		expectedErrMsg string
+	}{
+		{
+			name: "muted thread",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetNotificationsThreadsSubscriptionByThreadId,
+					expectPath(t, "/notifications/threads/42/subscription").andThen(
+						mockResponse(t, http.StatusOK, &github.Subscription{
+							Subscribed: github.Ptr(false),
+							Ignored:    github.Ptr(true),
+							CreatedAt:  &github.Timestamp{Time: time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)},
+						}),
+					),
+				),
+			),
+			expectError:  false,
+			expectedJSON: `{"thread_id":"42","subscribed":false,"ignored":true,"created_at":"2025-03-01T12:00:00Z","state":"` + threadStateIgnored + `"}`,
+		},
+		{
+			name: "subscribed thread",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetNotificationsThreadsSubscriptionByThreadId,
+					&github.Subscription{
+						Subscribed: github.Ptr(true),
+						Ignored:    github.Ptr(false),
+						Reason:     github.Ptr("manual"),
+					},
+				),
+			),
+			expectError:  false,
+			expectedJSON: `{"thread_id":"42","subscribed":true,"ignored":false,"reason":"manual","state":"` + threadStateSubscribed + `"}`,
+		},
+		{
+			name: "no explicit subscription",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetNotificationsThreadsSubscriptionByThreadId,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			expectError:  false,
+			expectedJSON: `{"thread_id":"42","subscribed":false,"ignored":false,"reason":"no explicit subscription","state":"` + threadStateDefault + `"}`,
+		},
+		{
+			name: "get subscription fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetNotificationsThreadsSubscriptionByThreadId,
+					mockResponse(t, http.StatusForbidden, `{"message": "Forbidden"}`),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to get subscription to notification thread '42'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetThreadSubscription(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(map[string]interface{}{
+				"threadID": float64(42),
+			})
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+			assert.JSONEq(t, tc.expectedJSON, textContent.Text)
+		})
+	}
+}
+
+func Test_SetThreadSubscription(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := SetThreadSubscription(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "set_thread_subscription", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "threadID")
+	assert.Contains(t, tool.InputSchema.Properties, "ignored")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"threadID", "ignored"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedJSON   string
+		expectedErrMsg string
+	}{
+		{
+			name: "mute thread",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutNotificationsThreadsSubscriptionByThreadId,
+					expectRequestBody(t, map[string]any{"ignored": true}).andThen(
+						mockResponse(t, http.StatusOK, &github.Subscription{
+							Subscribed: github.Ptr(false),
+							Ignored:    github.Ptr(true),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"threadID": "42",
+				"ignored":  true,
+			},
+			expectError:  false,
+			expectedJSON: `{"thread_id":"42","subscribed":false,"ignored":true,"state":"` + threadStateIgnored + `"}`,
+		},
+		{
+			name: "subscribe to thread",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutNotificationsThreadsSubscriptionByThreadId,
+					expectRequestBody(t, map[string]any{"ignored": false}).andThen(
+						mockResponse(t, http.StatusOK, &github.Subscription{
+							Subscribed: github.Ptr(true),
+							Ignored:    github.Ptr(false),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"threadID": "42",
+				"ignored":  false,
+			},
+			expectError:  false,
+			expectedJSON: `{"thread_id":"42","subscribed":true,"ignored":false,"state":"` + threadStateSubscribed + `"}`,
+		},
+		{
+			name:         "missing ignored",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"threadID": "42",
+			},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: ignored",
+		},
+		{
+			name: "set subscription fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutNotificationsThreadsSubscriptionByThreadId,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"threadID": "42",
+				"ignored":  true,
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to set subscription to notification thread '42'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := SetThreadSubscription(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+			assert.JSONEq(t, tc.expectedJSON, textContent.Text)
+		})
+	}
+}
+
+func Test_DeleteThreadSubscription(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := DeleteThreadSubscription(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "delete_thread_subscription", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "threadID")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"threadID"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "delete subscription",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteNotificationsThreadsSubscriptionByThreadId,
+					expectPath(t, "/notifications/threads/42/subscription").andThen(
+						mockResponse(t, http.StatusNoContent, ""),
+					),
+				),
+			),
+			expectError:  false,
+			expectedText: "subscription to notification thread 42 deleted; the thread is no longer muted or subscribed",
+		},
+		{
+			name: "delete subscription fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteNotificationsThreadsSubscriptionByThreadId,
+					mockResponse(t, http.StatusForbidden, `{"message": "Forbidden"}`),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to delete subscription to notification thread '42'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := DeleteThreadSubscription(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"threadID": "42",
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+			assert.Contains(t, textContent.Text, tc.expectedText)
+		})
+	}
+}
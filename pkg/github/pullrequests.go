@@ -1,11 +1,17 @@
 package github
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-viper/mapstructure/v2"
 	"github.com/google/go-github/v73/github"
@@ -202,6 +208,24 @@ func CreatePullRequest(getClient GetClientFn, t translations.TranslationHelperFu
 		}
 }
 
+// isPullRequestBaseValidationError reports whether the error returned by the API is a
+// validation failure of the base branch, e.g. because it doesn't exist.
+func isPullRequestBaseValidationError(resp *github.Response, err error) bool {
+	if resp == nil || resp.StatusCode != http.StatusUnprocessableEntity {
+		return false
+	}
+	var errResp *github.ErrorResponse
+	if !errors.As(err, &errResp) {
+		return false
+	}
+	for _, e := range errResp.Errors {
+		if e.Field == "base" {
+			return true
+		}
+	}
+	return false
+}
+
 // UpdatePullRequest creates a tool to update an existing pull request.
 func UpdatePullRequest(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
 	return mcp.NewTool("update_pull_request",
@@ -278,9 +302,11 @@ func UpdatePullRequest(getClient GetClientFn, t translations.TranslationHelperFu
 				updateNeeded = true
 			}
 
-			if base, ok, err := OptionalParamOK[string](request, "base"); err != nil {
+			base, baseSet, err := OptionalParamOK[string](request, "base")
+			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
-			} else if ok {
+			}
+			if baseSet {
 				update.Base = &github.PullRequestBranch{Ref: github.Ptr(base)}
 				updateNeeded = true
 			}
@@ -302,6 +328,13 @@ func UpdatePullRequest(getClient GetClientFn, t translations.TranslationHelperFu
 			}
 			pr, resp, err := client.PullRequests.Edit(ctx, owner, repo, pullNumber, update)
 			if err != nil {
+				if baseSet && isPullRequestBaseValidationError(resp, err) {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to update pull request: base branch %q can't be used in %s/%s, check that the branch exists", base, owner, repo),
+						resp,
+						err,
+					), nil
+				}
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
 					"failed to update pull request",
 					resp,
@@ -327,6 +360,112 @@ func UpdatePullRequest(getClient GetClientFn, t translations.TranslationHelperFu
 		}
 }
 
+// ClosePullRequest creates a tool to close a pull request, optionally deleting its head branch.
+func ClosePullRequest(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("close_pull_request",
+			mcp.WithDescription(t("TOOL_CLOSE_PULL_REQUEST_DESCRIPTION", "Close a pull request without merging it, for example because it was superseded. Optionally deletes its head branch, which is only done for branches in the same repository and never for the default branch. Returns whether the branch was deleted, and why not.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_CLOSE_PULL_REQUEST_USER_TITLE", "Close pull request"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithBoolean("delete_branch",
+				mcp.Description("Also delete the pull request's head branch"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			deleteBranch, err := OptionalParam[bool](request, "delete_branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			pr, resp, err := client.PullRequests.Get(ctx, owner, repo, pullNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get pull request",
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			// Branches are only deleted in the base repository, since forks belong to someone else. The default
+			// branch is checked before anything is done, so that a bad request doesn't leave the PR half handled.
+			headRef := pr.GetHead().GetRef()
+			baseRepo := pr.GetBase().GetRepo()
+			sameRepo := pr.GetHead().GetRepo().GetFullName() != "" && pr.GetHead().GetRepo().GetFullName() == baseRepo.GetFullName()
+			if deleteBranch && sameRepo && headRef == baseRepo.GetDefaultBranch() {
+				return mcp.NewToolResultError(fmt.Sprintf("refusing to delete %s, which is the default branch of %s", headRef, baseRepo.GetFullName())), nil
+			}
+
+			closed, resp, err := client.PullRequests.Edit(ctx, owner, repo, pullNumber, &github.PullRequest{
+				State: github.Ptr("closed"),
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to close pull request",
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			result := map[string]any{
+				"closed":         closed.GetState() == "closed",
+				"html_url":       closed.GetHTMLURL(),
+				"branch_deleted": false,
+			}
+			switch {
+			case !deleteBranch:
+				return MarshalledTextResult(result), nil
+			case !sameRepo:
+				result["reason"] = "the head branch is in a fork, which is left untouched"
+				return MarshalledTextResult(result), nil
+			}
+
+			// The pull request is closed by now, so failing to delete the branch is reported rather than
+			// returned as an error.
+			resp, err = client.Git.DeleteRef(ctx, owner, repo, "heads/"+headRef)
+			if err != nil {
+				result["reason"] = fmt.Sprintf("failed to delete branch %s: %s", headRef, err.Error())
+				return MarshalledTextResult(result), nil
+			}
+			_ = resp.Body.Close()
+
+			result["branch_deleted"] = true
+			result["branch"] = headRef
+			return MarshalledTextResult(result), nil
+		}
+}
+
 // ListPullRequests creates a tool to list and filter repository pull requests.
 func ListPullRequests(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
 	return mcp.NewTool("list_pull_requests",
@@ -362,6 +501,7 @@ func ListPullRequests(getClient GetClientFn, t translations.TranslationHelperFun
 				mcp.Enum("asc", "desc"),
 			),
 			WithPagination(),
+			WithOutputMode(pullRequestSummaryFields),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](request, "owner")
@@ -392,6 +532,10 @@ func ListPullRequests(getClient GetClientFn, t translations.TranslationHelperFun
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			output, err := OptionalOutputModeParam(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 			pagination, err := OptionalPaginationParams(request)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
@@ -430,7 +574,12 @@ func ListPullRequests(getClient GetClientFn, t translations.TranslationHelperFun
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list pull requests: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(prs)
+			var response any = prs
+			if output == OutputModeSummary {
+				response = summarizePullRequests(prs)
+			}
+
+			r, err := json.Marshal(response)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -442,10 +591,12 @@ func ListPullRequests(getClient GetClientFn, t translations.TranslationHelperFun
 // MergePullRequest creates a tool to merge a pull request.
 func MergePullRequest(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
 	return mcp.NewTool("merge_pull_request",
-			mcp.WithDescription(t("TOOL_MERGE_PULL_REQUEST_DESCRIPTION", "Merge a pull request in a GitHub repository.")),
+			mcp.WithDescription(t("TOOL_MERGE_PULL_REQUEST_DESCRIPTION", "Merge a pull request in a GitHub repository. Returns merged: true and the SHA of the merge commit on success.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				Title:        t("TOOL_MERGE_PULL_REQUEST_USER_TITLE", "Merge pull request"),
-				ReadOnlyHint: ToBoolPtr(false),
+				Title:           t("TOOL_MERGE_PULL_REQUEST_USER_TITLE", "Merge pull request"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(false),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -469,6 +620,9 @@ func MergePullRequest(getClient GetClientFn, t translations.TranslationHelperFun
 				mcp.Description("Merge method"),
 				mcp.Enum("merge", "squash", "rebase"),
 			),
+			mcp.WithString("sha",
+				mcp.Description("SHA that the pull request head must match to allow the merge, to guard against new commits being pushed since it was reviewed"),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](request, "owner")
@@ -495,10 +649,15 @@ func MergePullRequest(getClient GetClientFn, t translations.TranslationHelperFun
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			sha, err := OptionalParam[string](request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
 			options := &github.PullRequestOptions{
 				CommitTitle: commitTitle,
 				MergeMethod: mergeMethod,
+				SHA:         sha,
 			}
 
 			client, err := getClient(ctx)
@@ -507,6 +666,12 @@ func MergePullRequest(getClient GetClientFn, t translations.TranslationHelperFun
 			}
 			result, resp, err := client.PullRequests.Merge(ctx, owner, repo, pullNumber, commitMessage, options)
 			if err != nil {
+				// The pull request not being mergeable (405) or its head having moved away from sha (409)
+				// are expected outcomes that the model can act upon, so the API's message is passed on.
+				var errResp *github.ErrorResponse
+				if resp != nil && (resp.StatusCode == http.StatusMethodNotAllowed || resp.StatusCode == http.StatusConflict) && errors.As(err, &errResp) {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to merge pull request: %s", errResp.Message)), nil
+				}
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
 					"failed to merge pull request",
 					resp,
@@ -535,7 +700,7 @@ func MergePullRequest(getClient GetClientFn, t translations.TranslationHelperFun
 // SearchPullRequests creates a tool to search for pull requests.
 func SearchPullRequests(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("search_pull_requests",
-			mcp.WithDescription(t("TOOL_SEARCH_PULL_REQUESTS_DESCRIPTION", "Search for pull requests in GitHub repositories using issues search syntax already scoped to is:pr")),
+			mcp.WithDescription(t("TOOL_SEARCH_PULL_REQUESTS_DESCRIPTION", "Search for pull requests in GitHub repositories using issues search syntax already scoped to is:pr. Each result includes whether it is a draft and, under pull_request, when it was merged")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_SEARCH_PULL_REQUESTS_USER_TITLE", "Search pull requests"),
 				ReadOnlyHint: ToBoolPtr(true),
@@ -545,10 +710,10 @@ func SearchPullRequests(getClient GetClientFn, t translations.TranslationHelperF
 				mcp.Description("Search query using GitHub pull request search syntax"),
 			),
 			mcp.WithString("owner",
-				mcp.Description("Optional repository owner. If provided with repo, only notifications for this repository are listed."),
+				mcp.Description("Optional repository owner. Scopes the search to this owner's repositories, or to a single repository when repo is also given. Ignored if the query already contains a repo:, org: or user: qualifier."),
 			),
 			mcp.WithString("repo",
-				mcp.Description("Optional repository name. If provided with owner, only notifications for this repository are listed."),
+				mcp.Description("Optional repository name. Must be used together with owner to scope the search to a single repository."),
 			),
 			mcp.WithString("sort",
 				mcp.Description("Sort field by number of matches of categories, defaults to best match"),
@@ -571,16 +736,73 @@ func SearchPullRequests(getClient GetClientFn, t translations.TranslationHelperF
 				mcp.Enum("asc", "desc"),
 			),
 			WithPagination(),
+			WithFields(),
+			WithOutputMode(pullRequestSearchSummaryFields),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			return searchHandler(ctx, getClient, request, "pr", "failed to search pull requests")
 		}
 }
 
+// defaultMaxPatchBytes is the per-file patch size returned by get_pull_request_files unless
+// max_patch_bytes is given, so that a single large generated file can't fill the context window.
+const defaultMaxPatchBytes = 10000
+
+// PullRequestFile is a file changed in a pull request, as returned by get_pull_request_files.
+type PullRequestFile struct {
+	Filename         string `json:"filename"`
+	Status           string `json:"status"`
+	Additions        int    `json:"additions"`
+	Deletions        int    `json:"deletions"`
+	Changes          int    `json:"changes"`
+	PreviousFilename string `json:"previous_filename,omitempty"`
+	Patch            string `json:"patch,omitempty"`
+	// PatchTruncated is set when the patch was cut to max_patch_bytes.
+	PatchTruncated bool `json:"patch_truncated,omitempty"`
+	// PatchOmitted is set when the API didn't return a patch for a changed file, because the
+	// file is binary or its diff is too large.
+	PatchOmitted bool `json:"patch_omitted,omitempty"`
+}
+
+// newPullRequestFile converts a changed file into its tool output form, only keeping the patch,
+// truncated to maxPatchBytes at a line boundary, when includePatch is true.
+func newPullRequestFile(file *github.CommitFile, includePatch bool, maxPatchBytes int) PullRequestFile {
+	result := PullRequestFile{
+		Filename:         file.GetFilename(),
+		Status:           file.GetStatus(),
+		Additions:        file.GetAdditions(),
+		Deletions:        file.GetDeletions(),
+		Changes:          file.GetChanges(),
+		PreviousFilename: file.GetPreviousFilename(),
+	}
+	if !includePatch {
+		return result
+	}
+
+	patch := file.GetPatch()
+	switch {
+	case patch == "" && file.GetChanges() > 0:
+		result.PatchOmitted = true
+	case len(patch) > maxPatchBytes:
+		patch = patch[:maxPatchBytes]
+		if i := strings.LastIndexByte(patch, '\n'); i > 0 {
+			patch = patch[:i]
+		} else {
+			// Without a line break to cut at, drop any character split in half.
+			patch = strings.ToValidUTF8(patch, "")
+		}
+		result.Patch = patch
+		result.PatchTruncated = true
+	default:
+		result.Patch = patch
+	}
+	return result
+}
+
 // GetPullRequestFiles creates a tool to get the list of files changed in a pull request.
 func GetPullRequestFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
 	return mcp.NewTool("get_pull_request_files",
-			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_FILES_DESCRIPTION", "Get the files changed in a specific pull request.")),
+			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_FILES_DESCRIPTION", "Get the files changed in a specific pull request, with their status and line counts. Patches are only included when include_patch is true.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_GET_PULL_REQUEST_FILES_USER_TITLE", "Get pull request files"),
 				ReadOnlyHint: ToBoolPtr(true),
@@ -597,6 +819,13 @@ func GetPullRequestFiles(getClient GetClientFn, t translations.TranslationHelper
 				mcp.Required(),
 				mcp.Description("Pull request number"),
 			),
+			mcp.WithBoolean("include_patch",
+				mcp.Description("Include the patch of each file (default: false)"),
+			),
+			mcp.WithNumber("max_patch_bytes",
+				mcp.Description(fmt.Sprintf("Maximum size in bytes of each file's patch when include_patch is true; longer patches are truncated (default: %d)", defaultMaxPatchBytes)),
+				mcp.Min(1),
+			),
 			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -612,6 +841,17 @@ func GetPullRequestFiles(getClient GetClientFn, t translations.TranslationHelper
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			includePatch, err := OptionalParam[bool](request, "include_patch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxPatchBytes, err := OptionalIntParamWithDefault(request, "max_patch_bytes", defaultMaxPatchBytes)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if maxPatchBytes < 1 {
+				return mcp.NewToolResultError("max_patch_bytes must be at least 1"), nil
+			}
 			pagination, err := OptionalPaginationParams(request)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
@@ -643,7 +883,12 @@ func GetPullRequestFiles(getClient GetClientFn, t translations.TranslationHelper
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get pull request files: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(files)
+			changedFiles := make([]PullRequestFile, 0, len(files))
+			for _, file := range files {
+				changedFiles = append(changedFiles, newPullRequestFile(file, includePatch, maxPatchBytes))
+			}
+
+			r, err := json.Marshal(changedFiles)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -652,10 +897,354 @@ func GetPullRequestFiles(getClient GetClientFn, t translations.TranslationHelper
 		}
 }
 
+// defaultDiffstatTopN is the number of largest files get_pull_request_diffstat returns unless top_n is given.
+const defaultDiffstatTopN = 10
+
+// DiffstatGroup aggregates the changes to the files of a pull request that share a directory or extension.
+type DiffstatGroup struct {
+	Name      string `json:"name"`
+	Files     int    `json:"files"`
+	Additions int    `json:"additions"`
+	Deletions int    `json:"deletions"`
+	Changes   int    `json:"changes"`
+}
+
+// DiffstatFile is a file listed by get_pull_request_diffstat among the largest changes of a pull request.
+type DiffstatFile struct {
+	Filename         string `json:"filename"`
+	Status           string `json:"status"`
+	PreviousFilename string `json:"previous_filename,omitempty"`
+	Additions        int    `json:"additions"`
+	Deletions        int    `json:"deletions"`
+	Changes          int    `json:"changes"`
+	Binary           bool   `json:"binary,omitempty"`
+}
+
+// PullRequestDiffstat summarizes the size of a pull request's changes.
+type PullRequestDiffstat struct {
+	Files        int             `json:"files"`
+	Additions    int             `json:"additions"`
+	Deletions    int             `json:"deletions"`
+	Changes      int             `json:"changes"`
+	ByStatus     map[string]int  `json:"by_status"`
+	ByDirectory  []DiffstatGroup `json:"by_directory"`
+	ByExtension  []DiffstatGroup `json:"by_extension"`
+	LargestFiles []DiffstatFile  `json:"largest_files"`
+	// Truncated is set when the pull request has more files than were fetched.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// isBinaryCommitFile reports whether a changed file is binary. The API returns neither a patch nor line
+// counts for binary files, which otherwise only happens for renames without content changes.
+func isBinaryCommitFile(file *github.CommitFile) bool {
+	return file.GetPatch() == "" && file.GetChanges() == 0 && file.GetStatus() != "renamed"
+}
+
+// diffstatDirectory returns the top level directory of a file, or "(root)" for files at the top level.
+func diffstatDirectory(filename string) string {
+	if i := strings.IndexByte(filename, '/'); i >= 0 {
+		return filename[:i]
+	}
+	return "(root)"
+}
+
+// diffstatExtension returns the lower cased extension of a file, or "(none)" for files without one,
+// including dotfiles such as .gitignore.
+func diffstatExtension(filename string) string {
+	base := filename[strings.LastIndexByte(filename, '/')+1:]
+	if i := strings.LastIndexByte(base, '.'); i > 0 {
+		return strings.ToLower(base[i:])
+	}
+	return "(none)"
+}
+
+// sortedDiffstatGroups returns groups ordered by decreasing changes, then by name.
+func sortedDiffstatGroups(groups map[string]*DiffstatGroup) []DiffstatGroup {
+	result := make([]DiffstatGroup, 0, len(groups))
+	for _, group := range groups {
+		result = append(result, *group)
+	}
+	slices.SortFunc(result, func(a, b DiffstatGroup) int {
+		return cmp.Or(cmp.Compare(b.Changes, a.Changes), strings.Compare(a.Name, b.Name))
+	})
+	return result
+}
+
+// summarizeDiffstat aggregates the changed files of a pull request. Every file is listed once, renamed
+// files under their new name, so renames are counted once.
+func summarizeDiffstat(files []*github.CommitFile, topN int) PullRequestDiffstat {
+	summary := PullRequestDiffstat{ByStatus: map[string]int{}}
+	directories := map[string]*DiffstatGroup{}
+	extensions := map[string]*DiffstatGroup{}
+	largest := make([]DiffstatFile, 0, len(files))
+	for _, file := range files {
+		summary.Files++
+		summary.Additions += file.GetAdditions()
+		summary.Deletions += file.GetDeletions()
+		summary.Changes += file.GetChanges()
+		summary.ByStatus[file.GetStatus()]++
+
+		for _, g := range []struct {
+			groups map[string]*DiffstatGroup
+			name   string
+		}{
+			{directories, diffstatDirectory(file.GetFilename())},
+			{extensions, diffstatExtension(file.GetFilename())},
+		} {
+			group, ok := g.groups[g.name]
+			if !ok {
+				group = &DiffstatGroup{Name: g.name}
+				g.groups[g.name] = group
+			}
+			group.Files++
+			group.Additions += file.GetAdditions()
+			group.Deletions += file.GetDeletions()
+			group.Changes += file.GetChanges()
+		}
+
+		largest = append(largest, DiffstatFile{
+			Filename:         file.GetFilename(),
+			Status:           file.GetStatus(),
+			PreviousFilename: file.GetPreviousFilename(),
+			Additions:        file.GetAdditions(),
+			Deletions:        file.GetDeletions(),
+			Changes:          file.GetChanges(),
+			Binary:           isBinaryCommitFile(file),
+		})
+	}
+
+	slices.SortStableFunc(largest, func(a, b DiffstatFile) int {
+		return cmp.Compare(b.Changes, a.Changes)
+	})
+	if len(largest) > topN {
+		largest = largest[:topN]
+	}
+	summary.ByDirectory = sortedDiffstatGroups(directories)
+	summary.ByExtension = sortedDiffstatGroups(extensions)
+	summary.LargestFiles = largest
+	return summary
+}
+
+// GetPullRequestDiffstat creates a tool to summarize the size of a pull request's changes.
+func GetPullRequestDiffstat(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("get_pull_request_diffstat",
+			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_DIFFSTAT_DESCRIPTION", "Get a compact summary of the size of a pull request: total additions, deletions and changed files, the same broken down by top level directory and by file extension, and the files with the most changes. Use this before get_pull_request_files or get_pull_request_diff to decide what to look at.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PULL_REQUEST_DIFFSTAT_USER_TITLE", "Get pull request diffstat"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithNumber("top_n",
+				mcp.Description("Number of files with the most changes to list"),
+				mcp.Min(0),
+				mcp.DefaultNumber(defaultDiffstatTopN),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			topN, err := OptionalIntParamWithDefault(request, "top_n", defaultDiffstatTopN)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if topN < 0 {
+				return mcp.NewToolResultError("top_n must not be negative"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			files, resp, err := fetchAllPages(ctx, 1, DefaultFetchAllMaxItems, func(page int) ([]*github.CommitFile, *github.Response, error) {
+				return client.PullRequests.ListFiles(ctx, owner, repo, pullNumber, &github.ListOptions{Page: page, PerPage: 100})
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get pull request files",
+					resp,
+					err,
+				), nil
+			}
+
+			diffstat := summarizeDiffstat(files.Items, topN)
+			diffstat.Truncated = files.Truncated
+			return MarshalledTextResult(diffstat), nil
+		}
+}
+
+// PullRequestCheck is a single commit status or check run reported for the head of a pull request.
+type PullRequestCheck struct {
+	Name       string `json:"name"`
+	Conclusion string `json:"conclusion"`
+	DetailsURL string `json:"details_url,omitempty"`
+}
+
+// PullRequestStatus summarizes the commit statuses and check runs for the head of a pull request.
+type PullRequestStatus struct {
+	HeadSHA    string             `json:"head_sha"`
+	State      string             `json:"state"`
+	TotalCount int                `json:"total_count"`
+	Counts     map[string]int     `json:"counts"`
+	Failing    []PullRequestCheck `json:"failing"`
+	// MissingRequired lists required checks that haven't reported yet. It is only set when required_only is.
+	MissingRequired []string `json:"missing_required,omitempty"`
+	// Truncated is set when the head has too many statuses or check runs to read them all.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// failingCheckConclusions are the conclusions that block a merge.
+var failingCheckConclusions = map[string]bool{
+	"failure":         true,
+	"error":           true,
+	"cancelled":       true,
+	"timed_out":       true,
+	"action_required": true,
+	"startup_failure": true,
+}
+
+// pullRequestChecks combines commit statuses and check runs into a single list. Statuses report their state,
+// and check runs report their conclusion once completed, or pending until then.
+func pullRequestChecks(status *github.CombinedStatus, checkRuns []*github.CheckRun) []PullRequestCheck {
+	checks := make([]PullRequestCheck, 0, len(status.Statuses)+len(checkRuns))
+	for _, s := range status.Statuses {
+		checks = append(checks, PullRequestCheck{
+			Name:       s.GetContext(),
+			Conclusion: s.GetState(),
+			DetailsURL: s.GetTargetURL(),
+		})
+	}
+	for _, run := range checkRuns {
+		conclusion := "pending"
+		if run.GetStatus() == "completed" {
+			conclusion = run.GetConclusion()
+		}
+		checks = append(checks, PullRequestCheck{
+			Name:       run.GetName(),
+			Conclusion: conclusion,
+			DetailsURL: run.GetDetailsURL(),
+		})
+	}
+	return checks
+}
+
+// summarizePullRequestChecks builds the status of a pull request head from its checks. When required is not nil,
+// only the checks it names are considered, and those that haven't reported make the state pending.
+func summarizePullRequestChecks(headSHA string, checks []PullRequestCheck, required []string) PullRequestStatus {
+	var requiredSet map[string]bool
+	if required != nil {
+		requiredSet = make(map[string]bool, len(required))
+		for _, name := range required {
+			requiredSet[name] = true
+		}
+	}
+
+	summary := PullRequestStatus{
+		HeadSHA: headSHA,
+		Counts:  map[string]int{},
+		Failing: []PullRequestCheck{},
+	}
+	reported := map[string]bool{}
+	pending := false
+	for _, check := range checks {
+		if requiredSet != nil && !requiredSet[check.Name] {
+			continue
+		}
+		reported[check.Name] = true
+		summary.TotalCount++
+		summary.Counts[check.Conclusion]++
+		switch {
+		case failingCheckConclusions[check.Conclusion]:
+			summary.Failing = append(summary.Failing, check)
+		case check.Conclusion == "pending":
+			pending = true
+		}
+	}
+	for _, name := range required {
+		if !reported[name] {
+			summary.MissingRequired = append(summary.MissingRequired, name)
+		}
+	}
+
+	switch {
+	case len(summary.Failing) > 0:
+		summary.State = "failure"
+	case pending || len(summary.MissingRequired) > 0:
+		summary.State = "pending"
+	default:
+		summary.State = "success"
+	}
+	return summary
+}
+
+// requiredStatusCheckNames returns the names of the checks that branch protection and rulesets require on
+// branch. An unprotected branch without rulesets requires no checks.
+func requiredStatusCheckNames(ctx context.Context, client *github.Client, owner, repo, branch string) ([]string, *github.Response, error) {
+	names := []string{}
+	seen := map[string]bool{}
+	add := func(name string) {
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+
+	checks, resp, err := client.Repositories.GetRequiredStatusChecks(ctx, owner, repo, branch)
+	switch {
+	case errors.Is(err, github.ErrBranchNotProtected):
+	case err != nil:
+		return nil, resp, err
+	case checks.Checks != nil:
+		for _, check := range *checks.Checks {
+			add(check.Context)
+		}
+	case checks.Contexts != nil:
+		for _, name := range *checks.Contexts {
+			add(name)
+		}
+	}
+
+	// Servers without rulesets answer with a 404.
+	rules, resp, err := client.Repositories.GetRulesForBranch(ctx, owner, repo, branch, &github.ListOptions{PerPage: 100})
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return names, resp, nil
+		}
+		return nil, resp, err
+	}
+	_ = resp.Body.Close()
+	for _, rule := range rules.RequiredStatusChecks {
+		for _, check := range rule.Parameters.RequiredStatusChecks {
+			add(check.Context)
+		}
+	}
+	return names, resp, nil
+}
+
 // GetPullRequestStatus creates a tool to get the combined status of all status checks for a pull request.
 func GetPullRequestStatus(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
 	return mcp.NewTool("get_pull_request_status",
-			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_STATUS_DESCRIPTION", "Get the status of a specific pull request.")),
+			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_STATUS_DESCRIPTION", "Get the status of the checks on a pull request's head commit, combining commit statuses and check runs. Returns the overall state (success, pending or failure), counts by conclusion, and the failing checks with their details_url. When there are too many checks to read them all, truncated is set and, unless required_only is set, a state that would be success is unknown.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_GET_PULL_REQUEST_STATUS_USER_TITLE", "Get pull request status checks"),
 				ReadOnlyHint: ToBoolPtr(true),
@@ -672,6 +1261,9 @@ func GetPullRequestStatus(getClient GetClientFn, t translations.TranslationHelpe
 				mcp.Required(),
 				mcp.Description("Pull request number"),
 			),
+			mcp.WithBoolean("required_only",
+				mcp.Description("Only consider the checks required by the base branch's protection rules and rulesets, answering whether the pull request is mergeable by policy"),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](request, "owner")
@@ -686,6 +1278,10 @@ func GetPullRequestStatus(getClient GetClientFn, t translations.TranslationHelpe
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			requiredOnly, err := OptionalParam[bool](request, "required_only")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 			// First get the PR to find the head SHA
 			client, err := getClient(ctx)
 			if err != nil {
@@ -708,9 +1304,10 @@ func GetPullRequestStatus(getClient GetClientFn, t translations.TranslationHelpe
 				}
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get pull request: %s", string(body))), nil
 			}
+			headSHA := pr.GetHead().GetSHA()
 
 			// Get combined status for the head SHA
-			status, resp, err := client.Repositories.GetCombinedStatus(ctx, owner, repo, *pr.Head.SHA, nil)
+			status, resp, err := client.Repositories.GetCombinedStatus(ctx, owner, repo, headSHA, &github.ListOptions{PerPage: 100})
 			if err != nil {
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
 					"failed to get combined status",
@@ -728,22 +1325,85 @@ func GetPullRequestStatus(getClient GetClientFn, t translations.TranslationHelpe
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get combined status: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(status)
+			// Check runs are paged through here, so the summary covers all of them.
+			checkRuns, resp, err := fetchAllPages(ctx, 1, DefaultFetchAllMaxItems, func(page int) ([]*github.CheckRun, *github.Response, error) {
+				result, resp, err := client.Checks.ListCheckRunsForRef(ctx, owner, repo, headSHA, &github.ListCheckRunsOptions{
+					Filter:      github.Ptr("latest"),
+					ListOptions: github.ListOptions{Page: page, PerPage: 100},
+				})
+				if err != nil {
+					return nil, resp, err
+				}
+				return result.CheckRuns, resp, nil
+			})
 			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to list check runs",
+					resp,
+					err,
+				), nil
 			}
 
-			return mcp.NewToolResultText(string(r)), nil
+			var required []string
+			if requiredOnly {
+				required, resp, err = requiredStatusCheckNames(ctx, client, owner, repo, pr.GetBase().GetRef())
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to get required status checks",
+						resp,
+						err,
+					), nil
+				}
+			}
+
+			summary := summarizePullRequestChecks(headSHA, pullRequestChecks(status, checkRuns.Items), required)
+			summary.Truncated = checkRuns.Truncated || status.GetTotalCount() > len(status.Statuses)
+			// Checks that weren't read may have failed, so a partial list can't be reported as a success.
+			if summary.Truncated && required == nil && summary.State == "success" {
+				summary.State = "unknown"
+			}
+			return MarshalledTextResult(summary), nil
 		}
 }
 
-// UpdatePullRequestBranch creates a tool to update a pull request branch with the latest changes from the base branch.
-func UpdatePullRequestBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
-	return mcp.NewTool("update_pull_request_branch",
-			mcp.WithDescription(t("TOOL_UPDATE_PULL_REQUEST_BRANCH_DESCRIPTION", "Update the branch of a pull request with the latest changes from the base branch.")),
+var (
+	// pullRequestMergeabilityAttempts and pullRequestMergeabilityBackoff control how often get_pull_request_conflicts
+	// fetches a pull request while GitHub computes its mergeability. The backoff doubles after every attempt,
+	// and is a variable so tests can shorten it.
+	pullRequestMergeabilityAttempts = 3
+	pullRequestMergeabilityBackoff  = time.Second
+)
+
+// getPullRequestMergeability fetches a pull request until GitHub has computed whether it is mergeable, giving up
+// after pullRequestMergeabilityAttempts. The last pull request fetched is returned either way.
+func getPullRequestMergeability(ctx context.Context, client *github.Client, owner, repo string, pullNumber int) (*github.PullRequest, *github.Response, error) {
+	backoff := pullRequestMergeabilityBackoff
+	for attempt := 1; ; attempt++ {
+		pr, resp, err := client.PullRequests.Get(ctx, owner, repo, pullNumber)
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		if pr.Mergeable != nil || attempt >= pullRequestMergeabilityAttempts {
+			return pr, resp, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// GetPullRequestConflicts creates a tool to explain why a pull request can't be merged cleanly.
+func GetPullRequestConflicts(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("get_pull_request_conflicts",
+			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_CONFLICTS_DESCRIPTION", "Get the merge conflict details of a pull request. Reports whether it is mergeable and, when it conflicts with its base branch, how far the branches have diverged and the files changed on both sides since they diverged, which are the likely conflicts. The API lists at most 300 changed files per side, so when truncated is set the candidates may be incomplete.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				Title:        t("TOOL_UPDATE_PULL_REQUEST_BRANCH_USER_TITLE", "Update pull request branch"),
-				ReadOnlyHint: ToBoolPtr(false),
+				Title:        t("TOOL_GET_PULL_REQUEST_CONFLICTS_USER_TITLE", "Get pull request merge conflicts"),
+				ReadOnlyHint: ToBoolPtr(true),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -757,9 +1417,6 @@ func UpdatePullRequestBranch(getClient GetClientFn, t translations.TranslationHe
 				mcp.Required(),
 				mcp.Description("Pull request number"),
 			),
-			mcp.WithString("expectedHeadSha",
-				mcp.Description("The expected SHA of the pull request's HEAD ref"),
-			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](request, "owner")
@@ -774,9 +1431,164 @@ func UpdatePullRequestBranch(getClient GetClientFn, t translations.TranslationHe
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			expectedHeadSHA, err := OptionalParam[string](request, "expectedHeadSha")
+
+			client, err := getClient(ctx)
 			if err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			pr, resp, err := getPullRequestMergeability(ctx, client, owner, repo, pullNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get pull request",
+					resp,
+					err,
+				), nil
+			}
+
+			result := map[string]any{
+				"mergeable":       pr.Mergeable,
+				"mergeable_state": pr.GetMergeableState(),
+			}
+			if pr.Mergeable == nil {
+				result["note"] = "GitHub is still computing whether this pull request is mergeable, try again in a few seconds"
+				return MarshalledTextResult(result), nil
+			}
+			// Only a dirty pull request has merge conflicts. Other states that block merging, such as failing
+			// checks or missing reviews, are reported by get_pull_request_status and get_pull_request_reviews.
+			result["has_conflicts"] = pr.GetMergeableState() == "dirty"
+			if pr.GetMergeableState() != "dirty" {
+				return MarshalledTextResult(result), nil
+			}
+
+			baseRef, headSHA := pr.GetBase().GetRef(), pr.GetHead().GetSHA()
+			headComparison, resp, err := client.Repositories.CompareCommits(ctx, owner, repo, baseRef, headSHA, nil)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to compare the pull request with its base",
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			// Files changed on both sides since the merge base are the ones that can conflict.
+			mergeBaseSHA := headComparison.GetMergeBaseCommit().GetSHA()
+			baseComparison, resp, err := client.Repositories.CompareCommits(ctx, owner, repo, mergeBaseSHA, baseRef, nil)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to compare the base branch with the merge base",
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			changedInBase := map[string]bool{}
+			for _, file := range baseComparison.Files {
+				changedInBase[file.GetFilename()] = true
+			}
+			candidates := []string{}
+			for _, file := range headComparison.Files {
+				if changedInBase[file.GetFilename()] {
+					candidates = append(candidates, file.GetFilename())
+				}
+			}
+
+			result["ahead_by"] = headComparison.GetAheadBy()
+			result["behind_by"] = headComparison.GetBehindBy()
+			result["merge_base_sha"] = mergeBaseSHA
+			result["conflict_candidates"] = candidates
+			// The compare API stops listing files at its limit, so files past it can't be checked for conflicts.
+			result["truncated"] = len(headComparison.Files) >= compareFilesLimit || len(baseComparison.Files) >= compareFilesLimit
+			return MarshalledTextResult(result), nil
+		}
+}
+
+var (
+	// updatePullRequestBranchPollInterval and updatePullRequestBranchWaitTimeout control how long
+	// update_pull_request_branch polls the pull request when wait is set. They are variables so tests can shorten them.
+	updatePullRequestBranchPollInterval = 2 * time.Second
+	updatePullRequestBranchWaitTimeout  = 30 * time.Second
+)
+
+// waitForPullRequestBranchUpdate polls a pull request until its head has moved on from previousHeadSHA after a
+// branch update and GitHub has recomputed its mergeable state, or until updatePullRequestBranchWaitTimeout has
+// passed. It returns the last pull request seen and whether the update was observed to have been applied.
+func waitForPullRequestBranchUpdate(ctx context.Context, client *github.Client, owner, repo string, pullNumber int, previousHeadSHA string) (*github.PullRequest, bool, *github.Response, error) {
+	deadline := time.Now().Add(updatePullRequestBranchWaitTimeout)
+	for {
+		pr, resp, err := client.PullRequests.Get(ctx, owner, repo, pullNumber)
+		if err != nil {
+			return nil, false, resp, err
+		}
+		_ = resp.Body.Close()
+
+		// The mergeable state alone can't tell whether the update has landed, since a branch that
+		// was blocked or dirty before stays that way. The update is only applied once the head moves.
+		updated := pr.GetHead().GetSHA() != previousHeadSHA
+		state := pr.GetMergeableState()
+		if updated && state != "" && state != "unknown" {
+			return pr, true, resp, nil
+		}
+		if time.Now().Add(updatePullRequestBranchPollInterval).After(deadline) {
+			return pr, updated, resp, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, false, nil, ctx.Err()
+		case <-time.After(updatePullRequestBranchPollInterval):
+		}
+	}
+}
+
+// UpdatePullRequestBranch creates a tool to update a pull request branch with the latest changes from the base branch.
+func UpdatePullRequestBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("update_pull_request_branch",
+			mcp.WithDescription(t("TOOL_UPDATE_PULL_REQUEST_BRANCH_DESCRIPTION", "Update the branch of a pull request with the latest changes from the base branch. The update is queued and applied asynchronously, set wait to poll until it has been applied.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UPDATE_PULL_REQUEST_BRANCH_USER_TITLE", "Update pull request branch"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithString("expectedHeadSha",
+				mcp.Description("The expected SHA of the pull request's HEAD ref"),
+			),
+			mcp.WithBoolean("wait",
+				mcp.Description(fmt.Sprintf("Poll the pull request for up to %d seconds until the update has been applied and its mergeable_state is known", int(updatePullRequestBranchWaitTimeout.Seconds()))),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			expectedHeadSHA, err := OptionalParam[string](request, "expectedHeadSha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			wait, err := OptionalParam[bool](request, "wait")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
 			}
 			opts := &github.PullRequestBranchUpdateOptions{}
 			if expectedHeadSHA != "" {
@@ -787,12 +1599,54 @@ func UpdatePullRequestBranch(getClient GetClientFn, t translations.TranslationHe
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			result, resp, err := client.PullRequests.UpdateBranch(ctx, owner, repo, pullNumber, opts)
+
+			// Waiting needs the head before the update to tell when it has been applied. With an
+			// expected head SHA the update is rejected unless it matches, so it can be used as is.
+			previousHeadSHA := expectedHeadSHA
+			if wait && previousHeadSHA == "" {
+				pr, resp, err := client.PullRequests.Get(ctx, owner, repo, pullNumber)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to get pull request",
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+				previousHeadSHA = pr.GetHead().GetSHA()
+			}
+
+			_, resp, err := client.PullRequests.UpdateBranch(ctx, owner, repo, pullNumber, opts)
 			if err != nil {
-				// Check if it's an acceptedError. An acceptedError indicates that the update is in progress,
+				// Check if it's an acceptedError. An acceptedError indicates that the update is queued,
 				// and it's not a real error.
 				if resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err) {
-					return mcp.NewToolResultText("Pull request branch update is in progress"), nil
+					if !wait {
+						return mcp.NewToolResultText("Pull request branch update queued. GitHub applies it asynchronously, set wait to poll until it has been applied."), nil
+					}
+
+					pr, updated, resp, err := waitForPullRequestBranchUpdate(ctx, client, owner, repo, pullNumber, previousHeadSHA)
+					if err != nil {
+						return ghErrors.NewGitHubAPIErrorResponse(ctx,
+							"pull request branch update queued, but failed to get the pull request",
+							resp,
+							err,
+						), nil
+					}
+					status := "updated"
+					if !updated {
+						status = "queued"
+					}
+					return MarshalledTextResult(map[string]any{
+						"status":          status,
+						"head_sha":        pr.GetHead().GetSHA(),
+						"mergeable_state": pr.GetMergeableState(),
+					}), nil
+				}
+				// The branch can't be updated automatically when the base can't be merged into it cleanly.
+				var errResp *github.ErrorResponse
+				if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity && errors.As(err, &errResp) {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to update pull request branch: %s. The branch most likely conflicts with the base branch and needs a manual rebase or merge", errResp.Message)), nil
 				}
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
 					"failed to update pull request branch",
@@ -810,12 +1664,7 @@ func UpdatePullRequestBranch(getClient GetClientFn, t translations.TranslationHe
 				return mcp.NewToolResultError(fmt.Sprintf("failed to update pull request branch: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(result)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return mcp.NewToolResultText("Pull request branch update queued."), nil
 		}
 }
 
@@ -891,6 +1740,255 @@ func GetPullRequestComments(getClient GetClientFn, t translations.TranslationHel
 		}
 }
 
+// ReplyToPullRequestReviewComment creates a tool to reply to a review comment thread on a pull request.
+func ReplyToPullRequestReviewComment(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("reply_to_pull_request_review_comment",
+			mcp.WithDescription(t("TOOL_REPLY_TO_PULL_REQUEST_REVIEW_COMMENT_DESCRIPTION", "Reply in-thread to an existing review comment on a pull request. Use this to answer line comments instead of adding a top-level issue comment. Returns the created comment, including its html_url.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_REPLY_TO_PULL_REQUEST_REVIEW_COMMENT_USER_TITLE", "Reply to pull request review comment"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithNumber("comment_id",
+				mcp.Required(),
+				mcp.Description("The ID of the review comment to reply to, as returned by get_pull_request_comments"),
+			),
+			mcp.WithString("body",
+				mcp.Required(),
+				mcp.Description("The text of the reply"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			commentID, err := RequiredBigInt(request, "comment_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			body, err := RequiredParam[string](request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			comment, resp, err := client.PullRequests.CreateCommentInReplyTo(ctx, owner, repo, pullNumber, body, commentID)
+			if err != nil {
+				// A missing or unprocessable comment usually means it was deleted or its thread was resolved,
+				// so point the model at the comment list rather than returning a bare status code.
+				if resp != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusUnprocessableEntity) {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to reply to review comment %d: it may have been deleted or its thread resolved, refresh the comments with get_pull_request_comments", commentID),
+						resp,
+						err,
+					), nil
+				}
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to reply to review comment %d", commentID),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to reply to review comment %d: %s", commentID, string(body))), nil
+			}
+
+			return MarshalledTextResult(comment), nil
+		}
+}
+
+// PullRequestReviewComment is an inline review comment, as returned by list_pull_request_review_comments.
+type PullRequestReviewComment struct {
+	ID          int64  `json:"id"`
+	Path        string `json:"path"`
+	Line        int    `json:"line,omitempty"`
+	Side        string `json:"side,omitempty"`
+	Body        string `json:"body"`
+	Author      string `json:"author"`
+	InReplyToID int64  `json:"in_reply_to_id,omitempty"`
+	CreatedAt   string `json:"created_at,omitempty"`
+	HTMLURL     string `json:"html_url"`
+	// Replies holds the replies to a root comment when threaded is set.
+	Replies []PullRequestReviewComment `json:"replies,omitempty"`
+}
+
+// threadPullRequestReviewComments groups replies under their root comment. GitHub points every reply at the
+// root of its thread, so one level of nesting is enough. Replies whose root isn't in comments stay at the top level.
+func threadPullRequestReviewComments(comments []PullRequestReviewComment) []PullRequestReviewComment {
+	roots := map[int64]int{}
+	threads := make([]PullRequestReviewComment, 0, len(comments))
+	for _, comment := range comments {
+		if comment.InReplyToID == 0 {
+			roots[comment.ID] = len(threads)
+			threads = append(threads, comment)
+		}
+	}
+	for _, comment := range comments {
+		if comment.InReplyToID == 0 {
+			continue
+		}
+		if i, ok := roots[comment.InReplyToID]; ok {
+			threads[i].Replies = append(threads[i].Replies, comment)
+		} else {
+			threads = append(threads, comment)
+		}
+	}
+	return threads
+}
+
+// ListPullRequestReviewComments creates a tool to list the inline review comments on a pull request.
+func ListPullRequestReviewComments(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("list_pull_request_review_comments",
+			mcp.WithDescription(t("TOOL_LIST_PULL_REQUEST_REVIEW_COMMENTS_DESCRIPTION", "List the inline review comments on a pull request, which are separate from its issue comments. The path and author filters are applied to each page of results, so a page can hold fewer comments than perPage. Set threaded to group replies under the comment that started their thread.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_PULL_REQUEST_REVIEW_COMMENTS_USER_TITLE", "List pull request review comments"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithString("path",
+				mcp.Description("Only comments on this file path"),
+			),
+			mcp.WithString("author",
+				mcp.Description("Only comments by this user login"),
+			),
+			mcp.WithString("since",
+				mcp.Description("Only comments updated at or after this time (ISO 8601 timestamp, or relative such as 24h, 7d, 2w, yesterday)"),
+			),
+			mcp.WithBoolean("threaded",
+				mcp.Description("Group replies under the comment that started their thread"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			path, err := OptionalParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			author, err := OptionalParam[string](request, "author")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			since, err := OptionalParam[string](request, "since")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			threaded, err := OptionalParam[bool](request, "threaded")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.PullRequestListCommentsOptions{
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			}
+			if since != "" {
+				opts.Since, err = parseISOTimestamp(since)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			comments, resp, err := client.PullRequests.ListComments(ctx, owner, repo, pullNumber, opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to list pull request review comments",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			// The API can't filter by path or author, so those filters are applied here.
+			result := []PullRequestReviewComment{}
+			for _, comment := range comments {
+				if path != "" && comment.GetPath() != path {
+					continue
+				}
+				if author != "" && !strings.EqualFold(comment.GetUser().GetLogin(), author) {
+					continue
+				}
+				result = append(result, PullRequestReviewComment{
+					ID:          comment.GetID(),
+					Path:        comment.GetPath(),
+					Line:        comment.GetLine(),
+					Side:        comment.GetSide(),
+					Body:        comment.GetBody(),
+					Author:      comment.GetUser().GetLogin(),
+					InReplyToID: comment.GetInReplyTo(),
+					CreatedAt:   summaryTimestamp(comment.GetCreatedAt()),
+					HTMLURL:     comment.GetHTMLURL(),
+				})
+			}
+			if threaded {
+				result = threadPullRequestReviewComments(result)
+			}
+
+			return MarshalledTextResult(result), nil
+		}
+}
+
 // GetPullRequestReviews creates a tool to get the reviews on a pull request.
 func GetPullRequestReviews(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
 	return mcp.NewTool("get_pull_request_reviews",
@@ -957,6 +2055,129 @@ func GetPullRequestReviews(getClient GetClientFn, t translations.TranslationHelp
 		}
 }
 
+// PullRequestReviewSummary is a pull request review, as returned by list_pull_request_reviews.
+type PullRequestReviewSummary struct {
+	Reviewer    string `json:"reviewer"`
+	State       string `json:"state"`
+	SubmittedAt string `json:"submitted_at,omitempty"`
+	Body        string `json:"body"`
+	HTMLURL     string `json:"html_url"`
+}
+
+// summarizePullRequestReviews aggregates reviews, which the API returns in chronological order, into
+// the number of reviews in each state and the current state of each reviewer. As on GitHub, a comment
+// doesn't replace an earlier approval or request for changes, so a reviewer's state is their latest
+// review that isn't COMMENTED, or COMMENTED if they have only commented.
+func summarizePullRequestReviews(reviews []*github.PullRequestReview) map[string]any {
+	counts := map[string]int{}
+	latest := map[string]string{}
+	for _, review := range reviews {
+		state := review.GetState()
+		if state == "PENDING" {
+			continue
+		}
+		counts[state]++
+		reviewer := review.GetUser().GetLogin()
+		if state != "COMMENTED" || latest[reviewer] == "" {
+			latest[reviewer] = state
+		}
+	}
+	return map[string]any{
+		"counts":             counts,
+		"latest_by_reviewer": latest,
+	}
+}
+
+// ListPullRequestReviews creates a tool to list the reviews of a pull request, along with a summary of
+// the current state of each reviewer.
+func ListPullRequestReviews(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("list_pull_request_reviews",
+			mcp.WithDescription(t("TOOL_LIST_PULL_REQUEST_REVIEWS_DESCRIPTION", "List the reviews of a pull request, oldest first. Also returns a summary with the number of reviews in each state and the current state of each reviewer (their latest review that isn't a comment), which answers whether a pull request is approved. The summary only covers the returned page: it includes the page number and has_more_pages, and reviews on other pages can change a reviewer's state.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_PULL_REQUEST_REVIEWS_USER_TITLE", "List pull request reviews"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			opts := &github.ListOptions{
+				PerPage: pagination.PerPage,
+				Page:    pagination.Page,
+			}
+			reviews, resp, err := client.PullRequests.ListReviews(ctx, owner, repo, pullNumber, opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to list pull request reviews",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list pull request reviews: %s", string(body))), nil
+			}
+
+			summaries := make([]PullRequestReviewSummary, 0, len(reviews))
+			for _, review := range reviews {
+				summaries = append(summaries, PullRequestReviewSummary{
+					Reviewer:    review.GetUser().GetLogin(),
+					State:       review.GetState(),
+					SubmittedAt: summaryTimestamp(review.GetSubmittedAt()),
+					Body:        review.GetBody(),
+					HTMLURL:     review.GetHTMLURL(),
+				})
+			}
+
+			// The summary is built from this page alone, so say which page it covers.
+			summary := summarizePullRequestReviews(reviews)
+			summary["page"] = pagination.Page
+			summary["has_more_pages"] = resp.NextPage != 0
+
+			return MarshalledTextResult(map[string]any{
+				"reviews": summaries,
+				"summary": summary,
+			}), nil
+		}
+}
+
 func CreateAndSubmitPullRequestReview(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
 	return mcp.NewTool("create_and_submit_pull_request_review",
 			mcp.WithDescription(t("TOOL_CREATE_AND_SUBMIT_PULL_REQUEST_REVIEW_DESCRIPTION", "Create and submit a review for a pull request without review comments.")),
@@ -1033,7 +2254,7 @@ func CreateAndSubmitPullRequestReview(getGQLClient GetGQLClientFn, t translation
 			var addPullRequestReviewMutation struct {
 				AddPullRequestReview struct {
 					PullRequestReview struct {
-						ID githubv4.ID // We don't need this, but a selector is required or GQL complains.
+						ID githubv4.ID
 					}
 				} `graphql:"addPullRequestReview(input: $input)"`
 			}
@@ -1127,7 +2348,7 @@ func CreatePendingPullRequestReview(getGQLClient GetGQLClientFn, t translations.
 			var addPullRequestReviewMutation struct {
 				AddPullRequestReview struct {
 					PullRequestReview struct {
-						ID githubv4.ID // We don't need this, but a selector is required or GQL complains.
+						ID githubv4.ID
 					}
 				} `graphql:"addPullRequestReview(input: $input)"`
 			}
@@ -1144,13 +2365,56 @@ func CreatePendingPullRequestReview(getGQLClient GetGQLClientFn, t translations.
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			// Return nothing interesting, just indicate success for the time being.
-			// In future, we may want to return the review ID, but for the moment, we're not leaking
-			// API implementation details to the LLM.
-			return mcp.NewToolResultText("pending pull request created"), nil
+			// The review ID lets follow-up calls target this review, rather than relying on it
+			// still being the user's latest review.
+			return mcp.NewToolResultText(fmt.Sprintf("pending pull request review created with pullRequestReviewID %v. Pass it to add_comment_to_pending_review, submit_pending_pull_request_review or delete_pending_pull_request_review to act on this review.", addPullRequestReviewMutation.AddPullRequestReview.PullRequestReview.ID)), nil
 		}
 }
 
+// validateReviewCommentPosition checks the position of a review comment before it is sent, because the
+// API rejects invalid combinations with errors that don't say what is wrong.
+func validateReviewCommentPosition(subjectType string, line *int32, side *string, startLine *int32, startSide *string) error {
+	if side != nil && *side != "LEFT" && *side != "RIGHT" {
+		return fmt.Errorf("invalid side %q: must be LEFT or RIGHT", *side)
+	}
+	if startSide != nil && *startSide != "LEFT" && *startSide != "RIGHT" {
+		return fmt.Errorf("invalid startSide %q: must be LEFT or RIGHT", *startSide)
+	}
+
+	switch subjectType {
+	case "FILE":
+		if line != nil || side != nil || startLine != nil || startSide != nil {
+			return fmt.Errorf("line, side, startLine and startSide can't be used with subjectType FILE, which comments on the whole file")
+		}
+	case "LINE":
+		if line == nil {
+			return fmt.Errorf("line is required when subjectType is LINE")
+		}
+		if *line < 1 {
+			return fmt.Errorf("invalid line %d: must be at least 1", *line)
+		}
+		if startLine == nil {
+			if startSide != nil {
+				return fmt.Errorf("startSide can only be used with startLine, for multi-line comments")
+			}
+			break
+		}
+		if *startLine < 1 {
+			return fmt.Errorf("invalid startLine %d: must be at least 1", *startLine)
+		}
+		// Line numbers on the LEFT and RIGHT sides of the diff count different versions of the file,
+		// so startLine can only be compared with line when both are on the same side. The side
+		// defaults to RIGHT and startSide to side.
+		sameSide := startSide == nil || *startSide == "RIGHT" && (side == nil || *side == "RIGHT") || side != nil && *startSide == *side
+		if sameSide && *startLine >= *line {
+			return fmt.Errorf("invalid startLine %d: must be before line %d on the same side", *startLine, *line)
+		}
+	default:
+		return fmt.Errorf("invalid subjectType %q: must be FILE or LINE", subjectType)
+	}
+	return nil
+}
+
 // AddCommentToPendingReview creates a tool to add a comment to a pull request review.
 func AddCommentToPendingReview(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
 	return mcp.NewTool("add_comment_to_pending_review",
@@ -1159,15 +2423,9 @@ func AddCommentToPendingReview(getGQLClient GetGQLClientFn, t translations.Trans
 				Title:        t("TOOL_ADD_COMMENT_TO_PENDING_REVIEW_USER_TITLE", "Add review comment to the requester's latest pending pull request review"),
 				ReadOnlyHint: ToBoolPtr(false),
 			}),
-			// Ideally, for performance sake this would just accept the pullRequestReviewID. However, we would need to
-			// add a new tool to get that ID for clients that aren't in the same context as the original pending review
-			// creation. So for now, we'll just accept the owner, repo and pull number and assume this is adding a comment
-			// the latest review from a user, since only one can be active at a time. It can later be extended with
-			// a pullRequestReviewID parameter if targeting other reviews is desired:
-			// mcp.WithString("pullRequestReviewID",
-			// 	mcp.Required(),
-			// 	mcp.Description("The ID of the pull request review to add a comment to"),
-			// ),
+			// The pullRequestReviewID returned when creating the pending review is optional, because clients that
+			// aren't in the same context as the original pending review creation won't have it. Without it, we assume
+			// this is adding a comment to the latest review from a user, since only one can be active at a time.
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -1180,6 +2438,9 @@ func AddCommentToPendingReview(getGQLClient GetGQLClientFn, t translations.Trans
 				mcp.Required(),
 				mcp.Description("Pull request number"),
 			),
+			mcp.WithString("pullRequestReviewID",
+				mcp.Description("The ID of the pending review, as returned by create_pending_pull_request_review. Defaults to the requester's latest review"),
+			),
 			mcp.WithString("path",
 				mcp.Required(),
 				mcp.Description("The relative path to the file that necessitates a comment"),
@@ -1210,77 +2471,33 @@ func AddCommentToPendingReview(getGQLClient GetGQLClientFn, t translations.Trans
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			var params struct {
-				Owner       string
-				Repo        string
-				PullNumber  int32
-				Path        string
-				Body        string
-				SubjectType string
-				Line        *int32
-				Side        *string
-				StartLine   *int32
-				StartSide   *string
+				Owner               string
+				Repo                string
+				PullNumber          int32
+				Path                string
+				Body                string
+				SubjectType         string
+				Line                *int32
+				Side                *string
+				StartLine           *int32
+				StartSide           *string
+				PullRequestReviewID *string
 			}
 			if err := mapstructure.Decode(request.Params.Arguments, &params); err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			if err := validateReviewCommentPosition(params.SubjectType, params.Line, params.Side, params.StartLine, params.StartSide); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
 			client, err := getGQLClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
 			}
 
-			// First we'll get the current user
-			var getViewerQuery struct {
-				Viewer struct {
-					Login githubv4.String
-				}
-			}
-
-			if err := client.Query(ctx, &getViewerQuery, nil); err != nil {
-				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
-					"failed to get current user",
-					err,
-				), nil
-			}
-
-			var getLatestReviewForViewerQuery struct {
-				Repository struct {
-					PullRequest struct {
-						Reviews struct {
-							Nodes []struct {
-								ID    githubv4.ID
-								State githubv4.PullRequestReviewState
-								URL   githubv4.URI
-							}
-						} `graphql:"reviews(first: 1, author: $author)"`
-					} `graphql:"pullRequest(number: $prNum)"`
-				} `graphql:"repository(owner: $owner, name: $name)"`
-			}
-
-			vars := map[string]any{
-				"author": githubv4.String(getViewerQuery.Viewer.Login),
-				"owner":  githubv4.String(params.Owner),
-				"name":   githubv4.String(params.Repo),
-				"prNum":  githubv4.Int(params.PullNumber),
-			}
-
-			if err := client.Query(context.Background(), &getLatestReviewForViewerQuery, vars); err != nil {
-				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
-					"failed to get latest review for current user",
-					err,
-				), nil
-			}
-
-			// Validate there is one review and the state is pending
-			if len(getLatestReviewForViewerQuery.Repository.PullRequest.Reviews.Nodes) == 0 {
-				return mcp.NewToolResultError("No pending review found for the viewer"), nil
-			}
-
-			review := getLatestReviewForViewerQuery.Repository.PullRequest.Reviews.Nodes[0]
-			if review.State != githubv4.PullRequestReviewStatePending {
-				errText := fmt.Sprintf("The latest review, found at %s is not pending", review.URL)
-				return mcp.NewToolResultError(errText), nil
+			reviewID, errResult := getPendingReviewID(ctx, client, params.Owner, params.Repo, params.PullNumber, params.PullRequestReviewID)
+			if errResult != nil {
+				return errResult, nil
 			}
 
 			// Then we can create a new review thread comment on the review.
@@ -1303,7 +2520,7 @@ func AddCommentToPendingReview(getGQLClient GetGQLClientFn, t translations.Trans
 					Side:                newGQLStringlikePtr[githubv4.DiffSide](params.Side),
 					StartLine:           newGQLIntPtr(params.StartLine),
 					StartSide:           newGQLStringlikePtr[githubv4.DiffSide](params.StartSide),
-					PullRequestReviewID: &review.ID,
+					PullRequestReviewID: &reviewID,
 				},
 				nil,
 			); err != nil {
@@ -1317,6 +2534,71 @@ func AddCommentToPendingReview(getGQLClient GetGQLClientFn, t translations.Trans
 		}
 }
 
+// getPendingReviewID returns the ID of the pending review to act on, which is either the given
+// reviewID or, if that is nil, the viewer's latest review of the pull request. Since the latest review
+// may have been submitted already, it is checked to still be pending. Errors are returned as a tool
+// result that can be passed on as is.
+func getPendingReviewID(ctx context.Context, client *githubv4.Client, owner, repo string, pullNumber int32, reviewID *string) (githubv4.ID, *mcp.CallToolResult) {
+	if reviewID != nil && *reviewID != "" {
+		return githubv4.ID(*reviewID), nil
+	}
+
+	// First we'll get the current user
+	var getViewerQuery struct {
+		Viewer struct {
+			Login githubv4.String
+		}
+	}
+
+	if err := client.Query(ctx, &getViewerQuery, nil); err != nil {
+		return nil, ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+			"failed to get current user",
+			err,
+		)
+	}
+
+	var getLatestReviewForViewerQuery struct {
+		Repository struct {
+			PullRequest struct {
+				Reviews struct {
+					Nodes []struct {
+						ID    githubv4.ID
+						State githubv4.PullRequestReviewState
+						URL   githubv4.URI
+					}
+				} `graphql:"reviews(first: 1, author: $author)"`
+			} `graphql:"pullRequest(number: $prNum)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	vars := map[string]any{
+		"author": githubv4.String(getViewerQuery.Viewer.Login),
+		"owner":  githubv4.String(owner),
+		"name":   githubv4.String(repo),
+		"prNum":  githubv4.Int(pullNumber),
+	}
+
+	if err := client.Query(ctx, &getLatestReviewForViewerQuery, vars); err != nil {
+		return nil, ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+			"failed to get latest review for current user",
+			err,
+		)
+	}
+
+	// Validate there is one review and the state is pending
+	if len(getLatestReviewForViewerQuery.Repository.PullRequest.Reviews.Nodes) == 0 {
+		return nil, mcp.NewToolResultError("No pending review found for the viewer")
+	}
+
+	review := getLatestReviewForViewerQuery.Repository.PullRequest.Reviews.Nodes[0]
+	if review.State != githubv4.PullRequestReviewStatePending {
+		errText := fmt.Sprintf("The latest review, found at %s is not pending", review.URL)
+		return nil, mcp.NewToolResultError(errText)
+	}
+
+	return review.ID, nil
+}
+
 // SubmitPendingPullRequestReview creates a tool to submit a pull request review.
 func SubmitPendingPullRequestReview(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
 	return mcp.NewTool("submit_pending_pull_request_review",
@@ -1325,10 +2607,9 @@ func SubmitPendingPullRequestReview(getGQLClient GetGQLClientFn, t translations.
 				Title:        t("TOOL_SUBMIT_PENDING_PULL_REQUEST_REVIEW_USER_TITLE", "Submit the requester's latest pending pull request review"),
 				ReadOnlyHint: ToBoolPtr(false),
 			}),
-			// Ideally, for performance sake this would just accept the pullRequestReviewID. However, we would need to
-			// add a new tool to get that ID for clients that aren't in the same context as the original pending review
-			// creation. So for now, we'll just accept the owner, repo and pull number and assume this is submitting
-			// the latest review from a user, since only one can be active at a time.
+			// The pullRequestReviewID returned when creating the pending review is optional, because clients that
+			// aren't in the same context as the original pending review creation won't have it. Without it, we assume
+			// this is submitting the latest review from a user, since only one can be active at a time.
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -1341,6 +2622,9 @@ func SubmitPendingPullRequestReview(getGQLClient GetGQLClientFn, t translations.
 				mcp.Required(),
 				mcp.Description("Pull request number"),
 			),
+			mcp.WithString("pullRequestReviewID",
+				mcp.Description("The ID of the pending review, as returned by create_pending_pull_request_review. Defaults to the requester's latest review"),
+			),
 			mcp.WithString("event",
 				mcp.Required(),
 				mcp.Description("The event to perform"),
@@ -1352,11 +2636,12 @@ func SubmitPendingPullRequestReview(getGQLClient GetGQLClientFn, t translations.
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			var params struct {
-				Owner      string
-				Repo       string
-				PullNumber int32
-				Event      string
-				Body       *string
+				Owner               string
+				Repo                string
+				PullNumber          int32
+				Event               string
+				Body                *string
+				PullRequestReviewID *string
 			}
 			if err := mapstructure.Decode(request.Params.Arguments, &params); err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
@@ -1367,57 +2652,9 @@ func SubmitPendingPullRequestReview(getGQLClient GetGQLClientFn, t translations.
 				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
 			}
 
-			// First we'll get the current user
-			var getViewerQuery struct {
-				Viewer struct {
-					Login githubv4.String
-				}
-			}
-
-			if err := client.Query(ctx, &getViewerQuery, nil); err != nil {
-				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
-					"failed to get current user",
-					err,
-				), nil
-			}
-
-			var getLatestReviewForViewerQuery struct {
-				Repository struct {
-					PullRequest struct {
-						Reviews struct {
-							Nodes []struct {
-								ID    githubv4.ID
-								State githubv4.PullRequestReviewState
-								URL   githubv4.URI
-							}
-						} `graphql:"reviews(first: 1, author: $author)"`
-					} `graphql:"pullRequest(number: $prNum)"`
-				} `graphql:"repository(owner: $owner, name: $name)"`
-			}
-
-			vars := map[string]any{
-				"author": githubv4.String(getViewerQuery.Viewer.Login),
-				"owner":  githubv4.String(params.Owner),
-				"name":   githubv4.String(params.Repo),
-				"prNum":  githubv4.Int(params.PullNumber),
-			}
-
-			if err := client.Query(context.Background(), &getLatestReviewForViewerQuery, vars); err != nil {
-				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
-					"failed to get latest review for current user",
-					err,
-				), nil
-			}
-
-			// Validate there is one review and the state is pending
-			if len(getLatestReviewForViewerQuery.Repository.PullRequest.Reviews.Nodes) == 0 {
-				return mcp.NewToolResultError("No pending review found for the viewer"), nil
-			}
-
-			review := getLatestReviewForViewerQuery.Repository.PullRequest.Reviews.Nodes[0]
-			if review.State != githubv4.PullRequestReviewStatePending {
-				errText := fmt.Sprintf("The latest review, found at %s is not pending", review.URL)
-				return mcp.NewToolResultError(errText), nil
+			reviewID, errResult := getPendingReviewID(ctx, client, params.Owner, params.Repo, params.PullNumber, params.PullRequestReviewID)
+			if errResult != nil {
+				return errResult, nil
 			}
 
 			// Prepare the mutation
@@ -1433,7 +2670,7 @@ func SubmitPendingPullRequestReview(getGQLClient GetGQLClientFn, t translations.
 				ctx,
 				&submitPullRequestReviewMutation,
 				githubv4.SubmitPullRequestReviewInput{
-					PullRequestReviewID: &review.ID,
+					PullRequestReviewID: &reviewID,
 					Event:               githubv4.PullRequestReviewEvent(params.Event),
 					Body:                newGQLStringlikePtr[githubv4.String](params.Body),
 				},
@@ -1459,10 +2696,9 @@ func DeletePendingPullRequestReview(getGQLClient GetGQLClientFn, t translations.
 				Title:        t("TOOL_DELETE_PENDING_PULL_REQUEST_REVIEW_USER_TITLE", "Delete the requester's latest pending pull request review"),
 				ReadOnlyHint: ToBoolPtr(false),
 			}),
-			// Ideally, for performance sake this would just accept the pullRequestReviewID. However, we would need to
-			// add a new tool to get that ID for clients that aren't in the same context as the original pending review
-			// creation. So for now, we'll just accept the owner, repo and pull number and assume this is deleting
-			// the latest pending review from a user, since only one can be active at a time.
+			// The pullRequestReviewID returned when creating the pending review is optional, because clients that
+			// aren't in the same context as the original pending review creation won't have it. Without it, we assume
+			// this is deleting the latest pending review from a user, since only one can be active at a time.
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -1475,12 +2711,16 @@ func DeletePendingPullRequestReview(getGQLClient GetGQLClientFn, t translations.
 				mcp.Required(),
 				mcp.Description("Pull request number"),
 			),
+			mcp.WithString("pullRequestReviewID",
+				mcp.Description("The ID of the pending review, as returned by create_pending_pull_request_review. Defaults to the requester's latest review"),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			var params struct {
-				Owner      string
-				Repo       string
-				PullNumber int32
+				Owner               string
+				Repo                string
+				PullNumber          int32
+				PullRequestReviewID *string
 			}
 			if err := mapstructure.Decode(request.Params.Arguments, &params); err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
@@ -1491,57 +2731,9 @@ func DeletePendingPullRequestReview(getGQLClient GetGQLClientFn, t translations.
 				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
 			}
 
-			// First we'll get the current user
-			var getViewerQuery struct {
-				Viewer struct {
-					Login githubv4.String
-				}
-			}
-
-			if err := client.Query(ctx, &getViewerQuery, nil); err != nil {
-				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
-					"failed to get current user",
-					err,
-				), nil
-			}
-
-			var getLatestReviewForViewerQuery struct {
-				Repository struct {
-					PullRequest struct {
-						Reviews struct {
-							Nodes []struct {
-								ID    githubv4.ID
-								State githubv4.PullRequestReviewState
-								URL   githubv4.URI
-							}
-						} `graphql:"reviews(first: 1, author: $author)"`
-					} `graphql:"pullRequest(number: $prNum)"`
-				} `graphql:"repository(owner: $owner, name: $name)"`
-			}
-
-			vars := map[string]any{
-				"author": githubv4.String(getViewerQuery.Viewer.Login),
-				"owner":  githubv4.String(params.Owner),
-				"name":   githubv4.String(params.Repo),
-				"prNum":  githubv4.Int(params.PullNumber),
-			}
-
-			if err := client.Query(context.Background(), &getLatestReviewForViewerQuery, vars); err != nil {
-				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
-					"failed to get latest review for current user",
-					err,
-				), nil
-			}
-
-			// Validate there is one review and the state is pending
-			if len(getLatestReviewForViewerQuery.Repository.PullRequest.Reviews.Nodes) == 0 {
-				return mcp.NewToolResultError("No pending review found for the viewer"), nil
-			}
-
-			review := getLatestReviewForViewerQuery.Repository.PullRequest.Reviews.Nodes[0]
-			if review.State != githubv4.PullRequestReviewStatePending {
-				errText := fmt.Sprintf("The latest review, found at %s is not pending", review.URL)
-				return mcp.NewToolResultError(errText), nil
+			reviewID, errResult := getPendingReviewID(ctx, client, params.Owner, params.Repo, params.PullNumber, params.PullRequestReviewID)
+			if errResult != nil {
+				return errResult, nil
 			}
 
 			// Prepare the mutation
@@ -1557,7 +2749,7 @@ func DeletePendingPullRequestReview(getGQLClient GetGQLClientFn, t translations.
 				ctx,
 				&deletePullRequestReviewMutation,
 				githubv4.DeletePullRequestReviewInput{
-					PullRequestReviewID: &review.ID,
+					PullRequestReviewID: &reviewID,
 				},
 				nil,
 			); err != nil {
@@ -1571,9 +2763,23 @@ func DeletePendingPullRequestReview(getGQLClient GetGQLClientFn, t translations.
 		}
 }
 
+// defaultPullRequestDiffMaxLines is the number of diff lines returned by get_pull_request_diff unless
+// max_lines is given. Full diffs can be hundreds of kilobytes, which is far more than a model can use.
+const defaultPullRequestDiffMaxLines = 20000
+
+// truncateDiff cuts a diff down to its first maxLines lines, noting how much was left out and how
+// to see the rest, so that the model doesn't mistake a truncated diff for the complete change.
+func truncateDiff(diff string, maxLines int) string {
+	lines := strings.Split(strings.TrimSuffix(diff, "\n"), "\n")
+	if len(lines) <= maxLines {
+		return diff
+	}
+	return fmt.Sprintf("%s\n[diff truncated: showing %d of %d lines. Use get_pull_request_files to inspect the changes file by file.]\n", strings.Join(lines[:maxLines], "\n"), maxLines, len(lines))
+}
+
 func GetPullRequestDiff(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
 	return mcp.NewTool("get_pull_request_diff",
-			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_DIFF_DESCRIPTION", "Get the diff of a pull request.")),
+			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_DIFF_DESCRIPTION", "Get the unified diff of a pull request as plain text. Large diffs are truncated to max_lines; use get_pull_request_files to inspect the changes file by file instead.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_GET_PULL_REQUEST_DIFF_USER_TITLE", "Get pull request diff"),
 				ReadOnlyHint: ToBoolPtr(true),
@@ -1590,6 +2796,10 @@ func GetPullRequestDiff(getClient GetClientFn, t translations.TranslationHelperF
 				mcp.Required(),
 				mcp.Description("Pull request number"),
 			),
+			mcp.WithNumber("max_lines",
+				mcp.Description(fmt.Sprintf("Maximum number of lines of the diff to return (default: %d)", defaultPullRequestDiffMaxLines)),
+				mcp.Min(1),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			var params struct {
@@ -1600,6 +2810,13 @@ func GetPullRequestDiff(getClient GetClientFn, t translations.TranslationHelperF
 			if err := mapstructure.Decode(request.Params.Arguments, &params); err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			maxLines, err := OptionalIntParamWithDefault(request, "max_lines", defaultPullRequestDiffMaxLines)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if maxLines < 1 {
+				return mcp.NewToolResultError("max_lines must be at least 1"), nil
+			}
 
 			client, err := getClient(ctx)
 			if err != nil {
@@ -1631,8 +2848,7 @@ func GetPullRequestDiff(getClient GetClientFn, t translations.TranslationHelperF
 
 			defer func() { _ = resp.Body.Close() }()
 
-			// Return the raw response
-			return mcp.NewToolResultText(string(raw)), nil
+			return mcp.NewToolResultText(truncateDiff(string(raw), maxLines)), nil
 		}
 }
 
@@ -1712,6 +2928,255 @@ func RequestCopilotReview(getClient GetClientFn, t translations.TranslationHelpe
 		}
 }
 
+// RerequestPullRequestReview creates a tool to request another review from reviewers of a pull request.
+func RerequestPullRequestReview(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("rerequest_pull_request_review",
+			mcp.WithDescription(t("TOOL_REREQUEST_PULL_REQUEST_REVIEW_DESCRIPTION", "Re-request a review from users or teams, for example after pushing changes that address their feedback. Warns about users who haven't reviewed the pull request before, but still requests their review. Returns the pull request's requested reviewers and teams.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_REREQUEST_PULL_REQUEST_REVIEW_USER_TITLE", "Re-request pull request review"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithArray("reviewers",
+				mcp.Description("Logins of the users to re-request a review from"),
+				mcp.Items(
+					map[string]any{
+						"type": "string",
+					},
+				),
+			),
+			mcp.WithArray("team_reviewers",
+				mcp.Description("Slugs of the teams to re-request a review from"),
+				mcp.Items(
+					map[string]any{
+						"type": "string",
+					},
+				),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			reviewers, err := OptionalStringArrayParam(request, "reviewers")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			teamReviewers, err := OptionalStringArrayParam(request, "team_reviewers")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(reviewers) == 0 && len(teamReviewers) == 0 {
+				return mcp.NewToolResultError("at least one of reviewers or team_reviewers must be given"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// Requesting a review from someone who never reviewed is still useful, so it only produces a warning.
+			warnings := []string{}
+			if len(reviewers) > 0 {
+				reviews, resp, err := fetchAllPages(ctx, 1, DefaultFetchAllMaxItems, func(page int) ([]*github.PullRequestReview, *github.Response, error) {
+					return client.PullRequests.ListReviews(ctx, owner, repo, pullNumber, &github.ListOptions{Page: page, PerPage: 100})
+				})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to get pull request reviews",
+						resp,
+						err,
+					), nil
+				}
+				reviewed := map[string]bool{}
+				for _, review := range reviews.Items {
+					reviewed[strings.ToLower(review.GetUser().GetLogin())] = true
+				}
+				for _, reviewer := range reviewers {
+					if !reviewed[strings.ToLower(reviewer)] {
+						warnings = append(warnings, fmt.Sprintf("%s has not reviewed this pull request before, their review was requested for the first time", reviewer))
+					}
+				}
+			}
+
+			pr, resp, err := client.PullRequests.RequestReviewers(ctx, owner, repo, pullNumber, github.ReviewersRequest{
+				Reviewers:     reviewers,
+				TeamReviewers: teamReviewers,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to request reviewers",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to request reviewers: %s", string(body))), nil
+			}
+
+			requestedReviewers := make([]string, 0, len(pr.RequestedReviewers))
+			for _, user := range pr.RequestedReviewers {
+				requestedReviewers = append(requestedReviewers, user.GetLogin())
+			}
+			requestedTeams := make([]string, 0, len(pr.RequestedTeams))
+			for _, team := range pr.RequestedTeams {
+				requestedTeams = append(requestedTeams, team.GetSlug())
+			}
+
+			result := map[string]any{
+				"requested_reviewers": requestedReviewers,
+				"requested_teams":     requestedTeams,
+			}
+			if len(warnings) > 0 {
+				result["warnings"] = warnings
+			}
+			return MarshalledTextResult(result), nil
+		}
+}
+
+// SetPullRequestDraft creates a tool to convert a pull request to a draft or mark it as ready for review.
+func SetPullRequestDraft(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("set_pull_request_draft",
+			mcp.WithDescription(t("TOOL_SET_PULL_REQUEST_DRAFT_DESCRIPTION", "Convert a pull request to a draft, or mark a draft pull request as ready for review. Succeeds without changes if the pull request is already in the requested state. Returns the pull request's new isDraft value.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:          t("TOOL_SET_PULL_REQUEST_DRAFT_USER_TITLE", "Set pull request draft state"),
+				ReadOnlyHint:   ToBoolPtr(false),
+				IdempotentHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithBoolean("draft",
+				mcp.Required(),
+				mcp.Description("true to convert the pull request to a draft, false to mark it as ready for review"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				Owner      string
+				Repo       string
+				PullNumber int32
+				Draft      *bool
+			}
+			if err := mapstructure.Decode(request.Params.Arguments, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if params.Draft == nil {
+				return mcp.NewToolResultError("missing required parameter: draft"), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			var getPullRequestQuery struct {
+				Repository struct {
+					PullRequest struct {
+						ID      githubv4.ID
+						IsDraft githubv4.Boolean
+					} `graphql:"pullRequest(number: $prNum)"`
+				} `graphql:"repository(owner: $owner, name: $repo)"`
+			}
+			if err := client.Query(ctx, &getPullRequestQuery, map[string]any{
+				"owner": githubv4.String(params.Owner),
+				"repo":  githubv4.String(params.Repo),
+				"prNum": githubv4.Int(params.PullNumber),
+			}); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+					"failed to get pull request",
+					err,
+				), nil
+			}
+
+			// The mutations fail if the pull request is already in the requested state, so that case is
+			// reported as a success instead.
+			pullRequest := getPullRequestQuery.Repository.PullRequest
+			if bool(pullRequest.IsDraft) == *params.Draft {
+				return MarshalledTextResult(map[string]any{
+					"isDraft": *params.Draft,
+					"note":    "pull request was already in the requested state, nothing was changed",
+				}), nil
+			}
+
+			var isDraft githubv4.Boolean
+			if *params.Draft {
+				var convertToDraftMutation struct {
+					ConvertPullRequestToDraft struct {
+						PullRequest struct {
+							IsDraft githubv4.Boolean
+						}
+					} `graphql:"convertPullRequestToDraft(input: $input)"`
+				}
+				if err := client.Mutate(ctx, &convertToDraftMutation, githubv4.ConvertPullRequestToDraftInput{
+					PullRequestID: pullRequest.ID,
+				}, nil); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+						"failed to convert pull request to draft",
+						err,
+					), nil
+				}
+				isDraft = convertToDraftMutation.ConvertPullRequestToDraft.PullRequest.IsDraft
+			} else {
+				var markReadyForReviewMutation struct {
+					MarkPullRequestReadyForReview struct {
+						PullRequest struct {
+							IsDraft githubv4.Boolean
+						}
+					} `graphql:"markPullRequestReadyForReview(input: $input)"`
+				}
+				if err := client.Mutate(ctx, &markReadyForReviewMutation, githubv4.MarkPullRequestReadyForReviewInput{
+					PullRequestID: pullRequest.ID,
+				}, nil); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+						"failed to mark pull request as ready for review",
+						err,
+					), nil
+				}
+				isDraft = markReadyForReviewMutation.MarkPullRequestReadyForReview.PullRequest.IsDraft
+			}
+
+			return MarshalledTextResult(map[string]any{
+				"isDraft": bool(isDraft),
+			}), nil
+		}
+}
+
 // newGQLString like takes something that approximates a string (of which there are many types in shurcooL/githubv4)
 // and constructs a pointer to it, or nil if the string is empty. This is extremely useful because when we parse
 // params from the MCP request, we need to convert them to types that are pointers of type def strings and it's
@@ -1739,3 +3204,70 @@ func newGQLIntPtr(i *int32) *githubv4.Int {
 	gi := githubv4.Int(*i)
 	return &gi
 }
+
+// PullRequestDescriptionPrompt provides a guided session for writing the description of a pull
+// request from a pushed branch, where the pull request is only opened after the user has confirmed it.
+func PullRequestDescriptionPrompt(t translations.TranslationHelperFunc) (tool mcp.Prompt, handler server.PromptHandlerFunc) {
+	return mcp.NewPrompt("PullRequestDescription",
+			mcp.WithPromptDescription(t("PROMPT_PULL_REQUEST_DESCRIPTION_DESCRIPTION", "Write a consistent pull request description for a pushed branch, and open the pull request once the user has confirmed it.")),
+			mcp.WithArgument("repo", mcp.ArgumentDescription("The repository the pull request is opened in (owner/repo)."), mcp.RequiredArgument()),
+			mcp.WithArgument("head", mcp.ArgumentDescription("The branch containing the changes."), mcp.RequiredArgument()),
+			mcp.WithArgument("base", mcp.ArgumentDescription("The branch the pull request merges into (default: the repository's default branch).")),
+			mcp.WithArgument("issue_number", mcp.ArgumentDescription("The number of the issue the pull request closes.")),
+		), func(_ context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+			repo := request.Params.Arguments["repo"]
+			if err := validatePromptRepo(repo); err != nil {
+				return nil, err
+			}
+			head := request.Params.Arguments["head"]
+			if head == "" {
+				return nil, fmt.Errorf("missing required argument: head")
+			}
+
+			// compare_refs needs the base branch by name, so without one the model looks up the default branch first.
+			base := request.Params.Arguments["base"]
+			compareRequest := fmt.Sprintf("Please inspect the changes on the %[1]s branch of the %[2]s GitHub repository. Use `compare_refs` with base `%[3]s`, head `%[1]s` and include_files set to true to get the commits and the diff of %[3]s...%[1]s, the changes the pull request would merge.", head, repo, base)
+			if base == "" {
+				compareRequest = fmt.Sprintf("Please inspect the changes on the %[1]s branch of the %[2]s GitHub repository. Use `list_branches` to find the default branch of the repository, then `compare_refs` with that branch as base, head `%[1]s` and include_files set to true to get the commits and the diff of base...%[1]s, the changes the pull request would merge.", head, repo)
+			}
+
+			issueInstructions := "If the commits or branch name mention an issue the changes resolve, ask me whether to reference it with a closing keyword such as `Closes #123`."
+			if value := request.Params.Arguments["issue_number"]; value != "" {
+				issueNumber, err := strconv.Atoi(value)
+				if err != nil || issueNumber < 1 {
+					return nil, fmt.Errorf("invalid issue_number %q: must be a positive integer", value)
+				}
+				issueInstructions = fmt.Sprintf("Read issue #%[1]d with `get_issue` to understand what the changes are for, and end the description with `Closes #%[1]d` so the issue is closed when the pull request is merged.", issueNumber)
+			}
+
+			messages := []mcp.PromptMessage{
+				{
+					Role:    "system",
+					Content: mcp.NewTextContent("You are an assistant that writes pull request descriptions for GitHub repositories. You can use the `compare_refs` tool to inspect the changes on a branch against its base, `get_commit` to read a single commit, `list_branches` to find the default branch, `get_pull_request_diff` and `get_pull_request_files` to inspect an existing pull request, `get_issue` to read linked issues, and `create_pull_request` to open the pull request. You must never open a pull request until the user has explicitly confirmed the title and description."),
+				},
+				{
+					Role:    "user",
+					Content: mcp.NewTextContent(compareRequest),
+				},
+				{
+					Role:    "assistant",
+					Content: mcp.NewTextContent(fmt.Sprintf("Sure! I will review the commits and diffs on the %s branch of %s.", head, repo)),
+				},
+				{
+					Role:    "user",
+					Content: mcp.NewTextContent("Write a title and a markdown description with a `## Summary` section explaining what changed and why, a `## Breaking changes` section listing anything that changes existing behavior (or stating there are none), and a `## Testing` section describing how the changes were tested. " + issueInstructions),
+				},
+				{
+					Role:    "assistant",
+					Content: mcp.NewTextContent("Certainly! I will draft the title and description and show them to you before opening the pull request."),
+				},
+				{
+					Role:    "user",
+					Content: mcp.NewTextContent("Great. Do not invent test results: only describe tests that the commits or I have shown were actually run, and otherwise say what still needs to be tested. Only after I confirm the draft, open the pull request with `create_pull_request`, using the same base branch you compared against."),
+				},
+			}
+			return &mcp.GetPromptResult{
+				Messages: messages,
+			}, nil
+		}
+}
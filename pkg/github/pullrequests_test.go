@@ -3,14 +3,17 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"testing"
 	"time"
 
 	"github.com/github/github-mcp-server/internal/githubv4mock"
 	"github.com/github/github-mcp-server/internal/toolsnaps"
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v73/github"
+	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/shurcooL/githubv4"
 
 	"github.com/migueleliasweb/go-github-mock/src/mock"
@@ -228,6 +231,47 @@ func Test_UpdatePullRequest(t *testing.T) {
 			expectError: false,
 			expectedPR:  mockClosedPR,
 		},
+		{
+			name: "successful PR update (clear body)",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposPullsByOwnerByRepoByPullNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"body": "",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockClosedPR),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"body":       "",
+			},
+			expectError: false,
+			expectedPR:  mockClosedPR,
+		},
+		{
+			name: "PR update fails (nonexistent base branch)",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposPullsByOwnerByRepoByPullNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						_, _ = w.Write([]byte(`{"message": "Validation Failed", "errors": [{"resource": "PullRequest", "field": "base", "code": "invalid"}]}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"base":       "does-not-exist",
+			},
+			expectError:    true,
+			expectedErrMsg: `base branch "does-not-exist" can't be used in owner/repo, check that the branch exists`,
+		},
 		{
 			name:         "no update parameters provided",
 			mockedClient: mock.NewMockedHTTPClient(), // No API call expected
@@ -314,6 +358,232 @@ func Test_UpdatePullRequest(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("invalid base branch is recorded in the context", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.PatchReposPullsByOwnerByRepoByPullNumber,
+				mockResponse(t, http.StatusUnprocessableEntity, `{"message": "Validation Failed", "errors": [{"resource": "PullRequest", "field": "base", "code": "invalid"}]}`),
+			),
+		))
+		_, handler := UpdatePullRequest(stubGetClientFn(client), translations.NullTranslationHelper)
+		ctx := ghErrors.ContextWithGitHubErrors(context.Background())
+
+		result, err := handler(ctx, createMCPRequest(map[string]interface{}{
+			"owner":      "owner",
+			"repo":       "repo",
+			"pullNumber": float64(42),
+			"base":       "does-not-exist",
+		}))
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+
+		apiErrors, err := ghErrors.GetGitHubAPIErrors(ctx)
+		require.NoError(t, err)
+		require.Len(t, apiErrors, 1)
+		assert.Equal(t, http.StatusUnprocessableEntity, apiErrors[0].Response.StatusCode)
+	})
+}
+
+func Test_ClosePullRequest(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ClosePullRequest(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "close_pull_request", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "delete_branch")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	baseRepo := &github.Repository{
+		FullName:      github.Ptr("owner/repo"),
+		DefaultBranch: github.Ptr("main"),
+	}
+	newPR := func(headRef string, headRepo *github.Repository) *github.PullRequest {
+		return &github.PullRequest{
+			Number:  github.Ptr(42),
+			State:   github.Ptr("open"),
+			HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42"),
+			Head:    &github.PullRequestBranch{Ref: github.Ptr(headRef), Repo: headRepo},
+			Base:    &github.PullRequestBranch{Ref: github.Ptr("main"), Repo: baseRepo},
+		}
+	}
+	closedPR := &github.PullRequest{
+		Number:  github.Ptr(42),
+		State:   github.Ptr("closed"),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42"),
+	}
+	closeHandler := expectRequestBody(t, map[string]interface{}{
+		"state": "closed",
+	}).andThen(
+		mockResponse(t, http.StatusOK, closedPR),
+	)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult map[string]any
+		expectedErrMsg string
+	}{
+		{
+			name: "close without deleting the branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					newPR("feature", baseRepo),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposPullsByOwnerByRepoByPullNumber,
+					closeHandler,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectedResult: map[string]any{
+				"closed":         true,
+				"html_url":       "https://github.com/owner/repo/pull/42",
+				"branch_deleted": false,
+			},
+		},
+		{
+			name: "close and delete the branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					newPR("feature", baseRepo),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposPullsByOwnerByRepoByPullNumber,
+					closeHandler,
+				),
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposGitRefsByOwnerByRepoByRef,
+					expectPath(t, "/repos/owner/repo/git/refs/heads/feature").andThen(
+						mockResponse(t, http.StatusNoContent, nil),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"pullNumber":    float64(42),
+				"delete_branch": true,
+			},
+			expectedResult: map[string]any{
+				"closed":         true,
+				"html_url":       "https://github.com/owner/repo/pull/42",
+				"branch_deleted": true,
+				"branch":         "feature",
+			},
+		},
+		{
+			name: "branches in forks are not deleted",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					newPR("feature", &github.Repository{FullName: github.Ptr("contributor/repo")}),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposPullsByOwnerByRepoByPullNumber,
+					closeHandler,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"pullNumber":    float64(42),
+				"delete_branch": true,
+			},
+			expectedResult: map[string]any{
+				"closed":         true,
+				"html_url":       "https://github.com/owner/repo/pull/42",
+				"branch_deleted": false,
+				"reason":         "the head branch is in a fork, which is left untouched",
+			},
+		},
+		{
+			name: "default branch is never deleted",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					newPR("main", baseRepo),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"pullNumber":    float64(42),
+				"delete_branch": true,
+			},
+			expectError:    true,
+			expectedErrMsg: "refusing to delete main, which is the default branch of owner/repo",
+		},
+		{
+			name: "close fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					newPR("feature", baseRepo),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposPullsByOwnerByRepoByPullNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Must have admin rights to Repository."}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to close pull request",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ClosePullRequest(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.NoError(t, err)
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			var returned map[string]any
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
 }
 
 func Test_ListPullRequests(t *testing.T) {
@@ -464,6 +734,10 @@ func Test_MergePullRequest(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "commit_title")
 	assert.Contains(t, tool.InputSchema.Properties, "commit_message")
 	assert.Contains(t, tool.InputSchema.Properties, "merge_method")
+	assert.Contains(t, tool.InputSchema.Properties, "sha")
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.False(t, *tool.Annotations.DestructiveHint)
+	assert.False(t, *tool.Annotations.IdempotentHint)
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
 
 	// Setup mock merge result for success case
@@ -506,6 +780,27 @@ func Test_MergePullRequest(t *testing.T) {
 			expectError:         false,
 			expectedMergeResult: mockMergeResult,
 		},
+		{
+			name: "successful merge guarded by sha",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposPullsMergeByOwnerByRepoByPullNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"sha": "abcd1234",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockMergeResult),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"sha":        "abcd1234",
+			},
+			expectError:         false,
+			expectedMergeResult: mockMergeResult,
+		},
 		{
 			name: "merge fails",
 			mockedClient: mock.NewMockedHTTPClient(
@@ -523,7 +818,27 @@ func Test_MergePullRequest(t *testing.T) {
 				"pullNumber": float64(42),
 			},
 			expectError:    true,
-			expectedErrMsg: "failed to merge pull request",
+			expectedErrMsg: "failed to merge pull request: Pull request cannot be merged",
+		},
+		{
+			name: "merge fails because the head moved",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposPullsMergeByOwnerByRepoByPullNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusConflict)
+						_, _ = w.Write([]byte(`{"message": "Head branch was modified. Review and try the merge again."}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"sha":        "abcd1234",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to merge pull request: Head branch was modified. Review and try the merge again.",
 		},
 	}
 
@@ -579,6 +894,8 @@ func Test_SearchPullRequests(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "order")
 	assert.Contains(t, tool.InputSchema.Properties, "perPage")
 	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "fields")
+	assert.Contains(t, tool.InputSchema.Properties, "output")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"query"})
 
 	mockSearchResult := &github.IssuesSearchResult{
@@ -677,14 +994,14 @@ func Test_SearchPullRequests(t *testing.T) {
 			expectedResult: mockSearchResult,
 		},
 		{
-			name: "pull request search with only owner parameter (should ignore it)",
+			name: "pull request search with only owner parameter scopes to the org",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
 					mock.GetSearchIssues,
 					expectQueryParams(
 						t,
 						map[string]string{
-							"q":        "is:pr feature",
+							"q":        "org:test-owner is:pr feature",
 							"page":     "1",
 							"per_page": "30",
 						},
@@ -813,6 +1130,8 @@ func Test_GetPullRequestFiles(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
 	assert.Contains(t, tool.InputSchema.Properties, "page")
 	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.Contains(t, tool.InputSchema.Properties, "include_patch")
+	assert.Contains(t, tool.InputSchema.Properties, "max_patch_bytes")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
 
 	// Setup mock PR files for success case
@@ -842,6 +1161,8 @@ func Test_GetPullRequestFiles(t *testing.T) {
 		expectError    bool
 		expectedFiles  []*github.CommitFile
 		expectedErrMsg string
+		// expectedPullRequestFiles, when set, is compared with the whole result.
+		expectedPullRequestFiles []PullRequestFile
 	}{
 		{
 			name: "successful files fetch",
@@ -877,6 +1198,81 @@ func Test_GetPullRequestFiles(t *testing.T) {
 			expectError:   false,
 			expectedFiles: mockFiles,
 		},
+		{
+			name: "patches are not included by default",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsFilesByOwnerByRepoByPullNumber,
+					mockFiles,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectError: false,
+			expectedPullRequestFiles: []PullRequestFile{
+				{Filename: "file1.go", Status: "modified", Additions: 10, Deletions: 5, Changes: 15},
+				{Filename: "file2.go", Status: "added", Additions: 20, Changes: 20},
+			},
+		},
+		{
+			name: "patches are included, truncated or marked as omitted",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsFilesByOwnerByRepoByPullNumber,
+					[]*github.CommitFile{
+						{
+							Filename:  github.Ptr("file1.go"),
+							Status:    github.Ptr("modified"),
+							Additions: github.Ptr(2),
+							Changes:   github.Ptr(2),
+							Patch:     github.Ptr("@@ -1 +1,2 @@\n+one"),
+						},
+						{
+							Filename:  github.Ptr("package-lock.json"),
+							Status:    github.Ptr("modified"),
+							Additions: github.Ptr(3),
+							Changes:   github.Ptr(3),
+							Patch:     github.Ptr("@@ -1 +1,3 @@\n+first\n+second\n+third"),
+						},
+						{
+							Filename:         github.Ptr("logo.png"),
+							PreviousFilename: github.Ptr("old-logo.png"),
+							Status:           github.Ptr("renamed"),
+							Changes:          github.Ptr(1),
+						},
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"pullNumber":      float64(42),
+				"include_patch":   true,
+				"max_patch_bytes": float64(28),
+			},
+			expectError: false,
+			expectedPullRequestFiles: []PullRequestFile{
+				{Filename: "file1.go", Status: "modified", Additions: 2, Changes: 2, Patch: "@@ -1 +1,2 @@\n+one"},
+				{Filename: "package-lock.json", Status: "modified", Additions: 3, Changes: 3, Patch: "@@ -1 +1,3 @@\n+first", PatchTruncated: true},
+				{Filename: "logo.png", PreviousFilename: "old-logo.png", Status: "renamed", Changes: 1, PatchOmitted: true},
+			},
+		},
+		{
+			name:         "negative max_patch_bytes",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"pullNumber":      float64(42),
+				"include_patch":   true,
+				"max_patch_bytes": float64(-1),
+			},
+			expectError:    true,
+			expectedErrMsg: "max_patch_bytes must be at least 1",
+		},
 		{
 			name: "files fetch fails",
 			mockedClient: mock.NewMockedHTTPClient(
@@ -925,6 +1321,13 @@ func Test_GetPullRequestFiles(t *testing.T) {
 			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
+			if tc.expectedPullRequestFiles != nil {
+				var returned []PullRequestFile
+				require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+				assert.Equal(t, tc.expectedPullRequestFiles, returned)
+				return
+			}
+
 			// Unmarshal and verify the result
 			var returnedFiles []*github.CommitFile
 			err = json.Unmarshal([]byte(textContent.Text), &returnedFiles)
@@ -940,28 +1343,226 @@ func Test_GetPullRequestFiles(t *testing.T) {
 	}
 }
 
-func Test_GetPullRequestStatus(t *testing.T) {
+func Test_GetPullRequestDiffstat(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := GetPullRequestStatus(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := GetPullRequestDiffstat(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 	require.NoError(t, toolsnaps.Test(tool.Name, tool))
 
-	assert.Equal(t, "get_pull_request_status", tool.Name)
+	assert.Equal(t, "get_pull_request_diffstat", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "top_n")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
 
-	// Setup mock PR for successful PR fetch
-	mockPR := &github.PullRequest{
-		Number:  github.Ptr(42),
+	mockFiles := []*github.CommitFile{
+		{
+			Filename:  github.Ptr("pkg/github/tools.go"),
+			Status:    github.Ptr("modified"),
+			Additions: github.Ptr(10),
+			Deletions: github.Ptr(5),
+			Changes:   github.Ptr(15),
+			Patch:     github.Ptr("@@ -1,5 +1,10 @@"),
+		},
+		{
+			Filename:  github.Ptr("pkg/github/server.go"),
+			Status:    github.Ptr("added"),
+			Additions: github.Ptr(40),
+			Changes:   github.Ptr(40),
+			Patch:     github.Ptr("@@ -0,0 +1,40 @@"),
+		},
+		{
+			Filename:         github.Ptr("docs/Guide.MD"),
+			PreviousFilename: github.Ptr("docs/old-guide.md"),
+			Status:           github.Ptr("renamed"),
+		},
+		{
+			Filename: github.Ptr("docs/logo.png"),
+			Status:   github.Ptr("added"),
+		},
+		{
+			Filename:  github.Ptr(".gitignore"),
+			Status:    github.Ptr("removed"),
+			Deletions: github.Ptr(3),
+			Changes:   github.Ptr(3),
+			Patch:     github.Ptr("@@ -1,3 +0,0 @@"),
+		},
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectError      bool
+		expectedErrMsg   string
+		expectedDiffstat PullRequestDiffstat
+	}{
+		{
+			name: "aggregates files by directory and extension",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsFilesByOwnerByRepoByPullNumber,
+					expectQueryParams(t, map[string]string{
+						"page":     "1",
+						"per_page": "100",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockFiles),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectedDiffstat: PullRequestDiffstat{
+				Files:     5,
+				Additions: 50,
+				Deletions: 8,
+				Changes:   58,
+				ByStatus:  map[string]int{"modified": 1, "added": 2, "renamed": 1, "removed": 1},
+				ByDirectory: []DiffstatGroup{
+					{Name: "pkg", Files: 2, Additions: 50, Deletions: 5, Changes: 55},
+					{Name: "(root)", Files: 1, Deletions: 3, Changes: 3},
+					{Name: "docs", Files: 2},
+				},
+				ByExtension: []DiffstatGroup{
+					{Name: ".go", Files: 2, Additions: 50, Deletions: 5, Changes: 55},
+					{Name: "(none)", Files: 1, Deletions: 3, Changes: 3},
+					{Name: ".md", Files: 1},
+					{Name: ".png", Files: 1},
+				},
+				LargestFiles: []DiffstatFile{
+					{Filename: "pkg/github/server.go", Status: "added", Additions: 40, Changes: 40},
+					{Filename: "pkg/github/tools.go", Status: "modified", Additions: 10, Deletions: 5, Changes: 15},
+					{Filename: ".gitignore", Status: "removed", Deletions: 3, Changes: 3},
+					{Filename: "docs/Guide.MD", Status: "renamed", PreviousFilename: "docs/old-guide.md"},
+					{Filename: "docs/logo.png", Status: "added", Binary: true},
+				},
+			},
+		},
+		{
+			name: "top_n limits the largest files",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsFilesByOwnerByRepoByPullNumber,
+					mockFiles,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"top_n":      float64(1),
+			},
+			expectedDiffstat: PullRequestDiffstat{
+				Files:     5,
+				Additions: 50,
+				Deletions: 8,
+				Changes:   58,
+				ByStatus:  map[string]int{"modified": 1, "added": 2, "renamed": 1, "removed": 1},
+				ByDirectory: []DiffstatGroup{
+					{Name: "pkg", Files: 2, Additions: 50, Deletions: 5, Changes: 55},
+					{Name: "(root)", Files: 1, Deletions: 3, Changes: 3},
+					{Name: "docs", Files: 2},
+				},
+				ByExtension: []DiffstatGroup{
+					{Name: ".go", Files: 2, Additions: 50, Deletions: 5, Changes: 55},
+					{Name: "(none)", Files: 1, Deletions: 3, Changes: 3},
+					{Name: ".md", Files: 1},
+					{Name: ".png", Files: 1},
+				},
+				LargestFiles: []DiffstatFile{
+					{Filename: "pkg/github/server.go", Status: "added", Additions: 40, Changes: 40},
+				},
+			},
+		},
+		{
+			name:         "negative top_n",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"top_n":      float64(-1),
+			},
+			expectError:    true,
+			expectedErrMsg: "top_n must not be negative",
+		},
+		{
+			name: "files fetch fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsFilesByOwnerByRepoByPullNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(999),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get pull request files",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetPullRequestDiffstat(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError)
+
+			textContent := getTextResult(t, result)
+			var returned PullRequestDiffstat
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedDiffstat, returned)
+		})
+	}
+}
+
+func Test_GetPullRequestStatus(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetPullRequestStatus(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_pull_request_status", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "required_only")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	// Setup mock PR for successful PR fetch
+	mockPR := &github.PullRequest{
+		Number:  github.Ptr(42),
 		Title:   github.Ptr("Test PR"),
 		HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42"),
 		Head: &github.PullRequestBranch{
 			SHA: github.Ptr("abcd1234"),
 			Ref: github.Ptr("feature-branch"),
 		},
+		Base: &github.PullRequestBranch{
+			Ref: github.Ptr("main"),
+		},
 	}
 
 	// Setup mock status for success case
@@ -990,39 +1591,984 @@ func Test_GetPullRequestStatus(t *testing.T) {
 		},
 	}
 
+	// Setup mock check runs, one of which has failed and one of which is still running
+	mockCheckRuns := &github.ListCheckRunsResults{
+		Total: github.Ptr(3),
+		CheckRuns: []*github.CheckRun{
+			{
+				Name:       github.Ptr("build"),
+				Status:     github.Ptr("completed"),
+				Conclusion: github.Ptr("success"),
+				DetailsURL: github.Ptr("https://github.com/owner/repo/runs/1"),
+			},
+			{
+				Name:       github.Ptr("test"),
+				Status:     github.Ptr("completed"),
+				Conclusion: github.Ptr("failure"),
+				DetailsURL: github.Ptr("https://github.com/owner/repo/runs/2"),
+			},
+			{
+				Name:       github.Ptr("e2e"),
+				Status:     github.Ptr("in_progress"),
+				DetailsURL: github.Ptr("https://github.com/owner/repo/runs/3"),
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedStatus PullRequestStatus
+		expectedErrMsg string
+	}{
+		{
+			name: "successful status fetch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposCommitsStatusByOwnerByRepoByRef,
+					mockStatus,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsCheckRunsByOwnerByRepoByRef,
+					expectQueryParams(t, map[string]string{
+						"filter":   "latest",
+						"page":     "1",
+						"per_page": "100",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockCheckRuns),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectError: false,
+			expectedStatus: PullRequestStatus{
+				HeadSHA:    "abcd1234",
+				State:      "failure",
+				TotalCount: 6,
+				Counts:     map[string]int{"success": 4, "failure": 1, "pending": 1},
+				Failing: []PullRequestCheck{
+					{Name: "test", Conclusion: "failure", DetailsURL: "https://github.com/owner/repo/runs/2"},
+				},
+			},
+		},
+		{
+			name: "only required checks",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposCommitsStatusByOwnerByRepoByRef,
+					mockStatus,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposCommitsCheckRunsByOwnerByRepoByRef,
+					mockCheckRuns,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesProtectionRequiredStatusChecksByOwnerByRepoByBranch,
+					expectPath(t, "/repos/owner/repo/branches/main/protection/required_status_checks").andThen(
+						mockResponse(t, http.StatusOK, &github.RequiredStatusChecks{
+							Checks: &[]*github.RequiredStatusCheck{
+								{Context: "build"},
+								{Context: "codecov/patch"},
+								{Context: "deploy-preview"},
+							},
+						}),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposRulesBranchesByOwnerByRepoByBranch,
+					expectPath(t, "/repos/owner/repo/rules/branches/main").andThen(
+						mockResponse(t, http.StatusOK, []map[string]any{
+							{
+								"type":                "required_status_checks",
+								"ruleset_source_type": "Repository",
+								"ruleset_source":      "owner/repo",
+								"ruleset_id":          1,
+								"parameters": map[string]any{
+									"strict_required_status_checks_policy": false,
+									"required_status_checks": []map[string]any{
+										{"context": "build"},
+										{"context": "e2e"},
+									},
+								},
+							},
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"pullNumber":    float64(42),
+				"required_only": true,
+			},
+			expectError: false,
+			expectedStatus: PullRequestStatus{
+				HeadSHA:         "abcd1234",
+				State:           "pending",
+				TotalCount:      3,
+				Counts:          map[string]int{"success": 2, "pending": 1},
+				Failing:         []PullRequestCheck{},
+				MissingRequired: []string{"deploy-preview"},
+			},
+		},
+		{
+			name: "only required checks on an unprotected branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposCommitsStatusByOwnerByRepoByRef,
+					mockStatus,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposCommitsCheckRunsByOwnerByRepoByRef,
+					mockCheckRuns,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesProtectionRequiredStatusChecksByOwnerByRepoByBranch,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Branch not protected"}`))
+					}),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposRulesBranchesByOwnerByRepoByBranch,
+					[]map[string]any{},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"pullNumber":    float64(42),
+				"required_only": true,
+			},
+			expectError: false,
+			expectedStatus: PullRequestStatus{
+				HeadSHA: "abcd1234",
+				State:   "success",
+				Counts:  map[string]int{},
+				Failing: []PullRequestCheck{},
+			},
+		},
+		{
+			name: "only checks required by rulesets when rulesets are unavailable",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposCommitsStatusByOwnerByRepoByRef,
+					mockStatus,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposCommitsCheckRunsByOwnerByRepoByRef,
+					mockCheckRuns,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposBranchesProtectionRequiredStatusChecksByOwnerByRepoByBranch,
+					&github.RequiredStatusChecks{Contexts: &[]string{"build"}},
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposRulesBranchesByOwnerByRepoByBranch,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"pullNumber":    float64(42),
+				"required_only": true,
+			},
+			expectedStatus: PullRequestStatus{
+				HeadSHA:    "abcd1234",
+				State:      "success",
+				TotalCount: 1,
+				Counts:     map[string]int{"success": 1},
+				Failing:    []PullRequestCheck{},
+			},
+		},
+		{
+			name: "too many checks to read is not reported as a success",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposCommitsStatusByOwnerByRepoByRef,
+					&github.CombinedStatus{
+						State:      github.Ptr("success"),
+						TotalCount: github.Ptr(150),
+						Statuses:   mockStatus.Statuses,
+					},
+				),
+				mock.WithRequestMatch(
+					mock.GetReposCommitsCheckRunsByOwnerByRepoByRef,
+					&github.ListCheckRunsResults{Total: github.Ptr(0), CheckRuns: []*github.CheckRun{}},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectedStatus: PullRequestStatus{
+				HeadSHA:    "abcd1234",
+				State:      "unknown",
+				TotalCount: 3,
+				Counts:     map[string]int{"success": 3},
+				Failing:    []PullRequestCheck{},
+				Truncated:  true,
+			},
+		},
+		{
+			name: "PR fetch fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(999),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get pull request",
+		},
+		{
+			name: "status fetch fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsStatusesByOwnerByRepoByRef,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get combined status",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetPullRequestStatus(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.NoError(t, err)
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedStatus PullRequestStatus
+			err = json.Unmarshal([]byte(textContent.Text), &returnedStatus)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, returnedStatus)
+		})
+	}
+}
+
+func Test_GetPullRequestConflicts(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetPullRequestConflicts(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_pull_request_conflicts", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	// Retry quickly so that waiting for mergeability doesn't slow the tests down.
+	backoff := pullRequestMergeabilityBackoff
+	pullRequestMergeabilityBackoff = time.Millisecond
+	t.Cleanup(func() { pullRequestMergeabilityBackoff = backoff })
+
+	newPR := func(mergeable *bool, mergeableState string) *github.PullRequest {
+		return &github.PullRequest{
+			Number:         github.Ptr(42),
+			Mergeable:      mergeable,
+			MergeableState: github.Ptr(mergeableState),
+			Head:           &github.PullRequestBranch{Ref: github.Ptr("feature"), SHA: github.Ptr("headsha")},
+			Base:           &github.PullRequestBranch{Ref: github.Ptr("main"), SHA: github.Ptr("basesha")},
+		}
+	}
+	headComparison := &github.CommitsComparison{
+		AheadBy:         github.Ptr(2),
+		BehindBy:        github.Ptr(5),
+		MergeBaseCommit: &github.RepositoryCommit{SHA: github.Ptr("mergebase")},
+		Files: []*github.CommitFile{
+			{Filename: github.Ptr("go.mod")},
+			{Filename: github.Ptr("pkg/feature.go")},
+		},
+	}
+	baseComparison := &github.CommitsComparison{
+		Files: []*github.CommitFile{
+			{Filename: github.Ptr("go.mod")},
+			{Filename: github.Ptr("README.md")},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedResult map[string]any
+		expectedErrMsg string
+	}{
+		{
+			name: "conflicting pull request",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					newPR(github.Ptr(false), "dirty"),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						switch r.URL.Path {
+						case "/repos/owner/repo/compare/main...headsha":
+							mockResponse(t, http.StatusOK, headComparison)(w, r)
+						case "/repos/owner/repo/compare/mergebase...main":
+							mockResponse(t, http.StatusOK, baseComparison)(w, r)
+						default:
+							t.Errorf("unexpected comparison %s", r.URL.Path)
+						}
+					}),
+				),
+			),
+			expectedResult: map[string]any{
+				"mergeable":           false,
+				"mergeable_state":     "dirty",
+				"has_conflicts":       true,
+				"ahead_by":            float64(2),
+				"behind_by":           float64(5),
+				"merge_base_sha":      "mergebase",
+				"conflict_candidates": []any{"go.mod"},
+				"truncated":           false,
+			},
+		},
+		{
+			name: "changed files past the compare limit",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					newPR(github.Ptr(false), "dirty"),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						switch r.URL.Path {
+						case "/repos/owner/repo/compare/main...headsha":
+							mockResponse(t, http.StatusOK, headComparison)(w, r)
+						case "/repos/owner/repo/compare/mergebase...main":
+							files := make([]*github.CommitFile, compareFilesLimit)
+							for i := range files {
+								files[i] = &github.CommitFile{Filename: github.Ptr(fmt.Sprintf("docs/page%d.md", i))}
+							}
+							mockResponse(t, http.StatusOK, &github.CommitsComparison{Files: files})(w, r)
+						default:
+							t.Errorf("unexpected comparison %s", r.URL.Path)
+						}
+					}),
+				),
+			),
+			expectedResult: map[string]any{
+				"mergeable":           false,
+				"mergeable_state":     "dirty",
+				"has_conflicts":       true,
+				"ahead_by":            float64(2),
+				"behind_by":           float64(5),
+				"merge_base_sha":      "mergebase",
+				"conflict_candidates": []any{},
+				"truncated":           true,
+			},
+		},
+		{
+			name: "mergeability computed after a retry",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					newPR(nil, "unknown"),
+					newPR(github.Ptr(true), "clean"),
+				),
+			),
+			expectedResult: map[string]any{
+				"mergeable":       true,
+				"mergeable_state": "clean",
+				"has_conflicts":   false,
+			},
+		},
+		{
+			name: "mergeability still unknown",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusOK, newPR(nil, "unknown")),
+				),
+			),
+			expectedResult: map[string]any{
+				"mergeable":       nil,
+				"mergeable_state": "unknown",
+				"note":            "GitHub is still computing whether this pull request is mergeable, try again in a few seconds",
+			},
+		},
+		{
+			name: "PR fetch fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to get pull request",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetPullRequestConflicts(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			}))
+
+			// Verify results
+			if tc.expectError {
+				require.NoError(t, err)
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			var returned map[string]any
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_UpdatePullRequestBranch(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdatePullRequestBranch(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_pull_request_branch", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "expectedHeadSha")
+	assert.Contains(t, tool.InputSchema.Properties, "wait")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	// Setup mock update result for success case
+	mockUpdateResult := &github.PullRequestBranchUpdateResponse{
+		Message: github.Ptr("Branch was updated successfully"),
+		URL:     github.Ptr("https://api.github.com/repos/owner/repo/pulls/42"),
+	}
+
+	// Poll quickly so that waiting for the update doesn't slow the tests down.
+	pollInterval, waitTimeout := updatePullRequestBranchPollInterval, updatePullRequestBranchWaitTimeout
+	updatePullRequestBranchPollInterval, updatePullRequestBranchWaitTimeout = time.Millisecond, 50*time.Millisecond
+	t.Cleanup(func() {
+		updatePullRequestBranchPollInterval, updatePullRequestBranchWaitTimeout = pollInterval, waitTimeout
+	})
+
+	behindPR := &github.PullRequest{
+		Number:         github.Ptr(42),
+		MergeableState: github.Ptr("behind"),
+		Head:           &github.PullRequestBranch{SHA: github.Ptr("abcd1234")},
+	}
+	blockedPR := &github.PullRequest{
+		Number:         github.Ptr(42),
+		MergeableState: github.Ptr("blocked"),
+		Head:           &github.PullRequestBranch{SHA: github.Ptr("abcd1234")},
+	}
+	computingPR := &github.PullRequest{
+		Number:         github.Ptr(42),
+		MergeableState: github.Ptr("unknown"),
+		Head:           &github.PullRequestBranch{SHA: github.Ptr("efgh5678")},
+	}
+	updatedPR := &github.PullRequest{
+		Number:         github.Ptr(42),
+		MergeableState: github.Ptr("clean"),
+		Head:           &github.PullRequestBranch{SHA: github.Ptr("efgh5678")},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "successful branch update",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposPullsUpdateBranchByOwnerByRepoByPullNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"expected_head_sha": "abcd1234",
+					}).andThen(
+						mockResponse(t, http.StatusAccepted, mockUpdateResult),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"pullNumber":      float64(42),
+				"expectedHeadSha": "abcd1234",
+			},
+			expectError:  false,
+			expectedText: "update queued",
+		},
+		{
+			name: "branch update without expected SHA",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposPullsUpdateBranchByOwnerByRepoByPullNumber,
+					expectRequestBody(t, map[string]interface{}{}).andThen(
+						mockResponse(t, http.StatusAccepted, mockUpdateResult),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectError:  false,
+			expectedText: "update queued",
+		},
+		{
+			name: "branch update fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposPullsUpdateBranchByOwnerByRepoByPullNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusConflict)
+						_, _ = w.Write([]byte(`{"message": "Merge conflict"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to update pull request branch",
+		},
+		{
+			name: "waits until the update has been applied",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposPullsUpdateBranchByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusAccepted, mockUpdateResult),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					behindPR,
+					behindPR,
+					computingPR,
+					updatedPR,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"wait":       true,
+			},
+			expectError:  false,
+			expectedText: `{"head_sha":"efgh5678","mergeable_state":"clean","status":"updated"}`,
+		},
+		{
+			name: "waits from the expected head SHA",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposPullsUpdateBranchByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusAccepted, mockUpdateResult),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					updatedPR,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"pullNumber":      float64(42),
+				"expectedHeadSha": "abcd1234",
+				"wait":            true,
+			},
+			expectError:  false,
+			expectedText: `{"head_sha":"efgh5678","mergeable_state":"clean","status":"updated"}`,
+		},
+		{
+			name: "still queued when the wait times out",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposPullsUpdateBranchByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusAccepted, mockUpdateResult),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusOK, behindPR),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"wait":       true,
+			},
+			expectError:  false,
+			expectedText: `{"head_sha":"abcd1234","mergeable_state":"behind","status":"queued"}`,
+		},
+		{
+			name: "a known mergeable state without a new head is still queued",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposPullsUpdateBranchByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusAccepted, mockUpdateResult),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusOK, blockedPR),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"wait":       true,
+			},
+			expectError:  false,
+			expectedText: `{"head_sha":"abcd1234","mergeable_state":"blocked","status":"queued"}`,
+		},
+		{
+			name: "branch conflicts with base",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposPullsUpdateBranchByOwnerByRepoByPullNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						_, _ = w.Write([]byte(`{"message": "merge conflict between base and head"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to update pull request branch: merge conflict between base and head. The branch most likely conflicts with the base branch and needs a manual rebase or merge",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdatePullRequestBranch(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.NoError(t, err)
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			assert.Contains(t, textContent.Text, tc.expectedText)
+		})
+	}
+}
+
+func Test_GetPullRequestComments(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetPullRequestComments(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_pull_request_comments", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	// Setup mock PR comments for success case
+	mockComments := []*github.PullRequestComment{
+		{
+			ID:      github.Ptr(int64(101)),
+			Body:    github.Ptr("This looks good"),
+			HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42#discussion_r101"),
+			User: &github.User{
+				Login: github.Ptr("reviewer1"),
+			},
+			Path:      github.Ptr("file1.go"),
+			Position:  github.Ptr(5),
+			CommitID:  github.Ptr("abcdef123456"),
+			CreatedAt: &github.Timestamp{Time: time.Now().Add(-24 * time.Hour)},
+			UpdatedAt: &github.Timestamp{Time: time.Now().Add(-24 * time.Hour)},
+		},
+		{
+			ID:      github.Ptr(int64(102)),
+			Body:    github.Ptr("Please fix this"),
+			HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42#discussion_r102"),
+			User: &github.User{
+				Login: github.Ptr("reviewer2"),
+			},
+			Path:      github.Ptr("file2.go"),
+			Position:  github.Ptr(10),
+			CommitID:  github.Ptr("abcdef123456"),
+			CreatedAt: &github.Timestamp{Time: time.Now().Add(-12 * time.Hour)},
+			UpdatedAt: &github.Timestamp{Time: time.Now().Add(-12 * time.Hour)},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectError      bool
+		expectedComments []*github.PullRequestComment
+		expectedErrMsg   string
+	}{
+		{
+			name: "successful comments fetch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsCommentsByOwnerByRepoByPullNumber,
+					mockComments,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectError:      false,
+			expectedComments: mockComments,
+		},
+		{
+			name: "comments fetch fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsCommentsByOwnerByRepoByPullNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(999),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get pull request comments",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetPullRequestComments(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.NoError(t, err)
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedComments []*github.PullRequestComment
+			err = json.Unmarshal([]byte(textContent.Text), &returnedComments)
+			require.NoError(t, err)
+			assert.Len(t, returnedComments, len(tc.expectedComments))
+			for i, comment := range returnedComments {
+				assert.Equal(t, *tc.expectedComments[i].ID, *comment.ID)
+				assert.Equal(t, *tc.expectedComments[i].Body, *comment.Body)
+				assert.Equal(t, *tc.expectedComments[i].User.Login, *comment.User.Login)
+				assert.Equal(t, *tc.expectedComments[i].Path, *comment.Path)
+				assert.Equal(t, *tc.expectedComments[i].HTMLURL, *comment.HTMLURL)
+			}
+		})
+	}
+}
+
+func Test_ReplyToPullRequestReviewComment(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ReplyToPullRequestReviewComment(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "reply_to_pull_request_review_comment", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "comment_id")
+	assert.Contains(t, tool.InputSchema.Properties, "body")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber", "comment_id", "body"})
+
+	mockReply := &github.PullRequestComment{
+		ID:        github.Ptr(int64(9876543211)),
+		InReplyTo: github.Ptr(int64(9876543210)),
+		Body:      github.Ptr("Fixed in the latest commit"),
+		HTMLURL:   github.Ptr("https://github.com/owner/repo/pull/42#discussion_r9876543211"),
+		User: &github.User{
+			Login: github.Ptr("author"),
+		},
+	}
+
 	tests := []struct {
 		name           string
 		mockedClient   *http.Client
 		requestArgs    map[string]interface{}
 		expectError    bool
-		expectedStatus *github.CombinedStatus
+		expectedReply  *github.PullRequestComment
 		expectedErrMsg string
 	}{
 		{
-			name: "successful status fetch",
+			name: "successful reply",
 			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatch(
-					mock.GetReposPullsByOwnerByRepoByPullNumber,
-					mockPR,
-				),
-				mock.WithRequestMatch(
-					mock.GetReposCommitsStatusByOwnerByRepoByRef,
-					mockStatus,
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsCommentsByOwnerByRepoByPullNumber,
+					expectPath(
+						t,
+						"/repos/owner/repo/pulls/42/comments",
+					).andThen(
+						expectRequestBody(t, map[string]interface{}{
+							"body":        "Fixed in the latest commit",
+							"in_reply_to": float64(9876543210),
+						}).andThen(
+							mockResponse(t, http.StatusCreated, mockReply),
+						),
+					),
 				),
 			),
 			requestArgs: map[string]interface{}{
 				"owner":      "owner",
 				"repo":       "repo",
 				"pullNumber": float64(42),
+				"comment_id": float64(9876543210),
+				"body":       "Fixed in the latest commit",
 			},
-			expectError:    false,
-			expectedStatus: mockStatus,
+			expectError:   false,
+			expectedReply: mockReply,
 		},
 		{
-			name: "PR fetch fails",
+			name: "comment deleted",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mock.PostReposPullsCommentsByOwnerByRepoByPullNumber,
 					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
 						w.WriteHeader(http.StatusNotFound)
 						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
@@ -1032,33 +2578,25 @@ func Test_GetPullRequestStatus(t *testing.T) {
 			requestArgs: map[string]interface{}{
 				"owner":      "owner",
 				"repo":       "repo",
-				"pullNumber": float64(999),
+				"pullNumber": float64(42),
+				"comment_id": float64(9876543210),
+				"body":       "Fixed in the latest commit",
 			},
 			expectError:    true,
-			expectedErrMsg: "failed to get pull request",
+			expectedErrMsg: "failed to reply to review comment 9876543210: it may have been deleted or its thread resolved",
 		},
 		{
-			name: "status fetch fails",
-			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatch(
-					mock.GetReposPullsByOwnerByRepoByPullNumber,
-					mockPR,
-				),
-				mock.WithRequestMatchHandler(
-					mock.GetReposCommitsStatusesByOwnerByRepoByRef,
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusNotFound)
-						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
-					}),
-				),
-			),
+			name:         "fractional comment ID",
+			mockedClient: mock.NewMockedHTTPClient(),
 			requestArgs: map[string]interface{}{
 				"owner":      "owner",
 				"repo":       "repo",
 				"pullNumber": float64(42),
+				"comment_id": float64(1.5),
+				"body":       "Fixed in the latest commit",
 			},
 			expectError:    true,
-			expectedErrMsg: "failed to get combined status",
+			expectedErrMsg: "parameter comment_id must be a whole number",
 		},
 	}
 
@@ -1066,7 +2604,7 @@ func Test_GetPullRequestStatus(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := GetPullRequestStatus(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := ReplyToPullRequestReviewComment(stubGetClientFn(client), translations.NullTranslationHelper)
 
 			// Create call request
 			request := createMCPRequest(tc.requestArgs)
@@ -1090,106 +2628,177 @@ func Test_GetPullRequestStatus(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedStatus github.CombinedStatus
-			err = json.Unmarshal([]byte(textContent.Text), &returnedStatus)
+			var returnedReply github.PullRequestComment
+			err = json.Unmarshal([]byte(textContent.Text), &returnedReply)
 			require.NoError(t, err)
-			assert.Equal(t, *tc.expectedStatus.State, *returnedStatus.State)
-			assert.Equal(t, *tc.expectedStatus.TotalCount, *returnedStatus.TotalCount)
-			assert.Len(t, returnedStatus.Statuses, len(tc.expectedStatus.Statuses))
-			for i, status := range returnedStatus.Statuses {
-				assert.Equal(t, *tc.expectedStatus.Statuses[i].State, *status.State)
-				assert.Equal(t, *tc.expectedStatus.Statuses[i].Context, *status.Context)
-				assert.Equal(t, *tc.expectedStatus.Statuses[i].Description, *status.Description)
-			}
+			assert.Equal(t, *tc.expectedReply.ID, *returnedReply.ID)
+			assert.Equal(t, *tc.expectedReply.InReplyTo, *returnedReply.InReplyTo)
+			assert.Equal(t, *tc.expectedReply.HTMLURL, *returnedReply.HTMLURL)
 		})
 	}
 }
 
-func Test_UpdatePullRequestBranch(t *testing.T) {
+func Test_ListPullRequestReviewComments(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := UpdatePullRequestBranch(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := ListPullRequestReviewComments(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 	require.NoError(t, toolsnaps.Test(tool.Name, tool))
 
-	assert.Equal(t, "update_pull_request_branch", tool.Name)
+	assert.Equal(t, "list_pull_request_review_comments", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
-	assert.Contains(t, tool.InputSchema.Properties, "expectedHeadSha")
+	assert.Contains(t, tool.InputSchema.Properties, "path")
+	assert.Contains(t, tool.InputSchema.Properties, "author")
+	assert.Contains(t, tool.InputSchema.Properties, "since")
+	assert.Contains(t, tool.InputSchema.Properties, "threaded")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
 
-	// Setup mock update result for success case
-	mockUpdateResult := &github.PullRequestBranchUpdateResponse{
-		Message: github.Ptr("Branch was updated successfully"),
-		URL:     github.Ptr("https://api.github.com/repos/owner/repo/pulls/42"),
+	created := github.Timestamp{Time: time.Date(2024, 3, 14, 9, 0, 0, 0, time.UTC)}
+	newComment := func(id, inReplyTo int64, path, login string) *github.PullRequestComment {
+		comment := &github.PullRequestComment{
+			ID:        github.Ptr(id),
+			Path:      github.Ptr(path),
+			Line:      github.Ptr(10),
+			Side:      github.Ptr("RIGHT"),
+			Body:      github.Ptr(fmt.Sprintf("comment %d", id)),
+			User:      &github.User{Login: github.Ptr(login)},
+			CreatedAt: &created,
+			HTMLURL:   github.Ptr(fmt.Sprintf("https://github.com/owner/repo/pull/42#discussion_r%d", id)),
+		}
+		if inReplyTo != 0 {
+			comment.InReplyTo = github.Ptr(inReplyTo)
+		}
+		return comment
 	}
+	mockComments := []*github.PullRequestComment{
+		newComment(9876543210, 0, "main.go", "alice"),
+		newComment(9876543211, 0, "README.md", "alice"),
+		newComment(9876543212, 9876543210, "main.go", "bob"),
+	}
+	expected := func(comment *github.PullRequestComment) PullRequestReviewComment {
+		return PullRequestReviewComment{
+			ID:          comment.GetID(),
+			Path:        comment.GetPath(),
+			Line:        10,
+			Side:        "RIGHT",
+			Body:        comment.GetBody(),
+			Author:      comment.GetUser().GetLogin(),
+			InReplyToID: comment.GetInReplyTo(),
+			CreatedAt:   "2024-03-14T09:00:00Z",
+			HTMLURL:     comment.GetHTMLURL(),
+		}
+	}
+	threadRoot := expected(mockComments[0])
+	threadRoot.Replies = []PullRequestReviewComment{expected(mockComments[2])}
 
 	tests := []struct {
-		name                 string
-		mockedClient         *http.Client
-		requestArgs          map[string]interface{}
-		expectError          bool
-		expectedUpdateResult *github.PullRequestBranchUpdateResponse
-		expectedErrMsg       string
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectError      bool
+		expectedComments []PullRequestReviewComment
+		expectedErrMsg   string
 	}{
 		{
-			name: "successful branch update",
+			name: "flat list with since and pagination",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.PutReposPullsUpdateBranchByOwnerByRepoByPullNumber,
-					expectRequestBody(t, map[string]interface{}{
-						"expected_head_sha": "abcd1234",
+					mock.GetReposPullsCommentsByOwnerByRepoByPullNumber,
+					expectQueryParams(t, map[string]string{
+						"since":    "2024-03-01T00:00:00Z",
+						"page":     "2",
+						"per_page": "10",
 					}).andThen(
-						mockResponse(t, http.StatusAccepted, mockUpdateResult),
+						mockResponse(t, http.StatusOK, mockComments),
 					),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner":           "owner",
-				"repo":            "repo",
-				"pullNumber":      float64(42),
-				"expectedHeadSha": "abcd1234",
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"since":      "2024-03-01",
+				"page":       float64(2),
+				"perPage":    float64(10),
+			},
+			expectedComments: []PullRequestReviewComment{
+				expected(mockComments[0]),
+				expected(mockComments[1]),
+				expected(mockComments[2]),
 			},
-			expectError:          false,
-			expectedUpdateResult: mockUpdateResult,
 		},
 		{
-			name: "branch update without expected SHA",
+			name: "path and author filters",
 			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatchHandler(
-					mock.PutReposPullsUpdateBranchByOwnerByRepoByPullNumber,
-					expectRequestBody(t, map[string]interface{}{}).andThen(
-						mockResponse(t, http.StatusAccepted, mockUpdateResult),
-					),
+				mock.WithRequestMatch(
+					mock.GetReposPullsCommentsByOwnerByRepoByPullNumber,
+					mockComments,
 				),
 			),
 			requestArgs: map[string]interface{}{
 				"owner":      "owner",
 				"repo":       "repo",
 				"pullNumber": float64(42),
+				"path":       "main.go",
+				"author":     "Alice",
+			},
+			expectedComments: []PullRequestReviewComment{
+				expected(mockComments[0]),
 			},
-			expectError:          false,
-			expectedUpdateResult: mockUpdateResult,
 		},
 		{
-			name: "branch update fails",
+			name: "threaded",
 			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatchHandler(
-					mock.PutReposPullsUpdateBranchByOwnerByRepoByPullNumber,
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusConflict)
-						_, _ = w.Write([]byte(`{"message": "Merge conflict"}`))
-					}),
+				mock.WithRequestMatch(
+					mock.GetReposPullsCommentsByOwnerByRepoByPullNumber,
+					mockComments,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"threaded":   true,
+			},
+			expectedComments: []PullRequestReviewComment{
+				threadRoot,
+				expected(mockComments[1]),
+			},
+		},
+		{
+			name: "reply without its root stays at the top level",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsCommentsByOwnerByRepoByPullNumber,
+					mockComments,
 				),
 			),
 			requestArgs: map[string]interface{}{
 				"owner":      "owner",
 				"repo":       "repo",
 				"pullNumber": float64(42),
+				"author":     "bob",
+				"threaded":   true,
+			},
+			expectedComments: []PullRequestReviewComment{
+				expected(mockComments[2]),
+			},
+		},
+		{
+			name:         "invalid since",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"since":      "last tuesday",
 			},
 			expectError:    true,
-			expectedErrMsg: "failed to update pull request branch",
+			expectedErrMsg: "invalid ISO 8601 timestamp",
 		},
 	}
 
@@ -1197,7 +2806,7 @@ func Test_UpdatePullRequestBranch(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := UpdatePullRequestBranch(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := ListPullRequestReviewComments(stubGetClientFn(client), translations.NullTranslationHelper)
 
 			// Create call request
 			request := createMCPRequest(tc.requestArgs)
@@ -1220,68 +2829,66 @@ func Test_UpdatePullRequestBranch(t *testing.T) {
 			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
-			assert.Contains(t, textContent.Text, "is in progress")
+			var returnedComments []PullRequestReviewComment
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returnedComments))
+			assert.Equal(t, tc.expectedComments, returnedComments)
 		})
 	}
 }
 
-func Test_GetPullRequestComments(t *testing.T) {
+func Test_GetPullRequestReviews(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := GetPullRequestComments(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := GetPullRequestReviews(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 	require.NoError(t, toolsnaps.Test(tool.Name, tool))
 
-	assert.Equal(t, "get_pull_request_comments", tool.Name)
+	assert.Equal(t, "get_pull_request_reviews", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
 
-	// Setup mock PR comments for success case
-	mockComments := []*github.PullRequestComment{
+	// Setup mock PR reviews for success case
+	mockReviews := []*github.PullRequestReview{
 		{
-			ID:      github.Ptr(int64(101)),
-			Body:    github.Ptr("This looks good"),
-			HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42#discussion_r101"),
+			ID:      github.Ptr(int64(201)),
+			State:   github.Ptr("APPROVED"),
+			Body:    github.Ptr("LGTM"),
+			HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42#pullrequestreview-201"),
 			User: &github.User{
-				Login: github.Ptr("reviewer1"),
+				Login: github.Ptr("approver"),
 			},
-			Path:      github.Ptr("file1.go"),
-			Position:  github.Ptr(5),
-			CommitID:  github.Ptr("abcdef123456"),
-			CreatedAt: &github.Timestamp{Time: time.Now().Add(-24 * time.Hour)},
-			UpdatedAt: &github.Timestamp{Time: time.Now().Add(-24 * time.Hour)},
+			CommitID:    github.Ptr("abcdef123456"),
+			SubmittedAt: &github.Timestamp{Time: time.Now().Add(-24 * time.Hour)},
 		},
 		{
-			ID:      github.Ptr(int64(102)),
-			Body:    github.Ptr("Please fix this"),
-			HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42#discussion_r102"),
+			ID:      github.Ptr(int64(202)),
+			State:   github.Ptr("CHANGES_REQUESTED"),
+			Body:    github.Ptr("Please address the following issues"),
+			HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42#pullrequestreview-202"),
 			User: &github.User{
-				Login: github.Ptr("reviewer2"),
+				Login: github.Ptr("reviewer"),
 			},
-			Path:      github.Ptr("file2.go"),
-			Position:  github.Ptr(10),
-			CommitID:  github.Ptr("abcdef123456"),
-			CreatedAt: &github.Timestamp{Time: time.Now().Add(-12 * time.Hour)},
-			UpdatedAt: &github.Timestamp{Time: time.Now().Add(-12 * time.Hour)},
+			CommitID:    github.Ptr("abcdef123456"),
+			SubmittedAt: &github.Timestamp{Time: time.Now().Add(-12 * time.Hour)},
 		},
 	}
 
 	tests := []struct {
-		name             string
-		mockedClient     *http.Client
-		requestArgs      map[string]interface{}
-		expectError      bool
-		expectedComments []*github.PullRequestComment
-		expectedErrMsg   string
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectError     bool
+		expectedReviews []*github.PullRequestReview
+		expectedErrMsg  string
 	}{
 		{
-			name: "successful comments fetch",
+			name: "successful reviews fetch",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatch(
-					mock.GetReposPullsCommentsByOwnerByRepoByPullNumber,
-					mockComments,
+					mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
+					mockReviews,
 				),
 			),
 			requestArgs: map[string]interface{}{
@@ -1289,14 +2896,14 @@ func Test_GetPullRequestComments(t *testing.T) {
 				"repo":       "repo",
 				"pullNumber": float64(42),
 			},
-			expectError:      false,
-			expectedComments: mockComments,
+			expectError:     false,
+			expectedReviews: mockReviews,
 		},
 		{
-			name: "comments fetch fails",
+			name: "reviews fetch fails",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.GetReposPullsCommentsByOwnerByRepoByPullNumber,
+					mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
 					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
 						w.WriteHeader(http.StatusNotFound)
 						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
@@ -1309,7 +2916,7 @@ func Test_GetPullRequestComments(t *testing.T) {
 				"pullNumber": float64(999),
 			},
 			expectError:    true,
-			expectedErrMsg: "failed to get pull request comments",
+			expectedErrMsg: "failed to get pull request reviews",
 		},
 	}
 
@@ -1317,7 +2924,7 @@ func Test_GetPullRequestComments(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := GetPullRequestComments(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := GetPullRequestReviews(stubGetClientFn(client), translations.NullTranslationHelper)
 
 			// Create call request
 			request := createMCPRequest(tc.requestArgs)
@@ -1341,58 +2948,56 @@ func Test_GetPullRequestComments(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedComments []*github.PullRequestComment
-			err = json.Unmarshal([]byte(textContent.Text), &returnedComments)
+			var returnedReviews []*github.PullRequestReview
+			err = json.Unmarshal([]byte(textContent.Text), &returnedReviews)
 			require.NoError(t, err)
-			assert.Len(t, returnedComments, len(tc.expectedComments))
-			for i, comment := range returnedComments {
-				assert.Equal(t, *tc.expectedComments[i].ID, *comment.ID)
-				assert.Equal(t, *tc.expectedComments[i].Body, *comment.Body)
-				assert.Equal(t, *tc.expectedComments[i].User.Login, *comment.User.Login)
-				assert.Equal(t, *tc.expectedComments[i].Path, *comment.Path)
-				assert.Equal(t, *tc.expectedComments[i].HTMLURL, *comment.HTMLURL)
+			assert.Len(t, returnedReviews, len(tc.expectedReviews))
+			for i, review := range returnedReviews {
+				assert.Equal(t, *tc.expectedReviews[i].ID, *review.ID)
+				assert.Equal(t, *tc.expectedReviews[i].State, *review.State)
+				assert.Equal(t, *tc.expectedReviews[i].Body, *review.Body)
+				assert.Equal(t, *tc.expectedReviews[i].User.Login, *review.User.Login)
+				assert.Equal(t, *tc.expectedReviews[i].HTMLURL, *review.HTMLURL)
 			}
 		})
 	}
 }
 
-func Test_GetPullRequestReviews(t *testing.T) {
+func Test_ListPullRequestReviews(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := GetPullRequestReviews(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := ListPullRequestReviews(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 	require.NoError(t, toolsnaps.Test(tool.Name, tool))
 
-	assert.Equal(t, "get_pull_request_reviews", tool.Name)
+	assert.Equal(t, "list_pull_request_reviews", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
 
-	// Setup mock PR reviews for success case
+	submitted := github.Timestamp{Time: time.Date(2024, 3, 14, 9, 0, 0, 0, time.UTC)}
+	review := func(id int64, login, state string) *github.PullRequestReview {
+		return &github.PullRequestReview{
+			ID:          github.Ptr(id),
+			User:        &github.User{Login: github.Ptr(login)},
+			State:       github.Ptr(state),
+			Body:        github.Ptr(fmt.Sprintf("review %d", id)),
+			SubmittedAt: &submitted,
+			HTMLURL:     github.Ptr(fmt.Sprintf("https://github.com/owner/repo/pull/88#pullrequestreview-%d", id)),
+		}
+	}
+	// alice requests changes and then approves, after which her comment doesn't change her
+	// approval; bob's approval was dismissed.
 	mockReviews := []*github.PullRequestReview{
-		{
-			ID:      github.Ptr(int64(201)),
-			State:   github.Ptr("APPROVED"),
-			Body:    github.Ptr("LGTM"),
-			HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42#pullrequestreview-201"),
-			User: &github.User{
-				Login: github.Ptr("approver"),
-			},
-			CommitID:    github.Ptr("abcdef123456"),
-			SubmittedAt: &github.Timestamp{Time: time.Now().Add(-24 * time.Hour)},
-		},
-		{
-			ID:      github.Ptr(int64(202)),
-			State:   github.Ptr("CHANGES_REQUESTED"),
-			Body:    github.Ptr("Please address the following issues"),
-			HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42#pullrequestreview-202"),
-			User: &github.User{
-				Login: github.Ptr("reviewer"),
-			},
-			CommitID:    github.Ptr("abcdef123456"),
-			SubmittedAt: &github.Timestamp{Time: time.Now().Add(-12 * time.Hour)},
-		},
+		review(1, "alice", "CHANGES_REQUESTED"),
+		review(2, "bob", "DISMISSED"),
+		review(3, "alice", "APPROVED"),
+		review(4, "alice", "COMMENTED"),
+		review(5, "carol", "COMMENTED"),
 	}
 
 	tests := []struct {
@@ -1400,11 +3005,53 @@ func Test_GetPullRequestReviews(t *testing.T) {
 		mockedClient    *http.Client
 		requestArgs     map[string]interface{}
 		expectError     bool
-		expectedReviews []*github.PullRequestReview
 		expectedErrMsg  string
+		expectedCounts  map[string]int
+		expectedLatest  map[string]string
+		expectedPage    int
+		expectedMore    bool
+		expectedReviews int
 	}{
 		{
-			name: "successful reviews fetch",
+			name: "several reviews from the same reviewer",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
+					expectQueryParams(t, map[string]string{
+						"page":     "2",
+						"per_page": "5",
+					}).andThen(
+						func(w http.ResponseWriter, r *http.Request) {
+							w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/pulls/88/reviews?page=3&per_page=5>; rel="next"`)
+							mockResponse(t, http.StatusOK, mockReviews)(w, r)
+						},
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(88),
+				"page":       float64(2),
+				"perPage":    float64(5),
+			},
+			expectedCounts: map[string]int{
+				"APPROVED":          1,
+				"CHANGES_REQUESTED": 1,
+				"COMMENTED":         2,
+				"DISMISSED":         1,
+			},
+			expectedLatest: map[string]string{
+				"alice": "APPROVED",
+				"bob":   "DISMISSED",
+				"carol": "COMMENTED",
+			},
+			expectedPage:    2,
+			expectedMore:    true,
+			expectedReviews: 5,
+		},
+		{
+			name: "last page of reviews",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatch(
 					mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
@@ -1414,10 +3061,21 @@ func Test_GetPullRequestReviews(t *testing.T) {
 			requestArgs: map[string]interface{}{
 				"owner":      "owner",
 				"repo":       "repo",
-				"pullNumber": float64(42),
+				"pullNumber": float64(88),
 			},
-			expectError:     false,
-			expectedReviews: mockReviews,
+			expectedCounts: map[string]int{
+				"APPROVED":          1,
+				"CHANGES_REQUESTED": 1,
+				"COMMENTED":         2,
+				"DISMISSED":         1,
+			},
+			expectedLatest: map[string]string{
+				"alice": "APPROVED",
+				"bob":   "DISMISSED",
+				"carol": "COMMENTED",
+			},
+			expectedPage:    1,
+			expectedReviews: 5,
 		},
 		{
 			name: "reviews fetch fails",
@@ -1436,49 +3094,50 @@ func Test_GetPullRequestReviews(t *testing.T) {
 				"pullNumber": float64(999),
 			},
 			expectError:    true,
-			expectedErrMsg: "failed to get pull request reviews",
+			expectedErrMsg: "failed to list pull request reviews",
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := GetPullRequestReviews(stubGetClientFn(client), translations.NullTranslationHelper)
-
-			// Create call request
-			request := createMCPRequest(tc.requestArgs)
+			_, handler := ListPullRequestReviews(stubGetClientFn(client), translations.NullTranslationHelper)
 
-			// Call handler
-			result, err := handler(context.Background(), request)
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
 
-			// Verify results
 			if tc.expectError {
-				require.NoError(t, err)
 				require.True(t, result.IsError)
 				errorContent := getErrorResult(t, result)
 				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
 				return
 			}
 
-			require.NoError(t, err)
-			require.False(t, result.IsError)
-
-			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
-
-			// Unmarshal and verify the result
-			var returnedReviews []*github.PullRequestReview
-			err = json.Unmarshal([]byte(textContent.Text), &returnedReviews)
-			require.NoError(t, err)
-			assert.Len(t, returnedReviews, len(tc.expectedReviews))
-			for i, review := range returnedReviews {
-				assert.Equal(t, *tc.expectedReviews[i].ID, *review.ID)
-				assert.Equal(t, *tc.expectedReviews[i].State, *review.State)
-				assert.Equal(t, *tc.expectedReviews[i].Body, *review.Body)
-				assert.Equal(t, *tc.expectedReviews[i].User.Login, *review.User.Login)
-				assert.Equal(t, *tc.expectedReviews[i].HTMLURL, *review.HTMLURL)
+			require.False(t, result.IsError, textContent.Text)
+
+			var response struct {
+				Reviews []PullRequestReviewSummary `json:"reviews"`
+				Summary struct {
+					Counts           map[string]int    `json:"counts"`
+					LatestByReviewer map[string]string `json:"latest_by_reviewer"`
+					Page             int               `json:"page"`
+					HasMorePages     bool              `json:"has_more_pages"`
+				} `json:"summary"`
 			}
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+			require.Len(t, response.Reviews, tc.expectedReviews)
+			assert.Equal(t, PullRequestReviewSummary{
+				Reviewer:    "alice",
+				State:       "CHANGES_REQUESTED",
+				SubmittedAt: "2024-03-14T09:00:00Z",
+				Body:        "review 1",
+				HTMLURL:     "https://github.com/owner/repo/pull/88#pullrequestreview-1",
+			}, response.Reviews[0])
+			assert.Equal(t, tc.expectedCounts, response.Summary.Counts)
+			assert.Equal(t, tc.expectedLatest, response.Summary.LatestByReviewer)
+			assert.Equal(t, tc.expectedPage, response.Summary.Page)
+			assert.Equal(t, tc.expectedMore, response.Summary.HasMorePages)
 		})
 	}
 }
@@ -1886,36 +3545,371 @@ func Test_RequestCopilotReview(t *testing.T) {
 						requestBody: map[string]any{
 							"reviewers": []any{"copilot-pull-request-reviewer[bot]"},
 						},
-					}).andThen(
-						mockResponse(t, http.StatusCreated, mockPR),
-					),
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockPR),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(1),
+			},
+			expectError: false,
+		},
+		{
+			name: "request fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsRequestedReviewersByOwnerByRepoByPullNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(999),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to request copilot review",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := github.NewClient(tc.mockedClient)
+			_, handler := RequestCopilotReview(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+
+			result, err := handler(context.Background(), request)
+
+			if tc.expectError {
+				require.NoError(t, err)
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+			assert.NotNil(t, result)
+			assert.Len(t, result.Content, 1)
+
+			textContent := getTextResult(t, result)
+			require.Equal(t, "", textContent.Text)
+		})
+	}
+}
+
+func Test_RerequestPullRequestReview(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := RerequestPullRequestReview(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "rerequest_pull_request_review", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "reviewers")
+	assert.Contains(t, tool.InputSchema.Properties, "team_reviewers")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	mockReviews := []*github.PullRequestReview{
+		{
+			ID:    github.Ptr(int64(201)),
+			State: github.Ptr("CHANGES_REQUESTED"),
+			User:  &github.User{Login: github.Ptr("Reviewer1")},
+		},
+	}
+	mockPR := &github.PullRequest{
+		Number:             github.Ptr(42),
+		RequestedReviewers: []*github.User{{Login: github.Ptr("reviewer1")}, {Login: github.Ptr("newcomer")}},
+		RequestedTeams:     []*github.Team{{Slug: github.Ptr("maintainers")}},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult map[string]any
+		expectedErrMsg string
+	}{
+		{
+			name: "re-request previous reviewer and team",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
+					mockReviews,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsRequestedReviewersByOwnerByRepoByPullNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"reviewers":      []interface{}{"reviewer1", "newcomer"},
+						"team_reviewers": []interface{}{"maintainers"},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockPR),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":          "owner",
+				"repo":           "repo",
+				"pullNumber":     float64(42),
+				"reviewers":      []interface{}{"reviewer1", "newcomer"},
+				"team_reviewers": []interface{}{"maintainers"},
+			},
+			expectedResult: map[string]any{
+				"requested_reviewers": []any{"reviewer1", "newcomer"},
+				"requested_teams":     []any{"maintainers"},
+				"warnings": []any{
+					"newcomer has not reviewed this pull request before, their review was requested for the first time",
+				},
+			},
+		},
+		{
+			name: "teams only don't need the reviews",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsRequestedReviewersByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusCreated, &github.PullRequest{
+						RequestedTeams: []*github.Team{{Slug: github.Ptr("maintainers")}},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":          "owner",
+				"repo":           "repo",
+				"pullNumber":     float64(42),
+				"team_reviewers": []interface{}{"maintainers"},
+			},
+			expectedResult: map[string]any{
+				"requested_reviewers": []any{},
+				"requested_teams":     []any{"maintainers"},
+			},
+		},
+		{
+			name:         "no reviewers",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectError:    true,
+			expectedErrMsg: "at least one of reviewers or team_reviewers must be given",
+		},
+		{
+			name: "request fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
+					mockReviews,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsRequestedReviewersByOwnerByRepoByPullNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						_, _ = w.Write([]byte(`{"message": "Reviews may only be requested from collaborators."}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"reviewers":  []interface{}{"reviewer1"},
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to request reviewers",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := RerequestPullRequestReview(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.NoError(t, err)
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			var returned map[string]any
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func TestSetPullRequestDraft(t *testing.T) {
+	t.Parallel()
+
+	// Verify tool definition once
+	mockClient := githubv4.NewClient(nil)
+	tool, _ := SetPullRequestDraft(stubGetGQLClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "set_pull_request_draft", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "draft")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber", "draft"})
+
+	getPullRequestQuery := func(isDraft bool) githubv4mock.Matcher {
+		return githubv4mock.NewQueryMatcher(
+			struct {
+				Repository struct {
+					PullRequest struct {
+						ID      githubv4.ID
+						IsDraft githubv4.Boolean
+					} `graphql:"pullRequest(number: $prNum)"`
+				} `graphql:"repository(owner: $owner, name: $repo)"`
+			}{},
+			map[string]any{
+				"owner": githubv4.String("owner"),
+				"repo":  githubv4.String("repo"),
+				"prNum": githubv4.Int(42),
+			},
+			githubv4mock.DataResponse(map[string]any{
+				"repository": map[string]any{
+					"pullRequest": map[string]any{
+						"id":      "PR_kwDODKw3uc6WYN1T",
+						"isDraft": isDraft,
+					},
+				},
+			}),
+		)
+	}
+
+	tests := []struct {
+		name               string
+		mockedClient       *http.Client
+		requestArgs        map[string]any
+		expectToolError    bool
+		expectedToolErrMsg string
+		expectedResult     map[string]any
+	}{
+		{
+			name: "convert to draft",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				getPullRequestQuery(false),
+				githubv4mock.NewMutationMatcher(
+					struct {
+						ConvertPullRequestToDraft struct {
+							PullRequest struct {
+								IsDraft githubv4.Boolean
+							}
+						} `graphql:"convertPullRequestToDraft(input: $input)"`
+					}{},
+					githubv4.ConvertPullRequestToDraftInput{
+						PullRequestID: githubv4.ID("PR_kwDODKw3uc6WYN1T"),
+					},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"convertPullRequestToDraft": map[string]any{
+							"pullRequest": map[string]any{
+								"isDraft": true,
+							},
+						},
+					}),
 				),
 			),
 			requestArgs: map[string]any{
 				"owner":      "owner",
 				"repo":       "repo",
-				"pullNumber": float64(1),
+				"pullNumber": float64(42),
+				"draft":      true,
 			},
-			expectError: false,
+			expectedResult: map[string]any{"isDraft": true},
 		},
 		{
-			name: "request fails",
-			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatchHandler(
-					mock.PostReposPullsRequestedReviewersByOwnerByRepoByPullNumber,
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusNotFound)
-						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+			name: "mark ready for review",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				getPullRequestQuery(true),
+				githubv4mock.NewMutationMatcher(
+					struct {
+						MarkPullRequestReadyForReview struct {
+							PullRequest struct {
+								IsDraft githubv4.Boolean
+							}
+						} `graphql:"markPullRequestReadyForReview(input: $input)"`
+					}{},
+					githubv4.MarkPullRequestReadyForReviewInput{
+						PullRequestID: githubv4.ID("PR_kwDODKw3uc6WYN1T"),
+					},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"markPullRequestReadyForReview": map[string]any{
+							"pullRequest": map[string]any{
+								"isDraft": false,
+							},
+						},
 					}),
 				),
 			),
 			requestArgs: map[string]any{
 				"owner":      "owner",
 				"repo":       "repo",
-				"pullNumber": float64(999),
+				"pullNumber": float64(42),
+				"draft":      false,
 			},
-			expectError:    true,
-			expectedErrMsg: "failed to request copilot review",
+			expectedResult: map[string]any{"isDraft": false},
+		},
+		{
+			name: "already in the requested state",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				getPullRequestQuery(true),
+			),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"draft":      true,
+			},
+			expectedResult: map[string]any{
+				"isDraft": true,
+				"note":    "pull request was already in the requested state, nothing was changed",
+			},
+		},
+		{
+			name:         "missing draft",
+			mockedClient: githubv4mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectToolError:    true,
+			expectedToolErrMsg: "missing required parameter: draft",
 		},
 	}
 
@@ -1923,28 +3917,29 @@ func Test_RequestCopilotReview(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			client := github.NewClient(tc.mockedClient)
-			_, handler := RequestCopilotReview(stubGetClientFn(client), translations.NullTranslationHelper)
+			// Setup client with mock
+			client := githubv4.NewClient(tc.mockedClient)
+			_, handler := SetPullRequestDraft(stubGetGQLClientFn(client), translations.NullTranslationHelper)
 
+			// Create call request
 			request := createMCPRequest(tc.requestArgs)
 
+			// Call handler
 			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
 
-			if tc.expectError {
-				require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectToolError {
 				require.True(t, result.IsError)
-				errorContent := getErrorResult(t, result)
-				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				assert.Contains(t, textContent.Text, tc.expectedToolErrMsg)
 				return
 			}
 
-			require.NoError(t, err)
-			require.False(t, result.IsError)
-			assert.NotNil(t, result)
-			assert.Len(t, result.Content, 1)
-
-			textContent := getTextResult(t, result)
-			require.Equal(t, "", textContent.Text)
+			require.False(t, result.IsError, textContent.Text)
+			var returned map[string]any
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
 		})
 	}
 }
@@ -2011,7 +4006,13 @@ func TestCreatePendingPullRequestReview(t *testing.T) {
 						CommitOID:     githubv4.NewGitObjectID("abcd1234"),
 					},
 					nil,
-					githubv4mock.DataResponse(map[string]any{}),
+					githubv4mock.DataResponse(map[string]any{
+						"addPullRequestReview": map[string]any{
+							"pullRequestReview": map[string]any{
+								"id": "PRR_kwDODKw3uc6xNw9V",
+							},
+						},
+					}),
 				),
 			),
 			requestArgs: map[string]any{
@@ -2127,7 +4128,7 @@ func TestCreatePendingPullRequestReview(t *testing.T) {
 			}
 
 			// Parse the result and get the text content if no error
-			require.Equal(t, textContent.Text, "pending pull request created")
+			require.Contains(t, textContent.Text, "pending pull request review created with pullRequestReviewID PRR_kwDODKw3uc6xNw9V")
 		})
 	}
 }
@@ -2214,6 +4215,170 @@ func TestAddPullRequestReviewCommentToPendingReview(t *testing.T) {
 				),
 			),
 		},
+		{
+			name: "comment added to an explicit review without looking it up",
+			requestArgs: map[string]any{
+				"owner":               "owner",
+				"repo":                "repo",
+				"pullNumber":          float64(42),
+				"pullRequestReviewID": "PRR_kwDODKw3uc6xNw9V",
+				"path":                "file.go",
+				"body":                "This is a test comment",
+				"subjectType":         "FILE",
+			},
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewMutationMatcher(
+					struct {
+						AddPullRequestReviewThread struct {
+							Thread struct {
+								ID githubv4.String // We don't need this, but a selector is required or GQL complains.
+							}
+						} `graphql:"addPullRequestReviewThread(input: $input)"`
+					}{},
+					githubv4.AddPullRequestReviewThreadInput{
+						Path:                githubv4.String("file.go"),
+						Body:                githubv4.String("This is a test comment"),
+						SubjectType:         githubv4mock.Ptr(githubv4.PullRequestReviewThreadSubjectTypeFile),
+						PullRequestReviewID: githubv4.NewID("PRR_kwDODKw3uc6xNw9V"),
+					},
+					nil,
+					githubv4mock.DataResponse(map[string]any{}),
+				),
+			),
+		},
+		{
+			name: "line comment without line",
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"pullNumber":  float64(42),
+				"path":        "file.go",
+				"body":        "This is a test comment",
+				"subjectType": "LINE",
+			},
+			mockedClient:       githubv4mock.NewMockedHTTPClient(),
+			expectToolError:    true,
+			expectedToolErrMsg: "line is required when subjectType is LINE",
+		},
+		{
+			name: "file comment with line",
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"pullNumber":  float64(42),
+				"path":        "file.go",
+				"body":        "This is a test comment",
+				"subjectType": "FILE",
+				"line":        float64(10),
+			},
+			mockedClient:       githubv4mock.NewMockedHTTPClient(),
+			expectToolError:    true,
+			expectedToolErrMsg: "can't be used with subjectType FILE",
+		},
+		{
+			name: "start line after line",
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"pullNumber":  float64(42),
+				"path":        "file.go",
+				"body":        "This is a test comment",
+				"subjectType": "LINE",
+				"line":        float64(10),
+				"startLine":   float64(12),
+			},
+			mockedClient:       githubv4mock.NewMockedHTTPClient(),
+			expectToolError:    true,
+			expectedToolErrMsg: "invalid startLine 12: must be before line 10 on the same side",
+		},
+		{
+			name: "start line after line on the other side",
+			requestArgs: map[string]any{
+				"owner":               "owner",
+				"repo":                "repo",
+				"pullNumber":          float64(42),
+				"pullRequestReviewID": "PRR_kwDODKw3uc6xNw9V",
+				"path":                "file.go",
+				"body":                "This is a test comment",
+				"subjectType":         "LINE",
+				"line":                float64(10),
+				"side":                "RIGHT",
+				"startLine":           float64(12),
+				"startSide":           "LEFT",
+			},
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewMutationMatcher(
+					struct {
+						AddPullRequestReviewThread struct {
+							Thread struct {
+								ID githubv4.String // We don't need this, but a selector is required or GQL complains.
+							}
+						} `graphql:"addPullRequestReviewThread(input: $input)"`
+					}{},
+					githubv4.AddPullRequestReviewThreadInput{
+						Path:                githubv4.String("file.go"),
+						Body:                githubv4.String("This is a test comment"),
+						SubjectType:         githubv4mock.Ptr(githubv4.PullRequestReviewThreadSubjectTypeLine),
+						Line:                githubv4.NewInt(10),
+						Side:                githubv4mock.Ptr(githubv4.DiffSideRight),
+						StartLine:           githubv4.NewInt(12),
+						StartSide:           githubv4mock.Ptr(githubv4.DiffSideLeft),
+						PullRequestReviewID: githubv4.NewID("PRR_kwDODKw3uc6xNw9V"),
+					},
+					nil,
+					githubv4mock.DataResponse(map[string]any{}),
+				),
+			),
+		},
+		{
+			name: "start line after line on the default side",
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"pullNumber":  float64(42),
+				"path":        "file.go",
+				"body":        "This is a test comment",
+				"subjectType": "LINE",
+				"line":        float64(10),
+				"startLine":   float64(12),
+				"startSide":   "RIGHT",
+			},
+			mockedClient:       githubv4mock.NewMockedHTTPClient(),
+			expectToolError:    true,
+			expectedToolErrMsg: "invalid startLine 12: must be before line 10 on the same side",
+		},
+		{
+			name: "start side without start line",
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"pullNumber":  float64(42),
+				"path":        "file.go",
+				"body":        "This is a test comment",
+				"subjectType": "LINE",
+				"line":        float64(10),
+				"startSide":   "LEFT",
+			},
+			mockedClient:       githubv4mock.NewMockedHTTPClient(),
+			expectToolError:    true,
+			expectedToolErrMsg: "startSide can only be used with startLine",
+		},
+		{
+			name: "invalid side",
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"pullNumber":  float64(42),
+				"path":        "file.go",
+				"body":        "This is a test comment",
+				"subjectType": "LINE",
+				"line":        float64(10),
+				"side":        "MIDDLE",
+			},
+			mockedClient:       githubv4mock.NewMockedHTTPClient(),
+			expectToolError:    true,
+			expectedToolErrMsg: `invalid side "MIDDLE": must be LEFT or RIGHT`,
+		},
 	}
 
 	for _, tc := range tests {
@@ -2312,6 +4477,33 @@ func TestSubmitPendingPullRequestReview(t *testing.T) {
 				),
 			),
 		},
+		{
+			name: "explicit review is submitted without looking it up",
+			requestArgs: map[string]any{
+				"owner":               "owner",
+				"repo":                "repo",
+				"pullNumber":          float64(42),
+				"pullRequestReviewID": "PRR_kwDODKw3uc6xNw9V",
+				"event":               "APPROVE",
+			},
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewMutationMatcher(
+					struct {
+						SubmitPullRequestReview struct {
+							PullRequestReview struct {
+								ID githubv4.ID
+							}
+						} `graphql:"submitPullRequestReview(input: $input)"`
+					}{},
+					githubv4.SubmitPullRequestReviewInput{
+						PullRequestReviewID: githubv4.NewID("PRR_kwDODKw3uc6xNw9V"),
+						Event:               githubv4.PullRequestReviewEventApprove,
+					},
+					nil,
+					githubv4mock.DataResponse(map[string]any{}),
+				),
+			),
+		},
 	}
 
 	for _, tc := range tests {
@@ -2404,6 +4596,31 @@ func TestDeletePendingPullRequestReview(t *testing.T) {
 				),
 			),
 		},
+		{
+			name: "explicit review is deleted without looking it up",
+			requestArgs: map[string]any{
+				"owner":               "owner",
+				"repo":                "repo",
+				"pullNumber":          float64(42),
+				"pullRequestReviewID": "PRR_kwDODKw3uc6xNw9V",
+			},
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewMutationMatcher(
+					struct {
+						DeletePullRequestReview struct {
+							PullRequestReview struct {
+								ID githubv4.ID
+							}
+						} `graphql:"deletePullRequestReview(input: $input)"`
+					}{},
+					githubv4.DeletePullRequestReviewInput{
+						PullRequestReviewID: githubv4.NewID("PRR_kwDODKw3uc6xNw9V"),
+					},
+					nil,
+					githubv4mock.DataResponse(map[string]any{}),
+				),
+			),
+		},
 	}
 
 	for _, tc := range tests {
@@ -2448,6 +4665,7 @@ func TestGetPullRequestDiff(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "max_lines")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
 
 	stubbedDiff := `diff --git a/README.md b/README.md
@@ -2469,6 +4687,7 @@ index 5d6e7b2..8a4f5c3 100644
 		mockedClient       *http.Client
 		expectToolError    bool
 		expectedToolErrMsg string
+		expectedText       string
 	}{
 		{
 			name: "successful diff retrieval",
@@ -2487,6 +4706,40 @@ index 5d6e7b2..8a4f5c3 100644
 				),
 			),
 			expectToolError: false,
+			expectedText:    stubbedDiff,
+		},
+		{
+			name: "diff truncated to max_lines",
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"max_lines":  float64(3),
+			},
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusOK, stubbedDiff),
+				),
+			),
+			expectToolError: false,
+			expectedText: `diff --git a/README.md b/README.md
+index 5d6e7b2..8a4f5c3 100644
+--- a/README.md
+[diff truncated: showing 3 of 12 lines. Use get_pull_request_files to inspect the changes file by file.]
+`,
+		},
+		{
+			name: "negative max_lines",
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"max_lines":  float64(-1),
+			},
+			mockedClient:       mock.NewMockedHTTPClient(),
+			expectToolError:    true,
+			expectedToolErrMsg: "max_lines must be at least 1",
 		},
 	}
 
@@ -2514,7 +4767,7 @@ index 5d6e7b2..8a4f5c3 100644
 			}
 
 			// Parse the result and get the text content if no error
-			require.Equal(t, stubbedDiff, textContent.Text)
+			require.Equal(t, tc.expectedText, textContent.Text)
 		})
 	}
 }
@@ -2590,3 +4843,35 @@ func getLatestPendingReviewQuery(p getLatestPendingReviewQueryParams) githubv4mo
 		),
 	)
 }
+
+func Test_PullRequestDescriptionPrompt(t *testing.T) {
+	prompt, handler := PullRequestDescriptionPrompt(translations.NullTranslationHelper)
+	assert.Equal(t, "PullRequestDescription", prompt.Name)
+
+	request := mcp.GetPromptRequest{}
+	request.Params.Arguments = map[string]string{"repo": "owner/repo", "head": "feature-branch", "issue_number": "42"}
+	result, err := handler(context.Background(), request)
+	require.NoError(t, err)
+	require.NotEmpty(t, result.Messages)
+	assert.Contains(t, result.Messages[1].Content.(mcp.TextContent).Text, "Use `list_branches` to find the default branch")
+	assert.Contains(t, result.Messages[1].Content.(mcp.TextContent).Text, "`compare_refs` with that branch as base, head `feature-branch`")
+	assert.Contains(t, result.Messages[3].Content.(mcp.TextContent).Text, "Closes #42")
+	// The final instruction must forbid invented test results and require confirmation.
+	final := result.Messages[len(result.Messages)-1].Content.(mcp.TextContent).Text
+	assert.Contains(t, final, "Do not invent test results")
+	assert.Contains(t, final, "Only after I confirm the draft")
+
+	request.Params.Arguments = map[string]string{"repo": "owner/repo", "head": "feature-branch", "base": "release-1.x"}
+	result, err = handler(context.Background(), request)
+	require.NoError(t, err)
+	assert.Contains(t, result.Messages[1].Content.(mcp.TextContent).Text, "Use `compare_refs` with base `release-1.x`, head `feature-branch`")
+	assert.Contains(t, result.Messages[1].Content.(mcp.TextContent).Text, "release-1.x...feature-branch")
+
+	request.Params.Arguments = map[string]string{"repo": "owner/repo"}
+	_, err = handler(context.Background(), request)
+	assert.ErrorContains(t, err, "missing required argument: head")
+
+	request.Params.Arguments = map[string]string{"repo": "owner/repo", "head": "feature-branch", "issue_number": "abc"}
+	_, err = handler(context.Background(), request)
+	assert.ErrorContains(t, err, `invalid issue_number "abc"`)
+}
@@ -0,0 +1,562 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v73/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// CreatedRelease is the result of create_release.
+type CreatedRelease struct {
+	ID         int64  `json:"id"`
+	TagName    string `json:"tag_name"`
+	HTMLURL    string `json:"html_url"`
+	UploadURL  string `json:"upload_url"`
+	Draft      bool   `json:"draft"`
+	Prerelease bool   `json:"prerelease"`
+	Note       string `json:"note,omitempty"`
+}
+
+// isReleaseAlreadyExistsError reports whether the error returned by the API is a validation
+// failure caused by the tag already having a release.
+func isReleaseAlreadyExistsError(resp *github.Response, err error) bool {
+	if resp == nil || resp.StatusCode != http.StatusUnprocessableEntity {
+		return false
+	}
+	var errResp *github.ErrorResponse
+	if !errors.As(err, &errResp) {
+		return false
+	}
+	for _, e := range errResp.Errors {
+		if e.Code == "already_exists" && e.Field == "tag_name" {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateRelease creates a tool to create a release in a GitHub repository.
+func CreateRelease(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_release",
+			mcp.WithDescription(t("TOOL_CREATE_RELEASE_DESCRIPTION", "Create a release for a tag in a GitHub repository. The tag is created from target_commitish if it does not exist yet.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_RELEASE_USER_TITLE", "Create release"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("tag_name",
+				mcp.Required(),
+				mcp.Description("Name of the tag to release"),
+			),
+			mcp.WithString("target_commitish",
+				mcp.Description("Branch or commit SHA the tag is created from when it does not exist (defaults to the default branch)"),
+			),
+			mcp.WithString("name",
+				mcp.Description("Title of the release"),
+			),
+			mcp.WithString("body",
+				mcp.Description("Markdown description of the release"),
+			),
+			mcp.WithBoolean("draft",
+				mcp.Description("Create an unpublished draft release (default: false)"),
+			),
+			mcp.WithBoolean("prerelease",
+				mcp.Description("Mark the release as a pre-release (default: false)"),
+			),
+			mcp.WithBoolean("generate_release_notes",
+				mcp.Description("Generate the release name and notes automatically. When body is also given, the generated notes are appended after it (default: false)"),
+			),
+			mcp.WithString("make_latest",
+				mcp.Description("Whether to mark the release as the latest release. 'legacy' picks the latest by creation date and version (default: true)"),
+				mcp.Enum("true", "false", "legacy"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			tagName, err := RequiredParam[string](request, "tag_name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			targetCommitish, err := OptionalParam[string](request, "target_commitish")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			name, err := OptionalParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			body, err := OptionalParam[string](request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			draft, err := OptionalParam[bool](request, "draft")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			prerelease, err := OptionalParam[bool](request, "prerelease")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			generateNotes, err := OptionalParam[bool](request, "generate_release_notes")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			makeLatest, err := OptionalParam[string](request, "make_latest")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			release := &github.RepositoryRelease{
+				TagName:              github.Ptr(tagName),
+				TargetCommitish:      ToStringPtr(targetCommitish),
+				Name:                 ToStringPtr(name),
+				Body:                 ToStringPtr(body),
+				Draft:                github.Ptr(draft),
+				Prerelease:           github.Ptr(prerelease),
+				GenerateReleaseNotes: github.Ptr(generateNotes),
+				MakeLatest:           ToStringPtr(makeLatest),
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			created, resp, err := client.Repositories.CreateRelease(ctx, owner, repo, release)
+			if err != nil {
+				if isReleaseAlreadyExistsError(resp, err) {
+					existing, existingResp, lookupErr := client.Repositories.GetReleaseByTag(ctx, owner, repo, tagName)
+					if lookupErr != nil {
+						return mcp.NewToolResultError(fmt.Sprintf("tag %s in %s/%s already has a release", tagName, owner, repo)), nil
+					}
+					_ = existingResp.Body.Close()
+					return mcp.NewToolResultError(fmt.Sprintf("tag %s in %s/%s already has a release: %s", tagName, owner, repo, existing.GetHTMLURL())), nil
+				}
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to create release", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := CreatedRelease{
+				ID:         created.GetID(),
+				TagName:    created.GetTagName(),
+				HTMLURL:    created.GetHTMLURL(),
+				UploadURL:  created.GetUploadURL(),
+				Draft:      created.GetDraft(),
+				Prerelease: created.GetPrerelease(),
+			}
+			if generateNotes && body != "" {
+				result.Note = "the generated release notes were appended after the provided body"
+			}
+
+			return MarshalledTextResult(result), nil
+		}
+}
+
+// defaultMaxReleaseBodyBytes is the release body size returned when max_body_bytes is not given.
+const defaultMaxReleaseBodyBytes = 10000
+
+// ReleaseAsset is a file attached to a release.
+type ReleaseAsset struct {
+	Name          string `json:"name"`
+	Size          int    `json:"size"`
+	DownloadCount int    `json:"download_count"`
+}
+
+// ReleaseDetails is the compact form of a release returned by the release read tools.
+type ReleaseDetails struct {
+	TagName     string `json:"tag_name"`
+	Name        string `json:"name,omitempty"`
+	HTMLURL     string `json:"html_url"`
+	PublishedAt string `json:"published_at,omitempty"`
+	Draft       bool   `json:"draft"`
+	Prerelease  bool   `json:"prerelease"`
+	Body        string `json:"body,omitempty"`
+	// BodyTruncated is set when the body was cut to max_body_bytes.
+	BodyTruncated bool           `json:"body_truncated,omitempty"`
+	Assets        []ReleaseAsset `json:"assets"`
+}
+
+// newReleaseDetails converts a release into its compact form, cutting the body to maxBodyBytes.
+func newReleaseDetails(release *github.RepositoryRelease, maxBodyBytes int) ReleaseDetails {
+	body, truncated := truncateContent([]byte(release.GetBody()), maxBodyBytes)
+	details := ReleaseDetails{
+		TagName:       release.GetTagName(),
+		Name:          release.GetName(),
+		HTMLURL:       release.GetHTMLURL(),
+		PublishedAt:   summaryTimestamp(release.GetPublishedAt()),
+		Draft:         release.GetDraft(),
+		Prerelease:    release.GetPrerelease(),
+		Body:          string(body),
+		BodyTruncated: truncated,
+		Assets:        make([]ReleaseAsset, 0, len(release.Assets)),
+	}
+	for _, asset := range release.Assets {
+		details.Assets = append(details.Assets, ReleaseAsset{
+			Name:          asset.GetName(),
+			Size:          asset.GetSize(),
+			DownloadCount: asset.GetDownloadCount(),
+		})
+	}
+	return details
+}
+
+// withMaxReleaseBodyBytes adds the max_body_bytes parameter to the release read tools.
+func withMaxReleaseBodyBytes() mcp.ToolOption {
+	return mcp.WithNumber("max_body_bytes",
+		mcp.Description(fmt.Sprintf("Maximum size in bytes of the release body; longer bodies are truncated (default: %d)", defaultMaxReleaseBodyBytes)),
+		mcp.Min(1),
+	)
+}
+
+// GetLatestRelease creates a tool to get the latest published release of a GitHub repository.
+func GetLatestRelease(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_latest_release",
+			mcp.WithDescription(t("TOOL_GET_LATEST_RELEASE_DESCRIPTION", "Get the latest published release of a GitHub repository, excluding drafts and pre-releases")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_LATEST_RELEASE_USER_TITLE", "Get latest release"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			withMaxReleaseBodyBytes(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxBodyBytes, err := OptionalIntParamWithDefault(request, "max_body_bytes", defaultMaxReleaseBodyBytes)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if maxBodyBytes < 1 {
+				return mcp.NewToolResultError("max_body_bytes must be at least 1"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			release, resp, err := client.Repositories.GetLatestRelease(ctx, owner, repo)
+			if err != nil {
+				// A repository without published releases has no latest release, which is an answer rather than a failure.
+				// The API returns the same 404 for a repository that doesn't exist, so that is ruled out first.
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					if errResult := checkRepositoryExists(ctx, client, owner, repo); errResult != nil {
+						return errResult, nil
+					}
+					return mcp.NewToolResultText(fmt.Sprintf("%s/%s has no published releases", owner, repo)), nil
+				}
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get latest release", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(newReleaseDetails(release, maxBodyBytes)), nil
+		}
+}
+
+// GetReleaseByTag creates a tool to get the release of a tag in a GitHub repository.
+func GetReleaseByTag(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_release_by_tag",
+			mcp.WithDescription(t("TOOL_GET_RELEASE_BY_TAG_DESCRIPTION", "Get the release of a specific tag in a GitHub repository")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_RELEASE_BY_TAG_USER_TITLE", "Get release by tag"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("tag",
+				mcp.Required(),
+				mcp.Description("Tag name of the release"),
+			),
+			withMaxReleaseBodyBytes(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			tag, err := RequiredParam[string](request, "tag")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxBodyBytes, err := OptionalIntParamWithDefault(request, "max_body_bytes", defaultMaxReleaseBodyBytes)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if maxBodyBytes < 1 {
+				return mcp.NewToolResultError("max_body_bytes must be at least 1"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			release, resp, err := client.Repositories.GetReleaseByTag(ctx, owner, repo, tag)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					if errResult := checkRepositoryExists(ctx, client, owner, repo); errResult != nil {
+						return errResult, nil
+					}
+					return mcp.NewToolResultText(fmt.Sprintf("%s/%s has no release for tag %s", owner, repo, tag)), nil
+				}
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get release for tag %s", tag), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(newReleaseDetails(release, maxBodyBytes)), nil
+		}
+}
+
+// ReleaseSummary is the compact form of a release returned by list_releases.
+type ReleaseSummary struct {
+	TagName     string `json:"tag_name"`
+	Name        string `json:"name,omitempty"`
+	PublishedAt string `json:"published_at,omitempty"`
+	Draft       bool   `json:"draft"`
+	Prerelease  bool   `json:"prerelease"`
+	AssetCount  int    `json:"asset_count"`
+	// Body is only set when include_body is true.
+	Body          string `json:"body,omitempty"`
+	BodyTruncated bool   `json:"body_truncated,omitempty"`
+}
+
+// ListReleases creates a tool to list the releases of a GitHub repository.
+func ListReleases(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_releases",
+			mcp.WithDescription(t("TOOL_LIST_RELEASES_DESCRIPTION", "List the releases of a GitHub repository, newest first. Use get_release_by_tag for the full details of a release")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_RELEASES_USER_TITLE", "List releases"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithBoolean("include_drafts",
+				mcp.Description("Include draft releases, which are only visible with push access (default: false)"),
+			),
+			mcp.WithBoolean("include_prereleases",
+				mcp.Description("Include pre-releases (default: true)"),
+			),
+			mcp.WithBoolean("include_body",
+				mcp.Description("Include the body of each release, truncated to max_body_bytes (default: false)"),
+			),
+			withMaxReleaseBodyBytes(),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			includeDrafts, err := OptionalParam[bool](request, "include_drafts")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			includePrereleases, ok, err := OptionalParamOK[bool](request, "include_prereleases")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if !ok {
+				includePrereleases = true
+			}
+			includeBody, err := OptionalParam[bool](request, "include_body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxBodyBytes, err := OptionalIntParamWithDefault(request, "max_body_bytes", defaultMaxReleaseBodyBytes)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if maxBodyBytes < 1 {
+				return mcp.NewToolResultError("max_body_bytes must be at least 1"), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			releases, resp, err := client.Repositories.ListReleases(ctx, owner, repo, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list releases", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			// The API returns every release the token can see, so the filters apply to the requested page.
+			summaries := make([]ReleaseSummary, 0, len(releases))
+			for _, release := range releases {
+				if release.GetDraft() && !includeDrafts {
+					continue
+				}
+				if release.GetPrerelease() && !includePrereleases {
+					continue
+				}
+				summary := ReleaseSummary{
+					TagName:     release.GetTagName(),
+					Name:        release.GetName(),
+					PublishedAt: summaryTimestamp(release.GetPublishedAt()),
+					Draft:       release.GetDraft(),
+					Prerelease:  release.GetPrerelease(),
+					AssetCount:  len(release.Assets),
+				}
+				if includeBody {
+					body, truncated := truncateContent([]byte(release.GetBody()), maxBodyBytes)
+					summary.Body = string(body)
+					summary.BodyTruncated = truncated
+				}
+				summaries = append(summaries, summary)
+			}
+
+			return MarshalledTextResult(summaries), nil
+		}
+}
+
+// generateNotesRequest is the body of the generate-notes endpoint. go-github's
+// GenerateNotesOptions lacks configuration_file_path, so the request is built directly.
+type generateNotesRequest struct {
+	TagName               string `json:"tag_name"`
+	PreviousTagName       string `json:"previous_tag_name,omitempty"`
+	TargetCommitish       string `json:"target_commitish,omitempty"`
+	ConfigurationFilePath string `json:"configuration_file_path,omitempty"`
+}
+
+// GenerateReleaseNotes creates a tool to preview the release notes GitHub generates for a tag.
+func GenerateReleaseNotes(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("generate_release_notes",
+			mcp.WithDescription(t("TOOL_GENERATE_RELEASE_NOTES_DESCRIPTION", "Generate the name and markdown notes GitHub would use for a release of a tag, following the repository's .github/release.yml categories. This only previews the notes and does not create a release; pass the edited notes to create_release as its body.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GENERATE_RELEASE_NOTES_USER_TITLE", "Generate release notes"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("tag_name",
+				mcp.Required(),
+				mcp.Description("Tag of the release, which does not need to exist yet"),
+			),
+			mcp.WithString("previous_tag_name",
+				mcp.Description("Tag to start the notes from (defaults to the previous release)"),
+			),
+			mcp.WithString("target_commitish",
+				mcp.Description("Branch or commit SHA the notes end at when tag_name does not exist yet (defaults to the default branch)"),
+			),
+			mcp.WithString("config_file_path",
+				mcp.Description("Path of the release notes configuration file in the repository (defaults to .github/release.yml)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			tagName, err := RequiredParam[string](request, "tag_name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			previousTagName, err := OptionalParam[string](request, "previous_tag_name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			targetCommitish, err := OptionalParam[string](request, "target_commitish")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			configFilePath, err := OptionalParam[string](request, "config_file_path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			req, err := client.NewRequest(http.MethodPost, fmt.Sprintf("repos/%s/%s/releases/generate-notes", owner, repo), &generateNotesRequest{
+				TagName:               tagName,
+				PreviousTagName:       previousTagName,
+				TargetCommitish:       targetCommitish,
+				ConfigurationFilePath: configFilePath,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to create request: %w", err)
+			}
+			notes := new(github.RepositoryReleaseNotes)
+			resp, err := client.Do(ctx, req, notes)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to generate release notes", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(notes), nil
+		}
+}
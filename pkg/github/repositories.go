@@ -1,14 +1,23 @@
 package github
 
 import (
+	"bytes"
+	"cmp"
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/raw"
@@ -16,11 +25,74 @@ import (
 	"github.com/google/go-github/v73/github"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
 )
 
+// CommitStats counts the lines changed by a commit.
+type CommitStats struct {
+	Additions int `json:"additions"`
+	Deletions int `json:"deletions"`
+	Total     int `json:"total"`
+}
+
+// CommitVerification is the signature verification status of a commit.
+type CommitVerification struct {
+	Verified bool   `json:"verified"`
+	Reason   string `json:"reason"`
+}
+
+// CommitDetails is a commit with its changed files, as returned by get_commit.
+type CommitDetails struct {
+	SHA          string              `json:"sha"`
+	Message      string              `json:"message"`
+	Author       string              `json:"author"`
+	Date         string              `json:"date,omitempty"`
+	HTMLURL      string              `json:"html_url"`
+	Parents      []string            `json:"parents"`
+	Stats        CommitStats         `json:"stats"`
+	Verification *CommitVerification `json:"verification,omitempty"`
+	Files        []PullRequestFile   `json:"files"`
+}
+
+// newCommitDetails converts a commit into its get_commit output form. The author is the GitHub
+// login when the commit author is linked to an account, and the git author name otherwise.
+func newCommitDetails(commit *github.RepositoryCommit, includePatch bool, maxPatchBytes int) CommitDetails {
+	author := commit.GetAuthor().GetLogin()
+	if author == "" {
+		author = commit.GetCommit().GetAuthor().GetName()
+	}
+	details := CommitDetails{
+		SHA:     commit.GetSHA(),
+		Message: commit.GetCommit().GetMessage(),
+		Author:  author,
+		Date:    summaryTimestamp(commit.GetCommit().GetAuthor().GetDate()),
+		HTMLURL: commit.GetHTMLURL(),
+		Parents: make([]string, 0, len(commit.Parents)),
+		Stats: CommitStats{
+			Additions: commit.GetStats().GetAdditions(),
+			Deletions: commit.GetStats().GetDeletions(),
+			Total:     commit.GetStats().GetTotal(),
+		},
+		Files: make([]PullRequestFile, 0, len(commit.Files)),
+	}
+	for _, parent := range commit.Parents {
+		details.Parents = append(details.Parents, parent.GetSHA())
+	}
+	if verification := commit.GetCommit().GetVerification(); verification != nil {
+		details.Verification = &CommitVerification{
+			Verified: verification.GetVerified(),
+			Reason:   verification.GetReason(),
+		}
+	}
+	for _, file := range commit.Files {
+		details.Files = append(details.Files, newPullRequestFile(file, includePatch, maxPatchBytes))
+	}
+	return details
+}
+
 func GetCommit(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("get_commit",
-			mcp.WithDescription(t("TOOL_GET_COMMITS_DESCRIPTION", "Get details for a commit from a GitHub repository")),
+			mcp.WithDescription(t("TOOL_GET_COMMITS_DESCRIPTION", "Get details for a commit from a GitHub repository, with its stats, signature verification and changed files. Patches are only included when include_patch is true.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_GET_COMMITS_USER_TITLE", "Get commit details"),
 				ReadOnlyHint: ToBoolPtr(true),
@@ -35,7 +107,14 @@ func GetCommit(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 			),
 			mcp.WithString("sha",
 				mcp.Required(),
-				mcp.Description("Commit SHA, branch name, or tag name"),
+				mcp.Description("Commit SHA, short SHA, branch name, or tag name"),
+			),
+			mcp.WithBoolean("include_patch",
+				mcp.Description("Include the patch of each file (default: false)"),
+			),
+			mcp.WithNumber("max_patch_bytes",
+				mcp.Description(fmt.Sprintf("Maximum size in bytes of each file's patch when include_patch is true; longer patches are truncated (default: %d)", defaultMaxPatchBytes)),
+				mcp.Min(1),
 			),
 			WithPagination(),
 		),
@@ -52,6 +131,17 @@ func GetCommit(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			includePatch, err := OptionalParam[bool](request, "include_patch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxPatchBytes, err := OptionalIntParamWithDefault(request, "max_patch_bytes", defaultMaxPatchBytes)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if maxPatchBytes < 1 {
+				return mcp.NewToolResultError("max_patch_bytes must be at least 1"), nil
+			}
 			pagination, err := OptionalPaginationParams(request)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
@@ -68,6 +158,11 @@ func GetCommit(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 			}
 			commit, resp, err := client.Repositories.GetCommit(ctx, owner, repo, sha, opts)
 			if err != nil {
+				// The API resolves short SHAs itself and reports ambiguous ones as a validation failure.
+				var errResp *github.ErrorResponse
+				if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity && errors.As(err, &errResp) {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to get commit: %s: %s", sha, errResp.Message)), nil
+				}
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
 					fmt.Sprintf("failed to get commit: %s", sha),
 					resp,
@@ -84,12 +179,7 @@ func GetCommit(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get commit: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(commit)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledTextResult(newCommitDetails(commit, includePatch, maxPatchBytes)), nil
 		}
 }
 
@@ -183,10 +273,257 @@ func ListCommits(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 		}
 }
 
+// checkRepositoryExists fetches a repository, so that a tool can tell a 404 for something missing in
+// it from the repository itself not existing or not being visible to the token. It returns an error
+// result when the repository can't be fetched, and nil otherwise.
+func checkRepositoryExists(ctx context.Context, client *github.Client, owner, repo string) *mcp.CallToolResult {
+	_, resp, err := client.Repositories.Get(ctx, owner, repo)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get repository %s/%s", owner, repo), resp, err)
+	}
+	_ = resp.Body.Close()
+	return nil
+}
+
+// getBlobRawLimited reads at most limit bytes of the raw content of a Git blob. Unlike GitService.GetBlobRaw,
+// it doesn't load the whole blob into memory, which matters for the large files it is a fallback for.
+func getBlobRawLimited(ctx context.Context, client *github.Client, owner, repo, sha string, limit int64) ([]byte, error) {
+	req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("repos/%v/%v/git/blobs/%v", owner, repo, sha), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.github.v3.raw")
+
+	resp, err := client.BareDo(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	return io.ReadAll(io.LimitReader(resp.Body, limit))
+}
+
+// compareFilesLimit is the number of files after which the compare API stops listing changed files.
+const compareFilesLimit = 300
+
+// CommitSummary is the compact form of a commit listed by compare_refs.
+type CommitSummary struct {
+	SHA     string `json:"sha"`
+	Message string `json:"message"`
+	Author  string `json:"author"`
+	Date    string `json:"date,omitempty"`
+}
+
+// RefComparison is the result of compare_refs.
+type RefComparison struct {
+	Status       string          `json:"status"`
+	AheadBy      int             `json:"ahead_by"`
+	BehindBy     int             `json:"behind_by"`
+	TotalCommits int             `json:"total_commits"`
+	MergeBaseSHA string          `json:"merge_base_sha,omitempty"`
+	HTMLURL      string          `json:"html_url"`
+	Commits      []CommitSummary `json:"commits"`
+	// CommitsTruncated is set when more commits than listed are part of the comparison.
+	CommitsTruncated bool              `json:"commits_truncated,omitempty"`
+	Files            []PullRequestFile `json:"files,omitempty"`
+	// FilesTruncated is set when the API stopped listing changed files at its limit.
+	FilesTruncated bool `json:"files_truncated,omitempty"`
+}
+
+// summarizeCommit converts a commit into its compact form, keeping the first line of its message.
+func summarizeCommit(commit *github.RepositoryCommit) CommitSummary {
+	author := commit.GetAuthor().GetLogin()
+	if author == "" {
+		author = commit.GetCommit().GetAuthor().GetName()
+	}
+	headline, _, _ := strings.Cut(commit.GetCommit().GetMessage(), "\n")
+	return CommitSummary{
+		SHA:     commit.GetSHA(),
+		Message: headline,
+		Author:  author,
+		Date:    summaryTimestamp(commit.GetCommit().GetAuthor().GetDate()),
+	}
+}
+
+// compareCommits compares base and head. go-github only supports three-dot comparisons, which
+// diff head against the merge base, so two-dot comparisons of base and head themselves build
+// the request directly.
+func compareCommits(ctx context.Context, client *github.Client, owner, repo, base, head string, twoDot bool, opts *github.ListOptions) (*github.CommitsComparison, *github.Response, error) {
+	if !twoDot {
+		return client.Repositories.CompareCommits(ctx, owner, repo, base, head, opts)
+	}
+	u := fmt.Sprintf("repos/%v/%v/compare/%v..%v?page=%d&per_page=%d", owner, repo, url.QueryEscape(base), url.QueryEscape(head), opts.Page, opts.PerPage)
+	req, err := client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	comparison := new(github.CommitsComparison)
+	resp, err := client.Do(ctx, req, comparison)
+	if err != nil {
+		return nil, resp, err
+	}
+	return comparison, resp, nil
+}
+
+// CompareRefs creates a tool to compare two branches, tags or commits of a repository.
+func CompareRefs(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("compare_refs",
+			mcp.WithDescription(t("TOOL_COMPARE_REFS_DESCRIPTION", "Compare two branches, tags or commits of a GitHub repository: how far head is ahead of and behind base, the commits in between and optionally the changed files. The API lists at most 250 commits and 300 files, so check the truncated flags before treating the result as complete.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_COMPARE_REFS_USER_TITLE", "Compare refs"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("base",
+				mcp.Required(),
+				mcp.Description("Branch, tag or commit SHA to compare from"),
+			),
+			mcp.WithString("head",
+				mcp.Required(),
+				mcp.Description("Branch, tag or commit SHA to compare to"),
+			),
+			mcp.WithString("comparison",
+				mcp.Description("three-dot compares head with its merge base with base, as pull requests do. two-dot compares head with base directly"),
+				mcp.Enum("three-dot", "two-dot"),
+				mcp.DefaultString("three-dot"),
+			),
+			mcp.WithBoolean("include_files",
+				mcp.Description("Include the changed files and their patches (default: false)"),
+			),
+			mcp.WithNumber("max_patch_bytes",
+				mcp.Description(fmt.Sprintf("Maximum size in bytes of each file's patch when include_files is true; longer patches are truncated (default: %d)", defaultMaxPatchBytes)),
+				mcp.Min(1),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			base, err := RequiredParam[string](request, "base")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			head, err := RequiredParam[string](request, "head")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			comparison, err := OptionalParam[string](request, "comparison")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if comparison != "" && comparison != "three-dot" && comparison != "two-dot" {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid comparison %q, must be three-dot or two-dot", comparison)), nil
+			}
+			includeFiles, err := OptionalParam[bool](request, "include_files")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxPatchBytes, err := OptionalIntParamWithDefault(request, "max_patch_bytes", defaultMaxPatchBytes)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if maxPatchBytes < 1 {
+				return mcp.NewToolResultError("max_patch_bytes must be at least 1"), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			opts := &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			}
+			result, resp, err := compareCommits(ctx, client, owner, repo, base, head, comparison == "two-dot", opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to compare %s with %s", base, head),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			refComparison := RefComparison{
+				Status:       result.GetStatus(),
+				AheadBy:      result.GetAheadBy(),
+				BehindBy:     result.GetBehindBy(),
+				TotalCommits: result.GetTotalCommits(),
+				MergeBaseSHA: result.GetMergeBaseCommit().GetSHA(),
+				HTMLURL:      result.GetHTMLURL(),
+				Commits:      make([]CommitSummary, 0, len(result.Commits)),
+			}
+			for _, commit := range result.Commits {
+				refComparison.Commits = append(refComparison.Commits, summarizeCommit(commit))
+			}
+			// The commits before the requested page count as listed.
+			listed := (max(pagination.Page, 1)-1)*pagination.PerPage + len(result.Commits)
+			refComparison.CommitsTruncated = listed < result.GetTotalCommits()
+			if includeFiles {
+				refComparison.Files = make([]PullRequestFile, 0, len(result.Files))
+				for _, file := range result.Files {
+					refComparison.Files = append(refComparison.Files, newPullRequestFile(file, true, maxPatchBytes))
+				}
+				refComparison.FilesTruncated = len(result.Files) >= compareFilesLimit
+			}
+
+			return MarshalledTextResult(refComparison), nil
+		}
+}
+
+// BranchSummary is the compact form of a branch returned by list_branches.
+type BranchSummary struct {
+	Name      string `json:"name"`
+	SHA       string `json:"sha"`
+	Protected bool   `json:"protected"`
+}
+
+// BranchList is the result of list_branches.
+type BranchList struct {
+	DefaultBranch string          `json:"default_branch"`
+	Branches      []BranchSummary `json:"branches"`
+	// Truncated is set when fetch_all stopped at max_items.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// summarizeBranches converts branches into their compact form, keeping those whose name contains
+// query, ignoring case.
+func summarizeBranches(branches []*github.Branch, query string) []BranchSummary {
+	query = strings.ToLower(query)
+	summaries := make([]BranchSummary, 0, len(branches))
+	for _, branch := range branches {
+		if !strings.Contains(strings.ToLower(branch.GetName()), query) {
+			continue
+		}
+		summaries = append(summaries, BranchSummary{
+			Name:      branch.GetName(),
+			SHA:       branch.GetCommit().GetSHA(),
+			Protected: branch.GetProtected(),
+		})
+	}
+	return summaries
+}
+
 // ListBranches creates a tool to list branches in a GitHub repository.
 func ListBranches(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("list_branches",
-			mcp.WithDescription(t("TOOL_LIST_BRANCHES_DESCRIPTION", "List branches in a GitHub repository")),
+			mcp.WithDescription(t("TOOL_LIST_BRANCHES_DESCRIPTION", "List branches in a GitHub repository with their head SHA and whether they are protected, along with the repository's default branch")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_LIST_BRANCHES_USER_TITLE", "List branches"),
 				ReadOnlyHint: ToBoolPtr(true),
@@ -199,7 +536,14 @@ func ListBranches(getClient GetClientFn, t translations.TranslationHelperFunc) (
 				mcp.Required(),
 				mcp.Description("Repository name"),
 			),
+			mcp.WithBoolean("protected_only",
+				mcp.Description("Only list protected branches"),
+			),
+			mcp.WithString("query",
+				mcp.Description("Only list branches whose name contains this text, ignoring case. Applied to the listed page, use fetch_all to search all branches"),
+			),
 			WithPagination(),
+			WithFetchAll(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](request, "owner")
@@ -210,6 +554,14 @@ func ListBranches(getClient GetClientFn, t translations.TranslationHelperFunc) (
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			protectedOnly, err := OptionalParam[bool](request, "protected_only")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			query, err := OptionalParam[string](request, "query")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 			pagination, err := OptionalPaginationParams(request)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
@@ -221,12 +573,41 @@ func ListBranches(getClient GetClientFn, t translations.TranslationHelperFunc) (
 					PerPage: pagination.PerPage,
 				},
 			}
+			if protectedOnly {
+				opts.Protected = github.Ptr(true)
+			}
 
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
 
+			repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get repository",
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			if pagination.FetchAll {
+				opts.PerPage = fetchAllPerPage
+				result, resp, err := fetchAllPagesFrom(ctx, pagination.Page, pagination.PerPage, pagination.MaxItems, func(page int) ([]*github.Branch, *github.Response, error) {
+					opts.Page = page
+					return client.Repositories.ListBranches(ctx, owner, repo, opts)
+				})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list branches", resp, err), nil
+				}
+				return MarshalledTextResult(BranchList{
+					DefaultBranch: repository.GetDefaultBranch(),
+					Branches:      summarizeBranches(result.Items, query),
+					Truncated:     result.Truncated,
+				}), nil
+			}
+
 			branches, resp, err := client.Repositories.ListBranches(ctx, owner, repo, opts)
 			if err != nil {
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
@@ -245,19 +626,26 @@ func ListBranches(getClient GetClientFn, t translations.TranslationHelperFunc) (
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list branches: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(branches)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledTextResult(BranchList{
+				DefaultBranch: repository.GetDefaultBranch(),
+				Branches:      summarizeBranches(branches, query),
+			}), nil
 		}
 }
 
+// FileCommit is the result of creating or updating a single file.
+type FileCommit struct {
+	Path      string `json:"path"`
+	SHA       string `json:"sha"`
+	Created   bool   `json:"created"`
+	CommitSHA string `json:"commit_sha"`
+	CommitURL string `json:"commit_url"`
+}
+
 // CreateOrUpdateFile creates a tool to create or update a file in a GitHub repository.
 func CreateOrUpdateFile(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("create_or_update_file",
-			mcp.WithDescription(t("TOOL_CREATE_OR_UPDATE_FILE_DESCRIPTION", "Create or update a single file in a GitHub repository. If updating, you must provide the SHA of the file you want to update. Use this tool to create or update a file in a GitHub repository remotely; do not use it for local file operations.")),
+			mcp.WithDescription(t("TOOL_CREATE_OR_UPDATE_FILE_DESCRIPTION", "Create or update a single file in a GitHub repository. When updating a file without providing its SHA, the SHA of the current file on the branch is used. Use this tool to create or update a file in a GitHub repository remotely; do not use it for local file operations.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_CREATE_OR_UPDATE_FILE_USER_TITLE", "Create or update file"),
 				ReadOnlyHint: ToBoolPtr(false),
@@ -276,7 +664,7 @@ func CreateOrUpdateFile(getClient GetClientFn, t translations.TranslationHelperF
 			),
 			mcp.WithString("content",
 				mcp.Required(),
-				mcp.Description("Content of the file"),
+				mcp.Description("Content of the file as plain text"),
 			),
 			mcp.WithString("message",
 				mcp.Required(),
@@ -287,7 +675,7 @@ func CreateOrUpdateFile(getClient GetClientFn, t translations.TranslationHelperF
 				mcp.Description("Branch to create/update the file in"),
 			),
 			mcp.WithString("sha",
-				mcp.Description("Required if updating an existing file. The blob SHA of the file being replaced."),
+				mcp.Description("The blob SHA of the file being replaced. If omitted, the SHA of the current file on the branch is looked up."),
 			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -331,15 +719,39 @@ func CreateOrUpdateFile(getClient GetClientFn, t translations.TranslationHelperF
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			if sha != "" {
-				opts.SHA = github.Ptr(sha)
-			}
 
 			// Create or update the file
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
+
+			// The API rejects updates without the SHA of the file being replaced, so look it up
+			// when the path already exists on the branch.
+			if sha == "" {
+				existing, dirContent, resp, err := client.Repositories.GetContents(ctx, owner, repo, path, &github.RepositoryContentGetOptions{Ref: branch})
+				switch {
+				case resp != nil && resp.StatusCode == http.StatusNotFound:
+					// The file does not exist yet and will be created.
+				case err != nil:
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to get current file",
+						resp,
+						err,
+					), nil
+				case dirContent != nil:
+					return mcp.NewToolResultError(fmt.Sprintf("path %q is a directory", path)), nil
+				default:
+					sha = existing.GetSHA()
+				}
+				if resp != nil {
+					_ = resp.Body.Close()
+				}
+			}
+			if sha != "" {
+				opts.SHA = github.Ptr(sha)
+			}
+
 			fileContent, resp, err := client.Repositories.CreateFile(ctx, owner, repo, path, opts)
 			if err != nil {
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
@@ -358,12 +770,13 @@ func CreateOrUpdateFile(getClient GetClientFn, t translations.TranslationHelperF
 				return mcp.NewToolResultError(fmt.Sprintf("failed to create/update file: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(fileContent)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledTextResult(FileCommit{
+				Path:      fileContent.GetContent().GetPath(),
+				SHA:       fileContent.GetContent().GetSHA(),
+				Created:   resp.StatusCode == http.StatusCreated,
+				CommitSHA: fileContent.Commit.GetSHA(),
+				CommitURL: fileContent.Commit.GetHTMLURL(),
+			}), nil
 		}
 }
 
@@ -445,10 +858,56 @@ func CreateRepository(getClient GetClientFn, t translations.TranslationHelperFun
 		}
 }
 
+// defaultFileContentsMaxBytes is the number of bytes of a file get_file_contents returns unless max_bytes is given.
+const defaultFileContentsMaxBytes = 1 << 20
+
+// DirectoryEntry is an entry of a directory listed by get_file_contents.
+type DirectoryEntry struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Size int    `json:"size"`
+	Path string `json:"path"`
+}
+
+// BinaryFile describes a binary file whose content get_file_contents does not return.
+type BinaryFile struct {
+	Path        string `json:"path"`
+	SHA         string `json:"sha"`
+	Size        int    `json:"size"`
+	MIMEType    string `json:"mime_type,omitempty"`
+	Binary      bool   `json:"binary"`
+	DownloadURL string `json:"download_url,omitempty"`
+}
+
+// isBinaryContent reports whether file content is binary, based on its content type and, as git does,
+// on whether its first bytes contain a NUL byte.
+func isBinaryContent(contentType string, content []byte) bool {
+	switch {
+	case strings.HasPrefix(contentType, "text"):
+		return false
+	case strings.HasPrefix(contentType, "image"), strings.HasPrefix(contentType, "audio"), strings.HasPrefix(contentType, "video"):
+		return true
+	}
+	const sniffLen = 8000
+	return bytes.IndexByte(content[:min(len(content), sniffLen)], 0) >= 0
+}
+
+// truncateContent cuts content to at most maxBytes bytes without splitting a UTF-8 sequence.
+func truncateContent(content []byte, maxBytes int) ([]byte, bool) {
+	if len(content) <= maxBytes {
+		return content, false
+	}
+	n := maxBytes
+	for n > 0 && !utf8.RuneStart(content[n]) {
+		n--
+	}
+	return content[:n], true
+}
+
 // GetFileContents creates a tool to get the contents of a file or directory from a GitHub repository.
 func GetFileContents(getClient GetClientFn, getRawClient raw.GetRawClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("get_file_contents",
-			mcp.WithDescription(t("TOOL_GET_FILE_CONTENTS_DESCRIPTION", "Get the contents of a file or directory from a GitHub repository")),
+			mcp.WithDescription(t("TOOL_GET_FILE_CONTENTS_DESCRIPTION", "Get the contents of a file or directory from a GitHub repository. Files are returned as text, binary files are described with a download URL instead, and directories are returned as a listing of their entries.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_GET_FILE_CONTENTS_USER_TITLE", "Get file or directory contents"),
 				ReadOnlyHint: ToBoolPtr(true),
@@ -471,6 +930,11 @@ func GetFileContents(getClient GetClientFn, getRawClient raw.GetRawClientFn, t t
 			mcp.WithString("sha",
 				mcp.Description("Accepts optional commit SHA. If specified, it will be used instead of ref"),
 			),
+			mcp.WithNumber("max_bytes",
+				mcp.Description("Maximum number of bytes of file content to return. Larger files are truncated."),
+				mcp.Min(1),
+				mcp.DefaultNumber(defaultFileContentsMaxBytes),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](request, "owner")
@@ -493,6 +957,13 @@ func GetFileContents(getClient GetClientFn, getRawClient raw.GetRawClientFn, t t
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			maxBytes, err := OptionalIntParamWithDefault(request, "max_bytes", defaultFileContentsMaxBytes)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if maxBytes < 1 {
+				return mcp.NewToolResultError("max_bytes must be at least 1"), nil
+			}
 
 			client, err := getClient(ctx)
 			if err != nil {
@@ -538,13 +1009,45 @@ func GetFileContents(getClient GetClientFn, getRawClient raw.GetRawClientFn, t t
 					_ = resp.Body.Close()
 				}()
 
+				var body []byte
+				var contentType string
+				found := false
 				if resp.StatusCode == http.StatusOK {
-					// If the raw content is found, return it directly
-					body, err := io.ReadAll(resp.Body)
+					// Read one byte more than requested to detect whether the file is truncated.
+					body, err = io.ReadAll(io.LimitReader(resp.Body, int64(maxBytes)+1))
 					if err != nil {
 						return mcp.NewToolResultError("failed to read response body"), nil
 					}
-					contentType := resp.Header.Get("Content-Type")
+					contentType = resp.Header.Get("Content-Type")
+					found = true
+				} else {
+					// The raw content is not always available, for example for files larger than the
+					// contents API serves, so fall back to the Git blob of the file.
+					blob, err := getBlobRawLimited(ctx, client, owner, repo, fileSHA, int64(maxBytes)+1)
+					if err == nil {
+						body = blob
+						contentType = http.DetectContentType(blob)
+						found = true
+					}
+				}
+
+				if found {
+					size := fileContent.GetSize()
+					if size == 0 {
+						size = len(body)
+					}
+					if isBinaryContent(contentType, body) {
+						return MarshalledTextResult(BinaryFile{
+							Path:        fileContent.GetPath(),
+							SHA:         fileSHA,
+							Size:        size,
+							MIMEType:    contentType,
+							Binary:      true,
+							DownloadURL: fileContent.GetDownloadURL(),
+						}), nil
+					}
+					var truncated bool
+					body, truncated = truncateContent(body, maxBytes)
 
 					var resourceURI string
 					switch {
@@ -565,30 +1068,17 @@ func GetFileContents(getClient GetClientFn, getRawClient raw.GetRawClientFn, t t
 						}
 					}
 
-					if strings.HasPrefix(contentType, "application") || strings.HasPrefix(contentType, "text") {
-						result := mcp.TextResourceContents{
-							URI:      resourceURI,
-							Text:     string(body),
-							MIMEType: contentType,
-						}
-						// Include SHA in the result metadata
-						if fileSHA != "" {
-							return mcp.NewToolResultResource(fmt.Sprintf("successfully downloaded text file (SHA: %s)", fileSHA), result), nil
-						}
-						return mcp.NewToolResultResource("successfully downloaded text file", result), nil
-					}
-
-					result := mcp.BlobResourceContents{
+					result := mcp.TextResourceContents{
 						URI:      resourceURI,
-						Blob:     base64.StdEncoding.EncodeToString(body),
+						Text:     string(body),
 						MIMEType: contentType,
 					}
-					// Include SHA in the result metadata
-					if fileSHA != "" {
-						return mcp.NewToolResultResource(fmt.Sprintf("successfully downloaded binary file (SHA: %s)", fileSHA), result), nil
+					// Include SHA, size and truncation in the result metadata
+					message := fmt.Sprintf("successfully downloaded text file (SHA: %s, size: %d bytes)", fileSHA, size)
+					if truncated {
+						message += fmt.Sprintf("; truncated: true, only the first %d bytes are included, use max_bytes to get more", len(body))
 					}
-					return mcp.NewToolResultResource("successfully downloaded binary file", result), nil
-
+					return mcp.NewToolResultResource(message, result), nil
 				}
 			}
 
@@ -600,7 +1090,16 @@ func GetFileContents(getClient GetClientFn, getRawClient raw.GetRawClientFn, t t
 				_, dirContent, resp, err := client.Repositories.GetContents(ctx, owner, repo, path, opts)
 				if err == nil && resp.StatusCode == http.StatusOK {
 					defer func() { _ = resp.Body.Close() }()
-					r, err := json.Marshal(dirContent)
+					entries := make([]DirectoryEntry, 0, len(dirContent))
+					for _, content := range dirContent {
+						entries = append(entries, DirectoryEntry{
+							Name: content.GetName(),
+							Type: content.GetType(),
+							Size: content.GetSize(),
+							Path: content.GetPath(),
+						})
+					}
+					r, err := json.Marshal(entries)
 					if err != nil {
 						return mcp.NewToolResultError("failed to marshal response"), nil
 					}
@@ -641,13 +1140,48 @@ func GetFileContents(getClient GetClientFn, getRawClient raw.GetRawClientFn, t t
 		}
 }
 
-// ForkRepository creates a tool to fork a repository.
-func ForkRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("fork_repository",
-			mcp.WithDescription(t("TOOL_FORK_REPOSITORY_DESCRIPTION", "Fork a GitHub repository to your account or specified organization")),
+// defaultTreeMaxEntries is the number of entries get_repository_tree returns unless max_entries is given.
+const defaultTreeMaxEntries = 2000
+
+// RepositoryTreeEntry is a file or directory listed by get_repository_tree.
+type RepositoryTreeEntry struct {
+	Path string `json:"path"`
+	Type string `json:"type"`
+	Size int    `json:"size,omitempty"`
+	SHA  string `json:"sha"`
+}
+
+// RepositoryTree is the result of get_repository_tree.
+type RepositoryTree struct {
+	SHA     string                `json:"sha"`
+	Entries []RepositoryTreeEntry `json:"entries"`
+	// Truncated is set when the API or max_entries cut the listing short, Note explains which.
+	Truncated bool   `json:"truncated"`
+	Note      string `json:"note,omitempty"`
+}
+
+// matchTreeEntry reports whether a tree entry path has the given prefix and matches the glob
+// pattern. Patterns without a slash are matched against the base name, others against the full path.
+func matchTreeEntry(entryPath, prefix, pattern string) (bool, error) {
+	if !strings.HasPrefix(entryPath, prefix) {
+		return false, nil
+	}
+	if pattern == "" {
+		return true, nil
+	}
+	if !strings.Contains(pattern, "/") {
+		return path.Match(pattern, path.Base(entryPath))
+	}
+	return path.Match(pattern, entryPath)
+}
+
+// GetRepositoryTree creates a tool to list the file tree of a repository.
+func GetRepositoryTree(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_repository_tree",
+			mcp.WithDescription(t("TOOL_GET_REPOSITORY_TREE_DESCRIPTION", "List the files and directories of a GitHub repository at a ref, with their type, size and SHA. Use recursive to list the whole tree at once, and path_prefix or pattern to narrow it down.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				Title:        t("TOOL_FORK_REPOSITORY_USER_TITLE", "Fork repository"),
-				ReadOnlyHint: ToBoolPtr(false),
+				Title:        t("TOOL_GET_REPOSITORY_TREE_USER_TITLE", "Get repository tree"),
+				ReadOnlyHint: ToBoolPtr(true),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -657,8 +1191,22 @@ func ForkRepository(getClient GetClientFn, t translations.TranslationHelperFunc)
 				mcp.Required(),
 				mcp.Description("Repository name"),
 			),
-			mcp.WithString("organization",
-				mcp.Description("Organization to fork to"),
+			mcp.WithString("ref",
+				mcp.Description("Branch, tag or commit SHA to list the tree of. Defaults to the default branch"),
+			),
+			mcp.WithBoolean("recursive",
+				mcp.Description("List the entries of all subdirectories instead of only the top level (default: false)"),
+			),
+			mcp.WithString("path_prefix",
+				mcp.Description("Only list entries whose path starts with this prefix, e.g. 'src/'"),
+			),
+			mcp.WithString("pattern",
+				mcp.Description("Only list entries matching this glob pattern. Patterns without a slash, e.g. '*.go', match the file name, others match the full path"),
+			),
+			mcp.WithNumber("max_entries",
+				mcp.Description("Maximum number of entries to return"),
+				mcp.Min(1),
+				mcp.DefaultNumber(defaultTreeMaxEntries),
 			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -670,27 +1218,155 @@ func ForkRepository(getClient GetClientFn, t translations.TranslationHelperFunc)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			org, err := OptionalParam[string](request, "organization")
+			ref, err := OptionalParam[string](request, "ref")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-
-			opts := &github.RepositoryCreateForkOptions{}
-			if org != "" {
-				opts.Organization = org
+			recursive, err := OptionalParam[bool](request, "recursive")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
 			}
-
-			client, err := getClient(ctx)
+			pathPrefix, err := OptionalParam[string](request, "path_prefix")
 			if err != nil {
-				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+				return mcp.NewToolResultError(err.Error()), nil
 			}
-			forkedRepo, resp, err := client.Repositories.CreateFork(ctx, owner, repo, opts)
+			pattern, err := OptionalParam[string](request, "pattern")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if _, err := path.Match(pattern, ""); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid pattern %q: %s", pattern, err)), nil
+			}
+			maxEntries, err := OptionalIntParamWithDefault(request, "max_entries", defaultTreeMaxEntries)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if maxEntries < 1 {
+				return mcp.NewToolResultError("max_entries must be at least 1"), nil
+			}
+			pathPrefix = strings.TrimPrefix(pathPrefix, "/")
+			if ref == "" {
+				ref = "HEAD"
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// Resolve the ref to the tree of the commit it points to.
+			commitSHA, resp, err := client.Repositories.GetCommitSHA1(ctx, owner, repo, ref, "")
 			if err != nil {
-				// Check if it's an acceptedError. An acceptedError indicates that the update is in progress,
-				// and it's not a real error.
-				if resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err) {
-					return mcp.NewToolResultText("Fork is in progress"), nil
-				}
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to resolve ref: %s", ref),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+			commit, resp, err := client.Git.GetCommit(ctx, owner, repo, commitSHA)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get commit",
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			tree, resp, err := client.Git.GetTree(ctx, owner, repo, commit.GetTree().GetSHA(), recursive)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get git tree",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := RepositoryTree{
+				SHA:     tree.GetSHA(),
+				Entries: []RepositoryTreeEntry{},
+			}
+			if tree.GetTruncated() {
+				result.Truncated = true
+				result.Note = "The tree is too large for the API to list completely; narrow it down with path_prefix."
+			}
+			for _, entry := range tree.Entries {
+				ok, err := matchTreeEntry(entry.GetPath(), pathPrefix, pattern)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("invalid pattern %q: %s", pattern, err)), nil
+				}
+				if !ok {
+					continue
+				}
+				if len(result.Entries) == maxEntries {
+					result.Truncated = true
+					result.Note = fmt.Sprintf("Only the first %d matching entries are listed; narrow the listing down with path_prefix or pattern, or raise max_entries.", maxEntries)
+					break
+				}
+				result.Entries = append(result.Entries, RepositoryTreeEntry{
+					Path: entry.GetPath(),
+					Type: entry.GetType(),
+					Size: entry.GetSize(),
+					SHA:  entry.GetSHA(),
+				})
+			}
+
+			return MarshalledTextResult(result), nil
+		}
+}
+
+// ForkRepository creates a tool to fork a repository.
+func ForkRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("fork_repository",
+			mcp.WithDescription(t("TOOL_FORK_REPOSITORY_DESCRIPTION", "Fork a GitHub repository to your account or specified organization")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_FORK_REPOSITORY_USER_TITLE", "Fork repository"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("organization",
+				mcp.Description("Organization to fork to"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			org, err := OptionalParam[string](request, "organization")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.RepositoryCreateForkOptions{}
+			if org != "" {
+				opts.Organization = org
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			forkedRepo, resp, err := client.Repositories.CreateFork(ctx, owner, repo, opts)
+			if err != nil {
+				// Check if it's an acceptedError. An acceptedError indicates that the update is in progress,
+				// and it's not a real error.
+				if resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err) {
+					return mcp.NewToolResultText("Fork is in progress"), nil
+				}
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
 					"failed to fork repository",
 					resp,
@@ -716,6 +1392,95 @@ func ForkRepository(getClient GetClientFn, t translations.TranslationHelperFunc)
 		}
 }
 
+// ListForks creates a tool to list the forks of a repository.
+func ListForks(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_forks",
+			mcp.WithDescription(t("TOOL_LIST_FORKS_DESCRIPTION", "List the forks of a GitHub repository with their owner, stars, default branch and when they were last pushed to")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_FORKS_USER_TITLE", "List forks"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Sort order of the forks"),
+				mcp.Enum("newest", "oldest", "stargazers", "watchers"),
+			),
+			mcp.WithString("active_since",
+				mcp.Description("Only list forks pushed to since this time, as an ISO 8601 timestamp (YYYY-MM-DDTHH:MM:SSZ or YYYY-MM-DD) or relative such as 7d. Applied to the listed page"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sort, err := OptionalParam[string](request, "sort")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			activeSince, err := OptionalParam[string](request, "active_since")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			var since time.Time
+			if activeSince != "" {
+				since, err = parseISOTimestamp(activeSince)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to parse active_since: %s", err)), nil
+				}
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.RepositoryListForksOptions{
+				Sort: sort,
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			forks, resp, err := client.Repositories.ListForks(ctx, owner, repo, opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to list forks",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			summaries := make([]RepositorySummary, 0, len(forks))
+			for _, fork := range forks {
+				if !since.IsZero() && fork.GetPushedAt().Before(since) {
+					continue
+				}
+				summaries = append(summaries, summarizeRepository(fork))
+			}
+
+			return MarshalledTextResult(summaries), nil
+		}
+}
+
 // DeleteFile creates a tool to delete a file in a GitHub repository.
 // This tool uses a more roundabout way of deleting a file than just using the client.Repositories.DeleteFile.
 // This is because REST file deletion endpoint (and client.Repositories.DeleteFile) don't add commit signing to the deletion commit,
@@ -993,10 +1758,45 @@ func CreateBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (
 		}
 }
 
+// PushedCommit is the commit created by push_files.
+type PushedCommit struct {
+	SHA string `json:"sha"`
+	URL string `json:"url"`
+}
+
+// pushFilesConflictError returns the tool error reported when the branch moved while files were pushed.
+func pushFilesConflictError(branch, expectedHead string) *mcp.CallToolResult {
+	return mcp.NewToolResultError(fmt.Sprintf("branch %s no longer points to %s because it was updated while the files were pushed; retry the push to commit on top of the new head", branch, expectedHead))
+}
+
+// createCommitOnBranchSupported reports whether the GraphQL schema has the createCommitOnBranch
+// mutation, which older GitHub Enterprise Server versions lack.
+func createCommitOnBranchSupported(ctx context.Context, gqlClient *githubv4.Client) (bool, error) {
+	var query struct {
+		Type *struct {
+			Name githubv4.String
+		} `graphql:"__type(name: \"CreateCommitOnBranchInput\")"`
+	}
+	if err := gqlClient.Query(ctx, &query, nil); err != nil {
+		return false, err
+	}
+	return query.Type != nil, nil
+}
+
+// branchMoved reports whether the branch no longer points to expectedHead.
+func branchMoved(ctx context.Context, client *github.Client, owner, repo, branch, expectedHead string) (bool, error) {
+	ref, resp, err := client.Git.GetRef(ctx, owner, repo, "refs/heads/"+branch)
+	if err != nil {
+		return false, err
+	}
+	_ = resp.Body.Close()
+	return ref.GetObject().GetSHA() != expectedHead, nil
+}
+
 // PushFiles creates a tool to push multiple files in a single commit to a GitHub repository.
-func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+func PushFiles(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("push_files",
-			mcp.WithDescription(t("TOOL_PUSH_FILES_DESCRIPTION", "Push multiple files to a GitHub repository in a single commit")),
+			mcp.WithDescription(t("TOOL_PUSH_FILES_DESCRIPTION", "Push multiple files to a GitHub repository in a single commit, optionally deleting files in the same commit")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_PUSH_FILES_USER_TITLE", "Push files to repository"),
 				ReadOnlyHint: ToBoolPtr(false),
@@ -1033,6 +1833,14 @@ func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 					}),
 				mcp.Description("Array of file objects to push, each object with path (string) and content (string)"),
 			),
+			mcp.WithArray("deletions",
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+				mcp.Description("Paths of files to delete in the same commit"),
+			),
 			mcp.WithString("message",
 				mcp.Required(),
 				mcp.Description("Commit message"),
@@ -1055,6 +1863,10 @@ func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			deletions, err := OptionalStringArrayParam(request, "deletions")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
 			// Parse files parameter - this should be an array of objects with path and content
 			filesObj, ok := request.GetArguments()["files"].([]interface{})
@@ -1062,36 +1874,8 @@ func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 				return mcp.NewToolResultError("files parameter must be an array of objects with path and content"), nil
 			}
 
-			client, err := getClient(ctx)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
-			}
-
-			// Get the reference for the branch
-			ref, resp, err := client.Git.GetRef(ctx, owner, repo, "refs/heads/"+branch)
-			if err != nil {
-				return ghErrors.NewGitHubAPIErrorResponse(ctx,
-					"failed to get branch reference",
-					resp,
-					err,
-				), nil
-			}
-			defer func() { _ = resp.Body.Close() }()
-
-			// Get the commit object that the branch points to
-			baseCommit, resp, err := client.Git.GetCommit(ctx, owner, repo, *ref.Object.SHA)
-			if err != nil {
-				return ghErrors.NewGitHubAPIErrorResponse(ctx,
-					"failed to get base commit",
-					resp,
-					err,
-				), nil
-			}
-			defer func() { _ = resp.Body.Close() }()
-
-			// Create tree entries for all files
+			var additions []githubv4.FileAddition
 			var entries []*github.TreeEntry
-
 			for _, file := range filesObj {
 				fileMap, ok := file.(map[string]interface{})
 				if !ok {
@@ -1108,6 +1892,10 @@ func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 					return mcp.NewToolResultError("each file must have content"), nil
 				}
 
+				additions = append(additions, githubv4.FileAddition{
+					Path:     githubv4.String(path),
+					Contents: githubv4.Base64String(base64.StdEncoding.EncodeToString([]byte(content))),
+				})
 				// Create a tree entry for the file
 				entries = append(entries, &github.TreeEntry{
 					Path:    github.Ptr(path),
@@ -1117,6 +1905,106 @@ func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 				})
 			}
 
+			var fileDeletions []githubv4.FileDeletion
+			for _, path := range deletions {
+				fileDeletions = append(fileDeletions, githubv4.FileDeletion{Path: githubv4.String(path)})
+				// A tree entry without SHA and content deletes the file
+				entries = append(entries, &github.TreeEntry{
+					Path: github.Ptr(path),
+					Mode: github.Ptr("100644"),
+					Type: github.Ptr("blob"),
+				})
+			}
+			if len(entries) == 0 {
+				return mcp.NewToolResultError("at least one file or deletion is required"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// Get the reference for the branch
+			ref, resp, err := client.Git.GetRef(ctx, owner, repo, "refs/heads/"+branch)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get branch reference",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+			headSHA := ref.GetObject().GetSHA()
+
+			// createCommitOnBranch creates verified commits, so prefer it over the Git data API.
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+
+			headline, body, _ := strings.Cut(message, "\n")
+			commitMessage := githubv4.CommitMessage{Headline: githubv4.String(headline)}
+			if body = strings.TrimLeft(body, "\n"); body != "" {
+				commitMessage.Body = githubv4.NewString(githubv4.String(body))
+			}
+			fileChanges := &githubv4.FileChanges{}
+			if len(additions) > 0 {
+				fileChanges.Additions = &additions
+			}
+			if len(fileDeletions) > 0 {
+				fileChanges.Deletions = &fileDeletions
+			}
+
+			var mutation struct {
+				CreateCommitOnBranch struct {
+					Commit struct {
+						Oid githubv4.GitObjectID
+						URL githubv4.URI
+					}
+				} `graphql:"createCommitOnBranch(input: $input)"`
+			}
+			err = gqlClient.Mutate(ctx, &mutation, githubv4.CreateCommitOnBranchInput{
+				Branch: githubv4.CommittableBranch{
+					RepositoryNameWithOwner: githubv4.NewString(githubv4.String(owner + "/" + repo)),
+					BranchName:              githubv4.NewString(githubv4.String(branch)),
+				},
+				Message:         commitMessage,
+				ExpectedHeadOid: githubv4.GitObjectID(headSHA),
+				FileChanges:     fileChanges,
+			}, nil)
+			if err == nil {
+				commit := mutation.CreateCommitOnBranch.Commit
+				return MarshalledTextResult(PushedCommit{
+					SHA: string(commit.Oid),
+					URL: commit.URL.String(),
+				}), nil
+			}
+			// The GraphQL client drops the type of errors, so work out their cause from the schema
+			// and the branch rather than from the message.
+			supported, supportErr := createCommitOnBranchSupported(ctx, gqlClient)
+			if supportErr != nil || supported {
+				if moved, _ := branchMoved(ctx, client, owner, repo, branch, headSHA); moved {
+					return pushFilesConflictError(branch, headSHA), nil
+				}
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+					"failed to create commit",
+					err,
+				), nil
+			}
+
+			// GitHub Enterprise Server versions without createCommitOnBranch fall back to the Git data API.
+
+			// Get the commit object that the branch points to
+			baseCommit, resp, err := client.Git.GetCommit(ctx, owner, repo, headSHA)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get base commit",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
 			// Create a new tree with the file entries
 			newTree, resp, err := client.Git.CreateTree(ctx, owner, repo, *baseCommit.Tree.SHA, entries)
 			if err != nil {
@@ -1144,10 +2032,14 @@ func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			// Update the reference to point to the new commit
+			// Update the reference to point to the new commit. Without force this fails when
+			// the branch moved since it was read.
 			ref.Object.SHA = newCommit.SHA
-			updatedRef, resp, err := client.Git.UpdateRef(ctx, owner, repo, ref, false)
+			_, resp, err = client.Git.UpdateRef(ctx, owner, repo, ref, false)
 			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity {
+					return pushFilesConflictError(branch, headSHA), nil
+				}
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
 					"failed to update reference",
 					resp,
@@ -1156,19 +2048,122 @@ func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			r, err := json.Marshal(updatedRef)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
+			return MarshalledTextResult(PushedCommit{
+				SHA: newCommit.GetSHA(),
+				URL: newCommit.GetHTMLURL(),
+			}), nil
+		}
+}
 
-			return mcp.NewToolResultText(string(r)), nil
+// TagSummary is the compact form of a tag returned by list_tags.
+type TagSummary struct {
+	Name       string `json:"name"`
+	SHA        string `json:"sha"`
+	TarballURL string `json:"tarball_url,omitempty"`
+	ZipballURL string `json:"zipball_url,omitempty"`
+	// CommitDate is only set when include_commit_dates is true.
+	CommitDate string `json:"commit_date,omitempty"`
+}
+
+// semverTag is a parsed semantic version tag such as v1.2.3-rc.1.
+type semverTag struct {
+	major, minor, patch int
+	prerelease          []string
+}
+
+// parseSemverTag parses name as a semantic version, allowing a leading "v" and ignoring build
+// metadata. It reports false when name is not a semantic version.
+func parseSemverTag(name string) (semverTag, bool) {
+	version := strings.TrimPrefix(strings.TrimPrefix(name, "v"), "V")
+	version, _, _ = strings.Cut(version, "+")
+	core, prerelease, hasPrerelease := strings.Cut(version, "-")
+	parts := strings.Split(core, ".")
+	if len(parts) != 3 {
+		return semverTag{}, false
+	}
+	var numbers [3]int
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return semverTag{}, false
+		}
+		numbers[i] = n
+	}
+	tag := semverTag{major: numbers[0], minor: numbers[1], patch: numbers[2]}
+	if hasPrerelease {
+		if prerelease == "" {
+			return semverTag{}, false
+		}
+		tag.prerelease = strings.Split(prerelease, ".")
+	}
+	return tag, true
+}
+
+// compareSemverTags orders a and b by semantic version precedence.
+func compareSemverTags(a, b semverTag) int {
+	if c := cmp.Compare(a.major, b.major); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(a.minor, b.minor); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(a.patch, b.patch); c != 0 {
+		return c
+	}
+	// A release has higher precedence than any of its pre-releases.
+	switch {
+	case len(a.prerelease) == 0 && len(b.prerelease) == 0:
+		return 0
+	case len(a.prerelease) == 0:
+		return 1
+	case len(b.prerelease) == 0:
+		return -1
+	}
+	for i := 0; i < len(a.prerelease) && i < len(b.prerelease); i++ {
+		x, y := a.prerelease[i], b.prerelease[i]
+		xn, xErr := strconv.Atoi(x)
+		yn, yErr := strconv.Atoi(y)
+		var c int
+		switch {
+		case xErr == nil && yErr == nil:
+			c = cmp.Compare(xn, yn)
+		case xErr == nil:
+			c = -1
+		case yErr == nil:
+			c = 1
+		default:
+			c = strings.Compare(x, y)
+		}
+		if c != 0 {
+			return c
 		}
+	}
+	return cmp.Compare(len(a.prerelease), len(b.prerelease))
+}
+
+// sortTagsBySemver orders tags from the highest version to the lowest. Tags that are not
+// semantic versions keep their relative order after the versioned ones.
+func sortTagsBySemver(tags []TagSummary) {
+	slices.SortStableFunc(tags, func(a, b TagSummary) int {
+		av, aOK := parseSemverTag(a.Name)
+		bv, bOK := parseSemverTag(b.Name)
+		switch {
+		case aOK && bOK:
+			return compareSemverTags(bv, av)
+		case aOK:
+			return -1
+		case bOK:
+			return 1
+		default:
+			return 0
+		}
+	})
 }
 
 // ListTags creates a tool to list tags in a GitHub repository.
 func ListTags(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("list_tags",
-			mcp.WithDescription(t("TOOL_LIST_TAGS_DESCRIPTION", "List git tags in a GitHub repository")),
+			mcp.WithDescription(t("TOOL_LIST_TAGS_DESCRIPTION", "List git tags in a GitHub repository with their commit SHA and archive URLs, optionally filtered by prefix and sorted by semantic version")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_LIST_TAGS_USER_TITLE", "List tags"),
 				ReadOnlyHint: ToBoolPtr(true),
@@ -1181,6 +2176,16 @@ func ListTags(getClient GetClientFn, t translations.TranslationHelperFunc) (tool
 				mcp.Required(),
 				mcp.Description("Repository name"),
 			),
+			mcp.WithString("prefix",
+				mcp.Description("Only return tags whose name starts with this prefix, e.g. 'v1.'. Applied to the requested page"),
+			),
+			mcp.WithBoolean("include_commit_dates",
+				mcp.Description("Look up the commit date of each returned tag, one request per tag on the page (default: false)"),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Sort the returned page: 'semver' orders semantic versions from highest to lowest, with other tags after them. Defaults to the API order"),
+				mcp.Enum("semver"),
+			),
 			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -1192,6 +2197,21 @@ func ListTags(getClient GetClientFn, t translations.TranslationHelperFunc) (tool
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			prefix, err := OptionalParam[string](request, "prefix")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			includeCommitDates, err := OptionalParam[bool](request, "include_commit_dates")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sortBy, err := OptionalParam[string](request, "sort")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if sortBy != "" && sortBy != "semver" {
+				return mcp.NewToolResultError(fmt.Sprintf("unsupported sort: %s", sortBy)), nil
+			}
 			pagination, err := OptionalPaginationParams(request)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
@@ -1225,12 +2245,47 @@ func ListTags(getClient GetClientFn, t translations.TranslationHelperFunc) (tool
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list tags: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(tags)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			summaries := make([]TagSummary, 0, len(tags))
+			for _, tag := range tags {
+				if !strings.HasPrefix(tag.GetName(), prefix) {
+					continue
+				}
+				summaries = append(summaries, TagSummary{
+					Name:       tag.GetName(),
+					SHA:        tag.GetCommit().GetSHA(),
+					TarballURL: tag.GetTarballURL(),
+					ZipballURL: tag.GetZipballURL(),
+				})
 			}
 
-			return mcp.NewToolResultText(string(r)), nil
+			if includeCommitDates {
+				// Several tags can point at the same commit, so each commit is looked up once.
+				// The lookups are bounded by the page size.
+				dates := make(map[string]string, len(summaries))
+				for i, summary := range summaries {
+					date, ok := dates[summary.SHA]
+					if !ok {
+						commit, resp, err := client.Git.GetCommit(ctx, owner, repo, summary.SHA)
+						if err != nil {
+							return ghErrors.NewGitHubAPIErrorResponse(ctx,
+								fmt.Sprintf("failed to get commit %s for tag %s", summary.SHA, summary.Name),
+								resp,
+								err,
+							), nil
+						}
+						_ = resp.Body.Close()
+						date = summaryTimestamp(commit.GetCommitter().GetDate())
+						dates[summary.SHA] = date
+					}
+					summaries[i].CommitDate = date
+				}
+			}
+
+			if sortBy == "semver" {
+				sortTagsBySemver(summaries)
+			}
+
+			return MarshalledTextResult(summaries), nil
 		}
 }
 
@@ -1321,6 +2376,206 @@ func GetTag(getClient GetClientFn, t translations.TranslationHelperFunc) (tool m
 		}
 }
 
+// maxTopicLength is the longest repository topic GitHub accepts.
+const maxTopicLength = 50
+
+var topicRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+// validateTopic checks that topic is a valid repository topic, suggesting a valid form when it can.
+func validateTopic(topic string) error {
+	if len(topic) > maxTopicLength {
+		return fmt.Errorf("invalid topic %q: topics must be at most %d characters", topic, maxTopicLength)
+	}
+	if topicRegexp.MatchString(topic) {
+		return nil
+	}
+	suggestion := strings.Trim(strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r + 'a' - 'A'
+		case r == ' ' || r == '_' || r == '.':
+			return '-'
+		default:
+			return -1
+		}
+	}, topic), "-")
+	if suggestion != "" && topicRegexp.MatchString(suggestion) && len(suggestion) <= maxTopicLength {
+		return fmt.Errorf("invalid topic %q: topics may only contain lowercase letters, numbers and hyphens and must start with a letter or number, e.g. %q", topic, suggestion)
+	}
+	return fmt.Errorf("invalid topic %q: topics may only contain lowercase letters, numbers and hyphens and must start with a letter or number", topic)
+}
+
+// mergeTopics applies add and remove to topics, keeping the order of the existing topics and
+// dropping duplicates.
+func mergeTopics(topics, add, remove []string) []string {
+	removed := make(map[string]bool, len(remove))
+	for _, topic := range remove {
+		removed[topic] = true
+	}
+	seen := make(map[string]bool, len(topics)+len(add))
+	merged := make([]string, 0, len(topics)+len(add))
+	for _, topic := range slices.Concat(topics, add) {
+		if removed[topic] || seen[topic] {
+			continue
+		}
+		seen[topic] = true
+		merged = append(merged, topic)
+	}
+	return merged
+}
+
+// GetRepositoryTopics creates a tool to get the topics of a repository.
+func GetRepositoryTopics(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_repository_topics",
+			mcp.WithDescription(t("TOOL_GET_REPOSITORY_TOPICS_DESCRIPTION", "Get the topics of a GitHub repository")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_REPOSITORY_TOPICS_USER_TITLE", "Get repository topics"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			topics, resp, err := client.Repositories.ListAllTopics(ctx, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get repository topics",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(topics), nil
+		}
+}
+
+// UpdateRepositoryTopics creates a tool to replace, add or remove the topics of a repository.
+func UpdateRepositoryTopics(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_repository_topics",
+			mcp.WithDescription(t("TOOL_UPDATE_REPOSITORY_TOPICS_DESCRIPTION", "Update the topics of a GitHub repository. Use add and remove to change some topics while keeping the others, or topics to replace them all. Returns the resulting topics")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UPDATE_REPOSITORY_TOPICS_USER_TITLE", "Update repository topics"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithArray("topics",
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+				mcp.Description("Topics replacing all current topics; an empty array removes them all. add and remove are applied on top of it"),
+			),
+			mcp.WithArray("add",
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+				mcp.Description("Topics to add to the current topics"),
+			),
+			mcp.WithArray("remove",
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+				mcp.Description("Topics to remove from the current topics"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			_, replace := request.GetArguments()["topics"]
+			topics, err := OptionalStringArrayParam(request, "topics")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			add, err := OptionalStringArrayParam(request, "add")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			remove, err := OptionalStringArrayParam(request, "remove")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if !replace && len(add) == 0 && len(remove) == 0 {
+				return mcp.NewToolResultError("at least one of topics, add or remove must be provided"), nil
+			}
+			for _, topic := range slices.Concat(topics, add) {
+				if err := validateTopic(topic); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			if !replace {
+				current, resp, err := client.Repositories.ListAllTopics(ctx, owner, repo)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to get repository topics",
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+				topics = current
+			}
+
+			updated, resp, err := client.Repositories.ReplaceAllTopics(ctx, owner, repo, mergeTopics(topics, add, remove))
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to update repository topics",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(updated), nil
+		}
+}
+
 // filterPaths filters the entries in a GitHub tree to find paths that
 // match the given suffix.
 // maxResults limits the number of results returned to first maxResults entries,
@@ -1391,3 +2646,73 @@ func resolveGitReference(ctx context.Context, githubClient *github.Client, owner
 	// Use provided ref, or it will be empty which defaults to the default branch
 	return &raw.ContentOpts{Ref: ref, SHA: sha}, nil
 }
+
+// CodeownersErrors is the result of get_codeowners_errors. OK is set when the CODEOWNERS file has no errors.
+type CodeownersErrors struct {
+	OK     bool                      `json:"ok"`
+	Errors []*github.CodeownersError `json:"errors"`
+}
+
+// GetCodeownersErrors creates a tool to list the syntax errors in the CODEOWNERS file of a repository.
+func GetCodeownersErrors(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_codeowners_errors",
+			mcp.WithDescription(t("TOOL_GET_CODEOWNERS_ERRORS_DESCRIPTION", "List the errors in the CODEOWNERS file of a GitHub repository, such as unknown owners or invalid patterns, with the offending line and a suggested fix. Errors in CODEOWNERS silently stop review requests from being routed to code owners")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_CODEOWNERS_ERRORS_USER_TITLE", "Get CODEOWNERS errors"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Branch, tag or commit to check the CODEOWNERS file of. Defaults to the default branch"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			codeownersErrors, resp, err := client.Repositories.GetCodeownersErrors(ctx, owner, repo, &github.GetCodeownersErrorsOptions{Ref: ref})
+			if err != nil {
+				// GitHub answers 404 when there is no CODEOWNERS file in the root, .github/ or docs/ directories.
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					message := fmt.Sprintf("%s/%s has no CODEOWNERS file", owner, repo)
+					if ref != "" {
+						message = fmt.Sprintf("%s/%s has no CODEOWNERS file at %s", owner, repo, ref)
+					}
+					_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, message, resp, err)
+					return mcp.NewToolResultError(message), nil
+				}
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get CODEOWNERS errors", resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := CodeownersErrors{Errors: codeownersErrors.Errors}
+			if result.Errors == nil {
+				result.Errors = []*github.CodeownersError{}
+			}
+			result.OK = len(result.Errors) == 0
+
+			return MarshalledTextResult(result), nil
+		}
+}
@@ -2,19 +2,21 @@ package github
 
 import (
 	"context"
-	"encoding/base64"
 	"encoding/json"
 	"net/http"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/github/github-mcp-server/internal/githubv4mock"
 	"github.com/github/github-mcp-server/internal/toolsnaps"
 	"github.com/github/github-mcp-server/pkg/raw"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v73/github"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/shurcooL/githubv4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -33,6 +35,7 @@ func Test_GetFileContents(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "path")
 	assert.Contains(t, tool.InputSchema.Properties, "ref")
 	assert.Contains(t, tool.InputSchema.Properties, "sha")
+	assert.Contains(t, tool.InputSchema.Properties, "max_bytes")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
 
 	// Mock response for raw content
@@ -65,6 +68,8 @@ func Test_GetFileContents(t *testing.T) {
 		expectedResult interface{}
 		expectedErrMsg string
 		expectStatus   int
+		// expectedMessage, when set, is compared with the text accompanying a text resource.
+		expectedMessage string
 	}{
 		{
 			name: "successful text content fetch",
@@ -112,7 +117,7 @@ func Test_GetFileContents(t *testing.T) {
 			},
 		},
 		{
-			name: "successful file blob content fetch",
+			name: "binary file is described instead of returned",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
 					mock.GetReposGitRefByOwnerByRepoByRef,
@@ -126,10 +131,12 @@ func Test_GetFileContents(t *testing.T) {
 					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
 						w.WriteHeader(http.StatusOK)
 						fileContent := &github.RepositoryContent{
-							Name: github.Ptr("test.png"),
-							Path: github.Ptr("test.png"),
-							SHA:  github.Ptr("def456"),
-							Type: github.Ptr("file"),
+							Name:        github.Ptr("test.png"),
+							Path:        github.Ptr("test.png"),
+							SHA:         github.Ptr("def456"),
+							Type:        github.Ptr("file"),
+							Size:        github.Ptr(2048),
+							DownloadURL: github.Ptr("https://raw.githubusercontent.com/owner/repo/main/test.png"),
 						}
 						contentBytes, _ := json.Marshal(fileContent)
 						_, _ = w.Write(contentBytes)
@@ -150,11 +157,149 @@ func Test_GetFileContents(t *testing.T) {
 				"ref":   "refs/heads/main",
 			},
 			expectError: false,
-			expectedResult: mcp.BlobResourceContents{
-				URI:      "repo://owner/repo/refs/heads/main/contents/test.png",
-				Blob:     base64.StdEncoding.EncodeToString(mockRawContent),
-				MIMEType: "image/png",
+			expectedResult: BinaryFile{
+				Path:        "test.png",
+				SHA:         "def456",
+				Size:        2048,
+				MIMEType:    "image/png",
+				Binary:      true,
+				DownloadURL: "https://raw.githubusercontent.com/owner/repo/main/test.png",
+			},
+		},
+		{
+			name: "text content is truncated to max_bytes",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusOK)
+						_, _ = w.Write([]byte(`{"ref": "refs/heads/main", "object": {"sha": ""}}`))
+					}),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					&github.RepositoryContent{
+						Name: github.Ptr("README.md"),
+						Path: github.Ptr("README.md"),
+						SHA:  github.Ptr("abc123"),
+						Type: github.Ptr("file"),
+						Size: github.Ptr(len(mockRawContent)),
+					},
+				),
+				mock.WithRequestMatchHandler(
+					raw.GetRawReposContentsByOwnerByRepoByBranchByPath,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.Header().Set("Content-Type", "text/markdown")
+						_, _ = w.Write(mockRawContent)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"path":      "README.md",
+				"ref":       "refs/heads/main",
+				"max_bytes": float64(17),
+			},
+			expectError: false,
+			expectedResult: mcp.TextResourceContents{
+				URI:      "repo://owner/repo/refs/heads/main/contents/README.md",
+				Text:     "# Test Repository",
+				MIMEType: "text/markdown",
+			},
+			expectedMessage: "successfully downloaded text file (SHA: abc123, size: 45 bytes); truncated: true, only the first 17 bytes are included, use max_bytes to get more",
+		},
+		{
+			name: "falls back to the git blob when raw content is unavailable",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusOK)
+						_, _ = w.Write([]byte(`{"ref": "refs/heads/main", "object": {"sha": ""}}`))
+					}),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					&github.RepositoryContent{
+						Name: github.Ptr("README.md"),
+						Path: github.Ptr("README.md"),
+						SHA:  github.Ptr("abc123"),
+						Type: github.Ptr("file"),
+						Size: github.Ptr(len(mockRawContent)),
+					},
+				),
+				mock.WithRequestMatchHandler(
+					raw.GetRawReposContentsByOwnerByRepoByBranchByPath,
+					mockResponse(t, http.StatusNotFound, nil),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitBlobsByOwnerByRepoByFileSha,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "/repos/owner/repo/git/blobs/abc123", r.URL.Path)
+						_, _ = w.Write(mockRawContent)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "README.md",
+				"ref":   "refs/heads/main",
+			},
+			expectError: false,
+			expectedResult: mcp.TextResourceContents{
+				URI:      "repo://owner/repo/refs/heads/main/contents/README.md",
+				Text:     "# Test Repository\n\nThis is a test repository.",
+				MIMEType: "text/plain; charset=utf-8",
+			},
+			expectedMessage: "successfully downloaded text file (SHA: abc123, size: 45 bytes)",
+		},
+		{
+			name: "git blob fallback is read up to max_bytes",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusOK)
+						_, _ = w.Write([]byte(`{"ref": "refs/heads/main", "object": {"sha": ""}}`))
+					}),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					&github.RepositoryContent{
+						Name: github.Ptr("big.txt"),
+						Path: github.Ptr("big.txt"),
+						SHA:  github.Ptr("abc123"),
+						Type: github.Ptr("file"),
+						Size: github.Ptr(4 << 20),
+					},
+				),
+				mock.WithRequestMatchHandler(
+					raw.GetRawReposContentsByOwnerByRepoByBranchByPath,
+					mockResponse(t, http.StatusNotFound, nil),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitBlobsByOwnerByRepoByFileSha,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						_, _ = w.Write([]byte(strings.Repeat("a", 4<<20)))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"path":      "big.txt",
+				"ref":       "refs/heads/main",
+				"max_bytes": float64(10),
+			},
+			expectError: false,
+			expectedResult: mcp.TextResourceContents{
+				URI:      "repo://owner/repo/refs/heads/main/contents/big.txt",
+				Text:     "aaaaaaaaaa",
+				MIMEType: "text/plain; charset=utf-8",
 			},
+			expectedMessage: "successfully downloaded text file (SHA: abc123, size: 4194304 bytes); truncated: true, only the first 10 bytes are included, use max_bytes to get more",
 		},
 		{
 			name: "successful directory content fetch",
@@ -258,9 +403,15 @@ func Test_GetFileContents(t *testing.T) {
 			case mcp.TextResourceContents:
 				textResource := getTextResourceResult(t, result)
 				assert.Equal(t, expected, textResource)
-			case mcp.BlobResourceContents:
-				blobResource := getBlobResourceResult(t, result)
-				assert.Equal(t, expected, blobResource)
+				if tc.expectedMessage != "" {
+					require.IsType(t, mcp.TextContent{}, result.Content[0])
+					assert.Equal(t, tc.expectedMessage, result.Content[0].(mcp.TextContent).Text)
+				}
+			case BinaryFile:
+				textContent := getTextResult(t, result)
+				var returned BinaryFile
+				require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+				assert.Equal(t, expected, returned)
 			case []*github.RepositoryContent:
 				// Directory content fetch returns a text result (JSON array)
 				textContent := getTextResult(t, result)
@@ -272,6 +423,8 @@ func Test_GetFileContents(t *testing.T) {
 					assert.Equal(t, *expected[i].Name, *content.Name)
 					assert.Equal(t, *expected[i].Path, *content.Path)
 					assert.Equal(t, *expected[i].Type, *content.Type)
+					assert.Equal(t, expected[i].GetSize(), content.GetSize())
+					assert.Nil(t, content.HTMLURL)
 				}
 			case mcp.TextContent:
 				textContent := getErrorResult(t, result)
@@ -281,6 +434,207 @@ func Test_GetFileContents(t *testing.T) {
 	}
 }
 
+func Test_GetRepositoryTree(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRepositoryTree(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_repository_tree", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.Contains(t, tool.InputSchema.Properties, "recursive")
+	assert.Contains(t, tool.InputSchema.Properties, "path_prefix")
+	assert.Contains(t, tool.InputSchema.Properties, "pattern")
+	assert.Contains(t, tool.InputSchema.Properties, "max_entries")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockTree := &github.Tree{
+		SHA: github.Ptr("tree123"),
+		Entries: []*github.TreeEntry{
+			{Path: github.Ptr("README.md"), Type: github.Ptr("blob"), Size: github.Ptr(42), SHA: github.Ptr("a1")},
+			{Path: github.Ptr("src"), Type: github.Ptr("tree"), SHA: github.Ptr("b2")},
+			{Path: github.Ptr("src/main.go"), Type: github.Ptr("blob"), Size: github.Ptr(100), SHA: github.Ptr("c3")},
+			{Path: github.Ptr("src/util/strings.go"), Type: github.Ptr("blob"), Size: github.Ptr(50), SHA: github.Ptr("d4")},
+			{Path: github.Ptr("src/util/README.md"), Type: github.Ptr("blob"), Size: github.Ptr(10), SHA: github.Ptr("e5")},
+		},
+	}
+	// resolveRef mocks resolving ref to the commit abc123 whose tree is tree123.
+	resolveRef := func(ref string) []mock.MockBackendOption {
+		return []mock.MockBackendOption{
+			mock.WithRequestMatchHandler(
+				mock.GetReposCommitsByOwnerByRepoByRef,
+				expectPath(t, "/repos/owner/repo/commits/"+ref).andThen(
+					func(w http.ResponseWriter, _ *http.Request) {
+						_, _ = w.Write([]byte("abc123"))
+					},
+				),
+			),
+			mock.WithRequestMatch(
+				mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
+				&github.Commit{SHA: github.Ptr("abc123"), Tree: &github.Tree{SHA: github.Ptr("tree123")}},
+			),
+		}
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+		expectedTree   RepositoryTree
+	}{
+		{
+			name: "recursive tree of the default branch",
+			mockedClient: mock.NewMockedHTTPClient(append(resolveRef("HEAD"),
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitTreesByOwnerByRepoByTreeSha,
+					expectQueryParams(t, map[string]string{"recursive": "1"}).andThen(
+						mockResponse(t, http.StatusOK, mockTree),
+					),
+				),
+			)...),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"recursive": true,
+			},
+			expectedTree: RepositoryTree{
+				SHA: "tree123",
+				Entries: []RepositoryTreeEntry{
+					{Path: "README.md", Type: "blob", Size: 42, SHA: "a1"},
+					{Path: "src", Type: "tree", SHA: "b2"},
+					{Path: "src/main.go", Type: "blob", Size: 100, SHA: "c3"},
+					{Path: "src/util/strings.go", Type: "blob", Size: 50, SHA: "d4"},
+					{Path: "src/util/README.md", Type: "blob", Size: 10, SHA: "e5"},
+				},
+			},
+		},
+		{
+			name: "path prefix and pattern",
+			mockedClient: mock.NewMockedHTTPClient(append(resolveRef("v1.0"),
+				mock.WithRequestMatch(
+					mock.GetReposGitTreesByOwnerByRepoByTreeSha,
+					mockTree,
+				),
+			)...),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"ref":         "v1.0",
+				"path_prefix": "/src/",
+				"pattern":     "*.go",
+			},
+			expectedTree: RepositoryTree{
+				SHA: "tree123",
+				Entries: []RepositoryTreeEntry{
+					{Path: "src/main.go", Type: "blob", Size: 100, SHA: "c3"},
+					{Path: "src/util/strings.go", Type: "blob", Size: 50, SHA: "d4"},
+				},
+			},
+		},
+		{
+			name: "entries capped at max_entries",
+			mockedClient: mock.NewMockedHTTPClient(append(resolveRef("HEAD"),
+				mock.WithRequestMatch(
+					mock.GetReposGitTreesByOwnerByRepoByTreeSha,
+					mockTree,
+				),
+			)...),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"pattern":     "*.md",
+				"max_entries": float64(1),
+			},
+			expectedTree: RepositoryTree{
+				SHA: "tree123",
+				Entries: []RepositoryTreeEntry{
+					{Path: "README.md", Type: "blob", Size: 42, SHA: "a1"},
+				},
+				Truncated: true,
+				Note:      "Only the first 1 matching entries are listed; narrow the listing down with path_prefix or pattern, or raise max_entries.",
+			},
+		},
+		{
+			name: "tree truncated by the API",
+			mockedClient: mock.NewMockedHTTPClient(append(resolveRef("HEAD"),
+				mock.WithRequestMatch(
+					mock.GetReposGitTreesByOwnerByRepoByTreeSha,
+					&github.Tree{SHA: github.Ptr("tree123"), Entries: mockTree.Entries[:1], Truncated: github.Ptr(true)},
+				),
+			)...),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"recursive": true,
+			},
+			expectedTree: RepositoryTree{
+				SHA: "tree123",
+				Entries: []RepositoryTreeEntry{
+					{Path: "README.md", Type: "blob", Size: 42, SHA: "a1"},
+				},
+				Truncated: true,
+				Note:      "The tree is too large for the API to list completely; narrow it down with path_prefix.",
+			},
+		},
+		{
+			name:         "invalid pattern",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"pattern": "[",
+			},
+			expectError:    true,
+			expectedErrMsg: `invalid pattern "["`,
+		},
+		{
+			name: "ref not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepoByRef,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to resolve ref: missing",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetRepositoryTree(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError)
+
+			textContent := getTextResult(t, result)
+			var returned RepositoryTree
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedTree, returned)
+		})
+	}
+}
+
 func Test_ForkRepository(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
@@ -383,39 +737,55 @@ func Test_ForkRepository(t *testing.T) {
 	}
 }
 
-func Test_CreateBranch(t *testing.T) {
+func Test_ListForks(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := CreateBranch(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := ListForks(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 	require.NoError(t, toolsnaps.Test(tool.Name, tool))
 
-	assert.Equal(t, "create_branch", tool.Name)
+	assert.Equal(t, "list_forks", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
-	assert.Contains(t, tool.InputSchema.Properties, "branch")
-	assert.Contains(t, tool.InputSchema.Properties, "from_branch")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
-
-	// Setup mock repository for default branch test
-	mockRepo := &github.Repository{
-		DefaultBranch: github.Ptr("main"),
-	}
+	assert.Contains(t, tool.InputSchema.Properties, "sort")
+	assert.Contains(t, tool.InputSchema.Properties, "active_since")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
 
-	// Setup mock reference for from_branch tests
-	mockSourceRef := &github.Reference{
-		Ref: github.Ptr("refs/heads/main"),
-		Object: &github.GitObject{
-			SHA: github.Ptr("abc123def456"),
+	mockForks := []*github.Repository{
+		{
+			FullName:        github.Ptr("alice/repo"),
+			Owner:           &github.User{Login: github.Ptr("alice")},
+			StargazersCount: github.Ptr(12),
+			DefaultBranch:   github.Ptr("main"),
+			PushedAt:        &github.Timestamp{Time: time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)},
+			HTMLURL:         github.Ptr("https://github.com/alice/repo"),
+		},
+		{
+			FullName:        github.Ptr("bob/repo"),
+			Owner:           &github.User{Login: github.Ptr("bob")},
+			StargazersCount: github.Ptr(0),
+			DefaultBranch:   github.Ptr("main"),
+			PushedAt:        &github.Timestamp{Time: time.Date(2021, 1, 1, 10, 0, 0, 0, time.UTC)},
+			HTMLURL:         github.Ptr("https://github.com/bob/repo"),
 		},
 	}
 
-	// Setup mock created reference
-	mockCreatedRef := &github.Reference{
-		Ref: github.Ptr("refs/heads/new-feature"),
-		Object: &github.GitObject{
-			SHA: github.Ptr("abc123def456"),
-		},
+	aliceFork := RepositorySummary{
+		FullName:      "alice/repo",
+		Owner:         "alice",
+		Stars:         12,
+		DefaultBranch: "main",
+		PushedAt:      "2025-06-01T10:00:00Z",
+		HTMLURL:       "https://github.com/alice/repo",
+	}
+	bobFork := RepositorySummary{
+		FullName:      "bob/repo",
+		Owner:         "bob",
+		DefaultBranch: "main",
+		PushedAt:      "2021-01-01T10:00:00Z",
+		HTMLURL:       "https://github.com/bob/repo",
 	}
 
 	tests := []struct {
@@ -423,35 +793,176 @@ func Test_CreateBranch(t *testing.T) {
 		mockedClient   *http.Client
 		requestArgs    map[string]interface{}
 		expectError    bool
-		expectedRef    *github.Reference
+		expectedResult []RepositorySummary
 		expectedErrMsg string
 	}{
 		{
-			name: "successful branch creation with from_branch",
+			name: "list forks sorted by stargazers",
 			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatch(
-					mock.GetReposGitRefByOwnerByRepoByRef,
-					mockSourceRef,
-				),
-				mock.WithRequestMatch(
-					mock.PostReposGitRefsByOwnerByRepo,
-					mockCreatedRef,
+				mock.WithRequestMatchHandler(
+					mock.GetReposForksByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"sort":     "stargazers",
+						"page":     "2",
+						"per_page": "10",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockForks),
+					),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner":       "owner",
-				"repo":        "repo",
-				"branch":      "new-feature",
-				"from_branch": "main",
+				"owner":   "owner",
+				"repo":    "repo",
+				"sort":    "stargazers",
+				"page":    float64(2),
+				"perPage": float64(10),
 			},
-			expectError: false,
-			expectedRef: mockCreatedRef,
+			expectError:    false,
+			expectedResult: []RepositorySummary{aliceFork, bobFork},
 		},
 		{
-			name: "successful branch creation with default branch",
+			name: "only forks active since a date",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatch(
-					mock.GetReposByOwnerByRepo,
+					mock.GetReposForksByOwnerByRepo,
+					mockForks,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"active_since": "2024-01-01",
+			},
+			expectError:    false,
+			expectedResult: []RepositorySummary{aliceFork},
+		},
+		{
+			name:         "invalid active_since",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"active_since": "last spring",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to parse active_since",
+		},
+		{
+			name: "list forks fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposForksByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list forks",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListForks(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned []RepositorySummary
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_CreateBranch(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateBranch(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_branch", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.Contains(t, tool.InputSchema.Properties, "from_branch")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
+
+	// Setup mock repository for default branch test
+	mockRepo := &github.Repository{
+		DefaultBranch: github.Ptr("main"),
+	}
+
+	// Setup mock reference for from_branch tests
+	mockSourceRef := &github.Reference{
+		Ref: github.Ptr("refs/heads/main"),
+		Object: &github.GitObject{
+			SHA: github.Ptr("abc123def456"),
+		},
+	}
+
+	// Setup mock created reference
+	mockCreatedRef := &github.Reference{
+		Ref: github.Ptr("refs/heads/new-feature"),
+		Object: &github.GitObject{
+			SHA: github.Ptr("abc123def456"),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedRef    *github.Reference
+		expectedErrMsg string
+	}{
+		{
+			name: "successful branch creation with from_branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockSourceRef,
+				),
+				mock.WithRequestMatch(
+					mock.PostReposGitRefsByOwnerByRepo,
+					mockCreatedRef,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"branch":      "new-feature",
+				"from_branch": "main",
+			},
+			expectError: false,
+			expectedRef: mockCreatedRef,
+		},
+		{
+			name: "successful branch creation with default branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
 					mockRepo,
 				),
 				mock.WithRequestMatch(
@@ -589,6 +1100,8 @@ func Test_GetCommit(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "sha")
+	assert.Contains(t, tool.InputSchema.Properties, "include_patch")
+	assert.Contains(t, tool.InputSchema.Properties, "max_patch_bytes")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "sha"})
 
 	mockCommit := &github.RepositoryCommit{
@@ -598,9 +1111,14 @@ func Test_GetCommit(t *testing.T) {
 			Author: &github.CommitAuthor{
 				Name:  github.Ptr("Test User"),
 				Email: github.Ptr("test@example.com"),
-				Date:  &github.Timestamp{Time: time.Now().Add(-48 * time.Hour)},
+				Date:  &github.Timestamp{Time: time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)},
+			},
+			Verification: &github.SignatureVerification{
+				Verified: github.Ptr(true),
+				Reason:   github.Ptr("valid"),
 			},
 		},
+		Parents: []*github.Commit{{SHA: github.Ptr("parent123")}},
 		Author: &github.User{
 			Login: github.Ptr("testuser"),
 		},
@@ -627,7 +1145,7 @@ func Test_GetCommit(t *testing.T) {
 		mockedClient   *http.Client
 		requestArgs    map[string]interface{}
 		expectError    bool
-		expectedCommit *github.RepositoryCommit
+		expectedCommit CommitDetails
 		expectedErrMsg string
 	}{
 		{
@@ -643,8 +1161,85 @@ func Test_GetCommit(t *testing.T) {
 				"repo":  "repo",
 				"sha":   "abc123def456",
 			},
-			expectError:    false,
-			expectedCommit: mockCommit,
+			expectError: false,
+			expectedCommit: CommitDetails{
+				SHA:     "abc123def456",
+				Message: "First commit",
+				Author:  "testuser",
+				Date:    "2025-06-01T12:00:00Z",
+				HTMLURL: "https://github.com/owner/repo/commit/abc123def456",
+				Parents: []string{"parent123"},
+				Stats:   CommitStats{Additions: 10, Deletions: 2, Total: 12},
+				Verification: &CommitVerification{
+					Verified: true,
+					Reason:   "valid",
+				},
+				Files: []PullRequestFile{
+					{Filename: "file1.go", Status: "modified", Additions: 10, Deletions: 2, Changes: 12},
+				},
+			},
+		},
+		{
+			name: "patches are included when requested",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepoByRef,
+					mockResponse(t, http.StatusOK, mockCommit),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"sha":             "abc123d",
+				"include_patch":   true,
+				"max_patch_bytes": float64(100),
+			},
+			expectError: false,
+			expectedCommit: CommitDetails{
+				SHA:     "abc123def456",
+				Message: "First commit",
+				Author:  "testuser",
+				Date:    "2025-06-01T12:00:00Z",
+				HTMLURL: "https://github.com/owner/repo/commit/abc123def456",
+				Parents: []string{"parent123"},
+				Stats:   CommitStats{Additions: 10, Deletions: 2, Total: 12},
+				Verification: &CommitVerification{
+					Verified: true,
+					Reason:   "valid",
+				},
+				Files: []PullRequestFile{
+					{Filename: "file1.go", Status: "modified", Additions: 10, Deletions: 2, Changes: 12, Patch: "@@ -1,2 +1,10 @@"},
+				},
+			},
+		},
+		{
+			name:         "negative max_patch_bytes",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"sha":             "abc123d",
+				"include_patch":   true,
+				"max_patch_bytes": float64(-1),
+			},
+			expectError:    true,
+			expectedErrMsg: "max_patch_bytes must be at least 1",
+		},
+		{
+			name: "ambiguous short SHA",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepoByRef,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "No commit found for SHA: abc"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "abc",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get commit: abc: No commit found for SHA: abc",
 		},
 		{
 			name: "commit fetch fails",
@@ -695,14 +1290,10 @@ func Test_GetCommit(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedCommit github.RepositoryCommit
+			var returnedCommit CommitDetails
 			err = json.Unmarshal([]byte(textContent.Text), &returnedCommit)
 			require.NoError(t, err)
-
-			assert.Equal(t, *tc.expectedCommit.SHA, *returnedCommit.SHA)
-			assert.Equal(t, *tc.expectedCommit.Commit.Message, *returnedCommit.Commit.Message)
-			assert.Equal(t, *tc.expectedCommit.Author.Login, *returnedCommit.Author.Login)
-			assert.Equal(t, *tc.expectedCommit.HTMLURL, *returnedCommit.HTMLURL)
+			assert.Equal(t, tc.expectedCommit, returnedCommit)
 		})
 	}
 }
@@ -889,6 +1480,208 @@ func Test_ListCommits(t *testing.T) {
 	}
 }
 
+func Test_CompareRefs(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CompareRefs(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "compare_refs", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "base")
+	assert.Contains(t, tool.InputSchema.Properties, "head")
+	assert.Contains(t, tool.InputSchema.Properties, "comparison")
+	assert.Contains(t, tool.InputSchema.Properties, "include_files")
+	assert.Contains(t, tool.InputSchema.Properties, "max_patch_bytes")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "base", "head"})
+
+	mockComparison := &github.CommitsComparison{
+		Status:          github.Ptr("diverged"),
+		AheadBy:         github.Ptr(3),
+		BehindBy:        github.Ptr(1),
+		TotalCommits:    github.Ptr(3),
+		HTMLURL:         github.Ptr("https://github.com/owner/repo/compare/v1.0...main"),
+		MergeBaseCommit: &github.RepositoryCommit{SHA: github.Ptr("base123")},
+		Commits: []*github.RepositoryCommit{
+			{
+				SHA: github.Ptr("abc123"),
+				Commit: &github.Commit{
+					Message: github.Ptr("Add feature\n\nWith a longer description."),
+					Author: &github.CommitAuthor{
+						Name: github.Ptr("Test User"),
+						Date: &github.Timestamp{Time: time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)},
+					},
+				},
+				Author: &github.User{Login: github.Ptr("testuser")},
+			},
+			{
+				SHA: github.Ptr("def456"),
+				Commit: &github.Commit{
+					Message: github.Ptr("Fix bug"),
+					Author:  &github.CommitAuthor{Name: github.Ptr("Unlinked Author")},
+				},
+			},
+		},
+		Files: []*github.CommitFile{
+			{
+				Filename:  github.Ptr("main.go"),
+				Status:    github.Ptr("modified"),
+				Additions: github.Ptr(2),
+				Changes:   github.Ptr(2),
+				Patch:     github.Ptr("@@ -1 +1,2 @@\n+one\n+two"),
+			},
+		},
+	}
+	expectedCommits := []CommitSummary{
+		{SHA: "abc123", Message: "Add feature", Author: "testuser", Date: "2025-06-01T12:00:00Z"},
+		{SHA: "def456", Message: "Fix bug", Author: "Unlinked Author"},
+	}
+
+	tests := []struct {
+		name               string
+		mockedClient       *http.Client
+		requestArgs        map[string]interface{}
+		expectError        bool
+		expectedErrMsg     string
+		expectedComparison RefComparison
+	}{
+		{
+			name: "three-dot comparison with more commits than listed",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					expectPath(t, "/repos/owner/repo/compare/v1.0...main").andThen(
+						mockResponse(t, http.StatusOK, mockComparison),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"base":    "v1.0",
+				"head":    "main",
+				"perPage": float64(2),
+			},
+			expectedComparison: RefComparison{
+				Status:           "diverged",
+				AheadBy:          3,
+				BehindBy:         1,
+				TotalCommits:     3,
+				MergeBaseSHA:     "base123",
+				HTMLURL:          "https://github.com/owner/repo/compare/v1.0...main",
+				Commits:          expectedCommits,
+				CommitsTruncated: true,
+			},
+		},
+		{
+			name: "two-dot comparison with files",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					expectPath(t, "/repos/owner/repo/compare/v1.0..main").andThen(
+						mockResponse(t, http.StatusOK, mockComparison),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"base":            "v1.0",
+				"head":            "main",
+				"comparison":      "two-dot",
+				"include_files":   true,
+				"max_patch_bytes": float64(19),
+				"page":            float64(2),
+				"perPage":         float64(1),
+			},
+			expectedComparison: RefComparison{
+				Status:       "diverged",
+				AheadBy:      3,
+				BehindBy:     1,
+				TotalCommits: 3,
+				MergeBaseSHA: "base123",
+				HTMLURL:      "https://github.com/owner/repo/compare/v1.0...main",
+				Commits:      expectedCommits,
+				Files: []PullRequestFile{
+					{Filename: "main.go", Status: "modified", Additions: 2, Changes: 2, Patch: "@@ -1 +1,2 @@\n+one", PatchTruncated: true},
+				},
+			},
+		},
+		{
+			name:         "invalid comparison",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"base":       "v1.0",
+				"head":       "main",
+				"comparison": "four-dot",
+			},
+			expectError:    true,
+			expectedErrMsg: `invalid comparison "four-dot"`,
+		},
+		{
+			name:         "negative max_patch_bytes",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"base":            "v1.0",
+				"head":            "main",
+				"include_files":   true,
+				"max_patch_bytes": float64(-1),
+			},
+			expectError:    true,
+			expectedErrMsg: "max_patch_bytes must be at least 1",
+		},
+		{
+			name: "comparison fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"base":  "v1.0",
+				"head":  "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to compare v1.0 with missing",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CompareRefs(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError)
+
+			textContent := getTextResult(t, result)
+			var returned RefComparison
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedComparison, returned)
+		})
+	}
+}
+
 func Test_CreateOrUpdateFile(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
@@ -933,12 +1726,18 @@ func Test_CreateOrUpdateFile(t *testing.T) {
 		mockedClient    *http.Client
 		requestArgs     map[string]interface{}
 		expectError     bool
-		expectedContent *github.RepositoryContentResponse
+		expectedContent FileCommit
 		expectedErrMsg  string
 	}{
 		{
 			name: "successful file creation",
 			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					expectQueryParams(t, map[string]string{"ref": "main"}).andThen(
+						mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+					),
+				),
 				mock.WithRequestMatchHandler(
 					mock.PutReposContentsByOwnerByRepoByPath,
 					expectRequestBody(t, map[string]interface{}{
@@ -946,7 +1745,7 @@ func Test_CreateOrUpdateFile(t *testing.T) {
 						"content": "IyBFeGFtcGxlCgpUaGlzIGlzIGFuIGV4YW1wbGUgZmlsZS4=", // Base64 encoded content
 						"branch":  "main",
 					}).andThen(
-						mockResponse(t, http.StatusOK, mockFileResponse),
+						mockResponse(t, http.StatusCreated, mockFileResponse),
 					),
 				),
 			),
@@ -958,8 +1757,14 @@ func Test_CreateOrUpdateFile(t *testing.T) {
 				"message": "Add example file",
 				"branch":  "main",
 			},
-			expectError:     false,
-			expectedContent: mockFileResponse,
+			expectError: false,
+			expectedContent: FileCommit{
+				Path:      "docs/example.md",
+				SHA:       "abc123def456",
+				Created:   true,
+				CommitSHA: "def456abc789",
+				CommitURL: "https://github.com/owner/repo/commit/def456abc789",
+			},
 		},
 		{
 			name: "successful file update with SHA",
@@ -985,12 +1790,83 @@ func Test_CreateOrUpdateFile(t *testing.T) {
 				"branch":  "main",
 				"sha":     "abc123def456",
 			},
-			expectError:     false,
-			expectedContent: mockFileResponse,
+			expectError: false,
+			expectedContent: FileCommit{
+				Path:      "docs/example.md",
+				SHA:       "abc123def456",
+				CommitSHA: "def456abc789",
+				CommitURL: "https://github.com/owner/repo/commit/def456abc789",
+			},
+		},
+		{
+			name: "file update looks up the SHA of the current file",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					expectQueryParams(t, map[string]string{"ref": "main"}).andThen(
+						mockResponse(t, http.StatusOK, &github.RepositoryContent{
+							Type: github.Ptr("file"),
+							Path: github.Ptr("docs/example.md"),
+							SHA:  github.Ptr("0ld5ha"),
+						}),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PutReposContentsByOwnerByRepoByPath,
+					expectRequestBody(t, map[string]interface{}{
+						"message": "Update example file",
+						"content": "IyBVcGRhdGVkIEV4YW1wbGUKClRoaXMgZmlsZSBoYXMgYmVlbiB1cGRhdGVkLg==", // Base64 encoded content
+						"branch":  "main",
+						"sha":     "0ld5ha",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockFileResponse),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"path":    "docs/example.md",
+				"content": "# Updated Example\n\nThis file has been updated.",
+				"message": "Update example file",
+				"branch":  "main",
+			},
+			expectError: false,
+			expectedContent: FileCommit{
+				Path:      "docs/example.md",
+				SHA:       "abc123def456",
+				CommitSHA: "def456abc789",
+				CommitURL: "https://github.com/owner/repo/commit/def456abc789",
+			},
+		},
+		{
+			name: "path is a directory",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					[]*github.RepositoryContent{{Type: github.Ptr("file"), Path: github.Ptr("docs/example.md")}},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"path":    "docs",
+				"content": "# Example",
+				"message": "Add example file",
+				"branch":  "main",
+			},
+			expectError:    true,
+			expectedErrMsg: `path "docs" is a directory`,
 		},
 		{
 			name: "file creation fails",
 			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					expectQueryParams(t, map[string]string{"ref": "nonexistent-branch"}).andThen(
+						mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+					),
+				),
 				mock.WithRequestMatchHandler(
 					mock.PutReposContentsByOwnerByRepoByPath,
 					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
@@ -1040,18 +1916,10 @@ func Test_CreateOrUpdateFile(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedContent github.RepositoryContentResponse
+			var returnedContent FileCommit
 			err = json.Unmarshal([]byte(textContent.Text), &returnedContent)
 			require.NoError(t, err)
-
-			// Verify content
-			assert.Equal(t, *tc.expectedContent.Content.Name, *returnedContent.Content.Name)
-			assert.Equal(t, *tc.expectedContent.Content.Path, *returnedContent.Content.Path)
-			assert.Equal(t, *tc.expectedContent.Content.SHA, *returnedContent.Content.SHA)
-
-			// Verify commit
-			assert.Equal(t, *tc.expectedContent.Commit.SHA, *returnedContent.Commit.SHA)
-			assert.Equal(t, *tc.expectedContent.Commit.Message, *returnedContent.Commit.Message)
+			assert.Equal(t, tc.expectedContent, returnedContent)
 		})
 	}
 }
@@ -1209,7 +2077,7 @@ func Test_CreateRepository(t *testing.T) {
 func Test_PushFiles(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := PushFiles(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := PushFiles(stubGetClientFn(mockClient), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
 	require.NoError(t, toolsnaps.Test(tool.Name, tool))
 
 	assert.Equal(t, "push_files", tool.Name)
@@ -1219,6 +2087,7 @@ func Test_PushFiles(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "branch")
 	assert.Contains(t, tool.InputSchema.Properties, "files")
 	assert.Contains(t, tool.InputSchema.Properties, "message")
+	assert.Contains(t, tool.InputSchema.Properties, "deletions")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch", "files", "message"})
 
 	// Setup mock objects
@@ -1255,17 +2124,231 @@ func Test_PushFiles(t *testing.T) {
 		},
 	}
 
+	type createCommitOnBranchMutation struct {
+		CreateCommitOnBranch struct {
+			Commit struct {
+				Oid githubv4.GitObjectID
+				URL githubv4.URI
+			}
+		} `graphql:"createCommitOnBranch(input: $input)"`
+	}
+	type createCommitOnBranchSupportQuery struct {
+		Type *struct {
+			Name githubv4.String
+		} `graphql:"__type(name: \"CreateCommitOnBranchInput\")"`
+	}
+	// createCommitOnBranchSupport matches the schema check made after createCommitOnBranch fails.
+	createCommitOnBranchSupport := func(supported bool) githubv4mock.Matcher {
+		var inputType any
+		if supported {
+			inputType = map[string]any{"name": "CreateCommitOnBranchInput"}
+		}
+		return githubv4mock.NewQueryMatcher(createCommitOnBranchSupportQuery{}, nil, githubv4mock.DataResponse(map[string]any{"__type": inputType}))
+	}
+	// createCommitOnBranch matches the mutation pushing README.md on main, as most cases below do,
+	// along with the schema check saying whether the mutation is supported.
+	createCommitOnBranch := func(response githubv4mock.GQLResponse, supported bool) *http.Client {
+		return githubv4mock.NewMockedHTTPClient(
+			createCommitOnBranchSupport(supported),
+			githubv4mock.NewMutationMatcher(
+				createCommitOnBranchMutation{},
+				githubv4.CreateCommitOnBranchInput{
+					Branch: githubv4.CommittableBranch{
+						RepositoryNameWithOwner: githubv4.NewString("owner/repo"),
+						BranchName:              githubv4.NewString("main"),
+					},
+					Message:         githubv4.CommitMessage{Headline: "Update file"},
+					ExpectedHeadOid: "abc123",
+					FileChanges: &githubv4.FileChanges{
+						Additions: &[]githubv4.FileAddition{
+							{Path: "README.md", Contents: "IyBSRUFETUU="},
+						},
+					},
+				},
+				nil,
+				response,
+			),
+		)
+	}
+	unsupportedMutation := githubv4mock.ErrorResponse("Field 'createCommitOnBranch' doesn't exist on type 'Mutation'")
+
 	// Define test cases
 	tests := []struct {
-		name           string
-		mockedClient   *http.Client
-		requestArgs    map[string]interface{}
-		expectError    bool
-		expectedRef    *github.Reference
-		expectedErrMsg string
+		name            string
+		mockedClient    *http.Client
+		mockedGQLClient *http.Client
+		requestArgs     map[string]interface{}
+		expectError     bool
+		expectedCommit  PushedCommit
+		expectedErrMsg  string
 	}{
 		{
-			name: "successful push of multiple files",
+			name: "successful push with createCommitOnBranch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockRef,
+				),
+			),
+			mockedGQLClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewMutationMatcher(
+					createCommitOnBranchMutation{},
+					githubv4.CreateCommitOnBranchInput{
+						Branch: githubv4.CommittableBranch{
+							RepositoryNameWithOwner: githubv4.NewString("owner/repo"),
+							BranchName:              githubv4.NewString("main"),
+						},
+						Message: githubv4.CommitMessage{
+							Headline: "Update multiple files",
+							Body:     githubv4.NewString("Also removes the old docs."),
+						},
+						ExpectedHeadOid: "abc123",
+						FileChanges: &githubv4.FileChanges{
+							Additions: &[]githubv4.FileAddition{
+								{Path: "README.md", Contents: "IyBSRUFETUU="},
+								{Path: "docs/example.md", Contents: "IyBFeGFtcGxl"},
+							},
+							Deletions: &[]githubv4.FileDeletion{
+								{Path: "docs/old.md"},
+							},
+						},
+					},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"createCommitOnBranch": map[string]any{
+							"commit": map[string]any{
+								"oid": "jkl012",
+								"url": "https://github.com/owner/repo/commit/jkl012",
+							},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"files": []interface{}{
+					map[string]interface{}{
+						"path":    "README.md",
+						"content": "# README",
+					},
+					map[string]interface{}{
+						"path":    "docs/example.md",
+						"content": "# Example",
+					},
+				},
+				"deletions": []interface{}{"docs/old.md"},
+				"message":   "Update multiple files\n\nAlso removes the old docs.",
+			},
+			expectError: false,
+			expectedCommit: PushedCommit{
+				SHA: "jkl012",
+				URL: "https://github.com/owner/repo/commit/jkl012",
+			},
+		},
+		{
+			name: "branch moved before createCommitOnBranch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockRef,
+					mockUpdatedRef,
+				),
+			),
+			mockedGQLClient: createCommitOnBranch(githubv4mock.ErrorResponse(`Expected branch to point to "abc123" but it did not. Pull and try again.`), true),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"files": []interface{}{
+					map[string]interface{}{
+						"path":    "README.md",
+						"content": "# README",
+					},
+				},
+				"message": "Update file",
+			},
+			expectError:    true,
+			expectedErrMsg: "branch main no longer points to abc123 because it was updated while the files were pushed; retry the push",
+		},
+		{
+			name: "createCommitOnBranch fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockRef,
+					mockRef,
+				),
+			),
+			mockedGQLClient: createCommitOnBranch(githubv4mock.ErrorResponse("Resource not accessible by integration"), true),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"files": []interface{}{
+					map[string]interface{}{
+						"path":    "README.md",
+						"content": "# README",
+					},
+				},
+				"message": "Update file",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create commit",
+		},
+		{
+			name: "unrelated error mentioning the expected branch is not a conflict",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockRef,
+					mockRef,
+				),
+			),
+			mockedGQLClient: createCommitOnBranch(githubv4mock.ErrorResponse("Expected branch to point to a commit, but a tag was given"), true),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"files": []interface{}{
+					map[string]interface{}{
+						"path":    "README.md",
+						"content": "# README",
+					},
+				},
+				"message": "Update file",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create commit: Expected branch to point to a commit, but a tag was given",
+		},
+		{
+			name: "unrelated error mentioning a missing field does not fall back",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockRef,
+					mockRef,
+				),
+			),
+			mockedGQLClient: createCommitOnBranch(githubv4mock.ErrorResponse("Field 'signature' doesn't exist on type 'Commit'"), true),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"files": []interface{}{
+					map[string]interface{}{
+						"path":    "README.md",
+						"content": "# README",
+					},
+				},
+				"message": "Update file",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create commit: Field 'signature' doesn't exist on type 'Commit'",
+		},
+		{
+			name: "falls back to the Git data API without createCommitOnBranch",
 			mockedClient: mock.NewMockedHTTPClient(
 				// Get branch reference
 				mock.WithRequestMatch(
@@ -1295,6 +2378,12 @@ func Test_PushFiles(t *testing.T) {
 								"type":    "blob",
 								"content": "# Example\n\nThis is an example file.",
 							},
+							map[string]interface{}{
+								"path": "docs/old.md",
+								"mode": "100644",
+								"type": "blob",
+								"sha":  nil,
+							},
 						},
 					}).andThen(
 						mockResponse(t, http.StatusCreated, mockTree),
@@ -1336,10 +2425,79 @@ func Test_PushFiles(t *testing.T) {
 						"content": "# Example\n\nThis is an example file.",
 					},
 				},
-				"message": "Update multiple files",
+				"deletions": []interface{}{"docs/old.md"},
+				"message":   "Update multiple files",
 			},
+			mockedGQLClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewMutationMatcher(
+					createCommitOnBranchMutation{},
+					githubv4.CreateCommitOnBranchInput{
+						Branch: githubv4.CommittableBranch{
+							RepositoryNameWithOwner: githubv4.NewString("owner/repo"),
+							BranchName:              githubv4.NewString("main"),
+						},
+						Message:         githubv4.CommitMessage{Headline: "Update multiple files"},
+						ExpectedHeadOid: "abc123",
+						FileChanges: &githubv4.FileChanges{
+							Additions: &[]githubv4.FileAddition{
+								{Path: "README.md", Contents: "IyBVcGRhdGVkIFJFQURNRQoKVGhpcyBpcyBhbiB1cGRhdGVkIFJFQURNRSBmaWxlLg=="},
+								{Path: "docs/example.md", Contents: "IyBFeGFtcGxlCgpUaGlzIGlzIGFuIGV4YW1wbGUgZmlsZS4="},
+							},
+							Deletions: &[]githubv4.FileDeletion{
+								{Path: "docs/old.md"},
+							},
+						},
+					},
+					nil,
+					unsupportedMutation,
+				),
+				createCommitOnBranchSupport(false),
+			),
 			expectError: false,
-			expectedRef: mockUpdatedRef,
+			expectedCommit: PushedCommit{
+				SHA: "jkl012",
+				URL: "https://github.com/owner/repo/commit/jkl012",
+			},
+		},
+		{
+			name: "branch moved before updating the reference",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockRef,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
+					mockCommit,
+				),
+				mock.WithRequestMatch(
+					mock.PostReposGitTreesByOwnerByRepo,
+					mockTree,
+				),
+				mock.WithRequestMatch(
+					mock.PostReposGitCommitsByOwnerByRepo,
+					mockNewCommit,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposGitRefsByOwnerByRepoByRef,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Update is not a fast forward"}),
+				),
+			),
+			mockedGQLClient: createCommitOnBranch(unsupportedMutation, false),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"files": []interface{}{
+					map[string]interface{}{
+						"path":    "README.md",
+						"content": "# README",
+					},
+				},
+				"message": "Update file",
+			},
+			expectError:    true,
+			expectedErrMsg: "branch main no longer points to abc123",
 		},
 		{
 			name:         "fails when files parameter is invalid",
@@ -1462,8 +2620,9 @@ func Test_PushFiles(t *testing.T) {
 				},
 				"message": "Update file",
 			},
-			expectError:    true,
-			expectedErrMsg: "failed to get base commit",
+			mockedGQLClient: createCommitOnBranch(unsupportedMutation, false),
+			expectError:     true,
+			expectedErrMsg:  "failed to get base commit",
 		},
 		{
 			name: "fails to create tree",
@@ -1496,8 +2655,9 @@ func Test_PushFiles(t *testing.T) {
 				},
 				"message": "Update file",
 			},
-			expectError:    true,
-			expectedErrMsg: "failed to create tree",
+			mockedGQLClient: createCommitOnBranch(unsupportedMutation, false),
+			expectError:     true,
+			expectedErrMsg:  "failed to create tree",
 		},
 	}
 
@@ -1505,7 +2665,8 @@ func Test_PushFiles(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := PushFiles(stubGetClientFn(client), translations.NullTranslationHelper)
+			gqlClient := githubv4.NewClient(tc.mockedGQLClient)
+			_, handler := PushFiles(stubGetClientFn(client), stubGetGQLClientFn(gqlClient), translations.NullTranslationHelper)
 
 			// Create call request
 			request := createMCPRequest(tc.requestArgs)
@@ -1537,12 +2698,10 @@ func Test_PushFiles(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedRef github.Reference
-			err = json.Unmarshal([]byte(textContent.Text), &returnedRef)
+			var returnedCommit PushedCommit
+			err = json.Unmarshal([]byte(textContent.Text), &returnedCommit)
 			require.NoError(t, err)
-
-			assert.Equal(t, *tc.expectedRef.Ref, *returnedRef.Ref)
-			assert.Equal(t, *tc.expectedRef.Object.SHA, *returnedRef.Object.SHA)
+			assert.Equal(t, tc.expectedCommit, returnedCommit)
 		})
 	}
 }
@@ -1559,6 +2718,9 @@ func Test_ListBranches(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "page")
 	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.Contains(t, tool.InputSchema.Properties, "protected_only")
+	assert.Contains(t, tool.InputSchema.Properties, "query")
+	assert.Contains(t, tool.InputSchema.Properties, "fetch_all")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
 
 	// Setup mock branches for success case
@@ -1571,6 +2733,17 @@ func Test_ListBranches(t *testing.T) {
 			Name:   github.Ptr("develop"),
 			Commit: &github.RepositoryCommit{SHA: github.Ptr("def456")},
 		},
+		{
+			Name:      github.Ptr("release/1.0"),
+			Commit:    &github.RepositoryCommit{SHA: github.Ptr("ghi789")},
+			Protected: github.Ptr(true),
+		},
+	}
+	mockRepo := func() mock.MockBackendOption {
+		return mock.WithRequestMatch(
+			mock.GetReposByOwnerByRepo,
+			&github.Repository{Name: github.Ptr("repo"), DefaultBranch: github.Ptr("main")},
+		)
 	}
 
 	// Test cases
@@ -1580,6 +2753,7 @@ func Test_ListBranches(t *testing.T) {
 		mockResponses []mock.MockBackendOption
 		wantErr       bool
 		errContains   string
+		expected      BranchList
 	}{
 		{
 			name: "success",
@@ -1589,12 +2763,100 @@ func Test_ListBranches(t *testing.T) {
 				"page":  float64(2),
 			},
 			mockResponses: []mock.MockBackendOption{
-				mock.WithRequestMatch(
+				mockRepo(),
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"page":     "2",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockBranches),
+					),
+				),
+			},
+			wantErr: false,
+			expected: BranchList{
+				DefaultBranch: "main",
+				Branches: []BranchSummary{
+					{Name: "main", SHA: "abc123"},
+					{Name: "develop", SHA: "def456"},
+					{Name: "release/1.0", SHA: "ghi789", Protected: true},
+				},
+			},
+		},
+		{
+			name: "protected branches matching a query",
+			args: map[string]interface{}{
+				"owner":          "owner",
+				"repo":           "repo",
+				"protected_only": true,
+				"query":          "RELEASE",
+			},
+			mockResponses: []mock.MockBackendOption{
+				mockRepo(),
+				mock.WithRequestMatchHandler(
 					mock.GetReposBranchesByOwnerByRepo,
-					mockBranches,
+					expectQueryParams(t, map[string]string{
+						"page":      "1",
+						"per_page":  "30",
+						"protected": "true",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockBranches[2:]),
+					),
+				),
+			},
+			wantErr: false,
+			expected: BranchList{
+				DefaultBranch: "main",
+				Branches: []BranchSummary{
+					{Name: "release/1.0", SHA: "ghi789", Protected: true},
+				},
+			},
+		},
+		{
+			name: "fetch all branches",
+			args: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"fetch_all": true,
+				"max_items": float64(2),
+				"query":     "e",
+			},
+			mockResponses: []mock.MockBackendOption{
+				mockRepo(),
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"page":     "1",
+						"per_page": "100",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockBranches),
+					),
 				),
 			},
 			wantErr: false,
+			expected: BranchList{
+				DefaultBranch: "main",
+				Branches: []BranchSummary{
+					{Name: "develop", SHA: "def456"},
+				},
+				Truncated: true,
+			},
+		},
+		{
+			name: "repository not found",
+			args: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			mockResponses: []mock.MockBackendOption{
+				mock.WithRequestMatchHandler(
+					mock.GetReposByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			},
+			wantErr:     false,
+			errContains: "failed to get repository",
 		},
 		{
 			name: "missing owner",
@@ -1648,12 +2910,10 @@ func Test_ListBranches(t *testing.T) {
 			require.NotEmpty(t, textContent.Text)
 
 			// Verify response
-			var branches []*github.Branch
+			var branches BranchList
 			err = json.Unmarshal([]byte(textContent.Text), &branches)
 			require.NoError(t, err)
-			assert.Len(t, branches, 2)
-			assert.Equal(t, "main", *branches[0].Name)
-			assert.Equal(t, "develop", *branches[1].Name)
+			assert.Equal(t, tt.expected, branches)
 		})
 	}
 }
@@ -1846,6 +3106,9 @@ func Test_ListTags(t *testing.T) {
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "prefix")
+	assert.Contains(t, tool.InputSchema.Properties, "include_commit_dates")
+	assert.Contains(t, tool.InputSchema.Properties, "sort")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
 
 	// Setup mock tags for success case
@@ -1875,7 +3138,7 @@ func Test_ListTags(t *testing.T) {
 		mockedClient   *http.Client
 		requestArgs    map[string]interface{}
 		expectError    bool
-		expectedTags   []*github.RepositoryTag
+		expectedTags   []TagSummary
 		expectedErrMsg string
 	}{
 		{
@@ -1895,8 +3158,98 @@ func Test_ListTags(t *testing.T) {
 				"owner": "owner",
 				"repo":  "repo",
 			},
-			expectError:  false,
-			expectedTags: mockTags,
+			expectError: false,
+			expectedTags: []TagSummary{
+				{
+					Name:       "v1.0.0",
+					SHA:        "v1.0.0-tag-sha",
+					TarballURL: "https://github.com/owner/repo/tarball/v1.0.0",
+					ZipballURL: "https://github.com/owner/repo/zipball/v1.0.0",
+				},
+				{
+					Name:       "v0.9.0",
+					SHA:        "v0.9.0-tag-sha",
+					TarballURL: "https://github.com/owner/repo/tarball/v0.9.0",
+					ZipballURL: "https://github.com/owner/repo/zipball/v0.9.0",
+				},
+			},
+		},
+		{
+			name: "prefix filter with commit dates",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposTagsByOwnerByRepo,
+					mockTags,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
+					expectPath(t, "/repos/owner/repo/git/commits/v1.0.0-tag-sha").andThen(
+						mockResponse(t, http.StatusOK, &github.Commit{
+							SHA: github.Ptr("v1.0.0-tag-sha"),
+							Committer: &github.CommitAuthor{
+								Date: &github.Timestamp{Time: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)},
+							},
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":                "owner",
+				"repo":                 "repo",
+				"prefix":               "v1.",
+				"include_commit_dates": true,
+			},
+			expectError: false,
+			expectedTags: []TagSummary{
+				{
+					Name:       "v1.0.0",
+					SHA:        "v1.0.0-tag-sha",
+					TarballURL: "https://github.com/owner/repo/tarball/v1.0.0",
+					ZipballURL: "https://github.com/owner/repo/zipball/v1.0.0",
+					CommitDate: "2024-05-01T12:00:00Z",
+				},
+			},
+		},
+		{
+			name: "semver sort",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposTagsByOwnerByRepo,
+					[]*github.RepositoryTag{
+						{Name: github.Ptr("nightly"), Commit: &github.Commit{SHA: github.Ptr("sha-nightly")}},
+						{Name: github.Ptr("v1.2.0-rc.1"), Commit: &github.Commit{SHA: github.Ptr("sha-rc1")}},
+						{Name: github.Ptr("v1.10.0"), Commit: &github.Commit{SHA: github.Ptr("sha-110")}},
+						{Name: github.Ptr("v1.2.0"), Commit: &github.Commit{SHA: github.Ptr("sha-120")}},
+						{Name: github.Ptr("v1.2.0-rc.10"), Commit: &github.Commit{SHA: github.Ptr("sha-rc10")}},
+						{Name: github.Ptr("v1.9.3"), Commit: &github.Commit{SHA: github.Ptr("sha-193")}},
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sort":  "semver",
+			},
+			expectError: false,
+			expectedTags: []TagSummary{
+				{Name: "v1.10.0", SHA: "sha-110"},
+				{Name: "v1.9.3", SHA: "sha-193"},
+				{Name: "v1.2.0", SHA: "sha-120"},
+				{Name: "v1.2.0-rc.10", SHA: "sha-rc10"},
+				{Name: "v1.2.0-rc.1", SHA: "sha-rc1"},
+				{Name: "nightly", SHA: "sha-nightly"},
+			},
+		},
+		{
+			name:         "unsupported sort",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sort":  "date",
+			},
+			expectError:    true,
+			expectedErrMsg: "unsupported sort: date",
 		},
 		{
 			name: "list tags fails",
@@ -1946,16 +3299,10 @@ func Test_ListTags(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Parse and verify the result
-			var returnedTags []*github.RepositoryTag
+			var returnedTags []TagSummary
 			err = json.Unmarshal([]byte(textContent.Text), &returnedTags)
 			require.NoError(t, err)
-
-			// Verify each tag
-			require.Equal(t, len(tc.expectedTags), len(returnedTags))
-			for i, expectedTag := range tc.expectedTags {
-				assert.Equal(t, *expectedTag.Name, *returnedTags[i].Name)
-				assert.Equal(t, *expectedTag.Commit.SHA, *returnedTags[i].Commit.SHA)
-			}
+			assert.Equal(t, tc.expectedTags, returnedTags)
 		})
 	}
 }
@@ -2279,3 +3626,388 @@ func Test_resolveGitReference(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetRepositoryTopics(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRepositoryTopics(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_repository_topics", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedTopics []string
+		expectedErrMsg string
+	}{
+		{
+			name: "successful topics fetch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposTopicsByOwnerByRepo,
+					expectPath(t, "/repos/owner/repo/topics").andThen(
+						mockResponse(t, http.StatusOK, map[string][]string{"names": {"golang", "mcp"}}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    false,
+			expectedTopics: []string{"golang", "mcp"},
+		},
+		{
+			name: "topics fetch fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposTopicsByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get repository topics",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetRepositoryTopics(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returnedTopics []string
+			err = json.Unmarshal([]byte(textContent.Text), &returnedTopics)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedTopics, returnedTopics)
+		})
+	}
+}
+
+func Test_UpdateRepositoryTopics(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateRepositoryTopics(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_repository_topics", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "topics")
+	assert.Contains(t, tool.InputSchema.Properties, "add")
+	assert.Contains(t, tool.InputSchema.Properties, "remove")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	// replaceTopics echoes the replaced topics back, as the API does.
+	replaceTopics := func(expected []interface{}) mock.MockBackendOption {
+		return mock.WithRequestMatchHandler(
+			mock.PutReposTopicsByOwnerByRepo,
+			expectRequestBody(t, map[string]interface{}{
+				"names": expected,
+			}).andThen(
+				func(w http.ResponseWriter, r *http.Request) {
+					names := make([]string, len(expected))
+					for i, name := range expected {
+						names[i] = name.(string)
+					}
+					mockResponse(t, http.StatusOK, map[string][]string{"names": names})(w, r)
+				},
+			),
+		)
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedTopics []string
+		expectedErrMsg string
+	}{
+		{
+			name: "add and remove are merged with the current topics",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposTopicsByOwnerByRepo,
+					map[string][]string{"names": {"api", "golang", "legacy"}},
+				),
+				replaceTopics([]interface{}{"api", "golang", "mcp"}),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"add":    []interface{}{"mcp", "golang"},
+				"remove": []interface{}{"legacy"},
+			},
+			expectError:    false,
+			expectedTopics: []string{"api", "golang", "mcp"},
+		},
+		{
+			name: "topics replace the current topics",
+			mockedClient: mock.NewMockedHTTPClient(
+				replaceTopics([]interface{}{"mcp"}),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"topics": []interface{}{"mcp"},
+			},
+			expectError:    false,
+			expectedTopics: []string{"mcp"},
+		},
+		{
+			name: "empty topics remove all topics",
+			mockedClient: mock.NewMockedHTTPClient(
+				replaceTopics([]interface{}{}),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"topics": []interface{}{},
+			},
+			expectError:    false,
+			expectedTopics: []string{},
+		},
+		{
+			name:         "invalid topic",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"add":   []interface{}{"Model Context_Protocol"},
+			},
+			expectError:    true,
+			expectedErrMsg: `invalid topic "Model Context_Protocol": topics may only contain lowercase letters, numbers and hyphens and must start with a letter or number, e.g. "model-context-protocol"`,
+		},
+		{
+			name:         "too long topic",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"topics": []interface{}{strings.Repeat("a", 51)},
+			},
+			expectError:    true,
+			expectedErrMsg: "topics must be at most 50 characters",
+		},
+		{
+			name:         "nothing to update",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "at least one of topics, add or remove must be provided",
+		},
+		{
+			name: "update fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposTopicsByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Must have admin rights to Repository."}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"topics": []interface{}{"mcp"},
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to update repository topics",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdateRepositoryTopics(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returnedTopics []string
+			err = json.Unmarshal([]byte(textContent.Text), &returnedTopics)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedTopics, returnedTopics)
+		})
+	}
+}
+
+func Test_GetCodeownersErrors(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetCodeownersErrors(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_codeowners_errors", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockErrors := &github.CodeownersErrors{
+		Errors: []*github.CodeownersError{
+			{
+				Line:       3,
+				Column:     1,
+				Kind:       "Unknown owner",
+				Source:     "*.go @octo-org/go-team\n",
+				Suggestion: github.Ptr("make sure @octo-org/go-team exists and has write access to the repository"),
+				Message:    "Unknown owner on line 3: make sure @octo-org/go-team exists and has write access to the repository",
+				Path:       ".github/CODEOWNERS",
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult CodeownersErrors
+		expectedErrMsg string
+	}{
+		{
+			name: "CODEOWNERS with errors",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCodeownersErrorsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"ref": "release",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockErrors),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "release",
+			},
+			expectError:    false,
+			expectedResult: CodeownersErrors{OK: false, Errors: mockErrors.Errors},
+		},
+		{
+			name: "CODEOWNERS without errors",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposCodeownersErrorsByOwnerByRepo,
+					&github.CodeownersErrors{Errors: []*github.CodeownersError{}},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    false,
+			expectedResult: CodeownersErrors{OK: true, Errors: []*github.CodeownersError{}},
+		},
+		{
+			name: "no CODEOWNERS file",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCodeownersErrorsByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "release",
+			},
+			expectError:    true,
+			expectedErrMsg: "owner/repo has no CODEOWNERS file at release",
+		},
+		{
+			name: "get CODEOWNERS errors fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCodeownersErrorsByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Forbidden"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get CODEOWNERS errors",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetCodeownersErrors(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned CodeownersErrors
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
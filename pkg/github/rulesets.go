@@ -0,0 +1,183 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v73/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// RulesetSummary is the compact form of a ruleset returned by list_repository_rulesets.
+type RulesetSummary struct {
+	ID     int64  `json:"id"`
+	Name   string `json:"name"`
+	Target string `json:"target,omitempty"`
+	// SourceType is Repository, Organization or Enterprise, and Source the name of the owner the ruleset is configured on.
+	SourceType  string `json:"source_type,omitempty"`
+	Source      string `json:"source"`
+	Enforcement string `json:"enforcement"`
+}
+
+// EffectiveRule is a rule that applies to a branch and the ruleset it comes from.
+type EffectiveRule struct {
+	Type              string          `json:"type"`
+	RulesetID         int64           `json:"ruleset_id"`
+	RulesetName       string          `json:"ruleset_name,omitempty"`
+	RulesetSourceType string          `json:"ruleset_source_type"`
+	RulesetSource     string          `json:"ruleset_source"`
+	Parameters        json.RawMessage `json:"parameters,omitempty"`
+}
+
+// BranchRulesetRules is the result of list_repository_rulesets for a branch.
+type BranchRulesetRules struct {
+	Branch string          `json:"branch"`
+	Rules  []EffectiveRule `json:"rules"`
+}
+
+// getRulesForBranch gets the rules that apply to a branch. go-github splits the rules into one field
+// per rule type, so the request is built directly to keep the API's list of rules, including rule
+// types the library doesn't know yet.
+func getRulesForBranch(ctx context.Context, client *github.Client, owner, repo, branch string, opts *github.ListOptions) ([]EffectiveRule, *github.Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/rules/branches/%v?page=%d&per_page=%d", owner, repo, url.PathEscape(branch), opts.Page, opts.PerPage)
+	req, err := client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var rules []EffectiveRule
+	resp, err := client.Do(ctx, req, &rules)
+	if err != nil {
+		return nil, resp, err
+	}
+	return rules, resp, nil
+}
+
+// ListRepositoryRulesets creates a tool to list the rulesets of a repository, or the rules that apply to a branch.
+func ListRepositoryRulesets(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_repository_rulesets",
+			mcp.WithDescription(t("TOOL_LIST_REPOSITORY_RULESETS_DESCRIPTION", "List the rulesets of a GitHub repository, including those configured on its organization or enterprise. Pass branch to get the rules that apply to that branch and the ruleset each comes from, or ruleset_id to get the details of one ruleset")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_REPOSITORY_RULESETS_USER_TITLE", "List repository rulesets"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("branch",
+				mcp.Description("Branch to get the effective rules of, such as pull_request, required_status_checks, deletion or non_fast_forward"),
+			),
+			mcp.WithNumber("ruleset_id",
+				mcp.Description("ID of a ruleset to get the conditions, rules and bypass actors of"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := OptionalParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			rulesetID, err := OptionalIntParam(request, "ruleset_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if branch != "" && rulesetID != 0 {
+				return mcp.NewToolResultError("only one of branch or ruleset_id can be provided"), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts := github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			if rulesetID != 0 {
+				ruleset, resp, err := client.Repositories.GetRuleset(ctx, owner, repo, int64(rulesetID), true)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get ruleset %d", rulesetID), resp, err), nil
+				}
+				defer func() { _ = resp.Body.Close() }()
+
+				return MarshalledTextResult(ruleset), nil
+			}
+
+			// The rulesets configured on parents are included as they apply to the repository. The rules
+			// of a branch only carry the ID of their ruleset, so a full page of rulesets is fetched to name them.
+			rulesetOpts := opts
+			if branch != "" {
+				rulesetOpts = github.ListOptions{PerPage: 100}
+			}
+			rulesets, resp, err := client.Repositories.GetAllRulesets(ctx, owner, repo, &github.RepositoryListRulesetsOptions{
+				IncludesParents: github.Ptr(true),
+				ListOptions:     rulesetOpts,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list rulesets", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			if branch == "" {
+				summaries := make([]RulesetSummary, 0, len(rulesets))
+				for _, ruleset := range rulesets {
+					summary := RulesetSummary{
+						ID:          ruleset.GetID(),
+						Name:        ruleset.Name,
+						Source:      ruleset.Source,
+						Enforcement: string(ruleset.Enforcement),
+					}
+					if target := ruleset.GetTarget(); target != nil {
+						summary.Target = string(*target)
+					}
+					if sourceType := ruleset.GetSourceType(); sourceType != nil {
+						summary.SourceType = string(*sourceType)
+					}
+					summaries = append(summaries, summary)
+				}
+				return MarshalledTextResult(summaries), nil
+			}
+
+			names := make(map[int64]string, len(rulesets))
+			for _, ruleset := range rulesets {
+				names[ruleset.GetID()] = ruleset.Name
+			}
+
+			rules, resp, err := getRulesForBranch(ctx, client, owner, repo, branch, &opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get rules for branch %s", branch), resp, err), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := BranchRulesetRules{Branch: branch, Rules: make([]EffectiveRule, 0, len(rules))}
+			for _, rule := range rules {
+				rule.RulesetName = names[rule.RulesetID]
+				result.Rules = append(result.Rules, rule)
+			}
+
+			return MarshalledTextResult(result), nil
+		}
+}
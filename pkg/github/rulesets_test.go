@@ -0,0 +1,202 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v73/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListRepositoryRulesets(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListRepositoryRulesets(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_repository_rulesets", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.Contains(t, tool.InputSchema.Properties, "ruleset_id")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockRulesets := []*github.RepositoryRuleset{
+		{
+			ID:          github.Ptr(int64(1)),
+			Name:        "protect main",
+			Target:      github.Ptr(github.RulesetTargetBranch),
+			SourceType:  github.Ptr(github.RulesetSourceTypeRepository),
+			Source:      "owner/repo",
+			Enforcement: github.RulesetEnforcementActive,
+		},
+		{
+			ID:          github.Ptr(int64(2)),
+			Name:        "org signed commits",
+			Target:      github.Ptr(github.RulesetTargetBranch),
+			SourceType:  github.Ptr(github.RulesetSourceTypeOrganization),
+			Source:      "owner",
+			Enforcement: github.RulesetEnforcementEvaluate,
+		},
+	}
+
+	mockBranchRules := []map[string]any{
+		{
+			"type":                "pull_request",
+			"ruleset_source_type": "Repository",
+			"ruleset_source":      "owner/repo",
+			"ruleset_id":          1,
+			"parameters":          map[string]any{"required_approving_review_count": 2},
+		},
+		{
+			"type":                "required_signatures",
+			"ruleset_source_type": "Organization",
+			"ruleset_source":      "owner",
+			"ruleset_id":          2,
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedJSON   string
+		expectedErrMsg string
+	}{
+		{
+			name: "list rulesets including parents",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposRulesetsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"includes_parents": "true",
+						"page":             "1",
+						"per_page":         "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockRulesets),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError: false,
+			expectedJSON: `[
+				{"id":1,"name":"protect main","target":"branch","source_type":"Repository","source":"owner/repo","enforcement":"active"},
+				{"id":2,"name":"org signed commits","target":"branch","source_type":"Organization","source":"owner","enforcement":"evaluate"}
+			]`,
+		},
+		{
+			name: "effective rules for a branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposRulesetsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"includes_parents": "true",
+						"per_page":         "100",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockRulesets),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposRulesBranchesByOwnerByRepoByBranch,
+					expectPath(t, "/repos/owner/repo/rules/branches/main").andThen(
+						mockResponse(t, http.StatusOK, mockBranchRules),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+			},
+			expectError: false,
+			expectedJSON: `{"branch":"main","rules":[
+				{"type":"pull_request","ruleset_id":1,"ruleset_name":"protect main","ruleset_source_type":"Repository","ruleset_source":"owner/repo","parameters":{"required_approving_review_count":2}},
+				{"type":"required_signatures","ruleset_id":2,"ruleset_name":"org signed commits","ruleset_source_type":"Organization","ruleset_source":"owner"}
+			]}`,
+		},
+		{
+			name: "get ruleset by ID",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposRulesetsByOwnerByRepoByRulesetId,
+					expectQueryParams(t, map[string]string{
+						"includes_parents": "true",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockRulesets[0]),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"ruleset_id": float64(1),
+			},
+			expectError:  false,
+			expectedJSON: `{"id":1,"name":"protect main","target":"branch","source_type":"Repository","source":"owner/repo","enforcement":"active"}`,
+		},
+		{
+			name:         "branch and ruleset_id are exclusive",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"branch":     "main",
+				"ruleset_id": float64(1),
+			},
+			expectError:    true,
+			expectedErrMsg: "only one of branch or ruleset_id can be provided",
+		},
+		{
+			name: "list rulesets fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposRulesetsByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list rulesets",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListRepositoryRulesets(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+			assert.JSONEq(t, tc.expectedJSON, textContent.Text)
+		})
+	}
+}
@@ -75,18 +75,41 @@ func SearchRepositories(getClient GetClientFn, t translations.TranslationHelperF
 		}
 }
 
+// CodeSearchMatch is a file matched by search_code.
+type CodeSearchMatch struct {
+	Repository string `json:"repository"`
+	Path       string `json:"path"`
+	SHA        string `json:"sha"`
+	HTMLURL    string `json:"html_url"`
+	// Fragments are the parts of the file around the matches.
+	Fragments []string `json:"fragments,omitempty"`
+}
+
+// CodeSearchResults is the result of search_code.
+type CodeSearchResults struct {
+	TotalCount        int               `json:"total_count"`
+	IncompleteResults bool              `json:"incomplete_results"`
+	Items             []CodeSearchMatch `json:"items"`
+}
+
 // SearchCode creates a tool to search for code across GitHub repositories.
 func SearchCode(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("search_code",
-			mcp.WithDescription(t("TOOL_SEARCH_CODE_DESCRIPTION", "Search for code across GitHub repositories")),
+			mcp.WithDescription(t("TOOL_SEARCH_CODE_DESCRIPTION", "Search for code across GitHub repositories. Returns the matching files with the fragments of their content that matched, so the matching lines can be seen without fetching the files.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_SEARCH_CODE_USER_TITLE", "Search code"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
-			mcp.WithString("q",
+			mcp.WithString("query",
 				mcp.Required(),
 				mcp.Description("Search query using GitHub code search syntax"),
 			),
+			mcp.WithString("owner",
+				mcp.Description("Optional repository owner. Scopes the search to this owner's repositories, or to a single repository when repo is also given. Ignored if the query already contains a repo:, org: or user: qualifier."),
+			),
+			mcp.WithString("repo",
+				mcp.Description("Optional repository name, used together with owner"),
+			),
 			mcp.WithString("sort",
 				mcp.Description("Sort field ('indexed' only)"),
 			),
@@ -97,10 +120,21 @@ func SearchCode(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			query, err := RequiredParam[string](request, "q")
+			query, err := RequiredParam[string](request, "query")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := OptionalParam[string](request, "owner")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			repo, err := OptionalParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if repo != "" && owner == "" {
+				return mcp.NewToolResultError("owner is required when repo is given"), nil
+			}
 			sort, err := OptionalParam[string](request, "sort")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
@@ -115,8 +149,9 @@ func SearchCode(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 			}
 
 			opts := &github.SearchOptions{
-				Sort:  sort,
-				Order: order,
+				Sort:      sort,
+				Order:     order,
+				TextMatch: true,
 				ListOptions: github.ListOptions{
 					PerPage: pagination.PerPage,
 					Page:    pagination.Page,
@@ -128,10 +163,15 @@ func SearchCode(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
 
+			query = scopeSearchQuery(query, owner, repo)
 			result, resp, err := client.Search.Code(ctx, query, opts)
 			if err != nil {
+				message := fmt.Sprintf("failed to search code with query '%s'", query)
+				if rateLimited, ok := rateLimitErrorResult(ctx, message, resp, err); ok {
+					return rateLimited, nil
+				}
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
-					fmt.Sprintf("failed to search code with query '%s'", query),
+					message,
 					resp,
 					err,
 				), nil
@@ -146,12 +186,25 @@ func SearchCode(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				return mcp.NewToolResultError(fmt.Sprintf("failed to search code: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(result)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			results := CodeSearchResults{
+				TotalCount:        result.GetTotal(),
+				IncompleteResults: result.GetIncompleteResults(),
+				Items:             make([]CodeSearchMatch, 0, len(result.CodeResults)),
+			}
+			for _, code := range result.CodeResults {
+				match := CodeSearchMatch{
+					Repository: code.GetRepository().GetFullName(),
+					Path:       code.GetPath(),
+					SHA:        code.GetSHA(),
+					HTMLURL:    code.GetHTMLURL(),
+				}
+				for _, textMatch := range code.TextMatches {
+					match.Fragments = append(match.Fragments, textMatch.GetFragment())
+				}
+				results.Items = append(results.Items, match)
 			}
 
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledTextResult(results), nil
 		}
 }
 
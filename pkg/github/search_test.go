@@ -173,12 +173,14 @@ func Test_SearchCode(t *testing.T) {
 
 	assert.Equal(t, "search_code", tool.Name)
 	assert.NotEmpty(t, tool.Description)
-	assert.Contains(t, tool.InputSchema.Properties, "q")
+	assert.Contains(t, tool.InputSchema.Properties, "query")
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "sort")
 	assert.Contains(t, tool.InputSchema.Properties, "order")
 	assert.Contains(t, tool.InputSchema.Properties, "perPage")
 	assert.Contains(t, tool.InputSchema.Properties, "page")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"q"})
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"query"})
 
 	// Setup mock search results
 	mockSearchResult := &github.CodeSearchResult{
@@ -191,6 +193,10 @@ func Test_SearchCode(t *testing.T) {
 				SHA:        github.Ptr("abc123def456"),
 				HTMLURL:    github.Ptr("https://github.com/owner/repo/blob/main/path/to/file1.go"),
 				Repository: &github.Repository{Name: github.Ptr("repo"), FullName: github.Ptr("owner/repo")},
+				TextMatches: []*github.TextMatch{
+					{Fragment: github.Ptr("func main() {\n\tfmt.Println(\"hello\")")},
+					{Fragment: github.Ptr("\tfmt.Println(\"bye\")")},
+				},
 			},
 			{
 				Name:       github.Ptr("file2.go"),
@@ -207,7 +213,7 @@ func Test_SearchCode(t *testing.T) {
 		mockedClient   *http.Client
 		requestArgs    map[string]interface{}
 		expectError    bool
-		expectedResult *github.CodeSearchResult
+		expectedResult CodeSearchResults
 		expectedErrMsg string
 	}{
 		{
@@ -227,14 +233,31 @@ func Test_SearchCode(t *testing.T) {
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"q":       "fmt.Println language:go",
+				"query":   "fmt.Println language:go",
 				"sort":    "indexed",
 				"order":   "desc",
 				"page":    float64(1),
 				"perPage": float64(30),
 			},
-			expectError:    false,
-			expectedResult: mockSearchResult,
+			expectError: false,
+			expectedResult: CodeSearchResults{
+				TotalCount: 2,
+				Items: []CodeSearchMatch{
+					{
+						Repository: "owner/repo",
+						Path:       "path/to/file1.go",
+						SHA:        "abc123def456",
+						HTMLURL:    "https://github.com/owner/repo/blob/main/path/to/file1.go",
+						Fragments:  []string{"func main() {\n\tfmt.Println(\"hello\")", "\tfmt.Println(\"bye\")"},
+					},
+					{
+						Repository: "owner/repo",
+						Path:       "path/to/file2.go",
+						SHA:        "def456abc123",
+						HTMLURL:    "https://github.com/owner/repo/blob/main/path/to/file2.go",
+					},
+				},
+			},
 		},
 		{
 			name: "code search with minimal parameters",
@@ -251,10 +274,27 @@ func Test_SearchCode(t *testing.T) {
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"q": "fmt.Println language:go",
+				"query": "fmt.Println language:go",
+			},
+			expectError: false,
+			expectedResult: CodeSearchResults{
+				TotalCount: 2,
+				Items: []CodeSearchMatch{
+					{
+						Repository: "owner/repo",
+						Path:       "path/to/file1.go",
+						SHA:        "abc123def456",
+						HTMLURL:    "https://github.com/owner/repo/blob/main/path/to/file1.go",
+						Fragments:  []string{"func main() {\n\tfmt.Println(\"hello\")", "\tfmt.Println(\"bye\")"},
+					},
+					{
+						Repository: "owner/repo",
+						Path:       "path/to/file2.go",
+						SHA:        "def456abc123",
+						HTMLURL:    "https://github.com/owner/repo/blob/main/path/to/file2.go",
+					},
+				},
 			},
-			expectError:    false,
-			expectedResult: mockSearchResult,
 		},
 		{
 			name: "search code fails",
@@ -268,11 +308,83 @@ func Test_SearchCode(t *testing.T) {
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"q": "invalid:query",
+				"query": "invalid:query",
 			},
 			expectError:    true,
 			expectedErrMsg: "failed to search code",
 		},
+		{
+			name: "code search scoped to a repository",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchCode,
+					expectQueryParams(t, map[string]string{
+						"q":        "repo:owner/repo RateLimiter path:pkg/",
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						func(w http.ResponseWriter, r *http.Request) {
+							assert.Equal(t, "application/vnd.github.v3.text-match+json", r.Header.Get("Accept"))
+							mockResponse(t, http.StatusOK, &github.CodeSearchResult{Total: github.Ptr(0)})(w, r)
+						},
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"query": "RateLimiter path:pkg/",
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    false,
+			expectedResult: CodeSearchResults{Items: []CodeSearchMatch{}},
+		},
+		{
+			name:         "repo without owner",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"query": "RateLimiter",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "owner is required when repo is given",
+		},
+		{
+			name: "secondary rate limit",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchCode,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.Header().Set("Retry-After", "60")
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "You have exceeded a secondary rate limit.", "documentation_url": "https://docs.github.com/rest/overview/rate-limits-for-the-rest-api#about-secondary-rate-limits"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"query": "RateLimiter",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to search code with query 'RateLimiter': secondary rate limit exceeded, retry in 1m0s",
+		},
+		{
+			name: "primary rate limit",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchCode,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.Header().Set("X-RateLimit-Remaining", "0")
+						w.Header().Set("X-RateLimit-Reset", "4102444800")
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "API rate limit exceeded"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"query": "RateLimiter",
+			},
+			expectError:    true,
+			expectedErrMsg: "rate limit exceeded, retry in",
+		},
 	}
 
 	for _, tc := range tests {
@@ -303,19 +415,10 @@ func Test_SearchCode(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedResult github.CodeSearchResult
+			var returnedResult CodeSearchResults
 			err = json.Unmarshal([]byte(textContent.Text), &returnedResult)
 			require.NoError(t, err)
-			assert.Equal(t, *tc.expectedResult.Total, *returnedResult.Total)
-			assert.Equal(t, *tc.expectedResult.IncompleteResults, *returnedResult.IncompleteResults)
-			assert.Len(t, returnedResult.CodeResults, len(tc.expectedResult.CodeResults))
-			for i, code := range returnedResult.CodeResults {
-				assert.Equal(t, *tc.expectedResult.CodeResults[i].Name, *code.Name)
-				assert.Equal(t, *tc.expectedResult.CodeResults[i].Path, *code.Path)
-				assert.Equal(t, *tc.expectedResult.CodeResults[i].SHA, *code.SHA)
-				assert.Equal(t, *tc.expectedResult.CodeResults[i].HTMLURL, *code.HTMLURL)
-				assert.Equal(t, *tc.expectedResult.CodeResults[i].Repository.FullName, *code.Repository.FullName)
-			}
+			assert.Equal(t, tc.expectedResult, returnedResult)
 		})
 	}
 }
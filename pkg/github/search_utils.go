@@ -3,14 +3,171 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
+	"time"
 
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/google/go-github/v73/github"
 	"github.com/mark3labs/mcp-go/mcp"
 )
 
+// searchQualifiers are the qualifiers understood by the issues and pull requests search API.
+// Tokens using any other "key:value" form are treated as free text.
+var searchQualifiers = map[string]bool{
+	"app": true, "archived": true, "assignee": true, "author": true, "base": true,
+	"closed": true, "comments": true, "commenter": true, "created": true, "draft": true,
+	"head": true, "in": true, "interactions": true, "involves": true, "is": true,
+	"label": true, "language": true, "linked": true, "mentions": true, "merged": true,
+	"milestone": true, "no": true, "org": true, "project": true, "reactions": true,
+	"reason": true, "repo": true, "review": true, "review-requested": true,
+	"reviewed-by": true, "sort": true, "state": true, "status": true, "team": true,
+	"team-review-requested": true, "type": true, "updated": true, "user": true,
+	"user-review-requested": true,
+}
+
+// splitSearchQuery splits a query on whitespace, keeping double quoted runs (including
+// quoted qualifier values such as label:"good first issue") within a single token.
+func splitSearchQuery(query string) []string {
+	var tokens []string
+	var current strings.Builder
+	inQuotes := false
+	for _, r := range query {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+			current.WriteRune(r)
+		case !inQuotes && (r == ' ' || r == '\t' || r == '\n' || r == '\r'):
+			if current.Len() > 0 {
+				tokens = append(tokens, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteRune(r)
+		}
+	}
+	if current.Len() > 0 {
+		tokens = append(tokens, current.String())
+	}
+	return tokens
+}
+
+// searchQualifier returns the lower cased qualifier name of a "key:value" token, or an
+// empty string if the token is not a known qualifier. Negated qualifiers are reported
+// with their leading "-".
+func searchQualifier(token string) string {
+	key, value, found := strings.Cut(token, ":")
+	if !found || value == "" {
+		return ""
+	}
+	key = strings.ToLower(key)
+	if !searchQualifiers[strings.TrimPrefix(key, "-")] {
+		return ""
+	}
+	return key
+}
+
+// quoteSearchTerm makes a free-text token safe to send to the search API. Well formed
+// quoted phrases and plain words are kept as they are; anything else containing quotes
+// or colons is wrapped in quotes so it can't be interpreted as a qualifier.
+func quoteSearchTerm(token string) string {
+	if len(token) >= 2 && strings.HasPrefix(token, `"`) && strings.HasSuffix(token, `"`) &&
+		!strings.Contains(token[1:len(token)-1], `"`) {
+		return token
+	}
+	if !strings.ContainsAny(token, `":`) {
+		return token
+	}
+	return `"` + strings.ReplaceAll(token, `"`, "") + `"`
+}
+
+// buildSearchQuery turns a user supplied query into the final search query. The query
+// is scoped to the given search type (unless it already is) and to owner/repo (or just
+// owner) unless it already contains a repo:, org: or user: qualifier. Free-text terms
+// are quoted so that stray colons and quotes don't corrupt the query.
+func buildSearchQuery(query, searchType, owner, repo string) string {
+	terms := make([]string, 0)
+	hasType, hasScope := false, false
+	for _, token := range splitSearchQuery(query) {
+		switch qualifier := searchQualifier(token); qualifier {
+		case "":
+			terms = append(terms, quoteSearchTerm(token))
+		case "repo", "org", "user":
+			hasScope = true
+			terms = append(terms, token)
+		case "is", "type":
+			if strings.EqualFold(token[len(qualifier)+1:], searchType) {
+				hasType = true
+			}
+			terms = append(terms, token)
+		default:
+			terms = append(terms, token)
+		}
+	}
+
+	if !hasType {
+		terms = append([]string{"is:" + searchType}, terms...)
+	}
+	if !hasScope {
+		switch {
+		case owner != "" && repo != "":
+			terms = append([]string{fmt.Sprintf("repo:%s/%s", owner, repo)}, terms...)
+		case owner != "":
+			terms = append([]string{"org:" + owner}, terms...)
+		}
+	}
+	return strings.Join(terms, " ")
+}
+
+// scopeSearchQuery scopes a code or discussion search query to owner/repo (or just owner)
+// unless it already contains a repo:, org: or user: qualifier. Those searches have their own
+// qualifiers, so unlike buildSearchQuery the rest of the query is passed through unchanged.
+func scopeSearchQuery(query, owner, repo string) string {
+	if owner == "" {
+		return query
+	}
+	for _, token := range splitSearchQuery(query) {
+		key, value, found := strings.Cut(token, ":")
+		if !found || value == "" {
+			continue
+		}
+		switch strings.ToLower(key) {
+		case "repo", "org", "user":
+			return query
+		}
+	}
+	if repo != "" {
+		return fmt.Sprintf("repo:%s/%s %s", owner, repo, query)
+	}
+	return fmt.Sprintf("org:%s %s", owner, query)
+}
+
+// rateLimitErrorResult turns a rate limit error into a tool error telling when the request can
+// be retried. It reports false for any other error.
+func rateLimitErrorResult(ctx context.Context, message string, resp *github.Response, err error) (*mcp.CallToolResult, bool) {
+	var retryAfter string
+	var abuseErr *github.AbuseRateLimitError
+	var rateErr *github.RateLimitError
+	switch {
+	case errors.As(err, &abuseErr):
+		retryAfter = "in a minute"
+		if abuseErr.RetryAfter != nil {
+			retryAfter = fmt.Sprintf("in %s", abuseErr.RetryAfter.Round(time.Second))
+		}
+		message = fmt.Sprintf("%s: secondary rate limit exceeded, retry %s", message, retryAfter)
+	case errors.As(err, &rateErr):
+		reset := rateErr.Rate.Reset.Time
+		message = fmt.Sprintf("%s: rate limit exceeded, retry in %s (at %s)", message, time.Until(reset).Round(time.Second), reset.UTC().Format(time.RFC3339))
+	default:
+		return nil, false
+	}
+	_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, message, resp, err)
+	return mcp.NewToolResultError(message), true
+}
+
 func searchHandler(
 	ctx context.Context,
 	getClient GetClientFn,
@@ -22,7 +179,6 @@ func searchHandler(
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
-	query = fmt.Sprintf("is:%s %s", searchType, query)
 
 	owner, err := OptionalParam[string](request, "owner")
 	if err != nil {
@@ -34,9 +190,7 @@ func searchHandler(
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	if owner != "" && repo != "" {
-		query = fmt.Sprintf("repo:%s/%s %s", owner, repo, query)
-	}
+	query = buildSearchQuery(query, searchType, owner, repo)
 
 	sort, err := OptionalParam[string](request, "sort")
 	if err != nil {
@@ -50,6 +204,14 @@ func searchHandler(
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
+	fields, err := OptionalStringArrayParam(request, "fields")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	output, err := OptionalOutputModeParam(request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	opts := &github.SearchOptions{
 		// Default to "created" if no sort is provided, as it's a common use case.
@@ -79,7 +241,28 @@ func searchHandler(
 		return mcp.NewToolResultError(fmt.Sprintf("%s: %s", errorPrefix, string(body))), nil
 	}
 
-	r, err := json.Marshal(result)
+	var response any = result
+	if len(fields) > 0 || output == OutputModeSummary {
+		var items any = result.Issues
+		if output == OutputModeSummary {
+			if searchType == "pr" {
+				items = summarizeSearchedPullRequests(result.Issues)
+			} else {
+				items = summarizeIssues(result.Issues)
+			}
+		}
+		items, err := projectFields(items, fields)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", errorPrefix, err)
+		}
+		response = map[string]any{
+			"total_count":        result.GetTotal(),
+			"incomplete_results": result.GetIncompleteResults(),
+			"items":              items,
+		}
+	}
+
+	r, err := json.Marshal(response)
 	if err != nil {
 		return nil, fmt.Errorf("%s: failed to marshal response: %w", errorPrefix, err)
 	}
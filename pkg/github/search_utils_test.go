@@ -0,0 +1,136 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_BuildSearchQuery(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		searchType string
+		owner      string
+		repo       string
+		expected   string
+	}{
+		{
+			name:       "plain query is scoped to the search type",
+			query:      "memory leak",
+			searchType: "issue",
+			expected:   "is:issue memory leak",
+		},
+		{
+			name:       "owner and repo scope to the repository",
+			query:      "is:open",
+			searchType: "pr",
+			owner:      "octo",
+			repo:       "hello",
+			expected:   "repo:octo/hello is:pr is:open",
+		},
+		{
+			name:       "owner alone scopes to the org",
+			query:      "is:open",
+			searchType: "issue",
+			owner:      "octo",
+			expected:   "org:octo is:issue is:open",
+		},
+		{
+			name:       "repo alone is ignored",
+			query:      "is:open",
+			searchType: "issue",
+			repo:       "hello",
+			expected:   "is:issue is:open",
+		},
+		{
+			name:       "existing scope and type qualifiers are not duplicated",
+			query:      "user:octo is:PR is:open",
+			searchType: "pr",
+			owner:      "other",
+			repo:       "repo",
+			expected:   "user:octo is:PR is:open",
+		},
+		{
+			name:       "negated scope qualifiers exclude rather than scope",
+			query:      "-repo:octo/hello crash",
+			searchType: "issue",
+			owner:      "octo",
+			expected:   "org:octo is:issue -repo:octo/hello crash",
+		},
+		{
+			name:       "unknown qualifiers and stray quotes are quoted",
+			query:      `http://example.com "unterminated phrase`,
+			searchType: "issue",
+			expected:   `is:issue "http://example.com" "unterminated phrase"`,
+		},
+		{
+			name:       "type:pr counts as the pull request type qualifier",
+			query:      "type:pr review-requested:@me",
+			searchType: "pr",
+			expected:   "type:pr review-requested:@me",
+		},
+		{
+			name:       "pull request state qualifiers don't count as the type",
+			query:      "is:merged is:draft merged:>=2024-06-01 reviewed-by:octocat",
+			searchType: "pr",
+			owner:      "octo",
+			repo:       "hello",
+			expected:   "repo:octo/hello is:pr is:merged is:draft merged:>=2024-06-01 reviewed-by:octocat",
+		},
+		{
+			name:       "quoted qualifier values are kept intact",
+			query:      `label:"help wanted" "exact phrase"`,
+			searchType: "issue",
+			expected:   `is:issue label:"help wanted" "exact phrase"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, buildSearchQuery(tc.query, tc.searchType, tc.owner, tc.repo))
+		})
+	}
+}
+
+func Test_ScopeSearchQuery(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		owner    string
+		repo     string
+		expected string
+	}{
+		{
+			name:     "unscoped without owner",
+			query:    "fmt.Println",
+			expected: "fmt.Println",
+		},
+		{
+			name:     "owner and repo scope to the repository",
+			query:    "fmt.Println language:go",
+			owner:    "octo",
+			repo:     "hello",
+			expected: "repo:octo/hello fmt.Println language:go",
+		},
+		{
+			name:     "owner alone scopes to the org",
+			query:    "fmt.Println",
+			owner:    "octo",
+			expected: "org:octo fmt.Println",
+		},
+		{
+			name:     "existing scope is kept",
+			query:    "fmt.Println user:other",
+			owner:    "octo",
+			repo:     "hello",
+			expected: "fmt.Println user:other",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, scopeSearchQuery(tc.query, tc.owner, tc.repo))
+		})
+	}
+}
@@ -14,10 +14,86 @@ import (
 	"github.com/mark3labs/mcp-go/server"
 )
 
+// SecretScanningAlertSummary is the compact form of a secret scanning alert, as returned by
+// list_secret_scanning_alerts. It deliberately has no field for the secret, which the API returns in
+// plain text, so a leaked credential is never passed on.
+type SecretScanningAlertSummary struct {
+	Number                 int    `json:"number"`
+	SecretType             string `json:"secret_type"`
+	SecretTypeDisplayName  string `json:"secret_type_display_name,omitempty"`
+	State                  string `json:"state"`
+	Resolution             string `json:"resolution,omitempty"`
+	CreatedAt              string `json:"created_at,omitempty"`
+	ResolvedAt             string `json:"resolved_at,omitempty"`
+	PushProtectionBypassed bool   `json:"push_protection_bypassed"`
+	HTMLURL                string `json:"html_url"`
+}
+
+// summarizeSecretScanningAlert converts a secret scanning alert into its compact summary form, leaving
+// out the secret.
+func summarizeSecretScanningAlert(alert *github.SecretScanningAlert) SecretScanningAlertSummary {
+	return SecretScanningAlertSummary{
+		Number:                 alert.GetNumber(),
+		SecretType:             alert.GetSecretType(),
+		SecretTypeDisplayName:  alert.GetSecretTypeDisplayName(),
+		State:                  alert.GetState(),
+		Resolution:             alert.GetResolution(),
+		CreatedAt:              summaryTimestamp(alert.GetCreatedAt()),
+		ResolvedAt:             summaryTimestamp(alert.GetResolvedAt()),
+		PushProtectionBypassed: alert.GetPushProtectionBypassed(),
+		HTMLURL:                alert.GetHTMLURL(),
+	}
+}
+
+// secretScanningErrorResponse describes a failed secret scanning request, explaining a 404 as secret
+// scanning not being enabled.
+func secretScanningErrorResponse(ctx context.Context, message string, resp *github.Response, err error) *mcp.CallToolResult {
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		message = fmt.Sprintf("%s: secret scanning is not enabled for this repository, or the repository doesn't exist", message)
+		_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, message, resp, err)
+		return mcp.NewToolResultError(message)
+	}
+	return ghErrors.NewGitHubAPIErrorResponse(ctx, message, resp, err)
+}
+
+// SecretScanningAlertLocation is where a secret of a secret scanning alert was found.
+type SecretScanningAlertLocation struct {
+	Type      string `json:"type"`
+	Path      string `json:"path,omitempty"`
+	StartLine int    `json:"start_line,omitempty"`
+	EndLine   int    `json:"end_line,omitempty"`
+	CommitSHA string `json:"commit_sha,omitempty"`
+}
+
+// SecretScanningAlertDetails is a secret scanning alert, as returned by get_secret_scanning_alert and
+// update_secret_scanning_alert. Like the summary, it has no field for the secret.
+type SecretScanningAlertDetails struct {
+	SecretScanningAlertSummary
+	ResolvedBy               string                        `json:"resolved_by,omitempty"`
+	ResolutionComment        string                        `json:"resolution_comment,omitempty"`
+	PushProtectionBypassedBy string                        `json:"push_protection_bypassed_by,omitempty"`
+	PubliclyLeaked           bool                          `json:"publicly_leaked"`
+	Validity                 string                        `json:"validity,omitempty"`
+	Locations                []SecretScanningAlertLocation `json:"locations,omitempty"`
+}
+
+// newSecretScanningAlertDetails converts a secret scanning alert into its detailed form, leaving out
+// the secret.
+func newSecretScanningAlertDetails(alert *github.SecretScanningAlert) SecretScanningAlertDetails {
+	return SecretScanningAlertDetails{
+		SecretScanningAlertSummary: summarizeSecretScanningAlert(alert),
+		ResolvedBy:                 alert.GetResolvedBy().GetLogin(),
+		ResolutionComment:          alert.GetResolutionComment(),
+		PushProtectionBypassedBy:   alert.GetPushProtectionBypassedBy().GetLogin(),
+		PubliclyLeaked:             alert.GetPubliclyLeaked(),
+		Validity:                   alert.GetValidity(),
+	}
+}
+
 func GetSecretScanningAlert(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool(
 			"get_secret_scanning_alert",
-			mcp.WithDescription(t("TOOL_GET_SECRET_SCANNING_ALERT_DESCRIPTION", "Get details of a specific secret scanning alert in a GitHub repository.")),
+			mcp.WithDescription(t("TOOL_GET_SECRET_SCANNING_ALERT_DESCRIPTION", "Get details of a specific secret scanning alert in a GitHub repository. The secret itself is never included.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_GET_SECRET_SCANNING_ALERT_USER_TITLE", "Get secret scanning alert"),
 				ReadOnlyHint: ToBoolPtr(true),
@@ -34,6 +110,10 @@ func GetSecretScanningAlert(getClient GetClientFn, t translations.TranslationHel
 				mcp.Required(),
 				mcp.Description("The number of the alert."),
 			),
+			mcp.WithBoolean("include_locations",
+				mcp.Description("Include the files and commits the secret was found in, paginated with page and perPage."),
+			),
+			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](request, "owner")
@@ -44,7 +124,15 @@ func GetSecretScanningAlert(getClient GetClientFn, t translations.TranslationHel
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			alertNumber, err := RequiredInt(request, "alertNumber")
+			alertNumber, err := RequiredBigInt(request, "alertNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			includeLocations, err := OptionalParam[bool](request, "include_locations")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
@@ -54,7 +142,7 @@ func GetSecretScanningAlert(getClient GetClientFn, t translations.TranslationHel
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
 
-			alert, resp, err := client.SecretScanning.GetAlert(ctx, owner, repo, int64(alertNumber))
+			alert, resp, err := client.SecretScanning.GetAlert(ctx, owner, repo, alertNumber)
 			if err != nil {
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
 					fmt.Sprintf("failed to get alert with number '%d'", alertNumber),
@@ -72,7 +160,34 @@ func GetSecretScanningAlert(getClient GetClientFn, t translations.TranslationHel
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get alert: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(alert)
+			details := newSecretScanningAlertDetails(alert)
+			if includeLocations {
+				locations, locationsResp, err := client.SecretScanning.ListLocationsForAlert(ctx, owner, repo, alertNumber, &github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to list locations of alert with number '%d'", alertNumber),
+						locationsResp,
+						err,
+					), nil
+				}
+				_ = locationsResp.Body.Close()
+
+				details.Locations = make([]SecretScanningAlertLocation, 0, len(locations))
+				for _, location := range locations {
+					details.Locations = append(details.Locations, SecretScanningAlertLocation{
+						Type:      location.GetType(),
+						Path:      location.GetDetails().GetPath(),
+						StartLine: location.GetDetails().GetStartline(),
+						EndLine:   location.GetDetails().GetEndLine(),
+						CommitSHA: location.GetDetails().GetCommitSHA(),
+					})
+				}
+			}
+
+			r, err := json.Marshal(details)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal alert: %w", err)
 			}
@@ -84,7 +199,7 @@ func GetSecretScanningAlert(getClient GetClientFn, t translations.TranslationHel
 func ListSecretScanningAlerts(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool(
 			"list_secret_scanning_alerts",
-			mcp.WithDescription(t("TOOL_LIST_SECRET_SCANNING_ALERTS_DESCRIPTION", "List secret scanning alerts in a GitHub repository.")),
+			mcp.WithDescription(t("TOOL_LIST_SECRET_SCANNING_ALERTS_DESCRIPTION", "List secret scanning alerts in a GitHub repository. The secrets themselves are never included.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_LIST_SECRET_SCANNING_ALERTS_USER_TITLE", "List secret scanning alerts"),
 				ReadOnlyHint: ToBoolPtr(true),
@@ -108,6 +223,7 @@ func ListSecretScanningAlerts(getClient GetClientFn, t translations.TranslationH
 				mcp.Description("Filter by resolution"),
 				mcp.Enum("false_positive", "wont_fix", "revoked", "pattern_edited", "pattern_deleted", "used_in_tests"),
 			),
+			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](request, "owner")
@@ -130,14 +246,26 @@ func ListSecretScanningAlerts(getClient GetClientFn, t translations.TranslationH
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			alerts, resp, err := client.SecretScanning.ListAlertsForRepo(ctx, owner, repo, &github.SecretScanningAlertListOptions{State: state, SecretType: secretType, Resolution: resolution})
+			alerts, resp, err := client.SecretScanning.ListAlertsForRepo(ctx, owner, repo, &github.SecretScanningAlertListOptions{
+				State:      state,
+				SecretType: secretType,
+				Resolution: resolution,
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			})
 			if err != nil {
-				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+				return secretScanningErrorResponse(ctx,
 					fmt.Sprintf("failed to list alerts for repository '%s/%s'", owner, repo),
 					resp,
 					err,
@@ -153,7 +281,12 @@ func ListSecretScanningAlerts(getClient GetClientFn, t translations.TranslationH
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list alerts: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(alerts)
+			summaries := make([]SecretScanningAlertSummary, 0, len(alerts))
+			for _, alert := range alerts {
+				summaries = append(summaries, summarizeSecretScanningAlert(alert))
+			}
+
+			r, err := json.Marshal(summaries)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal alerts: %w", err)
 			}
@@ -161,3 +294,108 @@ func ListSecretScanningAlerts(getClient GetClientFn, t translations.TranslationH
 			return mcp.NewToolResultText(string(r)), nil
 		}
 }
+
+func UpdateSecretScanningAlert(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"update_secret_scanning_alert",
+			mcp.WithDescription(t("TOOL_UPDATE_SECRET_SCANNING_ALERT_DESCRIPTION", "Resolve or reopen a secret scanning alert in a GitHub repository. Resolving requires a resolution.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UPDATE_SECRET_SCANNING_ALERT_USER_TITLE", "Update secret scanning alert"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("The owner of the repository."),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("The name of the repository."),
+			),
+			mcp.WithNumber("alertNumber",
+				mcp.Required(),
+				mcp.Description("The number of the alert."),
+			),
+			mcp.WithString("state",
+				mcp.Required(),
+				mcp.Description("The new state of the alert."),
+				mcp.Enum("open", "resolved"),
+			),
+			mcp.WithString("resolution",
+				mcp.Description("The reason for resolving the alert. Required when state is resolved."),
+				mcp.Enum("false_positive", "wont_fix", "revoked", "used_in_tests"),
+			),
+			mcp.WithString("resolution_comment",
+				mcp.Description("A comment explaining the resolution."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			alertNumber, err := RequiredBigInt(request, "alertNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			state, err := RequiredParam[string](request, "state")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			resolution, err := OptionalParam[string](request, "resolution")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			resolutionComment, err := OptionalParam[string](request, "resolution_comment")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.SecretScanningAlertUpdateOptions{State: state}
+			switch state {
+			case "open":
+				if resolution != "" {
+					return mcp.NewToolResultError("resolution can only be set when state is resolved"), nil
+				}
+			case "resolved":
+				switch resolution {
+				case "":
+					return mcp.NewToolResultError("resolution is required when state is resolved"), nil
+				case "false_positive", "wont_fix", "revoked", "used_in_tests":
+				default:
+					return mcp.NewToolResultError(fmt.Sprintf("invalid resolution %q, must be one of false_positive, wont_fix, revoked, used_in_tests", resolution)), nil
+				}
+				opts.Resolution = github.Ptr(resolution)
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("invalid state %q, must be open or resolved", state)), nil
+			}
+			if resolutionComment != "" {
+				opts.ResolutionComment = github.Ptr(resolutionComment)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			alert, resp, err := client.SecretScanning.UpdateAlert(ctx, owner, repo, alertNumber, opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to update alert with number '%d'", alertNumber),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(newSecretScanningAlertDetails(alert))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal alert: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -24,11 +24,48 @@ func Test_GetSecretScanningAlert(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "alertNumber")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "alertNumber"})
 
+	assert.Contains(t, tool.InputSchema.Properties, "include_locations")
+
 	// Setup mock alert for success case
 	mockAlert := &github.SecretScanningAlert{
-		Number:  github.Ptr(42),
-		State:   github.Ptr("open"),
-		HTMLURL: github.Ptr("https://github.com/owner/private-repo/security/secret-scanning/42"),
+		Number:                github.Ptr(42),
+		State:                 github.Ptr("open"),
+		SecretType:            github.Ptr("github_personal_access_token"),
+		SecretTypeDisplayName: github.Ptr("GitHub Personal Access Token"),
+		Secret:                github.Ptr("ghp_leakedsecretvalue0123456789"),
+		Validity:              github.Ptr("active"),
+		HTMLURL:               github.Ptr("https://github.com/owner/private-repo/security/secret-scanning/42"),
+	}
+	mockLocations := []*github.SecretScanningAlertLocation{
+		{
+			Type: github.Ptr("commit"),
+			Details: &github.SecretScanningAlertLocationDetails{
+				Path:      github.Ptr("config/settings.yml"),
+				Startline: github.Ptr(3),
+				EndLine:   github.Ptr(3),
+				CommitSHA: github.Ptr("f14d7debf9775f957cf4f1e8176da0786431f72b"),
+			},
+		},
+	}
+	expectedAlert := SecretScanningAlertDetails{
+		SecretScanningAlertSummary: SecretScanningAlertSummary{
+			Number:                42,
+			SecretType:            "github_personal_access_token",
+			SecretTypeDisplayName: "GitHub Personal Access Token",
+			State:                 "open",
+			HTMLURL:               "https://github.com/owner/private-repo/security/secret-scanning/42",
+		},
+		Validity: "active",
+	}
+	expectedAlertWithLocations := expectedAlert
+	expectedAlertWithLocations.Locations = []SecretScanningAlertLocation{
+		{
+			Type:      "commit",
+			Path:      "config/settings.yml",
+			StartLine: 3,
+			EndLine:   3,
+			CommitSHA: "f14d7debf9775f957cf4f1e8176da0786431f72b",
+		},
 	}
 
 	tests := []struct {
@@ -36,7 +73,7 @@ func Test_GetSecretScanningAlert(t *testing.T) {
 		mockedClient   *http.Client
 		requestArgs    map[string]interface{}
 		expectError    bool
-		expectedAlert  *github.SecretScanningAlert
+		expectedAlert  SecretScanningAlertDetails
 		expectedErrMsg string
 	}{
 		{
@@ -53,7 +90,33 @@ func Test_GetSecretScanningAlert(t *testing.T) {
 				"alertNumber": float64(42),
 			},
 			expectError:   false,
-			expectedAlert: mockAlert,
+			expectedAlert: expectedAlert,
+		},
+		{
+			name: "alert fetch with locations",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposSecretScanningAlertsByOwnerByRepoByAlertNumber,
+					mockAlert,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposSecretScanningAlertsLocationsByOwnerByRepoByAlertNumber,
+					expectQueryParams(t, map[string]string{
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockLocations),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"alertNumber":       float64(42),
+				"include_locations": true,
+			},
+			expectError:   false,
+			expectedAlert: expectedAlertWithLocations,
 		},
 		{
 			name: "alert fetch fails",
@@ -103,14 +166,14 @@ func Test_GetSecretScanningAlert(t *testing.T) {
 			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
+			// The secret returned by the API must never be passed on
+			assert.NotContains(t, textContent.Text, "ghp_leakedsecretvalue0123456789")
+
 			// Unmarshal and verify the result
-			var returnedAlert github.Alert
+			var returnedAlert SecretScanningAlertDetails
 			err = json.Unmarshal([]byte(textContent.Text), &returnedAlert)
 			assert.NoError(t, err)
-			assert.Equal(t, *tc.expectedAlert.Number, *returnedAlert.Number)
-			assert.Equal(t, *tc.expectedAlert.State, *returnedAlert.State)
-			assert.Equal(t, *tc.expectedAlert.HTMLURL, *returnedAlert.HTMLURL)
-
+			assert.Equal(t, tc.expectedAlert, returnedAlert)
 		})
 	}
 }
@@ -127,6 +190,8 @@ func Test_ListSecretScanningAlerts(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "state")
 	assert.Contains(t, tool.InputSchema.Properties, "secret_type")
 	assert.Contains(t, tool.InputSchema.Properties, "resolution")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
 
 	// Setup mock alerts for success case
@@ -136,6 +201,7 @@ func Test_ListSecretScanningAlerts(t *testing.T) {
 		State:      github.Ptr("resolved"),
 		Resolution: github.Ptr("false_positive"),
 		SecretType: github.Ptr("adafruit_io_key"),
+		Secret:     github.Ptr("aio_leakedsecretvalue0123456789"),
 	}
 	openAlert := github.SecretScanningAlert{
 		Number:     github.Ptr(2),
@@ -143,6 +209,7 @@ func Test_ListSecretScanningAlerts(t *testing.T) {
 		State:      github.Ptr("open"),
 		Resolution: github.Ptr("false_positive"),
 		SecretType: github.Ptr("adafruit_io_key"),
+		Secret:     github.Ptr("aio_leakedsecretvalue0123456789"),
 	}
 
 	tests := []struct {
@@ -159,7 +226,9 @@ func Test_ListSecretScanningAlerts(t *testing.T) {
 				mock.WithRequestMatchHandler(
 					mock.GetReposSecretScanningAlertsByOwnerByRepo,
 					expectQueryParams(t, map[string]string{
-						"state": "resolved",
+						"state":    "resolved",
+						"page":     "1",
+						"per_page": "30",
 					}).andThen(
 						mockResponse(t, http.StatusOK, []*github.SecretScanningAlert{&resolvedAlert}),
 					),
@@ -178,7 +247,10 @@ func Test_ListSecretScanningAlerts(t *testing.T) {
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
 					mock.GetReposSecretScanningAlertsByOwnerByRepo,
-					expectQueryParams(t, map[string]string{}).andThen(
+					expectQueryParams(t, map[string]string{
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
 						mockResponse(t, http.StatusOK, []*github.SecretScanningAlert{&resolvedAlert, &openAlert}),
 					),
 				),
@@ -208,6 +280,24 @@ func Test_ListSecretScanningAlerts(t *testing.T) {
 			expectError:    true,
 			expectedErrMsg: "failed to list alerts",
 		},
+		{
+			name: "secret scanning not enabled",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposSecretScanningAlertsByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Secret scanning is disabled on this repository."}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "secret scanning is not enabled for this repository",
+		},
 	}
 
 	for _, tc := range tests {
@@ -232,6 +322,10 @@ func Test_ListSecretScanningAlerts(t *testing.T) {
 
 			textContent := getTextResult(t, result)
 
+			// The secret returned by the API must never be passed on
+			assert.NotContains(t, textContent.Text, "aio_leakedsecretvalue0123456789")
+			assert.NotContains(t, textContent.Text, `"secret"`)
+
 			// Unmarshal and verify the result
 			var returnedAlerts []*github.SecretScanningAlert
 			err = json.Unmarshal([]byte(textContent.Text), &returnedAlerts)
@@ -247,3 +341,160 @@ func Test_ListSecretScanningAlerts(t *testing.T) {
 		})
 	}
 }
+
+func Test_UpdateSecretScanningAlert(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateSecretScanningAlert(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "update_secret_scanning_alert", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "alertNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "state")
+	assert.Contains(t, tool.InputSchema.Properties, "resolution")
+	assert.Contains(t, tool.InputSchema.Properties, "resolution_comment")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "alertNumber", "state"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	resolvedAlert := &github.SecretScanningAlert{
+		Number:            github.Ptr(42),
+		State:             github.Ptr("resolved"),
+		Resolution:        github.Ptr("revoked"),
+		ResolutionComment: github.Ptr("Token rotated"),
+		ResolvedBy:        &github.User{Login: github.Ptr("octocat")},
+		SecretType:        github.Ptr("github_personal_access_token"),
+		Secret:            github.Ptr("ghp_leakedsecretvalue0123456789"),
+		HTMLURL:           github.Ptr("https://github.com/owner/repo/security/secret-scanning/42"),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedAlert  SecretScanningAlertDetails
+		expectedErrMsg string
+	}{
+		{
+			name: "resolve alert",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposSecretScanningAlertsByOwnerByRepoByAlertNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"state":              "resolved",
+						"resolution":         "revoked",
+						"resolution_comment": "Token rotated",
+					}).andThen(
+						mockResponse(t, http.StatusOK, resolvedAlert),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":              "owner",
+				"repo":               "repo",
+				"alertNumber":        float64(42),
+				"state":              "resolved",
+				"resolution":         "revoked",
+				"resolution_comment": "Token rotated",
+			},
+			expectError: false,
+			expectedAlert: SecretScanningAlertDetails{
+				SecretScanningAlertSummary: SecretScanningAlertSummary{
+					Number:     42,
+					SecretType: "github_personal_access_token",
+					State:      "resolved",
+					Resolution: "revoked",
+					HTMLURL:    "https://github.com/owner/repo/security/secret-scanning/42",
+				},
+				ResolvedBy:        "octocat",
+				ResolutionComment: "Token rotated",
+			},
+		},
+		{
+			name:         "resolve without resolution",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"alertNumber": float64(42),
+				"state":       "resolved",
+			},
+			expectError:    true,
+			expectedErrMsg: "resolution is required when state is resolved",
+		},
+		{
+			name:         "invalid resolution",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"alertNumber": float64(42),
+				"state":       "resolved",
+				"resolution":  "pattern_deleted",
+			},
+			expectError:    true,
+			expectedErrMsg: `invalid resolution "pattern_deleted"`,
+		},
+		{
+			name:         "resolution when reopening",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"alertNumber": float64(42),
+				"state":       "open",
+				"resolution":  "wont_fix",
+			},
+			expectError:    true,
+			expectedErrMsg: "resolution can only be set when state is resolved",
+		},
+		{
+			name: "update fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposSecretScanningAlertsByOwnerByRepoByAlertNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Resource not accessible by integration"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"alertNumber": float64(42),
+				"state":       "open",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to update alert with number '42'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdateSecretScanningAlert(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+			assert.NotContains(t, textContent.Text, "ghp_leakedsecretvalue0123456789")
+
+			var returnedAlert SecretScanningAlertDetails
+			err = json.Unmarshal([]byte(textContent.Text), &returnedAlert)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedAlert, returnedAlert)
+		})
+	}
+}
@@ -0,0 +1,506 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v73/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// securityAdvisoryReadPermission explains a 403 from reading the security advisories of a repository
+	// when the user lacks the role to see triage and draft advisories.
+	securityAdvisoryReadPermission = "reading triage and draft security advisories requires the admin or security manager role on the repository"
+	// securityAdvisoryReadTokenPermission explains a 403 from reading security advisories with a token that
+	// lacks the permission, which GitHub reports as the resource not being accessible.
+	securityAdvisoryReadTokenPermission = "reading security advisories requires a token with the repo scope or the repository_advisories read permission"
+	// securityAdvisoryWritePermission explains a 403 from creating a security advisory when the user lacks the role.
+	securityAdvisoryWritePermission = "creating a security advisory requires the admin or security manager role on the repository"
+	// securityAdvisoryWriteTokenPermission explains a 403 from creating a security advisory with a token that
+	// lacks the permission.
+	securityAdvisoryWriteTokenPermission = "creating a security advisory requires a token with the repo scope or the repository_advisories write permission"
+)
+
+// SecurityAdvisorySummary is the compact form of a repository security advisory, as returned by
+// list_repository_security_advisories.
+type SecurityAdvisorySummary struct {
+	GHSAID   string `json:"ghsa_id"`
+	CVEID    string `json:"cve_id,omitempty"`
+	Summary  string `json:"summary"`
+	Severity string `json:"severity,omitempty"`
+	State    string `json:"state"`
+	HTMLURL  string `json:"html_url"`
+}
+
+// SecurityAdvisoryVulnerability is a package affected by a security advisory and its vulnerable and patched versions.
+type SecurityAdvisoryVulnerability struct {
+	Ecosystem              string `json:"ecosystem"`
+	Package                string `json:"package"`
+	VulnerableVersionRange string `json:"vulnerable_version_range,omitempty"`
+	PatchedVersions        string `json:"patched_versions,omitempty"`
+}
+
+// SecurityAdvisoryCredit is a user credited on a security advisory.
+type SecurityAdvisoryCredit struct {
+	Login string `json:"login"`
+	Type  string `json:"type"`
+	State string `json:"state,omitempty"`
+}
+
+// SecurityAdvisoryDetails is a repository security advisory, as returned by get_repository_security_advisory
+// and create_repository_security_advisory_draft.
+type SecurityAdvisoryDetails struct {
+	SecurityAdvisorySummary
+	Description     string                          `json:"description,omitempty"`
+	CVSSScore       *float64                        `json:"cvss_score,omitempty"`
+	CWEIDs          []string                        `json:"cwe_ids,omitempty"`
+	Vulnerabilities []SecurityAdvisoryVulnerability `json:"vulnerabilities"`
+	Credits         []SecurityAdvisoryCredit        `json:"credits"`
+	Author          string                          `json:"author,omitempty"`
+	PublishedAt     string                          `json:"published_at,omitempty"`
+}
+
+// summarizeSecurityAdvisory converts a repository security advisory into its compact summary form.
+func summarizeSecurityAdvisory(advisory *github.SecurityAdvisory) SecurityAdvisorySummary {
+	return SecurityAdvisorySummary{
+		GHSAID:   advisory.GetGHSAID(),
+		CVEID:    advisory.GetCVEID(),
+		Summary:  advisory.GetSummary(),
+		Severity: advisory.GetSeverity(),
+		State:    advisory.GetState(),
+		HTMLURL:  advisory.GetHTMLURL(),
+	}
+}
+
+// newSecurityAdvisoryDetails converts a repository security advisory into its detailed form.
+func newSecurityAdvisoryDetails(advisory *github.SecurityAdvisory) SecurityAdvisoryDetails {
+	details := SecurityAdvisoryDetails{
+		SecurityAdvisorySummary: summarizeSecurityAdvisory(advisory),
+		Description:             advisory.GetDescription(),
+		CVSSScore:               advisory.GetCVSS().GetScore(),
+		CWEIDs:                  advisory.CWEIDs,
+		Vulnerabilities:         make([]SecurityAdvisoryVulnerability, 0, len(advisory.Vulnerabilities)),
+		Credits:                 make([]SecurityAdvisoryCredit, 0, len(advisory.CreditsDetailed)),
+		Author:                  advisory.GetAuthor().GetLogin(),
+	}
+	if advisory.PublishedAt != nil {
+		details.PublishedAt = summaryTimestamp(*advisory.PublishedAt)
+	}
+	for _, vulnerability := range advisory.Vulnerabilities {
+		details.Vulnerabilities = append(details.Vulnerabilities, SecurityAdvisoryVulnerability{
+			Ecosystem:              vulnerability.GetPackage().GetEcosystem(),
+			Package:                vulnerability.GetPackage().GetName(),
+			VulnerableVersionRange: vulnerability.GetVulnerableVersionRange(),
+			PatchedVersions:        vulnerability.GetPatchedVersions(),
+		})
+	}
+	for _, credit := range advisory.CreditsDetailed {
+		details.Credits = append(details.Credits, SecurityAdvisoryCredit{
+			Login: credit.GetUser().GetLogin(),
+			Type:  credit.GetType(),
+			State: credit.GetState(),
+		})
+	}
+	return details
+}
+
+// securityAdvisoryErrorResponse describes a failed security advisory request. A 403 is explained as the token
+// lacking permission when GitHub says the resource isn't accessible to it, and as the user lacking the role
+// otherwise. Rate limits and GitHub's message are handled by permissionErrorResponse.
+func securityAdvisoryErrorResponse(ctx context.Context, message, rolePermission, tokenPermission string, resp *github.Response, err error) *mcp.CallToolResult {
+	permission := rolePermission
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) && strings.HasPrefix(errResp.Message, "Resource not accessible by") {
+		permission = tokenPermission
+	}
+	return permissionErrorResponse(ctx, message, permission, resp, err)
+}
+
+// getRepositorySecurityAdvisory gets a repository security advisory by its GHSA ID. go-github can
+// only list repository advisories, so the request is built directly.
+func getRepositorySecurityAdvisory(ctx context.Context, client *github.Client, owner, repo, ghsaID string) (*github.SecurityAdvisory, *github.Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/security-advisories/%v", owner, repo, url.PathEscape(ghsaID))
+	req, err := client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var advisory *github.SecurityAdvisory
+	resp, err := client.Do(ctx, req, &advisory)
+	if err != nil {
+		return nil, resp, err
+	}
+	return advisory, resp, nil
+}
+
+// securityAdvisoryDraftPackage is the affected package of a security advisory draft.
+type securityAdvisoryDraftPackage struct {
+	Ecosystem string `json:"ecosystem"`
+	Name      string `json:"name,omitempty"`
+}
+
+// securityAdvisoryDraftVulnerability is an affected package of a security advisory draft and its versions.
+type securityAdvisoryDraftVulnerability struct {
+	Package                securityAdvisoryDraftPackage `json:"package"`
+	VulnerableVersionRange *string                      `json:"vulnerable_version_range,omitempty"`
+	PatchedVersions        *string                      `json:"patched_versions,omitempty"`
+}
+
+// securityAdvisoryDraft is the request body for creating a repository security advisory.
+type securityAdvisoryDraft struct {
+	Summary         string                               `json:"summary"`
+	Description     string                               `json:"description"`
+	Severity        *string                              `json:"severity,omitempty"`
+	CVEID           *string                              `json:"cve_id,omitempty"`
+	CWEIDs          []string                             `json:"cwe_ids,omitempty"`
+	Vulnerabilities []securityAdvisoryDraftVulnerability `json:"vulnerabilities"`
+}
+
+// createRepositorySecurityAdvisory creates a draft repository security advisory. go-github has no
+// method for it, so the request is built directly.
+func createRepositorySecurityAdvisory(ctx context.Context, client *github.Client, owner, repo string, draft *securityAdvisoryDraft) (*github.SecurityAdvisory, *github.Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/security-advisories", owner, repo)
+	req, err := client.NewRequest(http.MethodPost, u, draft)
+	if err != nil {
+		return nil, nil, err
+	}
+	var advisory *github.SecurityAdvisory
+	resp, err := client.Do(ctx, req, &advisory)
+	if err != nil {
+		return nil, resp, err
+	}
+	return advisory, resp, nil
+}
+
+// ListRepositorySecurityAdvisories creates a tool to list the security advisories of a repository.
+func ListRepositorySecurityAdvisories(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_repository_security_advisories",
+			mcp.WithDescription(t("TOOL_LIST_REPOSITORY_SECURITY_ADVISORIES_DESCRIPTION", "List the security advisories (GHSAs) of a GitHub repository. Triage and draft advisories are only listed for repository admins and security managers. Pages are selected with the after and before cursors returned in pageInfo.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_REPOSITORY_SECURITY_ADVISORIES_USER_TITLE", "List repository security advisories"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("state",
+				mcp.Description("Filter advisories by state"),
+				mcp.Enum("triage", "draft", "published", "closed"),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Sort advisories by when they were created, updated or published"),
+				mcp.Enum("created", "updated", "published"),
+			),
+			mcp.WithString("direction",
+				mcp.Description("The direction to sort the results by"),
+				mcp.Enum("asc", "desc"),
+			),
+			WithCursorPagination(),
+			mcp.WithString("before",
+				mcp.Description("Cursor for the previous page, from the startCursor of the current page's pageInfo."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			state, err := OptionalParam[string](request, "state")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sort, err := OptionalParam[string](request, "sort")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			direction, err := OptionalParam[string](request, "direction")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalCursorPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			before, err := OptionalParam[string](request, "before")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if pagination.After != "" && before != "" {
+				return mcp.NewToolResultError("after and before cannot be used together"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			advisories, resp, err := client.SecurityAdvisories.ListRepositorySecurityAdvisories(ctx, owner, repo, &github.ListRepositorySecurityAdvisoriesOptions{
+				State:     state,
+				Sort:      sort,
+				Direction: direction,
+				ListCursorOptions: github.ListCursorOptions{
+					PerPage: pagination.PerPage,
+					After:   pagination.After,
+					Before:  before,
+				},
+			})
+			if err != nil {
+				return securityAdvisoryErrorResponse(ctx,
+					fmt.Sprintf("failed to list security advisories for repository '%s/%s'", owner, repo),
+					securityAdvisoryReadPermission,
+					securityAdvisoryReadTokenPermission,
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			summaries := make([]SecurityAdvisorySummary, 0, len(advisories))
+			for _, advisory := range advisories {
+				summaries = append(summaries, summarizeSecurityAdvisory(advisory))
+			}
+
+			// The cursors of the neighbouring pages come from the Link header.
+			r, err := json.Marshal(map[string]any{
+				"advisories": summaries,
+				"pageInfo": map[string]any{
+					"hasNextPage":     resp.After != "",
+					"hasPreviousPage": resp.Before != "",
+					"endCursor":       resp.After,
+					"startCursor":     resp.Before,
+				},
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal advisories: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// GetRepositorySecurityAdvisory creates a tool to get a security advisory of a repository by its GHSA ID.
+func GetRepositorySecurityAdvisory(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_repository_security_advisory",
+			mcp.WithDescription(t("TOOL_GET_REPOSITORY_SECURITY_ADVISORY_DESCRIPTION", "Get a security advisory of a GitHub repository by its GHSA ID, including its description, severity, CVE ID, affected packages and version ranges, and credits")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_REPOSITORY_SECURITY_ADVISORY_USER_TITLE", "Get repository security advisory"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("ghsa_id",
+				mcp.Required(),
+				mcp.Description("The GHSA ID of the advisory, such as GHSA-xxxx-xxxx-xxxx"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ghsaID, err := RequiredParam[string](request, "ghsa_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			advisory, resp, err := getRepositorySecurityAdvisory(ctx, client, owner, repo, ghsaID)
+			if err != nil {
+				// Triage and draft advisories are hidden from users without the role, so a 404 can mean either.
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("security advisory '%s' not found in %s/%s; check the GHSA ID, and that the repository exists. Triage and draft advisories are only visible with the admin or security manager role", ghsaID, owner, repo),
+						resp,
+						err,
+					), nil
+				}
+				return securityAdvisoryErrorResponse(ctx,
+					fmt.Sprintf("failed to get security advisory '%s'", ghsaID),
+					securityAdvisoryReadPermission,
+					securityAdvisoryReadTokenPermission,
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(newSecurityAdvisoryDetails(advisory))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal advisory: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// CreateRepositorySecurityAdvisoryDraft creates a tool to draft a security advisory for a repository.
+func CreateRepositorySecurityAdvisoryDraft(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_repository_security_advisory_draft",
+			mcp.WithDescription(t("TOOL_CREATE_REPOSITORY_SECURITY_ADVISORY_DRAFT_DESCRIPTION", "Create a draft security advisory for a GitHub repository. The draft is private to the repository's admins and security managers until it is published.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_REPOSITORY_SECURITY_ADVISORY_DRAFT_USER_TITLE", "Create repository security advisory draft"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("summary",
+				mcp.Required(),
+				mcp.Description("A short summary of the advisory"),
+			),
+			mcp.WithString("description",
+				mcp.Required(),
+				mcp.Description("A detailed description of the vulnerability, in Markdown"),
+			),
+			mcp.WithString("severity",
+				mcp.Description("The severity of the advisory"),
+				mcp.Enum("critical", "high", "medium", "low"),
+			),
+			mcp.WithString("cve_id",
+				mcp.Description("The CVE ID of the vulnerability, if one has already been assigned"),
+			),
+			mcp.WithArray("cwe_ids",
+				mcp.Description("The CWE IDs of the weaknesses, such as CWE-79"),
+				mcp.Items(map[string]any{
+					"type": "string",
+				}),
+			),
+			mcp.WithString("ecosystem",
+				mcp.Description("The ecosystem of the affected package"),
+				mcp.Enum("rubygems", "npm", "pip", "maven", "nuget", "composer", "go", "rust", "erlang", "actions", "pub", "other", "swift"),
+			),
+			mcp.WithString("package",
+				mcp.Description("The name of the affected package. Requires ecosystem"),
+			),
+			mcp.WithString("vulnerable_version_range",
+				mcp.Description("The range of affected versions, such as < 1.2.3. Requires ecosystem"),
+			),
+			mcp.WithString("patched_versions",
+				mcp.Description("The versions that fix the vulnerability, such as 1.2.3. Requires ecosystem"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			summary, err := RequiredParam[string](request, "summary")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			description, err := RequiredParam[string](request, "description")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			severity, err := OptionalParam[string](request, "severity")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			cveID, err := OptionalParam[string](request, "cve_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			cweIDs, err := OptionalStringArrayParam(request, "cwe_ids")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ecosystem, err := OptionalParam[string](request, "ecosystem")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pkg, err := OptionalParam[string](request, "package")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			vulnerableVersionRange, err := OptionalParam[string](request, "vulnerable_version_range")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			patchedVersions, err := OptionalParam[string](request, "patched_versions")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			draft := &securityAdvisoryDraft{
+				Summary:         summary,
+				Description:     description,
+				Severity:        ToStringPtr(severity),
+				CVEID:           ToStringPtr(cveID),
+				CWEIDs:          cweIDs,
+				Vulnerabilities: []securityAdvisoryDraftVulnerability{},
+			}
+			if ecosystem != "" {
+				draft.Vulnerabilities = append(draft.Vulnerabilities, securityAdvisoryDraftVulnerability{
+					Package:                securityAdvisoryDraftPackage{Ecosystem: ecosystem, Name: pkg},
+					VulnerableVersionRange: ToStringPtr(vulnerableVersionRange),
+					PatchedVersions:        ToStringPtr(patchedVersions),
+				})
+			} else if pkg != "" || vulnerableVersionRange != "" || patchedVersions != "" {
+				return mcp.NewToolResultError("ecosystem is required when package, vulnerable_version_range or patched_versions is set"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			advisory, resp, err := createRepositorySecurityAdvisory(ctx, client, owner, repo, draft)
+			if err != nil {
+				return securityAdvisoryErrorResponse(ctx,
+					fmt.Sprintf("failed to create security advisory for repository '%s/%s'", owner, repo),
+					securityAdvisoryWritePermission,
+					securityAdvisoryWriteTokenPermission,
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(newSecurityAdvisoryDetails(advisory))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal advisory: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
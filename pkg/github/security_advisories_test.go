@@ -0,0 +1,544 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v73/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListRepositorySecurityAdvisories(t *testing.T) {
+	// Verify tool definition
+	mockClient := github.NewClient(nil)
+	tool, _ := ListRepositorySecurityAdvisories(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_repository_security_advisories", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "state")
+	assert.Contains(t, tool.InputSchema.Properties, "sort")
+	assert.Contains(t, tool.InputSchema.Properties, "direction")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.Contains(t, tool.InputSchema.Properties, "after")
+	assert.Contains(t, tool.InputSchema.Properties, "before")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	draftAdvisory := &github.SecurityAdvisory{
+		GHSAID:      github.Ptr("GHSA-abcd-1234-efgh"),
+		Summary:     github.Ptr("Path traversal in archive extraction"),
+		Description: github.Ptr("Extracting a crafted archive writes files outside the target directory."),
+		Severity:    github.Ptr("high"),
+		State:       github.Ptr("draft"),
+		HTMLURL:     github.Ptr("https://github.com/owner/repo/security/advisories/GHSA-abcd-1234-efgh"),
+	}
+
+	tests := []struct {
+		name               string
+		mockedClient       *http.Client
+		requestArgs        map[string]interface{}
+		expectError        bool
+		expectedAdvisories []SecurityAdvisorySummary
+		expectedEndCursor  string
+		expectedErrMsg     string
+	}{
+		{
+			name: "list draft advisories",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposSecurityAdvisoriesByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"state":     "draft",
+						"sort":      "updated",
+						"direction": "desc",
+						"per_page":  "30",
+					}).andThen(
+						func(w http.ResponseWriter, _ *http.Request) {
+							w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/security-advisories?after=Y3Vyc29yOjE%3D>; rel="next"`)
+							mockResponse(t, http.StatusOK, []*github.SecurityAdvisory{draftAdvisory})(w, nil)
+						},
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"state":     "draft",
+				"sort":      "updated",
+				"direction": "desc",
+			},
+			expectError: false,
+			expectedAdvisories: []SecurityAdvisorySummary{
+				{
+					GHSAID:   "GHSA-abcd-1234-efgh",
+					Summary:  "Path traversal in archive extraction",
+					Severity: "high",
+					State:    "draft",
+					HTMLURL:  "https://github.com/owner/repo/security/advisories/GHSA-abcd-1234-efgh",
+				},
+			},
+			expectedEndCursor: "Y3Vyc29yOjE=",
+		},
+		{
+			name:         "after and before together",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"after":  "Y3Vyc29yOjE=",
+				"before": "Y3Vyc29yOjI=",
+			},
+			expectError:    true,
+			expectedErrMsg: "after and before cannot be used together",
+		},
+		{
+			name: "missing permission",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposSecurityAdvisoriesByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Resource not accessible by integration"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"state": "triage",
+			},
+			expectError:    true,
+			expectedErrMsg: securityAdvisoryReadTokenPermission,
+		},
+		{
+			name: "missing role",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposSecurityAdvisoriesByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Must have admin or security manager access"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"state": "draft",
+			},
+			expectError:    true,
+			expectedErrMsg: securityAdvisoryReadPermission + ": Must have admin or security manager access",
+		},
+		{
+			name: "secondary rate limit is not reported as missing permission",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposSecurityAdvisoriesByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.Header().Set("Retry-After", "60")
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "You have exceeded a secondary rate limit.", "documentation_url": "https://docs.github.com/rest/overview/rate-limits-for-the-rest-api#about-secondary-rate-limits"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "secondary rate limit exceeded",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListRepositorySecurityAdvisories(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+
+			result, err := handler(context.Background(), request)
+
+			if tc.expectError {
+				require.NoError(t, err)
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			textContent := getTextResult(t, result)
+
+			// The description belongs to get_repository_security_advisory
+			assert.NotContains(t, textContent.Text, "crafted archive")
+
+			var returned struct {
+				Advisories []SecurityAdvisorySummary `json:"advisories"`
+				PageInfo   struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+			}
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedAdvisories, returned.Advisories)
+			assert.True(t, returned.PageInfo.HasNextPage)
+			assert.Equal(t, tc.expectedEndCursor, returned.PageInfo.EndCursor)
+		})
+	}
+}
+
+func Test_GetRepositorySecurityAdvisory(t *testing.T) {
+	// Verify tool definition
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRepositorySecurityAdvisory(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_repository_security_advisory", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "ghsa_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "ghsa_id"})
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	publishedAt := time.Date(2025, 3, 4, 10, 0, 0, 0, time.UTC)
+	mockAdvisory := &github.SecurityAdvisory{
+		GHSAID:      github.Ptr("GHSA-abcd-1234-efgh"),
+		CVEID:       github.Ptr("CVE-2025-12345"),
+		Summary:     github.Ptr("Path traversal in archive extraction"),
+		Description: github.Ptr("Extracting a crafted archive writes files outside the target directory."),
+		Severity:    github.Ptr("high"),
+		State:       github.Ptr("published"),
+		HTMLURL:     github.Ptr("https://github.com/owner/repo/security/advisories/GHSA-abcd-1234-efgh"),
+		CVSS:        &github.AdvisoryCVSS{Score: github.Ptr(7.5)},
+		CWEIDs:      []string{"CWE-22"},
+		Author:      &github.User{Login: github.Ptr("maintainer")},
+		PublishedAt: &github.Timestamp{Time: publishedAt},
+		Vulnerabilities: []*github.AdvisoryVulnerability{
+			{
+				Package:                &github.VulnerabilityPackage{Ecosystem: github.Ptr("npm"), Name: github.Ptr("unpack-it")},
+				VulnerableVersionRange: github.Ptr("< 2.1.0"),
+				PatchedVersions:        github.Ptr("2.1.0"),
+			},
+		},
+		CreditsDetailed: []*github.RepoAdvisoryCreditDetailed{
+			{
+				User:  &github.User{Login: github.Ptr("reporter")},
+				Type:  github.Ptr("finder"),
+				State: github.Ptr("accepted"),
+			},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectError      bool
+		expectedAdvisory SecurityAdvisoryDetails
+		expectedErrMsg   string
+	}{
+		{
+			name: "successful advisory fetch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposSecurityAdvisoriesByOwnerByRepoByGhsaId,
+					mockAdvisory,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"ghsa_id": "GHSA-abcd-1234-efgh",
+			},
+			expectError: false,
+			expectedAdvisory: SecurityAdvisoryDetails{
+				SecurityAdvisorySummary: SecurityAdvisorySummary{
+					GHSAID:   "GHSA-abcd-1234-efgh",
+					CVEID:    "CVE-2025-12345",
+					Summary:  "Path traversal in archive extraction",
+					Severity: "high",
+					State:    "published",
+					HTMLURL:  "https://github.com/owner/repo/security/advisories/GHSA-abcd-1234-efgh",
+				},
+				Description: "Extracting a crafted archive writes files outside the target directory.",
+				CVSSScore:   github.Ptr(7.5),
+				CWEIDs:      []string{"CWE-22"},
+				Vulnerabilities: []SecurityAdvisoryVulnerability{
+					{
+						Ecosystem:              "npm",
+						Package:                "unpack-it",
+						VulnerableVersionRange: "< 2.1.0",
+						PatchedVersions:        "2.1.0",
+					},
+				},
+				Credits: []SecurityAdvisoryCredit{
+					{Login: "reporter", Type: "finder", State: "accepted"},
+				},
+				Author:      "maintainer",
+				PublishedAt: summaryTimestamp(github.Timestamp{Time: publishedAt}),
+			},
+		},
+		{
+			name: "advisory not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposSecurityAdvisoriesByOwnerByRepoByGhsaId,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"ghsa_id": "GHSA-0000-0000-0000",
+			},
+			expectError:    true,
+			expectedErrMsg: "security advisory 'GHSA-0000-0000-0000' not found in owner/repo; check the GHSA ID",
+		},
+		{
+			name: "missing permission",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposSecurityAdvisoriesByOwnerByRepoByGhsaId,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Resource not accessible by integration"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"ghsa_id": "GHSA-abcd-1234-efgh",
+			},
+			expectError:    true,
+			expectedErrMsg: securityAdvisoryReadTokenPermission + ": Resource not accessible by integration",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetRepositorySecurityAdvisory(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+
+			result, err := handler(context.Background(), request)
+
+			if tc.expectError {
+				require.NoError(t, err)
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			textContent := getTextResult(t, result)
+
+			var returned SecurityAdvisoryDetails
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedAdvisory, returned)
+		})
+	}
+}
+
+func Test_CreateRepositorySecurityAdvisoryDraft(t *testing.T) {
+	// Verify tool definition
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateRepositorySecurityAdvisoryDraft(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_repository_security_advisory_draft", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "summary")
+	assert.Contains(t, tool.InputSchema.Properties, "description")
+	assert.Contains(t, tool.InputSchema.Properties, "severity")
+	assert.Contains(t, tool.InputSchema.Properties, "cve_id")
+	assert.Contains(t, tool.InputSchema.Properties, "cwe_ids")
+	assert.Contains(t, tool.InputSchema.Properties, "ecosystem")
+	assert.Contains(t, tool.InputSchema.Properties, "package")
+	assert.Contains(t, tool.InputSchema.Properties, "vulnerable_version_range")
+	assert.Contains(t, tool.InputSchema.Properties, "patched_versions")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "summary", "description"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	createdAdvisory := &github.SecurityAdvisory{
+		GHSAID:      github.Ptr("GHSA-abcd-1234-efgh"),
+		Summary:     github.Ptr("Path traversal in archive extraction"),
+		Description: github.Ptr("Extracting a crafted archive writes files outside the target directory."),
+		Severity:    github.Ptr("high"),
+		State:       github.Ptr("draft"),
+		HTMLURL:     github.Ptr("https://github.com/owner/repo/security/advisories/GHSA-abcd-1234-efgh"),
+		Vulnerabilities: []*github.AdvisoryVulnerability{
+			{
+				Package:                &github.VulnerabilityPackage{Ecosystem: github.Ptr("npm"), Name: github.Ptr("unpack-it")},
+				VulnerableVersionRange: github.Ptr("< 2.1.0"),
+				PatchedVersions:        github.Ptr("2.1.0"),
+			},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectError      bool
+		expectedAdvisory SecurityAdvisoryDetails
+		expectedErrMsg   string
+	}{
+		{
+			name: "create draft with affected package",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposSecurityAdvisoriesByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"summary":     "Path traversal in archive extraction",
+						"description": "Extracting a crafted archive writes files outside the target directory.",
+						"severity":    "high",
+						"cwe_ids":     []interface{}{"CWE-22"},
+						"vulnerabilities": []interface{}{
+							map[string]interface{}{
+								"package": map[string]interface{}{
+									"ecosystem": "npm",
+									"name":      "unpack-it",
+								},
+								"vulnerable_version_range": "< 2.1.0",
+								"patched_versions":         "2.1.0",
+							},
+						},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, createdAdvisory),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":                    "owner",
+				"repo":                     "repo",
+				"summary":                  "Path traversal in archive extraction",
+				"description":              "Extracting a crafted archive writes files outside the target directory.",
+				"severity":                 "high",
+				"cwe_ids":                  []interface{}{"CWE-22"},
+				"ecosystem":                "npm",
+				"package":                  "unpack-it",
+				"vulnerable_version_range": "< 2.1.0",
+				"patched_versions":         "2.1.0",
+			},
+			expectError: false,
+			expectedAdvisory: SecurityAdvisoryDetails{
+				SecurityAdvisorySummary: SecurityAdvisorySummary{
+					GHSAID:   "GHSA-abcd-1234-efgh",
+					Summary:  "Path traversal in archive extraction",
+					Severity: "high",
+					State:    "draft",
+					HTMLURL:  "https://github.com/owner/repo/security/advisories/GHSA-abcd-1234-efgh",
+				},
+				Description: "Extracting a crafted archive writes files outside the target directory.",
+				Vulnerabilities: []SecurityAdvisoryVulnerability{
+					{
+						Ecosystem:              "npm",
+						Package:                "unpack-it",
+						VulnerableVersionRange: "< 2.1.0",
+						PatchedVersions:        "2.1.0",
+					},
+				},
+				Credits: []SecurityAdvisoryCredit{},
+			},
+		},
+		{
+			name:         "package without ecosystem",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"summary":     "Path traversal in archive extraction",
+				"description": "Extracting a crafted archive writes files outside the target directory.",
+				"package":     "unpack-it",
+			},
+			expectError:    true,
+			expectedErrMsg: "ecosystem is required",
+		},
+		{
+			name:         "missing description",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"summary": "Path traversal in archive extraction",
+			},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: description",
+		},
+		{
+			name: "missing permission",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposSecurityAdvisoriesByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Must have admin or security manager access"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"summary":     "Path traversal in archive extraction",
+				"description": "Extracting a crafted archive writes files outside the target directory.",
+			},
+			expectError:    true,
+			expectedErrMsg: securityAdvisoryWritePermission + ": Must have admin or security manager access",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateRepositorySecurityAdvisoryDraft(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+
+			result, err := handler(context.Background(), request)
+
+			if tc.expectError {
+				require.NoError(t, err)
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			textContent := getTextResult(t, result)
+
+			var returned SecurityAdvisoryDetails
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedAdvisory, returned)
+		})
+	}
+}
@@ -1,9 +1,11 @@
 package github
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/google/go-github/v73/github"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -99,6 +101,26 @@ func RequiredInt(r mcp.CallToolRequest, p string) (int, error) {
 	return int(v), nil
 }
 
+// RequiredBigInt is a helper function that can be used to fetch a requested int64 parameter, such as a
+// comment or run ID, from the request.
+// It does the following checks:
+// 1. Checks if the parameter is present in the request.
+// 2. Checks if the parameter is of the expected type.
+// 3. Checks if the parameter is not empty, i.e: non-zero value
+// 4. Checks that the value is a whole number that converts to int64 without truncation
+func RequiredBigInt(r mcp.CallToolRequest, p string) (int64, error) {
+	v, err := RequiredParam[float64](r, p)
+	if err != nil {
+		return 0, err
+	}
+
+	result := int64(v)
+	if float64(result) != v {
+		return 0, fmt.Errorf("parameter %s must be a whole number that fits in int64, got %v", p, v)
+	}
+	return result, nil
+}
+
 // OptionalParam is a helper function that can be used to fetch a requested parameter from the request.
 // It does the following checks:
 // 1. Checks if the parameter is present in the request, if not, it returns its zero-value
@@ -227,14 +249,40 @@ func WithCursorPagination() mcp.ToolOption {
 	}
 }
 
+// DefaultFetchAllMaxItems is the default cap on the number of items collected when a
+// tool is asked to fetch all pages, to protect both the rate limit and the model's context.
+const DefaultFetchAllMaxItems = 1000
+
+// fetchAllPerPage is the page size used to follow pagination for fetch_all, the largest the API allows.
+const fetchAllPerPage = 100
+
+// WithFetchAll adds auto-pagination parameters to a tool. Tools that opt in must
+// handle PaginationParams.FetchAll, typically by using fetchAllPages.
+func WithFetchAll() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithBoolean("fetch_all",
+			mcp.Description("When true, follow pagination and return all results (up to max_items) in a single response, starting from the first item of the given page"),
+		)(tool)
+
+		mcp.WithNumber("max_items",
+			mcp.Description(fmt.Sprintf("Maximum number of items to return when fetch_all is true (default %d)", DefaultFetchAllMaxItems)),
+			mcp.Min(1),
+		)(tool)
+	}
+}
+
 type PaginationParams struct {
-	Page    int
-	PerPage int
-	After   string
+	Page     int
+	PerPage  int
+	After    string
+	FetchAll bool
+	MaxItems int
 }
 
 // OptionalPaginationParams returns the "page", "perPage", and "after" parameters from the request,
 // or their default values if not present, "page" default is 1, "perPage" default is 30.
+// The "fetch_all" and "max_items" parameters added by WithFetchAll are also returned; a zero
+// MaxItems means fetchAllPages applies DefaultFetchAllMaxItems.
 // In future, we may want to make the default values configurable, or even have this
 // function returned from `withPagination`, where the defaults are provided alongside
 // the min/max values.
@@ -251,13 +299,122 @@ func OptionalPaginationParams(r mcp.CallToolRequest) (PaginationParams, error) {
 	if err != nil {
 		return PaginationParams{}, err
 	}
+	fetchAll, err := OptionalParam[bool](r, "fetch_all")
+	if err != nil {
+		return PaginationParams{}, err
+	}
+	maxItems, err := OptionalIntParam(r, "max_items")
+	if err != nil {
+		return PaginationParams{}, err
+	}
 	return PaginationParams{
-		Page:    page,
-		PerPage: perPage,
-		After:   after,
+		Page:     page,
+		PerPage:  perPage,
+		After:    after,
+		FetchAll: fetchAll,
+		MaxItems: maxItems,
 	}, nil
 }
 
+// FetchAllResult is returned by tools when fetch_all is requested. It wraps the merged
+// items with whether the cap was hit and how many API calls were needed to collect them.
+type FetchAllResult[T any] struct {
+	Items     []T  `json:"items"`
+	Truncated bool `json:"truncated"`
+	APICalls  int  `json:"api_calls"`
+}
+
+// mapFetchAllResult converts the items of a fetch_all result, keeping its metadata.
+func mapFetchAllResult[T, U any](r *FetchAllResult[T], convert func([]T) []U) *FetchAllResult[U] {
+	return &FetchAllResult[U]{
+		Items:     convert(r.Items),
+		Truncated: r.Truncated,
+		APICalls:  r.APICalls,
+	}
+}
+
+// fetchAllPages repeatedly calls fetchPage, starting at startPage and following
+// resp.NextPage, until there are no more pages or maxItems have been collected.
+// The context is checked between pages so that cancelled requests stop early.
+// The last response is returned so callers can surface API errors consistently.
+func fetchAllPages[T any](ctx context.Context, startPage, maxItems int, fetchPage func(page int) ([]T, *github.Response, error)) (*FetchAllResult[T], *github.Response, error) {
+	return fetchAllPagesSkipping(ctx, startPage, 0, maxItems, fetchPage)
+}
+
+// fetchAllPagesFrom is fetchAllPages for a caller that pages with its own perPage. The caller's
+// page is translated into an item offset, so that page 2 of 30 starts at the 31st item even
+// though fetchPage is expected to read fetchAllPerPage items at a time.
+func fetchAllPagesFrom[T any](ctx context.Context, page, perPage, maxItems int, fetchPage func(page int) ([]T, *github.Response, error)) (*FetchAllResult[T], *github.Response, error) {
+	offset := (max(page, 1) - 1) * max(perPage, 1)
+	return fetchAllPagesSkipping(ctx, offset/fetchAllPerPage+1, offset%fetchAllPerPage, maxItems, fetchPage)
+}
+
+// fetchAllPagesSkipping is fetchAllPages, dropping the first skip items of the start page.
+func fetchAllPagesSkipping[T any](ctx context.Context, startPage, skip, maxItems int, fetchPage func(page int) ([]T, *github.Response, error)) (*FetchAllResult[T], *github.Response, error) {
+	if startPage < 1 {
+		startPage = 1
+	}
+	if maxItems < 1 {
+		maxItems = DefaultFetchAllMaxItems
+	}
+
+	result := &FetchAllResult[T]{Items: []T{}}
+	page := startPage
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+
+		items, resp, err := fetchPage(page)
+		result.APICalls++
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+
+		if skip > 0 {
+			n := min(skip, len(items))
+			items, skip = items[n:], skip-n
+		}
+		result.Items = append(result.Items, items...)
+		if len(result.Items) >= maxItems {
+			result.Truncated = len(result.Items) > maxItems || resp.NextPage != 0
+			result.Items = result.Items[:maxItems]
+			return result, resp, nil
+		}
+
+		if resp.NextPage == 0 {
+			return result, resp, nil
+		}
+		page = resp.NextPage
+	}
+}
+
+// forEachConcurrently calls fn for each index below n, with at most limit calls running at once.
+// Once ctx is cancelled, the indexes that haven't started are passed to skip instead, with the
+// context error. It returns when all calls have finished.
+func forEachConcurrently(ctx context.Context, n, limit int, fn func(i int), skip func(i int, err error)) {
+	sem := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if err := ctx.Err(); err != nil {
+			skip(i, err)
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			fn(i)
+		}()
+	}
+	wg.Wait()
+}
+
 // OptionalCursorPaginationParams returns the "perPage" and "after" parameters from the request,
 // without the "page" parameter, suitable for cursor-based pagination only.
 func OptionalCursorPaginationParams(r mcp.CallToolRequest) (CursorPaginationParams, error) {
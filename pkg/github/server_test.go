@@ -12,6 +12,7 @@ import (
 	"github.com/google/go-github/v73/github"
 	"github.com/shurcooL/githubv4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func stubGetClientFn(client *github.Client) GetClientFn {
@@ -246,6 +247,67 @@ func Test_RequiredInt(t *testing.T) {
 		})
 	}
 }
+
+func Test_RequiredBigInt(t *testing.T) {
+	tests := []struct {
+		name        string
+		params      map[string]interface{}
+		paramName   string
+		expected    int64
+		expectError bool
+	}{
+		{
+			name:        "valid number parameter",
+			params:      map[string]interface{}{"id": float64(42)},
+			paramName:   "id",
+			expected:    42,
+			expectError: false,
+		},
+		{
+			name:        "id larger than int32",
+			params:      map[string]interface{}{"id": float64(9876543210)},
+			paramName:   "id",
+			expected:    9876543210,
+			expectError: false,
+		},
+		{
+			name:        "missing parameter",
+			params:      map[string]interface{}{},
+			paramName:   "id",
+			expected:    0,
+			expectError: true,
+		},
+		{
+			name:        "fractional value",
+			params:      map[string]interface{}{"id": float64(1.5)},
+			paramName:   "id",
+			expected:    0,
+			expectError: true,
+		},
+		{
+			name:        "value too large for int64",
+			params:      map[string]interface{}{"id": float64(1e19)},
+			paramName:   "id",
+			expected:    0,
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			request := createMCPRequest(tc.params)
+			result, err := RequiredBigInt(request, tc.paramName)
+
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, result)
+			}
+		})
+	}
+}
+
 func Test_OptionalIntParam(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -528,6 +590,28 @@ func TestOptionalPaginationParams(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "fetch_all and max_items parameters",
+			params: map[string]any{
+				"fetch_all": true,
+				"max_items": float64(250),
+			},
+			expected: PaginationParams{
+				Page:     1,
+				PerPage:  30,
+				FetchAll: true,
+				MaxItems: 250,
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid fetch_all parameter",
+			params: map[string]any{
+				"fetch_all": "yes",
+			},
+			expected:    PaginationParams{},
+			expectError: true,
+		},
 		{
 			name: "invalid page parameter",
 			params: map[string]any{
@@ -560,3 +644,90 @@ func TestOptionalPaginationParams(t *testing.T) {
 		})
 	}
 }
+
+func Test_FetchAllPages(t *testing.T) {
+	// pager returns a fetch function over a fixed number of pages of the given size,
+	// recording how many times it was called.
+	pager := func(pages, size int, calls *int) func(page int) ([]int, *github.Response, error) {
+		return func(page int) ([]int, *github.Response, error) {
+			*calls++
+			items := make([]int, size)
+			for i := range items {
+				items[i] = (page-1)*size + i
+			}
+			resp := &github.Response{Response: &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}}
+			if page < pages {
+				resp.NextPage = page + 1
+			}
+			return items, resp, nil
+		}
+	}
+
+	t.Run("follows next page until exhausted", func(t *testing.T) {
+		calls := 0
+		result, _, err := fetchAllPages(context.Background(), 1, 0, pager(3, 10, &calls))
+		require.NoError(t, err)
+		assert.Len(t, result.Items, 30)
+		assert.False(t, result.Truncated)
+		assert.Equal(t, 3, result.APICalls)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("starts from the given page", func(t *testing.T) {
+		calls := 0
+		result, _, err := fetchAllPages(context.Background(), 2, 0, pager(3, 10, &calls))
+		require.NoError(t, err)
+		assert.Len(t, result.Items, 20)
+		assert.Equal(t, 10, result.Items[0])
+		assert.Equal(t, 2, result.APICalls)
+	})
+
+	t.Run("translates the caller's page into an item offset", func(t *testing.T) {
+		calls := 0
+		// Page 5 of 30 starts at item 120, the 21st item of the second page of fetchAllPerPage.
+		result, _, err := fetchAllPagesFrom(context.Background(), 5, 30, 10, pager(3, fetchAllPerPage, &calls))
+		require.NoError(t, err)
+		require.Len(t, result.Items, 10)
+		assert.Equal(t, 120, result.Items[0])
+		assert.True(t, result.Truncated)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("stops and truncates at the cap", func(t *testing.T) {
+		calls := 0
+		result, _, err := fetchAllPages(context.Background(), 1, 25, pager(5, 10, &calls))
+		require.NoError(t, err)
+		assert.Len(t, result.Items, 25)
+		assert.True(t, result.Truncated)
+		assert.Equal(t, 3, result.APICalls)
+	})
+
+	t.Run("cap exactly matching the last page is not truncated", func(t *testing.T) {
+		calls := 0
+		result, _, err := fetchAllPages(context.Background(), 1, 20, pager(2, 10, &calls))
+		require.NoError(t, err)
+		assert.Len(t, result.Items, 20)
+		assert.False(t, result.Truncated)
+	})
+
+	t.Run("respects context cancellation between pages", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		calls := 0
+		fetch := pager(5, 10, &calls)
+		_, _, err := fetchAllPages(ctx, 1, 0, func(page int) ([]int, *github.Response, error) {
+			cancel()
+			return fetch(page)
+		})
+		require.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("returns API errors with the response", func(t *testing.T) {
+		resp := &github.Response{Response: &http.Response{StatusCode: http.StatusInternalServerError}}
+		_, gotResp, err := fetchAllPages(context.Background(), 1, 0, func(_ int) ([]int, *github.Response, error) {
+			return nil, resp, errors.New("boom")
+		})
+		require.EqualError(t, err, "boom")
+		assert.Same(t, resp, gotResp)
+	})
+}
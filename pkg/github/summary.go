@@ -0,0 +1,228 @@
+package github
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v73/github"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const (
+	OutputModeFull    = "full"
+	OutputModeSummary = "summary"
+)
+
+const (
+	// issueSummaryFields describes the fields of IssueSummary.
+	issueSummaryFields = "number, title, state, state_reason, label names, assignee logins, comment count, timestamps and html_url"
+	// pullRequestSummaryFields describes the fields of PullRequestSummary.
+	pullRequestSummaryFields = "number, title, state, draft, author login, head and base refs, updated_at and html_url"
+	// pullRequestSearchSummaryFields describes the fields of PullRequestSearchSummary.
+	pullRequestSearchSummaryFields = "number, title, state, draft, author login, merged_at, updated_at and html_url"
+)
+
+// WithOutputMode adds an "output" parameter to a tool, allowing callers to request
+// compact summary objects instead of the full API objects. summaryFields describes
+// what the summary objects contain.
+func WithOutputMode(summaryFields string) mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("output",
+			mcp.Description(fmt.Sprintf("Shape of each result. 'summary' returns only %s, which is much smaller than the full object (default: full)", summaryFields)),
+			mcp.Enum(OutputModeFull, OutputModeSummary),
+		)(tool)
+	}
+}
+
+// OptionalOutputModeParam returns the "output" parameter from the request, defaulting to full.
+func OptionalOutputModeParam(r mcp.CallToolRequest) (string, error) {
+	mode, err := OptionalParam[string](r, "output")
+	if err != nil {
+		return "", err
+	}
+	switch mode {
+	case "":
+		return OutputModeFull, nil
+	case OutputModeFull, OutputModeSummary:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("invalid output %q: must be one of %s, %s", mode, OutputModeFull, OutputModeSummary)
+	}
+}
+
+// IssueSummary is the compact output type for issues, used when output=summary.
+type IssueSummary struct {
+	Number      int      `json:"number"`
+	Title       string   `json:"title"`
+	State       string   `json:"state"`
+	StateReason string   `json:"state_reason,omitempty"`
+	Labels      []string `json:"labels"`
+	Assignees   []string `json:"assignees"`
+	Comments    int      `json:"comments"`
+	CreatedAt   string   `json:"created_at,omitempty"`
+	UpdatedAt   string   `json:"updated_at,omitempty"`
+	HTMLURL     string   `json:"html_url"`
+}
+
+// summaryTimestamp renders a timestamp as RFC 3339, or an empty string if it is unset.
+func summaryTimestamp(ts github.Timestamp) string {
+	if ts.IsZero() {
+		return ""
+	}
+	return ts.UTC().Format(time.RFC3339)
+}
+
+// summarizeIssue converts an issue into its compact summary form.
+func summarizeIssue(issue *github.Issue) IssueSummary {
+	return IssueSummary{
+		Number:      issue.GetNumber(),
+		Title:       issue.GetTitle(),
+		State:       issue.GetState(),
+		StateReason: issue.GetStateReason(),
+		Labels:      issueLabelNames(issue),
+		Assignees:   issueAssigneeLogins(issue),
+		Comments:    issue.GetComments(),
+		CreatedAt:   summaryTimestamp(issue.GetCreatedAt()),
+		UpdatedAt:   summaryTimestamp(issue.GetUpdatedAt()),
+		HTMLURL:     issue.GetHTMLURL(),
+	}
+}
+
+// summarizeIssues converts a list of issues into their compact summary form.
+func summarizeIssues(issues []*github.Issue) []IssueSummary {
+	summaries := make([]IssueSummary, 0, len(issues))
+	for _, issue := range issues {
+		summaries = append(summaries, summarizeIssue(issue))
+	}
+	return summaries
+}
+
+// summarizeSubIssues converts a list of sub-issues into their compact summary form.
+func summarizeSubIssues(subIssues []*github.SubIssue) []IssueSummary {
+	summaries := make([]IssueSummary, 0, len(subIssues))
+	for _, subIssue := range subIssues {
+		summaries = append(summaries, summarizeIssue((*github.Issue)(subIssue)))
+	}
+	return summaries
+}
+
+// PullRequestSummary is the compact output type for pull requests, used when output=summary.
+type PullRequestSummary struct {
+	Number    int    `json:"number"`
+	Title     string `json:"title"`
+	State     string `json:"state"`
+	Draft     bool   `json:"draft"`
+	Author    string `json:"author"`
+	Head      string `json:"head"`
+	Base      string `json:"base"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+	HTMLURL   string `json:"html_url"`
+}
+
+// summarizePullRequests converts a list of pull requests into their compact summary form.
+func summarizePullRequests(prs []*github.PullRequest) []PullRequestSummary {
+	summaries := make([]PullRequestSummary, 0, len(prs))
+	for _, pr := range prs {
+		summaries = append(summaries, PullRequestSummary{
+			Number:    pr.GetNumber(),
+			Title:     pr.GetTitle(),
+			State:     pr.GetState(),
+			Draft:     pr.GetDraft(),
+			Author:    pr.GetUser().GetLogin(),
+			Head:      pr.GetHead().GetRef(),
+			Base:      pr.GetBase().GetRef(),
+			UpdatedAt: summaryTimestamp(pr.GetUpdatedAt()),
+			HTMLURL:   pr.GetHTMLURL(),
+		})
+	}
+	return summaries
+}
+
+// PullRequestSearchSummary is the compact output type for pull requests found by search_pull_requests,
+// used when output=summary. Search returns pull requests in issue form, so head and base refs aren't known.
+type PullRequestSearchSummary struct {
+	Number    int    `json:"number"`
+	Title     string `json:"title"`
+	State     string `json:"state"`
+	Draft     bool   `json:"draft"`
+	Author    string `json:"author"`
+	MergedAt  string `json:"merged_at,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+	HTMLURL   string `json:"html_url"`
+}
+
+// summarizeSearchedPullRequests converts pull requests returned by the search API into their compact summary form.
+func summarizeSearchedPullRequests(issues []*github.Issue) []PullRequestSearchSummary {
+	summaries := make([]PullRequestSearchSummary, 0, len(issues))
+	for _, issue := range issues {
+		summaries = append(summaries, PullRequestSearchSummary{
+			Number:    issue.GetNumber(),
+			Title:     issue.GetTitle(),
+			State:     issue.GetState(),
+			Draft:     issue.GetDraft(),
+			Author:    issue.GetUser().GetLogin(),
+			MergedAt:  summaryTimestamp(issue.GetPullRequestLinks().GetMergedAt()),
+			UpdatedAt: summaryTimestamp(issue.GetUpdatedAt()),
+			HTMLURL:   issue.GetHTMLURL(),
+		})
+	}
+	return summaries
+}
+
+// IssueSummaryList is the structured output type of tools that list issues.
+type IssueSummaryList struct {
+	Issues []IssueSummary `json:"issues"`
+}
+
+// IssueCommentSummary is the compact output type for issue comments.
+type IssueCommentSummary struct {
+	ID        int64  `json:"id"`
+	Body      string `json:"body"`
+	Author    string `json:"author"`
+	CreatedAt string `json:"created_at,omitempty"`
+	HTMLURL   string `json:"html_url"`
+}
+
+// summarizeIssueComment converts an issue comment into its compact summary form.
+func summarizeIssueComment(comment *github.IssueComment) IssueCommentSummary {
+	return IssueCommentSummary{
+		ID:        comment.GetID(),
+		Body:      comment.GetBody(),
+		Author:    comment.GetUser().GetLogin(),
+		CreatedAt: summaryTimestamp(comment.GetCreatedAt()),
+		HTMLURL:   comment.GetHTMLURL(),
+	}
+}
+
+// RepositorySummary is the compact output type for repositories.
+type RepositorySummary struct {
+	FullName      string `json:"full_name"`
+	Owner         string `json:"owner"`
+	Stars         int    `json:"stars"`
+	DefaultBranch string `json:"default_branch,omitempty"`
+	PushedAt      string `json:"pushed_at,omitempty"`
+	HTMLURL       string `json:"html_url"`
+}
+
+// summarizeRepository converts a repository into its compact summary form.
+func summarizeRepository(repo *github.Repository) RepositorySummary {
+	return RepositorySummary{
+		FullName:      repo.GetFullName(),
+		Owner:         repo.GetOwner().GetLogin(),
+		Stars:         repo.GetStargazersCount(),
+		DefaultBranch: repo.GetDefaultBranch(),
+		PushedAt:      summaryTimestamp(repo.GetPushedAt()),
+		HTMLURL:       repo.GetHTMLURL(),
+	}
+}
+
+// withStructuredContent attaches structured content, which must conform to the tool's
+// output schema, to a successful result. The existing text content is kept as the
+// fallback for clients that don't support structured tool output.
+func withStructuredContent(result *mcp.CallToolResult, structured any) *mcp.CallToolResult {
+	if result.IsError {
+		return result
+	}
+	result.StructuredContent = structured
+	return result
+}
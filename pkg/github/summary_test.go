@@ -0,0 +1,389 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v73/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_OptionalOutputModeParam(t *testing.T) {
+	tests := []struct {
+		name        string
+		params      map[string]any
+		expected    string
+		expectError bool
+	}{
+		{
+			name:     "defaults to full",
+			params:   map[string]any{},
+			expected: OutputModeFull,
+		},
+		{
+			name:     "summary",
+			params:   map[string]any{"output": "summary"},
+			expected: OutputModeSummary,
+		},
+		{
+			name:        "unknown mode",
+			params:      map[string]any{"output": "tiny"},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mode, err := OptionalOutputModeParam(createMCPRequest(tc.params))
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, mode)
+		})
+	}
+}
+
+func Test_SummarizeIssue(t *testing.T) {
+	created := github.Timestamp{Time: time.Date(2024, 3, 14, 9, 0, 0, 0, time.UTC)}
+	issue := &github.Issue{
+		Number:      github.Ptr(42),
+		Title:       github.Ptr("Found a bug"),
+		State:       github.Ptr("closed"),
+		StateReason: github.Ptr("completed"),
+		Body:        github.Ptr("A very long body that is not part of the summary"),
+		User:        &github.User{Login: github.Ptr("reporter")},
+		Labels:      []*github.Label{{Name: github.Ptr("bug"), Color: github.Ptr("f29513")}},
+		Assignees:   []*github.User{{Login: github.Ptr("fixer")}},
+		Comments:    github.Ptr(3),
+		CreatedAt:   &created,
+		HTMLURL:     github.Ptr("https://github.com/owner/repo/issues/42"),
+		URL:         github.Ptr("https://api.github.com/repos/owner/repo/issues/42"),
+	}
+
+	assert.Equal(t, IssueSummary{
+		Number:      42,
+		Title:       "Found a bug",
+		State:       "closed",
+		StateReason: "completed",
+		Labels:      []string{"bug"},
+		Assignees:   []string{"fixer"},
+		Comments:    3,
+		CreatedAt:   "2024-03-14T09:00:00Z",
+		HTMLURL:     "https://github.com/owner/repo/issues/42",
+	}, summarizeIssue(issue))
+
+	// Empty label and assignee lists are kept as empty arrays rather than null.
+	data, err := json.Marshal(summarizeIssue(&github.Issue{Number: github.Ptr(1)}))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"number":1,"title":"","state":"","labels":[],"assignees":[],"comments":0,"html_url":""}`, string(data))
+}
+
+func Test_ListIssues_SummaryOutput(t *testing.T) {
+	mockIssues := []*github.Issue{
+		{
+			Number:  github.Ptr(123),
+			Title:   github.Ptr("First Issue"),
+			State:   github.Ptr("open"),
+			Body:    github.Ptr("This body is dropped in summary mode"),
+			HTMLURL: github.Ptr("https://github.com/owner/repo/issues/123"),
+			Labels:  []*github.Label{{Name: github.Ptr("bug")}},
+		},
+	}
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetReposIssuesByOwnerByRepo,
+			mockIssues,
+		),
+	))
+	_, handler := ListIssues(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":  "owner",
+		"repo":   "repo",
+		"output": "summary",
+	}))
+	require.NoError(t, err)
+	textContent := getTextResult(t, result)
+
+	var returned []IssueSummary
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+	assert.Equal(t, summarizeIssues(mockIssues), returned)
+	assert.NotContains(t, textContent.Text, "This body is dropped")
+}
+
+func Test_ListPullRequests_SummaryOutput(t *testing.T) {
+	updated := github.Timestamp{Time: time.Date(2024, 3, 14, 9, 0, 0, 0, time.UTC)}
+	mockPRs := []*github.PullRequest{
+		{
+			Number:    github.Ptr(42),
+			Title:     github.Ptr("Add a feature"),
+			State:     github.Ptr("open"),
+			Draft:     github.Ptr(true),
+			Body:      github.Ptr("This body is dropped in summary mode"),
+			User:      &github.User{Login: github.Ptr("author")},
+			Head:      &github.PullRequestBranch{Ref: github.Ptr("feature/x"), SHA: github.Ptr("abc123")},
+			Base:      &github.PullRequestBranch{Ref: github.Ptr("main")},
+			UpdatedAt: &updated,
+			HTMLURL:   github.Ptr("https://github.com/owner/repo/pull/42"),
+		},
+	}
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetReposPullsByOwnerByRepo,
+			mockPRs,
+		),
+	))
+	_, handler := ListPullRequests(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":  "owner",
+		"repo":   "repo",
+		"output": "summary",
+	}))
+	require.NoError(t, err)
+	textContent := getTextResult(t, result)
+
+	var returned []PullRequestSummary
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+	assert.Equal(t, []PullRequestSummary{
+		{
+			Number:    42,
+			Title:     "Add a feature",
+			State:     "open",
+			Draft:     true,
+			Author:    "author",
+			Head:      "feature/x",
+			Base:      "main",
+			UpdatedAt: "2024-03-14T09:00:00Z",
+			HTMLURL:   "https://github.com/owner/repo/pull/42",
+		},
+	}, returned)
+	assert.NotContains(t, textContent.Text, "This body is dropped")
+}
+
+func Test_SearchIssues_SummaryOutput(t *testing.T) {
+	mockSearchResult := &github.IssuesSearchResult{
+		Total:             github.Ptr(1),
+		IncompleteResults: github.Ptr(false),
+		Issues: []*github.Issue{
+			{
+				Number: github.Ptr(7),
+				Title:  github.Ptr("Search hit"),
+				State:  github.Ptr("open"),
+				Body:   github.Ptr("Not in the summary"),
+			},
+		},
+	}
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetSearchIssues,
+			mockSearchResult,
+		),
+	))
+	_, handler := SearchIssues(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"query":  "is:open",
+		"output": "summary",
+	}))
+	require.NoError(t, err)
+	textContent := getTextResult(t, result)
+
+	var returned struct {
+		TotalCount int            `json:"total_count"`
+		Items      []IssueSummary `json:"items"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+	assert.Equal(t, 1, returned.TotalCount)
+	assert.Equal(t, summarizeIssues(mockSearchResult.Issues), returned.Items)
+	assert.NotContains(t, textContent.Text, "Not in the summary")
+}
+
+func Test_SearchPullRequests_SummaryOutput(t *testing.T) {
+	updated := github.Timestamp{Time: time.Date(2024, 3, 14, 9, 0, 0, 0, time.UTC)}
+	merged := github.Timestamp{Time: time.Date(2024, 3, 15, 12, 30, 0, 0, time.UTC)}
+	mockSearchResult := &github.IssuesSearchResult{
+		Total:             github.Ptr(2),
+		IncompleteResults: github.Ptr(false),
+		Issues: []*github.Issue{
+			{
+				Number:           github.Ptr(42),
+				Title:            github.Ptr("Merged change"),
+				State:            github.Ptr("closed"),
+				Body:             github.Ptr("Not in the summary"),
+				User:             &github.User{Login: github.Ptr("author")},
+				PullRequestLinks: &github.PullRequestLinks{MergedAt: &merged},
+				UpdatedAt:        &updated,
+				HTMLURL:          github.Ptr("https://github.com/owner/repo/pull/42"),
+			},
+			{
+				Number:           github.Ptr(43),
+				Title:            github.Ptr("Work in progress"),
+				State:            github.Ptr("open"),
+				Draft:            github.Ptr(true),
+				User:             &github.User{Login: github.Ptr("author")},
+				PullRequestLinks: &github.PullRequestLinks{},
+				HTMLURL:          github.Ptr("https://github.com/owner/repo/pull/43"),
+			},
+		},
+	}
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetSearchIssues,
+			expectQueryParams(t, map[string]string{
+				"q":        "repo:owner/repo is:pr is:merged",
+				"page":     "1",
+				"per_page": "30",
+			}).andThen(
+				mockResponse(t, http.StatusOK, mockSearchResult),
+			),
+		),
+	))
+	_, handler := SearchPullRequests(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"query":  "is:merged",
+		"owner":  "owner",
+		"repo":   "repo",
+		"output": "summary",
+	}))
+	require.NoError(t, err)
+	textContent := getTextResult(t, result)
+
+	var returned struct {
+		TotalCount int                        `json:"total_count"`
+		Items      []PullRequestSearchSummary `json:"items"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+	assert.Equal(t, 2, returned.TotalCount)
+	assert.Equal(t, []PullRequestSearchSummary{
+		{
+			Number:    42,
+			Title:     "Merged change",
+			State:     "closed",
+			Author:    "author",
+			MergedAt:  "2024-03-15T12:30:00Z",
+			UpdatedAt: "2024-03-14T09:00:00Z",
+			HTMLURL:   "https://github.com/owner/repo/pull/42",
+		},
+		{
+			Number:  43,
+			Title:   "Work in progress",
+			State:   "open",
+			Draft:   true,
+			Author:  "author",
+			HTMLURL: "https://github.com/owner/repo/pull/43",
+		},
+	}, returned.Items)
+	assert.NotContains(t, textContent.Text, "Not in the summary")
+}
+
+func Test_IssueTools_StructuredContent(t *testing.T) {
+	created := github.Timestamp{Time: time.Date(2024, 3, 14, 9, 0, 0, 0, time.UTC)}
+	mockIssue := &github.Issue{
+		Number:      github.Ptr(42),
+		Title:       github.Ptr("Found a bug"),
+		State:       github.Ptr("open"),
+		Body:        github.Ptr("Details"),
+		Labels:      []*github.Label{{Name: github.Ptr("bug")}},
+		Assignees:   []*github.User{{Login: github.Ptr("fixer")}},
+		CreatedAt:   &created,
+		UpdatedAt:   &created,
+		HTMLURL:     github.Ptr("https://github.com/owner/repo/issues/42"),
+		StateReason: github.Ptr("reopened"),
+	}
+	mockComment := &github.IssueComment{
+		ID:        github.Ptr(int64(123)),
+		Body:      github.Ptr("A comment"),
+		User:      &github.User{Login: github.Ptr("commenter")},
+		CreatedAt: &created,
+		HTMLURL:   github.Ptr("https://github.com/owner/repo/issues/42#issuecomment-123"),
+	}
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetReposIssuesByOwnerByRepoByIssueNumber,
+			mockIssue,
+			mockIssue,
+		),
+		mock.WithRequestMatch(
+			mock.GetReposIssuesByOwnerByRepo,
+			[]*github.Issue{mockIssue},
+		),
+		mock.WithRequestMatchHandler(
+			mock.PostReposIssuesByOwnerByRepo,
+			mockResponse(t, http.StatusCreated, mockIssue),
+		),
+		mock.WithRequestMatchHandler(
+			mock.PostReposIssuesCommentsByOwnerByRepoByIssueNumber,
+			mockResponse(t, http.StatusCreated, mockComment),
+		),
+	))
+	getClient := stubGetClientFn(client)
+
+	tests := []struct {
+		name         string
+		tool         func(GetClientFn, translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc)
+		requestArgs  map[string]any
+		expectedData any
+	}{
+		{
+			name:         "get_issue",
+			tool:         GetIssue,
+			requestArgs:  map[string]any{"owner": "owner", "repo": "repo", "issue_number": float64(42)},
+			expectedData: summarizeIssue(mockIssue),
+		},
+		{
+			name:         "get_issue as markdown",
+			tool:         GetIssue,
+			requestArgs:  map[string]any{"owner": "owner", "repo": "repo", "issue_number": float64(42), "format": "markdown"},
+			expectedData: summarizeIssue(mockIssue),
+		},
+		{
+			name:         "list_issues",
+			tool:         ListIssues,
+			requestArgs:  map[string]any{"owner": "owner", "repo": "repo"},
+			expectedData: IssueSummaryList{Issues: summarizeIssues([]*github.Issue{mockIssue})},
+		},
+		{
+			name:         "create_issue",
+			tool:         CreateIssue,
+			requestArgs:  map[string]any{"owner": "owner", "repo": "repo", "title": "Found a bug"},
+			expectedData: summarizeIssue(mockIssue),
+		},
+		{
+			name:         "add_issue_comment",
+			tool:         AddIssueComment,
+			requestArgs:  map[string]any{"owner": "owner", "repo": "repo", "issue_number": float64(42), "body": "A comment"},
+			expectedData: summarizeIssueComment(mockComment),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tool, handler := tc.tool(getClient, translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			// The text content is kept as a fallback for clients without structured output support.
+			assert.NotEmpty(t, getTextResult(t, result).Text)
+			requireStructuredContentMatchesSchema(t, tool, result)
+			assert.Equal(t, tc.expectedData, result.StructuredContent)
+		})
+	}
+}
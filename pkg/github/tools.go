@@ -25,20 +25,44 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 		AddReadTools(
 			toolsets.NewServerTool(SearchRepositories(getClient, t)),
 			toolsets.NewServerTool(GetFileContents(getClient, getRawClient, t)),
+			toolsets.NewServerTool(GetRepositoryTree(getClient, t)),
 			toolsets.NewServerTool(ListCommits(getClient, t)),
+			toolsets.NewServerTool(CompareRefs(getClient, t)),
 			toolsets.NewServerTool(SearchCode(getClient, t)),
 			toolsets.NewServerTool(GetCommit(getClient, t)),
 			toolsets.NewServerTool(ListBranches(getClient, t)),
 			toolsets.NewServerTool(ListTags(getClient, t)),
 			toolsets.NewServerTool(GetTag(getClient, t)),
+			toolsets.NewServerTool(GetRepositoryTopics(getClient, t)),
+			toolsets.NewServerTool(GetRepositoryTraffic(getClient, t)),
+			toolsets.NewServerTool(GetRepositoryActivityStats(getClient, t)),
+			toolsets.NewServerTool(ListStargazers(getClient, t)),
+			toolsets.NewServerTool(GetRepositoryHealth(getClient, t)),
+			toolsets.NewServerTool(ListCollaborators(getClient, t)),
+			toolsets.NewServerTool(GetCollaboratorPermission(getClient, t)),
+			toolsets.NewServerTool(ListRepositoryRulesets(getClient, t)),
+			toolsets.NewServerTool(GetCodeownersErrors(getClient, t)),
+			toolsets.NewServerTool(ListAutolinks(getClient, t)),
+			toolsets.NewServerTool(ListForks(getClient, t)),
+			toolsets.NewServerTool(ListReleases(getClient, t)),
+			toolsets.NewServerTool(GetLatestRelease(getClient, t)),
+			toolsets.NewServerTool(GetReleaseByTag(getClient, t)),
+			toolsets.NewServerTool(GenerateReleaseNotes(getClient, t)),
 		).
 		AddWriteTools(
 			toolsets.NewServerTool(CreateOrUpdateFile(getClient, t)),
+			toolsets.NewServerTool(DownloadRepositoryArchive(getClient, t)),
 			toolsets.NewServerTool(CreateRepository(getClient, t)),
 			toolsets.NewServerTool(ForkRepository(getClient, t)),
 			toolsets.NewServerTool(CreateBranch(getClient, t)),
-			toolsets.NewServerTool(PushFiles(getClient, t)),
+			toolsets.NewServerTool(PushFiles(getClient, getGQLClient, t)),
 			toolsets.NewServerTool(DeleteFile(getClient, t)),
+			toolsets.NewServerTool(UpdateRepositoryTopics(getClient, t)),
+			toolsets.NewServerTool(AddCollaborator(getClient, t)),
+			toolsets.NewServerTool(RemoveCollaborator(getClient, t)),
+			toolsets.NewServerTool(CreateAutolink(getClient, t)),
+			toolsets.NewServerTool(DeleteAutolink(getClient, t)),
+			toolsets.NewServerTool(CreateRelease(getClient, t)),
 		).
 		AddResourceTemplates(
 			toolsets.NewServerResourceTemplate(GetRepositoryResourceContent(getClient, getRawClient, t)),
@@ -52,18 +76,33 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 			toolsets.NewServerTool(GetIssue(getClient, t)),
 			toolsets.NewServerTool(SearchIssues(getClient, t)),
 			toolsets.NewServerTool(ListIssues(getClient, t)),
+			toolsets.NewServerTool(ListIssuesGraphQL(getGQLClient, t)),
 			toolsets.NewServerTool(GetIssueComments(getClient, t)),
 			toolsets.NewServerTool(ListSubIssues(getClient, t)),
+			toolsets.NewServerTool(GetSubIssueProgress(getClient, t)),
+			toolsets.NewServerTool(ListCopilotSessions(getGQLClient, t)),
+			toolsets.NewServerTool(ListIssueLinkedPullRequests(getGQLClient, t)),
+			toolsets.NewServerTool(GetCopilotSessionLogs(getClient, t)),
 		).
 		AddWriteTools(
 			toolsets.NewServerTool(CreateIssue(getClient, t)),
 			toolsets.NewServerTool(AddIssueComment(getClient, t)),
 			toolsets.NewServerTool(UpdateIssue(getClient, t)),
 			toolsets.NewServerTool(AssignCopilotToIssue(getGQLClient, t)),
+			toolsets.NewServerTool(UnassignCopilotFromIssue(getGQLClient, t)),
 			toolsets.NewServerTool(AddSubIssue(getClient, t)),
 			toolsets.NewServerTool(RemoveSubIssue(getClient, t)),
 			toolsets.NewServerTool(ReprioritizeSubIssue(getClient, t)),
-		).AddPrompts(toolsets.NewServerPrompt(AssignCodingAgentPrompt(t)))
+			toolsets.NewServerTool(CreateLabel(getClient, t)),
+			toolsets.NewServerTool(UpdateLabel(getClient, t)),
+			toolsets.NewServerTool(DeleteLabel(getClient, t)),
+		).
+		AddPrompts(
+			toolsets.NewServerPrompt(AssignCodingAgentPrompt(t)),
+			toolsets.NewServerPrompt(SprintPlanningPrompt(t)),
+			toolsets.NewServerPrompt(IssueTriagePrompt(t)),
+			toolsets.NewServerPrompt(ReleaseNotesPrompt(t)),
+		)
 	users := toolsets.NewToolset("users", "GitHub User related tools").
 		AddReadTools(
 			toolsets.NewServerTool(SearchUsers(getClient, t)),
@@ -77,10 +116,14 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 			toolsets.NewServerTool(GetPullRequest(getClient, t)),
 			toolsets.NewServerTool(ListPullRequests(getClient, t)),
 			toolsets.NewServerTool(GetPullRequestFiles(getClient, t)),
+			toolsets.NewServerTool(GetPullRequestDiffstat(getClient, t)),
 			toolsets.NewServerTool(SearchPullRequests(getClient, t)),
 			toolsets.NewServerTool(GetPullRequestStatus(getClient, t)),
+			toolsets.NewServerTool(GetPullRequestConflicts(getClient, t)),
 			toolsets.NewServerTool(GetPullRequestComments(getClient, t)),
+			toolsets.NewServerTool(ListPullRequestReviewComments(getClient, t)),
 			toolsets.NewServerTool(GetPullRequestReviews(getClient, t)),
+			toolsets.NewServerTool(ListPullRequestReviews(getClient, t)),
 			toolsets.NewServerTool(GetPullRequestDiff(getClient, t)),
 		).
 		AddWriteTools(
@@ -88,7 +131,11 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 			toolsets.NewServerTool(UpdatePullRequestBranch(getClient, t)),
 			toolsets.NewServerTool(CreatePullRequest(getClient, t)),
 			toolsets.NewServerTool(UpdatePullRequest(getClient, t)),
+			toolsets.NewServerTool(SetPullRequestDraft(getGQLClient, t)),
+			toolsets.NewServerTool(ClosePullRequest(getClient, t)),
 			toolsets.NewServerTool(RequestCopilotReview(getClient, t)),
+			toolsets.NewServerTool(RerequestPullRequestReview(getClient, t)),
+			toolsets.NewServerTool(ReplyToPullRequestReviewComment(getClient, t)),
 
 			// Reviews
 			toolsets.NewServerTool(CreateAndSubmitPullRequestReview(getGQLClient, t)),
@@ -96,33 +143,68 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 			toolsets.NewServerTool(AddCommentToPendingReview(getGQLClient, t)),
 			toolsets.NewServerTool(SubmitPendingPullRequestReview(getGQLClient, t)),
 			toolsets.NewServerTool(DeletePendingPullRequestReview(getGQLClient, t)),
+		).
+		AddPrompts(
+			toolsets.NewServerPrompt(PullRequestDescriptionPrompt(t)),
 		)
 	codeSecurity := toolsets.NewToolset("code_security", "Code security related tools, such as GitHub Code Scanning").
 		AddReadTools(
 			toolsets.NewServerTool(GetCodeScanningAlert(getClient, t)),
 			toolsets.NewServerTool(ListCodeScanningAlerts(getClient, t)),
+			toolsets.NewServerTool(ListCodeScanningAnalyses(getClient, t)),
+			toolsets.NewServerTool(GetCodeScanningDefaultSetup(getClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(UpdateCodeScanningAlert(getClient, t)),
 		)
 	secretProtection := toolsets.NewToolset("secret_protection", "Secret protection related tools, such as GitHub Secret Scanning").
 		AddReadTools(
 			toolsets.NewServerTool(GetSecretScanningAlert(getClient, t)),
 			toolsets.NewServerTool(ListSecretScanningAlerts(getClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(UpdateSecretScanningAlert(getClient, t)),
 		)
 	dependabot := toolsets.NewToolset("dependabot", "Dependabot tools").
 		AddReadTools(
 			toolsets.NewServerTool(GetDependabotAlert(getClient, t)),
 			toolsets.NewServerTool(ListDependabotAlerts(getClient, t)),
+			toolsets.NewServerTool(GetRepositorySBOM(getClient, t)),
+			toolsets.NewServerTool(GetDependencyDiff(getClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(UpdateDependabotAlert(getClient, t)),
+			toolsets.NewServerTool(SaveRepositorySBOM(getClient, t)),
+		)
+	securityAdvisories := toolsets.NewToolset("security_advisories", "Repository security advisory related tools").
+		AddReadTools(
+			toolsets.NewServerTool(ListRepositorySecurityAdvisories(getClient, t)),
+			toolsets.NewServerTool(GetRepositorySecurityAdvisory(getClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(CreateRepositorySecurityAdvisoryDraft(getClient, t)),
 		)
 
 	notifications := toolsets.NewToolset("notifications", "GitHub Notifications related tools").
 		AddReadTools(
-			toolsets.NewServerTool(ListNotifications(getClient, t)),
+			toolsets.NewServerTool(ListNotifications(getClient, getGQLClient, t)),
 			toolsets.NewServerTool(GetNotificationDetails(getClient, t)),
+			toolsets.NewServerTool(GetNotificationThread(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(GetThreadSubscription(getClient, t)),
+			toolsets.NewServerTool(GetRepositorySubscription(getClient, t)),
+			toolsets.NewServerTool(ListWatchedRepositories(getClient, t)),
 		).
 		AddWriteTools(
 			toolsets.NewServerTool(DismissNotification(getClient, t)),
+			toolsets.NewServerTool(MarkNotificationRead(getClient, t)),
+			toolsets.NewServerTool(MarkNotificationDone(getClient, t)),
 			toolsets.NewServerTool(MarkAllNotificationsRead(getClient, t)),
 			toolsets.NewServerTool(ManageNotificationSubscription(getClient, t)),
+			toolsets.NewServerTool(SetThreadSubscription(getClient, t)),
+			toolsets.NewServerTool(DeleteThreadSubscription(getClient, t)),
 			toolsets.NewServerTool(ManageRepositoryNotificationSubscription(getClient, t)),
+			toolsets.NewServerTool(SetRepositorySubscription(getClient, t)),
+			toolsets.NewServerTool(DeleteRepositorySubscription(getClient, t)),
 		)
 
 	discussions := toolsets.NewToolset("discussions", "GitHub Discussions related tools").
@@ -131,6 +213,10 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 			toolsets.NewServerTool(GetDiscussion(getGQLClient, t)),
 			toolsets.NewServerTool(GetDiscussionComments(getGQLClient, t)),
 			toolsets.NewServerTool(ListDiscussionCategories(getGQLClient, t)),
+			toolsets.NewServerTool(SearchDiscussions(getGQLClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(AddDiscussionComment(getGQLClient, t)),
 		)
 
 	actions := toolsets.NewToolset("actions", "GitHub Actions workflows and CI/CD operations").
@@ -144,13 +230,21 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 			toolsets.NewServerTool(ListWorkflowRunArtifacts(getClient, t)),
 			toolsets.NewServerTool(DownloadWorkflowRunArtifact(getClient, t)),
 			toolsets.NewServerTool(GetWorkflowRunUsage(getClient, t)),
+			toolsets.NewServerTool(GetPendingDeployments(getClient, t)),
+			toolsets.NewServerTool(ListActionsVariables(getClient, t)),
+			toolsets.NewServerTool(ListActionsSecrets(getClient, t)),
 		).
 		AddWriteTools(
 			toolsets.NewServerTool(RunWorkflow(getClient, t)),
+			toolsets.NewServerTool(SaveWorkflowRunArtifact(getClient, t)),
 			toolsets.NewServerTool(RerunWorkflowRun(getClient, t)),
 			toolsets.NewServerTool(RerunFailedJobs(getClient, t)),
 			toolsets.NewServerTool(CancelWorkflowRun(getClient, t)),
 			toolsets.NewServerTool(DeleteWorkflowRunLogs(getClient, t)),
+			toolsets.NewServerTool(DeleteArtifact(getClient, t)),
+			toolsets.NewServerTool(CleanupArtifacts(getClient, t)),
+			toolsets.NewServerTool(ReviewPendingDeployments(getClient, t)),
+			toolsets.NewServerTool(ApproveWorkflowRun(getClient, t)),
 		)
 
 	// Keep experiments alive so the system doesn't error out when it's always enabled
@@ -172,6 +266,7 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 	tsg.AddToolset(codeSecurity)
 	tsg.AddToolset(secretProtection)
 	tsg.AddToolset(dependabot)
+	tsg.AddToolset(securityAdvisories)
 	tsg.AddToolset(notifications)
 	tsg.AddToolset(experiments)
 	tsg.AddToolset(discussions)